@@ -100,7 +100,8 @@ func TestBaseApp_BlockGas(t *testing.T) {
 			txBuilder.SetFeeAmount(feeAmount)
 			txBuilder.SetGasLimit(txtypes.MaxGasWanted) // tx validation checks that gasLimit can't be bigger than this
 
-			privs, accNums, accSeqs := []cryptotypes.PrivKey{priv1}, []uint64{7}, []uint64{0}
+			accNum := app.AccountKeeper.GetAccount(ctx, addr1).GetAccountNumber()
+			privs, accNums, accSeqs := []cryptotypes.PrivKey{priv1}, []uint64{accNum}, []uint64{0}
 			_, txBytes, err := createTestTx(encCfg.TxConfig, txBuilder, privs, accNums, accSeqs, ctx.ChainID())
 			require.NoError(t, err)
 
@@ -123,7 +124,10 @@ func TestBaseApp_BlockGas(t *testing.T) {
 				require.Equal(t, []byte("ok"), okValue)
 			}
 			// check block gas is always consumed
-			baseGas := uint64(18992) // baseGas is the gas consumed before tx msg
+			baseGas := uint64(21406) // baseGas is the gas consumed before tx msg
+			if !tc.panicTx {
+				baseGas += 282 // the post handler reads the gas refund params after the tx msg
+			}
 			expGasConsumed := addUint64Saturating(tc.gasToConsume, baseGas)
 			if expGasConsumed > txtypes.MaxGasWanted {
 				// capped by gasLimit
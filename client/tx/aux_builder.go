@@ -1,6 +1,8 @@
 package tx
 
 import (
+	"time"
+
 	"github.com/gogo/protobuf/proto"
 
 	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
@@ -52,6 +54,27 @@ func (b *AuxTxBuilder) SetTimeoutHeight(height uint64) {
 	b.auxSignerData.SignDoc.BodyBytes = nil
 }
 
+// SetUnordered sets whether the tx is unordered.
+func (b *AuxTxBuilder) SetUnordered(unordered bool) {
+	b.checkEmptyFields()
+
+	b.body.Unordered = unordered
+	b.auxSignerData.SignDoc.BodyBytes = nil
+}
+
+// SetTimeoutTimestamp sets a timeout timestamp in the tx. The zero time unsets
+// it.
+func (b *AuxTxBuilder) SetTimeoutTimestamp(timestamp time.Time) {
+	b.checkEmptyFields()
+
+	if timestamp.IsZero() {
+		b.body.TimeoutTimestamp = nil
+	} else {
+		b.body.TimeoutTimestamp = &timestamp
+	}
+	b.auxSignerData.SignDoc.BodyBytes = nil
+}
+
 // SetMsgs sets an array of Msgs in the tx.
 func (b *AuxTxBuilder) SetMsgs(msgs ...sdk.Msg) error {
 	anys := make([]*codectypes.Any, len(msgs))
@@ -190,6 +213,10 @@ func (b *AuxTxBuilder) GetSignBytes() ([]byte, error) {
 		}
 	case signing.SignMode_SIGN_MODE_LEGACY_AMINO_JSON:
 		{
+			if b.body.TimeoutTimestamp != nil {
+				return nil, sdkerrors.ErrInvalidRequest.Wrapf("%s does not support a timeout timestamp", signing.SignMode_SIGN_MODE_LEGACY_AMINO_JSON)
+			}
+
 			signBz = legacytx.StdSignBytes(
 				b.auxSignerData.SignDoc.ChainId, b.auxSignerData.SignDoc.AccountNumber,
 				b.auxSignerData.SignDoc.Sequence, b.body.TimeoutHeight,
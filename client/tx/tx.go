@@ -156,13 +156,18 @@ func SignWithPrivKey(
 	var sigV2 signing.SignatureV2
 
 	// Generate the bytes to be signed.
-	signBytes, err := txConfig.SignModeHandler().GetSignBytes(signMode, signerData, txBuilder.GetTx())
+	signBytes, err := authsigning.GetSignBytesWithContext(context.Background(), txConfig.SignModeHandler(), signMode, signerData, txBuilder.GetTx())
 	if err != nil {
 		return sigV2, err
 	}
 
 	// Sign those bytes
-	signature, err := priv.Sign(signBytes)
+	var signature []byte
+	if signMode == signing.SignMode_SIGN_MODE_EIP_191 {
+		signature, err = authsigning.SignEIP191(priv, signBytes)
+	} else {
+		signature, err = priv.Sign(signBytes)
+	}
 	if err != nil {
 		return sigV2, err
 	}
@@ -238,6 +243,18 @@ func Sign(txf Factory, name string, txBuilder client.TxBuilder, overwriteSig boo
 		signMode = txf.txConfig.SignModeHandler().DefaultMode()
 	}
 
+	// the unordered field is not part of the legacy amino JSON sign bytes,
+	// which are also wrapped by EIP-191
+	if txf.unordered && (signMode == signing.SignMode_SIGN_MODE_LEGACY_AMINO_JSON || signMode == signing.SignMode_SIGN_MODE_EIP_191) {
+		return fmt.Errorf("unordered txs cannot be signed with %s", signMode)
+	}
+
+	// the SIGN_MODE_TEXTUAL sign bytes render the coins with the denom metadata
+	// of the chain, which cannot be queried offline
+	if txf.offline && signMode == signing.SignMode_SIGN_MODE_TEXTUAL {
+		return fmt.Errorf("%s requires the denom metadata of the chain, and cannot be used offline", signMode)
+	}
+
 	k, err := txf.keybase.Key(name)
 	if err != nil {
 		return err
@@ -297,12 +314,13 @@ func Sign(txf Factory, name string, txBuilder client.TxBuilder, overwriteSig boo
 	}
 
 	// Generate the bytes to be signed.
-	bytesToSign, err := txf.txConfig.SignModeHandler().GetSignBytes(signMode, signerData, txBuilder.GetTx())
+	bytesToSign, err := authsigning.GetSignBytesWithContext(context.Background(), txf.txConfig.SignModeHandler(), signMode, signerData, txBuilder.GetTx())
 	if err != nil {
 		return err
 	}
 
-	// Sign those bytes
+	// Sign those bytes. The EIP-191 sign bytes are the personal_sign envelope,
+	// signed by the keyring like the sign bytes of the other modes.
 	sigBytes, _, err := txf.keybase.Sign(name, bytesToSign)
 	if err != nil {
 		return err
@@ -17,6 +17,7 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	txtypes "github.com/cosmos/cosmos-sdk/types/tx"
 	signingtypes "github.com/cosmos/cosmos-sdk/types/tx/signing"
+	"github.com/cosmos/cosmos-sdk/x/auth/ante"
 	"github.com/cosmos/cosmos-sdk/x/auth/signing"
 	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
 )
@@ -149,6 +150,14 @@ func TestBuildUnsignedTx(t *testing.T) {
 	sigs, err := tx.GetTx().(signing.SigVerifiableTx).GetSignaturesV2()
 	require.NoError(t, err)
 	require.Empty(t, sigs)
+
+	// unordered txs must set a timeout height
+	_, err = txf.WithUnordered(true).BuildUnsignedTx(msg)
+	require.Error(t, err)
+
+	tx, err = txf.WithUnordered(true).WithTimeoutHeight(100).BuildUnsignedTx(msg)
+	require.NoError(t, err)
+	require.True(t, tx.GetTx().(ante.TxWithUnordered).GetUnordered())
 }
 
 func TestSign(t *testing.T) {
@@ -307,6 +316,39 @@ func TestSign(t *testing.T) {
 	}
 }
 
+func TestSignEIP191(t *testing.T) {
+	encCfg := simapp.MakeTestEncodingConfig()
+	kb, err := keyring.New(t.Name(), "test", t.TempDir(), nil, encCfg.Codec)
+	require.NoError(t, err)
+
+	k, _, err := kb.NewMnemonic("test_key", keyring.English, hd.CreateHDPath(118, 0, 0).String(), keyring.DefaultBIP39Passphrase, hd.Secp256k1)
+	require.NoError(t, err)
+	pubKey, err := k.GetPubKey()
+	require.NoError(t, err)
+	addr, err := k.GetAddress()
+	require.NoError(t, err)
+
+	txf := tx.Factory{}.
+		WithTxConfig(encCfg.TxConfig).
+		WithKeybase(kb).
+		WithAccountNumber(50).
+		WithSequence(23).
+		WithChainID("test-chain").
+		WithSignMode(signingtypes.SignMode_SIGN_MODE_EIP_191)
+	txb, err := txf.BuildUnsignedTx(banktypes.NewMsgSend(addr, sdk.AccAddress("to"), nil))
+	require.NoError(t, err)
+
+	// the keyring signs the personal_sign envelope
+	require.NoError(t, tx.Sign(txf, "test_key", txb, true))
+	sigs := testSigners(require.New(t), txb.GetTx(), pubKey)
+
+	signerData := signing.SignerData{Address: addr.String(), ChainID: "test-chain", AccountNumber: 50, Sequence: 23}
+	require.NoError(t, signing.VerifySignature(pubKey, signerData, sigs[0].Data, encCfg.TxConfig.SignModeHandler(), txb.GetTx()))
+
+	signerData.Sequence = 24
+	require.Error(t, signing.VerifySignature(pubKey, signerData, sigs[0].Data, encCfg.TxConfig.SignModeHandler(), txb.GetTx()))
+}
+
 func testSigners(require *require.Assertions, tr signing.Tx, pks ...cryptotypes.PubKey) []signingtypes.SignatureV2 {
 	sigs, err := tr.GetSignaturesV2()
 	require.Len(sigs, len(pks))
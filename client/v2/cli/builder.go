@@ -3,7 +3,11 @@ package cli
 import (
 	"context"
 
+	"github.com/spf13/cobra"
 	"google.golang.org/grpc"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/reflect/protoregistry"
 
 	"github.com/cosmos/cosmos-sdk/client/v2/cli/flag"
 )
@@ -16,4 +20,37 @@ type Builder struct {
 	// GetClientConn specifies how CLI commands will resolve a grpc.ClientConnInterface
 	// from a given context.
 	GetClientConn func(context.Context) grpc.ClientConnInterface
+
+	// AddTxConnFlags adds the flags used to sign and broadcast transactions to
+	// tx commands. If it is nil, the default tx flags of addTxFlags are used:
+	// --from, --generate-only, --note, --timeout-height, the --fee-* flags and
+	// the --offline, --account-number, --sequence and --chain-id flags. None of
+	// them accesses the network: --generate-only prints the unsigned
+	// transaction, and --offline prints its SIGN_MODE_DIRECT sign doc for the
+	// given account number, sequence and chain ID, to be signed offline.
+	AddTxConnFlags func(*cobra.Command)
+
+	// GetFromAddress resolves the address of the signer of a tx command from
+	// its --from flag, which may name a key. If it is nil, --from must be a
+	// bech32 address.
+	GetFromAddress func(*cobra.Command) (string, error)
+
+	// BroadcastTx signs and broadcasts a transaction containing msg. It is
+	// responsible for all the flags added by AddTxConnFlags. If it is nil, tx
+	// commands only support the --generate-only and --offline modes of the
+	// default tx flags.
+	BroadcastTx func(cmd *cobra.Command, msg proto.Message) error
+}
+
+func (b *Builder) resolveService(serviceName protoreflect.FullName) protoreflect.ServiceDescriptor {
+	resolver := b.FileResolver
+	if resolver == nil {
+		resolver = protoregistry.GlobalFiles
+	}
+	descriptor, err := resolver.FindDescriptorByName(serviceName)
+	if err != nil {
+		panic(err)
+	}
+
+	return descriptor.(protoreflect.ServiceDescriptor)
 }
@@ -0,0 +1,99 @@
+package flag
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/spf13/pflag"
+	"google.golang.org/protobuf/reflect/protoreflect"
+
+	"github.com/cosmos/cosmos-sdk/client/v2/internal/util"
+)
+
+// PositionalArg binds a message field to a positional argument.
+type PositionalArg struct {
+	// Field is the name of the message field.
+	Field protoreflect.Name
+
+	// Varargs binds all the remaining arguments to the field, which must be
+	// repeated. Only the last positional argument can be variadic.
+	Varargs bool
+}
+
+// AddMessageFlagsAndArgs adds flags for each field in the message to the flag
+// set like AddMessageFlags, except for the fields bound to positional arguments
+// and the fields listed in skip, which get no flag.
+func (b *Builder) AddMessageFlagsAndArgs(ctx context.Context, set *pflag.FlagSet, messageType protoreflect.MessageType, args []PositionalArg, skip []protoreflect.Name, options Options) (*MessageBinder, error) {
+	fields := messageType.Descriptor().Fields()
+	handler := &MessageBinder{
+		messageType: messageType,
+	}
+
+	ignored := map[protoreflect.Name]bool{}
+	for _, name := range skip {
+		ignored[name] = true
+	}
+
+	if len(args) > 0 {
+		handler.positionalFlagSet = pflag.NewFlagSet("positional", pflag.ContinueOnError)
+	}
+	for i, arg := range args {
+		field := fields.ByName(arg.Field)
+		if field == nil {
+			return nil, fmt.Errorf("can't find field %s on %s", arg.Field, messageType.Descriptor().FullName())
+		}
+		if arg.Varargs && (i != len(args)-1 || !field.IsList()) {
+			return nil, fmt.Errorf("varargs field %s must be the last positional argument and repeated", arg.Field)
+		}
+
+		binder := b.AddFieldFlag(ctx, handler.positionalFlagSet, field, Options{})
+		if binder == nil {
+			return nil, fmt.Errorf("can't bind field %s to a positional argument", arg.Field)
+		}
+		handler.addField(binder, field)
+		handler.positionalArgs = append(handler.positionalArgs, arg)
+		handler.positionalNames = append(handler.positionalNames, util.DescriptorKebabName(field))
+		ignored[arg.Field] = true
+	}
+
+	for i := 0; i < fields.Len(); i++ {
+		field := fields.Get(i)
+		if ignored[field.Name()] {
+			continue
+		}
+
+		binder := b.AddFieldFlag(ctx, set, field, options)
+		if binder == nil {
+			return nil, fmt.Errorf("can't bind field %s to a flag", field.FullName())
+		}
+		handler.addField(binder, field)
+	}
+
+	return handler, nil
+}
+
+// NumPositionalArgs returns the number of positional arguments and whether
+// the last one is variadic.
+func (m MessageBinder) NumPositionalArgs() (n int, varargs bool) {
+	n = len(m.positionalArgs)
+	return n, n > 0 && m.positionalArgs[n-1].Varargs
+}
+
+// SetPositionalArgs sets the fields bound to positional arguments from args.
+func (m MessageBinder) SetPositionalArgs(args []string) error {
+	n, varargs := m.NumPositionalArgs()
+	if len(args) < n || (len(args) > n && !varargs) {
+		return fmt.Errorf("expected %d positional arguments, got %d", n, len(args))
+	}
+
+	for i, arg := range args {
+		if i >= n {
+			i = n - 1
+		}
+		if err := m.positionalFlagSet.Set(m.positionalNames[i], arg); err != nil {
+			return fmt.Errorf("invalid argument %s %q: %w", m.positionalArgs[i].Field, arg, err)
+		}
+	}
+
+	return nil
+}
@@ -28,6 +28,8 @@ func (b *Builder) init() {
 		b.messageFlagTypes = map[protoreflect.FullName]Type{}
 		b.messageFlagTypes["google.protobuf.Timestamp"] = timestampType{}
 		b.messageFlagTypes["google.protobuf.Duration"] = durationType{}
+		b.messageFlagTypes["cosmos.base.v1beta1.Coin"] = coinType{}
+		b.messageFlagTypes["cosmos.base.v1beta1.DecCoin"] = coinType{dec: true}
 	}
 
 	if b.scalarFlagTypes == nil {
@@ -0,0 +1,100 @@
+package flag
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"strings"
+
+	"github.com/spf13/pflag"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+
+	basev1beta1 "github.com/cosmos/cosmos-sdk/api/cosmos/base/v1beta1"
+)
+
+var (
+	coinDenomRegex = `[a-zA-Z][a-zA-Z0-9/:._-]{2,127}`
+	coinRegex      = regexp.MustCompile(fmt.Sprintf(`^([0-9]+)\s*(%s)$`, coinDenomRegex))
+	decCoinRegex   = regexp.MustCompile(fmt.Sprintf(`^([0-9]+(?:\.[0-9]+)?|\.[0-9]+)\s*(%s)$`, coinDenomRegex))
+)
+
+// coinType parses coins written as an amount followed by a denom, e.g.
+// 10stake, or as JSON. Repeated coin flags also accept comma separated coins.
+type coinType struct {
+	dec bool
+}
+
+func (t coinType) NewValue(context.Context, *Builder) pflag.Value {
+	return &coinValue{dec: t.dec}
+}
+
+func (t coinType) DefaultValue() string {
+	return ""
+}
+
+func (t coinType) splitList(s string) []string {
+	if strings.HasPrefix(strings.TrimSpace(s), "{") {
+		return []string{s}
+	}
+
+	return strings.Split(s, ",")
+}
+
+type coinValue struct {
+	dec   bool
+	value proto.Message
+}
+
+func (c coinValue) Get() protoreflect.Value {
+	if c.value == nil {
+		return protoreflect.Value{}
+	}
+	return protoreflect.ValueOfMessage(c.value.ProtoReflect())
+}
+
+func (c coinValue) String() string {
+	switch coin := c.value.(type) {
+	case *basev1beta1.Coin:
+		return coin.Amount + coin.Denom
+	case *basev1beta1.DecCoin:
+		return coin.Amount + coin.Denom
+	default:
+		return ""
+	}
+}
+
+func (c *coinValue) Set(s string) error {
+	s = strings.TrimSpace(s)
+	if strings.HasPrefix(s, "{") {
+		c.value = c.newCoin("", "")
+		return protojson.Unmarshal([]byte(s), c.value)
+	}
+
+	regex := coinRegex
+	if c.dec {
+		regex = decCoinRegex
+	}
+	matches := regex.FindStringSubmatch(s)
+	if matches == nil {
+		return fmt.Errorf("invalid coin expression: %q", s)
+	}
+
+	c.value = c.newCoin(matches[2], matches[1])
+	return nil
+}
+
+func (c coinValue) Type() string {
+	if c.dec {
+		return "dec-coin"
+	}
+	return "coin"
+}
+
+func (c coinValue) newCoin(denom, amount string) proto.Message {
+	if c.dec {
+		return &basev1beta1.DecCoin{Denom: denom, Amount: amount}
+	}
+	return &basev1beta1.Coin{Denom: denom, Amount: amount}
+}
@@ -22,6 +22,11 @@ type FieldValueBinder interface {
 type Options struct {
 	// Prefix is a prefix to prepend to all flags.
 	Prefix string
+
+	// NestedMessageDepth is the number of levels of nested message fields that
+	// are expanded into one flag per field, prefixed with the field name. Nested
+	// messages beyond that depth, and repeated ones, are parsed from JSON.
+	NestedMessageDepth int
 }
 
 // AddFieldFlag adds a flag for the provided field to the flag set.
@@ -30,6 +35,10 @@ func (b *Builder) AddFieldFlag(ctx context.Context, flagSet *pflag.FlagSet, fiel
 		return b.bindPageRequest(ctx, flagSet, field)
 	}
 
+	if options.NestedMessageDepth > 0 && b.isNestedMessage(field) {
+		return b.bindNestedMessage(ctx, flagSet, field, options)
+	}
+
 	name := options.Prefix + util.DescriptorKebabName(field)
 	usage := util.DescriptorDocs(field)
 	shorthand := ""
@@ -124,5 +133,5 @@ type listValueBinder struct {
 }
 
 func (s listValueBinder) Bind(message protoreflect.Message, field protoreflect.FieldDescriptor) {
-	s.AppendTo(message.NewField(field).List())
+	s.AppendTo(message.Mutable(field).List())
 }
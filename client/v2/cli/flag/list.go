@@ -54,6 +54,12 @@ func (f listValue) AppendTo(list protoreflect.List) {
 	f(list)
 }
 
+// listSplitter is implemented by the types whose repeated flags accept several
+// values in a single argument.
+type listSplitter interface {
+	splitList(string) []string
+}
+
 type compositeListType struct {
 	simpleType Type
 }
@@ -93,12 +99,19 @@ func (c compositeListValue) String() string {
 }
 
 func (c *compositeListValue) Set(val string) error {
-	simpleVal := c.simpleType.NewValue(c.ctx, c.opts)
-	err := simpleVal.Set(val)
-	if err != nil {
-		return err
+	vals := []string{val}
+	if splitter, ok := c.simpleType.(listSplitter); ok {
+		vals = splitter.splitList(val)
+	}
+
+	for _, val := range vals {
+		simpleVal := c.simpleType.NewValue(c.ctx, c.opts)
+		err := simpleVal.Set(val)
+		if err != nil {
+			return err
+		}
+		c.values = append(c.values, simpleVal.(SimpleValue).Get())
 	}
-	c.values = append(c.values, simpleVal.(SimpleValue).Get())
 	return nil
 }
 
@@ -0,0 +1,40 @@
+package flag
+
+import (
+	"context"
+
+	"github.com/spf13/pflag"
+	"google.golang.org/protobuf/reflect/protoreflect"
+
+	"github.com/cosmos/cosmos-sdk/client/v2/internal/util"
+)
+
+// isNestedMessage reports whether field is a singular message field which has
+// no flag type of its own and can be expanded into a flag per sub-field.
+func (b *Builder) isNestedMessage(field protoreflect.FieldDescriptor) bool {
+	if field.Kind() != protoreflect.MessageKind || field.IsList() || field.IsMap() {
+		return false
+	}
+
+	name := field.Message().FullName()
+	if name == "google.protobuf.Any" {
+		return false
+	}
+
+	b.init()
+	_, ok := b.messageFlagTypes[name]
+	return !ok
+}
+
+func (b *Builder) bindNestedMessage(ctx context.Context, flagSet *pflag.FlagSet, field protoreflect.FieldDescriptor, options Options) FieldValueBinder {
+	handler := b.AddMessageFlags(
+		ctx,
+		flagSet,
+		util.ResolveMessageType(b.TypeResolver, field.Message()),
+		Options{
+			Prefix:             options.Prefix + util.DescriptorKebabName(field) + "-",
+			NestedMessageDepth: options.NestedMessageDepth - 1,
+		},
+	)
+	return simpleValueBinder{handler}
+}
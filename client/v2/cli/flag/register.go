@@ -22,10 +22,7 @@ func (b *Builder) AddMessageFlags(ctx context.Context, set *pflag.FlagSet, messa
 			fmt.Printf("unable to bind field %s to a flag, support will be added soon\n", field)
 			continue
 		}
-		handler.flagFieldPairs = append(handler.flagFieldPairs, struct {
-			binder FieldValueBinder
-			field  protoreflect.FieldDescriptor
-		}{binder: binder, field: field})
+		handler.addField(binder, field)
 	}
 	return handler
 }
@@ -37,6 +34,17 @@ type MessageBinder struct {
 		field  protoreflect.FieldDescriptor
 	}
 	messageType protoreflect.MessageType
+
+	positionalFlagSet *pflag.FlagSet
+	positionalArgs    []PositionalArg
+	positionalNames   []string
+}
+
+func (m *MessageBinder) addField(binder FieldValueBinder, field protoreflect.FieldDescriptor) {
+	m.flagFieldPairs = append(m.flagFieldPairs, struct {
+		binder FieldValueBinder
+		field  protoreflect.FieldDescriptor
+	}{binder: binder, field: field})
 }
 
 // BuildMessage builds and returns a new message for the bound flags.
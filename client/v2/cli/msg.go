@@ -0,0 +1,205 @@
+package cli
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/cosmos/btcutil/bech32"
+	"github.com/spf13/cobra"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+
+	msgv1 "github.com/cosmos/cosmos-sdk/api/cosmos/msg/v1"
+	"github.com/cosmos/cosmos-sdk/client/v2/cli/flag"
+	"github.com/cosmos/cosmos-sdk/client/v2/internal/util"
+)
+
+// RpcCommandOptions customizes the command generated for a service method.
+type RpcCommandOptions struct {
+	// RpcMethod is the name of the method the options apply to.
+	RpcMethod string
+
+	// Use overrides the command name, positional arguments are appended to it.
+	Use string
+
+	// Short, Long and Example override the command docs. Long defaults to
+	// the method comments.
+	Short, Long, Example string
+
+	// PositionalArgs lists the request fields which are read from positional
+	// arguments, in order, instead of flags.
+	PositionalArgs []flag.PositionalArg
+
+	// Skip omits the method from the generated commands.
+	Skip bool
+}
+
+// AddMsgServiceCommands adds a tx sub-command to the provided command for each
+// method in the specified Msg service and returns the command.
+func (b *Builder) AddMsgServiceCommands(command *cobra.Command, serviceName protoreflect.FullName, options ...RpcCommandOptions) *cobra.Command {
+	service := b.resolveService(serviceName)
+
+	optionsByMethod := map[string]RpcCommandOptions{}
+	for _, opts := range options {
+		optionsByMethod[opts.RpcMethod] = opts
+	}
+
+	methods := service.Methods()
+	n := methods.Len()
+	for i := 0; i < n; i++ {
+		method := methods.Get(i)
+		opts := optionsByMethod[string(method.Name())]
+		if opts.Skip {
+			continue
+		}
+
+		cmd, err := b.CreateMsgMethodCommand(method, opts)
+		if err != nil {
+			panic(err)
+		}
+		command.AddCommand(cmd)
+	}
+	return command
+}
+
+// CreateMsgMethodCommand creates a tx command for the given Msg service method.
+//
+// The request signer, as declared by the cosmos.msg.v1.signer option, has no
+// flag and is populated from --from.
+func (b *Builder) CreateMsgMethodCommand(descriptor protoreflect.MethodDescriptor, options RpcCommandOptions) (*cobra.Command, error) {
+	inputType := util.ResolveMessageType(b.TypeResolver, descriptor.Input())
+	signerField := signerFieldName(descriptor.Input())
+
+	use := options.Use
+	if use == "" {
+		use = protoNameToCliName(descriptor.Name())
+	}
+	for _, arg := range options.PositionalArgs {
+		use += fmt.Sprintf(" [%s]", arg.Field)
+		if arg.Varargs {
+			use += "..."
+		}
+	}
+
+	long := options.Long
+	if long == "" {
+		long = util.DescriptorDocs(descriptor)
+	}
+
+	cmd := &cobra.Command{
+		Use:     use,
+		Short:   options.Short,
+		Long:    long,
+		Example: options.Example,
+	}
+
+	var skip []protoreflect.Name
+	signerIsArg := false
+	for _, arg := range options.PositionalArgs {
+		signerIsArg = signerIsArg || arg.Field == signerField
+	}
+	if signerField != "" && !signerIsArg {
+		skip = append(skip, signerField)
+	}
+
+	binder, err := b.AddMessageFlagsAndArgs(cmd.Context(), cmd.Flags(), inputType, options.PositionalArgs, skip, flag.Options{NestedMessageDepth: 1})
+	if err != nil {
+		return nil, err
+	}
+
+	n, varargs := binder.NumPositionalArgs()
+	if varargs {
+		cmd.Args = cobra.MinimumNArgs(n)
+	} else {
+		cmd.Args = cobra.ExactArgs(n)
+	}
+
+	var defaultTxFlags *txFlags
+	if b.AddTxConnFlags != nil {
+		b.AddTxConnFlags(cmd)
+	} else {
+		defaultTxFlags = b.addTxFlags(cmd.Context(), cmd)
+	}
+
+	cmd.RunE = func(cmd *cobra.Command, args []string) error {
+		if err := binder.SetPositionalArgs(args); err != nil {
+			return err
+		}
+
+		input := binder.BuildMessage()
+		if signerField != "" && !signerIsArg {
+			from, err := b.fromAddress(cmd)
+			if err != nil {
+				return err
+			}
+			input.Set(input.Descriptor().Fields().ByName(signerField), protoreflect.ValueOfString(from))
+		}
+		msg := input.Interface()
+
+		if b.BroadcastTx != nil {
+			return b.BroadcastTx(cmd, msg)
+		}
+
+		if defaultTxFlags == nil {
+			return errors.New("broadcasting transactions is not supported")
+		}
+
+		generateOnly, _ := cmd.Flags().GetBool(FlagGenerateOnly)
+		offline, _ := cmd.Flags().GetBool(FlagOffline)
+		switch {
+		case generateOnly && offline:
+			return fmt.Errorf("--%s and --%s cannot be used together", FlagGenerateOnly, FlagOffline)
+		case !generateOnly && !offline:
+			return fmt.Errorf("broadcasting transactions is not supported, use --%s or --%s", FlagGenerateOnly, FlagOffline)
+		}
+
+		tx, err := defaultTxFlags.buildUnsignedTx(cmd, msg)
+		if err != nil {
+			return err
+		}
+		if !offline {
+			return b.printTxMessage(cmd, tx)
+		}
+
+		signDoc, err := defaultTxFlags.buildSignDoc(cmd, tx)
+		if err != nil {
+			return err
+		}
+		return b.printTxMessage(cmd, signDoc)
+	}
+
+	return cmd, nil
+}
+
+func (b *Builder) fromAddress(cmd *cobra.Command) (string, error) {
+	if b.GetFromAddress != nil {
+		return b.GetFromAddress(cmd)
+	}
+
+	from, err := cmd.Flags().GetString(FlagFrom)
+	if err != nil {
+		return "", err
+	}
+	if from == "" {
+		return "", fmt.Errorf("--%s is required", FlagFrom)
+	}
+	if _, _, err := bech32.DecodeNoLimit(from); err != nil {
+		return "", fmt.Errorf("--%s must be a bech32 address, key names are only resolved with GetFromAddress: %w", FlagFrom, err)
+	}
+	return from, nil
+}
+
+// signerFieldName returns the name of the first signer field declared with the
+// cosmos.msg.v1.signer option, if it is a string field.
+func signerFieldName(desc protoreflect.MessageDescriptor) protoreflect.Name {
+	signers, ok := proto.GetExtension(desc.Options(), msgv1.E_Signer).([]string)
+	if !ok || len(signers) == 0 {
+		return ""
+	}
+
+	field := desc.Fields().ByName(protoreflect.Name(signers[0]))
+	if field == nil || field.Kind() != protoreflect.StringKind || field.IsList() {
+		return ""
+	}
+	return field.Name()
+}
@@ -0,0 +1,240 @@
+package cli
+
+import (
+	"bytes"
+	"encoding/json"
+	"testing"
+
+	"github.com/spf13/cobra"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/testing/protocmp"
+	"gotest.tools/v3/assert"
+
+	bankv1beta1 "github.com/cosmos/cosmos-sdk/api/cosmos/bank/v1beta1"
+	basev1beta1 "github.com/cosmos/cosmos-sdk/api/cosmos/base/v1beta1"
+	stakingv1beta1 "github.com/cosmos/cosmos-sdk/api/cosmos/staking/v1beta1"
+	signingv1beta1 "github.com/cosmos/cosmos-sdk/api/cosmos/tx/signing/v1beta1"
+	txv1beta1 "github.com/cosmos/cosmos-sdk/api/cosmos/tx/v1beta1"
+	"github.com/cosmos/cosmos-sdk/client/v2/cli/flag"
+)
+
+const (
+	testFromAddress = "cosmos1veex7m2lta047h6lta047h6lta047h6lt50pqc"
+	testValAddress  = "cosmosvaloper1weskch6lta047h6lta047h6lta047h6l7l0snm"
+)
+
+var bankSendOptions = RpcCommandOptions{
+	RpcMethod: "Send",
+	PositionalArgs: []flag.PositionalArg{
+		{Field: "to_address"},
+		{Field: "amount", Varargs: true},
+	},
+}
+
+func testExecMsg(t *testing.T, b *Builder, serviceName string, options []RpcCommandOptions, args ...string) (*bytes.Buffer, error) {
+	cmd := b.AddMsgServiceCommands(&cobra.Command{Use: "test"}, protoreflect.FullName(serviceName), options...)
+	out := &bytes.Buffer{}
+	cmd.SetArgs(args)
+	cmd.SetOut(out)
+	cmd.SetErr(out)
+	return out, cmd.Execute()
+}
+
+func TestMsgBroadcast(t *testing.T) {
+	var broadcast proto.Message
+	b := &Builder{
+		BroadcastTx: func(cmd *cobra.Command, msg proto.Message) error {
+			broadcast = msg
+			return nil
+		},
+	}
+
+	_, err := testExecMsg(t, b, "cosmos.bank.v1beta1.Msg", []RpcCommandOptions{bankSendOptions},
+		"send", "cosmos1to", "10stake,5foo", "7bar",
+		"--from", testFromAddress,
+	)
+	assert.NilError(t, err)
+	assert.DeepEqual(t, &bankv1beta1.MsgSend{
+		FromAddress: testFromAddress,
+		ToAddress:   "cosmos1to",
+		Amount: []*basev1beta1.Coin{
+			{Denom: "stake", Amount: "10"},
+			{Denom: "foo", Amount: "5"},
+			{Denom: "bar", Amount: "7"},
+		},
+	}, broadcast, protocmp.Transform())
+
+	// the signer comes from --from
+	_, err = testExecMsg(t, b, "cosmos.bank.v1beta1.Msg", []RpcCommandOptions{bankSendOptions},
+		"send", "cosmos1to", "10stake",
+	)
+	assert.ErrorContains(t, err, "--from is required")
+
+	// without GetFromAddress, --from must be an address
+	_, err = testExecMsg(t, b, "cosmos.bank.v1beta1.Msg", []RpcCommandOptions{bankSendOptions},
+		"send", "cosmos1to", "10stake", "--from", "alice",
+	)
+	assert.ErrorContains(t, err, "--from must be a bech32 address")
+
+	_, err = testExecMsg(t, b, "cosmos.bank.v1beta1.Msg", []RpcCommandOptions{bankSendOptions},
+		"send", "cosmos1to", "10stake", "--from", testFromAddress[:len(testFromAddress)-1]+"q",
+	)
+	assert.ErrorContains(t, err, "--from must be a bech32 address")
+
+	_, err = testExecMsg(t, b, "cosmos.bank.v1beta1.Msg", []RpcCommandOptions{bankSendOptions},
+		"send", "cosmos1to", "10", "--from", testFromAddress,
+	)
+	assert.ErrorContains(t, err, "invalid coin expression")
+
+	_, err = testExecMsg(t, b, "cosmos.bank.v1beta1.Msg", []RpcCommandOptions{bankSendOptions},
+		"send", "cosmos1to", "--from", testFromAddress,
+	)
+	assert.ErrorContains(t, err, "requires at least 2 arg(s)")
+}
+
+func TestMsgGetFromAddress(t *testing.T) {
+	var broadcast proto.Message
+	b := &Builder{
+		GetFromAddress: func(cmd *cobra.Command) (string, error) {
+			from, err := cmd.Flags().GetString(FlagFrom)
+			return "cosmos1" + from, err
+		},
+		BroadcastTx: func(cmd *cobra.Command, msg proto.Message) error {
+			broadcast = msg
+			return nil
+		},
+	}
+
+	_, err := testExecMsg(t, b, "cosmos.bank.v1beta1.Msg", nil,
+		"send", "--to-address", "cosmos1to", "--amount", "10stake", "--from", "alice",
+	)
+	assert.NilError(t, err)
+	assert.Equal(t, "cosmos1alice", broadcast.(*bankv1beta1.MsgSend).FromAddress)
+}
+
+func TestMsgNestedFlags(t *testing.T) {
+	var broadcast proto.Message
+	b := &Builder{
+		BroadcastTx: func(cmd *cobra.Command, msg proto.Message) error {
+			broadcast = msg
+			return nil
+		},
+	}
+
+	_, err := testExecMsg(t, b, "cosmos.staking.v1beta1.Msg", nil,
+		"edit-validator",
+		"--description-moniker", "foo",
+		"--description-website", "https://example.com",
+		"--commission-rate", "0.1",
+		"--from", testValAddress,
+	)
+	assert.NilError(t, err)
+
+	msg := broadcast.(*stakingv1beta1.MsgEditValidator)
+	assert.Equal(t, testValAddress, msg.ValidatorAddress)
+	assert.Equal(t, "0.1", msg.CommissionRate)
+	assert.DeepEqual(t, &stakingv1beta1.Description{
+		Moniker: "foo",
+		Website: "https://example.com",
+	}, msg.Description, protocmp.Transform())
+}
+
+func TestMsgGenerateOnly(t *testing.T) {
+	b := &Builder{}
+
+	out, err := testExecMsg(t, b, "cosmos.bank.v1beta1.Msg", []RpcCommandOptions{bankSendOptions},
+		"send", "cosmos1to", "10stake",
+		"--from", testFromAddress,
+		"--generate-only",
+		"--note", "hello",
+		"--fee-amount", "1stake",
+		"--fee-gas-limit", "100000",
+	)
+	assert.NilError(t, err)
+
+	var tx struct {
+		Body struct {
+			Messages []map[string]interface{} `json:"messages"`
+			Memo     string                   `json:"memo"`
+		} `json:"body"`
+		AuthInfo struct {
+			Fee struct {
+				Amount   []map[string]string `json:"amount"`
+				GasLimit string              `json:"gas_limit"`
+			} `json:"fee"`
+		} `json:"auth_info"`
+	}
+	assert.NilError(t, json.Unmarshal(out.Bytes(), &tx))
+	assert.Equal(t, 1, len(tx.Body.Messages))
+	assert.Equal(t, "/cosmos.bank.v1beta1.MsgSend", tx.Body.Messages[0]["@type"])
+	assert.Equal(t, testFromAddress, tx.Body.Messages[0]["from_address"])
+	assert.Equal(t, "hello", tx.Body.Memo)
+	assert.DeepEqual(t, []map[string]string{{"denom": "stake", "amount": "1"}}, tx.AuthInfo.Fee.Amount)
+	assert.Equal(t, "100000", tx.AuthInfo.Fee.GasLimit)
+
+	// without a BroadcastTx function only --generate-only is supported
+	_, err = testExecMsg(t, b, "cosmos.bank.v1beta1.Msg", []RpcCommandOptions{bankSendOptions},
+		"send", "cosmos1to", "10stake", "--from", testFromAddress,
+	)
+	assert.ErrorContains(t, err, "use --generate-only")
+}
+
+func TestMsgOffline(t *testing.T) {
+	b := &Builder{}
+
+	out, err := testExecMsg(t, b, "cosmos.bank.v1beta1.Msg", []RpcCommandOptions{bankSendOptions},
+		"send", "cosmos1to", "10stake",
+		"--from", testFromAddress,
+		"--offline",
+		"--account-number", "3",
+		"--sequence", "7",
+		"--chain-id", "test-chain",
+		"--note", "hello",
+	)
+	assert.NilError(t, err)
+
+	signDoc := &txv1beta1.SignDoc{}
+	assert.NilError(t, protojson.Unmarshal(out.Bytes(), signDoc))
+	assert.Equal(t, "test-chain", signDoc.ChainId)
+	assert.Equal(t, uint64(3), signDoc.AccountNumber)
+
+	body := &txv1beta1.TxBody{}
+	assert.NilError(t, proto.Unmarshal(signDoc.BodyBytes, body))
+	assert.Equal(t, "hello", body.Memo)
+	assert.Equal(t, "/cosmos.bank.v1beta1.MsgSend", body.Messages[0].TypeUrl)
+
+	authInfo := &txv1beta1.AuthInfo{}
+	assert.NilError(t, proto.Unmarshal(signDoc.AuthInfoBytes, authInfo))
+	assert.Equal(t, 1, len(authInfo.SignerInfos))
+	assert.Equal(t, uint64(7), authInfo.SignerInfos[0].Sequence)
+	assert.Equal(t, signingv1beta1.SignMode_SIGN_MODE_DIRECT, authInfo.SignerInfos[0].ModeInfo.GetSingle().Mode)
+
+	// the account number, sequence and chain ID are required
+	_, err = testExecMsg(t, b, "cosmos.bank.v1beta1.Msg", []RpcCommandOptions{bankSendOptions},
+		"send", "cosmos1to", "10stake", "--from", testFromAddress,
+		"--offline", "--account-number", "3", "--chain-id", "test-chain",
+	)
+	assert.ErrorContains(t, err, "--sequence is required with --offline")
+
+	_, err = testExecMsg(t, b, "cosmos.bank.v1beta1.Msg", []RpcCommandOptions{bankSendOptions},
+		"send", "cosmos1to", "10stake", "--from", testFromAddress,
+		"--offline", "--generate-only",
+	)
+	assert.ErrorContains(t, err, "cannot be used together")
+}
+
+func TestMsgSkip(t *testing.T) {
+	b := &Builder{}
+	cmd := b.AddMsgServiceCommands(&cobra.Command{Use: "test"}, "cosmos.bank.v1beta1.Msg",
+		RpcCommandOptions{RpcMethod: "MultiSend", Skip: true},
+		bankSendOptions,
+	)
+
+	var names []string
+	for _, c := range cmd.Commands() {
+		names = append(names, c.Use)
+	}
+	assert.DeepEqual(t, []string{"send [to_address] [amount]..."}, names)
+}
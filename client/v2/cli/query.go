@@ -7,7 +7,6 @@ import (
 	"github.com/spf13/cobra"
 	"google.golang.org/protobuf/encoding/protojson"
 	"google.golang.org/protobuf/reflect/protoreflect"
-	"google.golang.org/protobuf/reflect/protoregistry"
 
 	"github.com/cosmos/cosmos-sdk/client/v2/cli/flag"
 	"github.com/cosmos/cosmos-sdk/client/v2/internal/util"
@@ -16,16 +15,7 @@ import (
 // AddQueryServiceCommands adds a sub-command to the provided command for each
 // method in the specified service and returns the command.
 func (b *Builder) AddQueryServiceCommands(command *cobra.Command, serviceName protoreflect.FullName) *cobra.Command {
-	resolver := b.FileResolver
-	if resolver == nil {
-		resolver = protoregistry.GlobalFiles
-	}
-	descriptor, err := resolver.FindDescriptorByName(serviceName)
-	if err != nil {
-		panic(err)
-	}
-
-	service := descriptor.(protoreflect.ServiceDescriptor)
+	service := b.resolveService(serviceName)
 	methods := service.Methods()
 	n := methods.Len()
 	for i := 0; i < n; i++ {
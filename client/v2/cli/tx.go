@@ -0,0 +1,173 @@
+package cli
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/spf13/cobra"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/anypb"
+
+	signingv1beta1 "github.com/cosmos/cosmos-sdk/api/cosmos/tx/signing/v1beta1"
+	txv1beta1 "github.com/cosmos/cosmos-sdk/api/cosmos/tx/v1beta1"
+	"github.com/cosmos/cosmos-sdk/client/v2/cli/flag"
+)
+
+const (
+	// FlagFrom is the address of the signer of a tx command.
+	FlagFrom = "from"
+	// FlagGenerateOnly makes a tx command print the unsigned transaction
+	// instead of broadcasting it.
+	FlagGenerateOnly = "generate-only"
+	// FlagNote is the memo of the transaction.
+	FlagNote = "note"
+	// FlagTimeoutHeight is the block height after which the transaction is
+	// not valid anymore.
+	FlagTimeoutHeight = "timeout-height"
+	// FlagOffline makes a tx command print the SIGN_MODE_DIRECT sign doc of
+	// the transaction, to be signed offline, instead of broadcasting it.
+	FlagOffline = "offline"
+	// FlagAccountNumber is the account number of the signer in offline mode.
+	FlagAccountNumber = "account-number"
+	// FlagSequence is the sequence of the signer in offline mode.
+	FlagSequence = "sequence"
+	// FlagChainID is the chain ID the transaction is signed for in offline
+	// mode.
+	FlagChainID = "chain-id"
+)
+
+// txFlags binds the default tx flags which are used to build unsigned
+// transactions and sign docs when no BroadcastTx function is set.
+type txFlags struct {
+	fee *flag.MessageBinder
+}
+
+// addTxFlags adds the default tx flags to cmd, which are the --from,
+// --generate-only, --note and --timeout-height flags, the --offline,
+// --account-number, --sequence and --chain-id flags of the offline mode, and a
+// flag for each fee field. None of them accesses the network.
+func (b *Builder) addTxFlags(ctx context.Context, cmd *cobra.Command) *txFlags {
+	cmd.Flags().String(FlagFrom, "", "Address of the signer of the transaction")
+	cmd.Flags().Bool(FlagGenerateOnly, false, "Print the unsigned transaction as JSON instead of broadcasting it, does not access the network")
+	cmd.Flags().String(FlagNote, "", "Note to add to the transaction memo")
+	cmd.Flags().Uint64(FlagTimeoutHeight, 0, "Block height after which the transaction is not valid anymore")
+	cmd.Flags().Bool(FlagOffline, false, "Print the SIGN_MODE_DIRECT sign doc of the transaction as JSON, to sign it offline, does not access the network")
+	cmd.Flags().Uint64(FlagAccountNumber, 0, "Account number of the signer, required with --offline")
+	cmd.Flags().Uint64(FlagSequence, 0, "Sequence of the signer, required with --offline")
+	cmd.Flags().String(FlagChainID, "", "Chain ID the transaction is signed for, required with --offline")
+
+	feeType := (&txv1beta1.Fee{}).ProtoReflect().Type()
+	return &txFlags{
+		fee: b.AddMessageFlags(ctx, cmd.Flags(), feeType, flag.Options{Prefix: "fee-"}),
+	}
+}
+
+// buildUnsignedTx builds an unsigned transaction containing msg from the
+// default tx flags.
+func (t *txFlags) buildUnsignedTx(cmd *cobra.Command, msg proto.Message) (*txv1beta1.Tx, error) {
+	bz, err := proto.Marshal(msg)
+	if err != nil {
+		return nil, err
+	}
+	any := &anypb.Any{
+		TypeUrl: "/" + string(msg.ProtoReflect().Descriptor().FullName()),
+		Value:   bz,
+	}
+
+	note, err := cmd.Flags().GetString(FlagNote)
+	if err != nil {
+		return nil, err
+	}
+
+	timeoutHeight, err := cmd.Flags().GetUint64(FlagTimeoutHeight)
+	if err != nil {
+		return nil, err
+	}
+
+	feeMsg := t.fee.BuildMessage().Interface()
+	fee, ok := feeMsg.(*txv1beta1.Fee)
+	if !ok {
+		return nil, fmt.Errorf("unexpected fee type %T", feeMsg)
+	}
+
+	return &txv1beta1.Tx{
+		Body: &txv1beta1.TxBody{
+			Messages:      []*anypb.Any{any},
+			Memo:          note,
+			TimeoutHeight: timeoutHeight,
+		},
+		AuthInfo: &txv1beta1.AuthInfo{
+			Fee: fee,
+		},
+		Signatures: [][]byte{},
+	}, nil
+}
+
+// buildSignDoc builds the SIGN_MODE_DIRECT sign doc of tx from the offline
+// mode flags. The signer info of tx has no public key, so the signer account
+// must already have one on chain.
+func (t *txFlags) buildSignDoc(cmd *cobra.Command, tx *txv1beta1.Tx) (*txv1beta1.SignDoc, error) {
+	for _, name := range []string{FlagAccountNumber, FlagSequence, FlagChainID} {
+		if !cmd.Flags().Changed(name) {
+			return nil, fmt.Errorf("--%s is required with --%s", name, FlagOffline)
+		}
+	}
+
+	accountNumber, err := cmd.Flags().GetUint64(FlagAccountNumber)
+	if err != nil {
+		return nil, err
+	}
+
+	sequence, err := cmd.Flags().GetUint64(FlagSequence)
+	if err != nil {
+		return nil, err
+	}
+
+	chainID, err := cmd.Flags().GetString(FlagChainID)
+	if err != nil {
+		return nil, err
+	}
+
+	tx.AuthInfo.SignerInfos = []*txv1beta1.SignerInfo{{
+		ModeInfo: &txv1beta1.ModeInfo{
+			Sum: &txv1beta1.ModeInfo_Single_{
+				Single: &txv1beta1.ModeInfo_Single{Mode: signingv1beta1.SignMode_SIGN_MODE_DIRECT},
+			},
+		},
+		Sequence: sequence,
+	}}
+
+	marshal := proto.MarshalOptions{Deterministic: true}
+	bodyBytes, err := marshal.Marshal(tx.Body)
+	if err != nil {
+		return nil, err
+	}
+
+	authInfoBytes, err := marshal.Marshal(tx.AuthInfo)
+	if err != nil {
+		return nil, err
+	}
+
+	return &txv1beta1.SignDoc{
+		BodyBytes:     bodyBytes,
+		AuthInfoBytes: authInfoBytes,
+		ChainId:       chainID,
+		AccountNumber: accountNumber,
+	}, nil
+}
+
+// printTxMessage prints an unsigned transaction or a sign doc as JSON.
+func (b *Builder) printTxMessage(cmd *cobra.Command, msg proto.Message) error {
+	bz, err := protojson.MarshalOptions{
+		Indent:        "  ",
+		UseProtoNames: true,
+		Resolver:      b.TypeResolver,
+	}.Marshal(msg)
+	if err != nil {
+		return err
+	}
+
+	_, err = fmt.Fprintln(cmd.OutOrStdout(), string(bz))
+	return err
+}
@@ -3,6 +3,7 @@ module github.com/cosmos/cosmos-sdk/client/v2
 go 1.18
 
 require (
+	github.com/cosmos/btcutil v1.0.5
 	github.com/cosmos/cosmos-proto v1.0.0-alpha7
 	github.com/cosmos/cosmos-sdk/api v0.1.0
 	github.com/iancoleman/strcase v0.2.0
@@ -4,6 +4,7 @@ import (
 	"fmt"
 	"math"
 	"strings"
+	"time"
 
 	"github.com/spf13/viper"
 
@@ -36,6 +37,9 @@ const (
 
 	// FileStreamer defines the store streaming type for file streaming.
 	FileStreamer = "file"
+
+	// GRPCStreamer defines the store streaming type for gRPC streaming.
+	GRPCStreamer = "grpc"
 )
 
 // BaseConfig defines the server's basic configuration
@@ -202,6 +206,24 @@ type StateSyncConfig struct {
 	SnapshotKeepRecent uint32 `mapstructure:"snapshot-keep-recent"`
 }
 
+// RateLimitConfig defines the limits on the txs and gas that each signer can
+// submit to the mempool of the node over a sliding window.
+type RateLimitConfig struct {
+	// Enable enables the rate limits in CheckTx.
+	Enable bool `mapstructure:"enable"`
+
+	// Window is the duration of the sliding window.
+	Window time.Duration `mapstructure:"window"`
+
+	// MaxTxs is the max number of txs of a signer in the window, 0 disables the
+	// limit.
+	MaxTxs uint64 `mapstructure:"max-txs"`
+
+	// MaxGas is the max sum of the gas limits of the txs of a signer in the
+	// window, 0 disables the limit.
+	MaxGas uint64 `mapstructure:"max-gas"`
+}
+
 type (
 	// StoreConfig defines application configuration for state streaming and other
 	// storage related operations.
@@ -214,6 +236,7 @@ type (
 	// list defined by 'StoreConfig.Streamers'.
 	StreamersConfig struct {
 		File FileStreamerConfig `mapstructure:"file"`
+		GRPC GRPCStreamerConfig `mapstructure:"grpc"`
 	}
 
 	// FileStreamerConfig defines the file streaming configuration options.
@@ -230,6 +253,34 @@ type (
 		// Fsync specifies if calling fsync after writing the files, it slows down
 		// the commit, but don't lose data in face of system crash.
 		Fsync bool `mapstructure:"fsync"`
+		// Compression is the compression of the files, either none, gzip or zstd.
+		Compression string `mapstructure:"compression"`
+		// MaxFileSize and MaxFileBlocks enable the rotation of the files: the
+		// blocks are appended to a file until it reaches MaxFileSize bytes or
+		// MaxFileBlocks blocks, 0 disables a limit. Without rotation, each block
+		// is written to its own files.
+		MaxFileSize   int64 `mapstructure:"max-file-size"`
+		MaxFileBlocks int64 `mapstructure:"max-file-blocks"`
+		// RetainBlocks is the number of recent blocks for which the files are
+		// kept, 0 keeps all the files.
+		RetainBlocks int64 `mapstructure:"retain-blocks"`
+	}
+
+	// GRPCStreamerConfig defines the gRPC streaming configuration options.
+	GRPCStreamerConfig struct {
+		Keys []string `mapstructure:"keys"`
+		// Address is the address of the external listener, either
+		// unix://<path> or <host>:<port>.
+		Address string `mapstructure:"address"`
+		// BufferSize is the number of messages queued for delivery, the ABCI
+		// processing blocks when the queue is full.
+		BufferSize int `mapstructure:"buffer-size"`
+		// Timeout bounds the delivery of a single message, 0 means no timeout.
+		Timeout time.Duration `mapstructure:"timeout"`
+		// StopNodeOnError specifies if the node waits for the messages of each
+		// block to be delivered at commit and halts if a delivery failed,
+		// otherwise the messages which fail to be delivered are dropped.
+		StopNodeOnError bool `mapstructure:"stop-node-on-error"`
 	}
 )
 
@@ -244,6 +295,7 @@ type Config struct {
 	Rosetta   RosettaConfig    `mapstructure:"rosetta"`
 	GRPCWeb   GRPCWebConfig    `mapstructure:"grpc-web"`
 	StateSync StateSyncConfig  `mapstructure:"state-sync"`
+	RateLimit RateLimitConfig  `mapstructure:"rate-limit"`
 	Store     StoreConfig      `mapstructure:"store"`
 	Streamers StreamersConfig  `mapstructure:"streamers"`
 }
@@ -328,6 +380,12 @@ func DefaultConfig() *Config {
 			SnapshotInterval:   0,
 			SnapshotKeepRecent: 2,
 		},
+		RateLimit: RateLimitConfig{
+			Enable: false,
+			Window: time.Minute,
+			MaxTxs: 100,
+			MaxGas: 100_000_000,
+		},
 		Store: StoreConfig{
 			Streamers: []string{},
 		},
@@ -339,7 +397,18 @@ func DefaultConfig() *Config {
 				StopNodeOnError: true,
 				// NOTICE: The default config doesn't protect the streamer data integrity
 				// in face of system crash.
-				Fsync: false,
+				Fsync:         false,
+				Compression:   "none",
+				MaxFileSize:   0,
+				MaxFileBlocks: 0,
+				RetainBlocks:  0,
+			},
+			GRPC: GRPCStreamerConfig{
+				Keys:            []string{"*"},
+				Address:         "",
+				BufferSize:      1000,
+				Timeout:         5 * time.Second,
+				StopNodeOnError: true,
 			},
 		},
 	}
@@ -365,5 +434,9 @@ func (c Config) ValidateBasic() error {
 		)
 	}
 
+	if c.RateLimit.Enable && c.RateLimit.Window <= 0 {
+		return sdkerrors.ErrAppConfig.Wrap("rate limit window must be positive")
+	}
+
 	return nil
 }
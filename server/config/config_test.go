@@ -4,11 +4,13 @@ import (
 	"bytes"
 	"path/filepath"
 	"testing"
+	"time"
 
 	"github.com/spf13/viper"
 	"github.com/stretchr/testify/require"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 )
 
 func TestDefaultConfig(t *testing.T) {
@@ -54,6 +56,24 @@ prefix = ""`
 	require.Contains(t, buffer.String(), expectedContents, "config file contents")
 }
 
+func TestParseGRPCStreaming(t *testing.T) {
+	expectedContents := `[streamers.grpc]
+keys = ["bank", ]
+
+# address of the external listener, either unix:///path/to/socket or host:port.
+address = "unix:///tmp/listener.sock"`
+
+	cfg := DefaultConfig()
+	cfg.Store.Streamers = []string{GRPCStreamer}
+	cfg.Streamers.GRPC.Keys = []string{"bank"}
+	cfg.Streamers.GRPC.Address = "unix:///tmp/listener.sock"
+
+	var buffer bytes.Buffer
+	require.NoError(t, configTemplate.Execute(&buffer, cfg), "executing template")
+	require.Contains(t, buffer.String(), expectedContents, "config file contents")
+	require.Contains(t, buffer.String(), `timeout = "5s"`, "config file contents")
+}
+
 func TestReadConfig(t *testing.T) {
 	cfg := DefaultConfig()
 	tmpFile := filepath.Join(t.TempDir(), "config")
@@ -95,6 +115,27 @@ func TestIndexEventsWriteRead(t *testing.T) {
 	require.Equal(t, expected, actual, "config value")
 }
 
+func TestRateLimitWriteRead(t *testing.T) {
+	confFile := filepath.Join(t.TempDir(), "app.toml")
+	conf := DefaultConfig()
+	conf.RateLimit = RateLimitConfig{Enable: true, Window: 30 * time.Second, MaxTxs: 10, MaxGas: 0}
+	WriteConfigFile(confFile, conf)
+
+	vpr := viper.New()
+	vpr.SetConfigFile(confFile)
+	require.NoError(t, vpr.ReadInConfig(), "reading config file into viper")
+
+	cfg, err := ParseConfig(vpr)
+	require.NoError(t, err, "parsing config")
+	require.Equal(t, conf.RateLimit, cfg.RateLimit)
+
+	// a rate limit window must be set when the limits are enabled
+	cfg.MinGasPrices = "0stake"
+	require.NoError(t, cfg.ValidateBasic())
+	cfg.RateLimit.Window = 0
+	require.ErrorIs(t, cfg.ValidateBasic(), sdkerrors.ErrAppConfig)
+}
+
 func TestGlobalLabelsEventsMarshalling(t *testing.T) {
 	expectedIn := `global-labels = [
   ["labelname1", "labelvalue1"],
@@ -240,6 +240,28 @@ snapshot-interval = {{ .StateSync.SnapshotInterval }}
 # snapshot-keep-recent specifies the number of recent snapshots to keep and serve (0 to keep all).
 snapshot-keep-recent = {{ .StateSync.SnapshotKeepRecent }}
 
+###############################################################################
+###                        Rate Limit Configuration                         ###
+###############################################################################
+
+# Rate limits restrict the txs that each signer can submit to the mempool of the
+# node over a sliding window. They only apply to CheckTx, and are not part of the
+# consensus.
+[rate-limit]
+
+# enable defines if the rate limits should be enabled.
+enable = {{ .RateLimit.Enable }}
+
+# window defines the duration of the sliding window.
+window = "{{ .RateLimit.Window }}"
+
+# max-txs defines the max number of txs of a signer in the window (0 to disable).
+max-txs = {{ .RateLimit.MaxTxs }}
+
+# max-gas defines the max sum of the gas limits of the txs of a signer in the
+# window (0 to disable).
+max-gas = {{ .RateLimit.MaxGas }}
+
 ###############################################################################
 ###                         Store / State Streaming                         ###
 ###############################################################################
@@ -262,6 +284,37 @@ stop-node-on-error = "{{ .Streamers.File.StopNodeOnError }}"
 
 # fsync specifies if call fsync after writing the files.
 fsync = "{{ .Streamers.File.Fsync }}"
+
+# compression of the files, either "none", "gzip" or "zstd".
+compression = "{{ .Streamers.File.Compression }}"
+
+# max-file-size and max-file-blocks enable the rotation of the files: the blocks
+# are appended to a segment file until it reaches max-file-size bytes or
+# max-file-blocks blocks, 0 disables a limit. Without rotation, each block is
+# written to its own files.
+max-file-size = {{ .Streamers.File.MaxFileSize }}
+max-file-blocks = {{ .Streamers.File.MaxFileBlocks }}
+
+# retain-blocks is the number of recent blocks for which the files are kept,
+# 0 keeps all the files.
+retain-blocks = {{ .Streamers.File.RetainBlocks }}
+
+[streamers.grpc]
+keys = [{{ range .Streamers.GRPC.Keys }}{{ printf "%q, " . }}{{end}}]
+
+# address of the external listener, either unix:///path/to/socket or host:port.
+address = "{{ .Streamers.GRPC.Address }}"
+
+# buffer-size is the number of messages queued for delivery, block processing
+# waits for the listener when the queue is full.
+buffer-size = {{ .Streamers.GRPC.BufferSize }}
+
+# timeout of the delivery of a single message, 0 disables the timeout.
+timeout = "{{ .Streamers.GRPC.Timeout }}"
+
+# stop-node-on-error specifies if the node waits for the messages of each block
+# to be delivered at commit and halts if a delivery failed.
+stop-node-on-error = "{{ .Streamers.GRPC.StopNodeOnError }}"
 `
 
 var configTemplate *template.Template
@@ -64,6 +64,12 @@ const (
 	FlagStateSyncSnapshotInterval   = "state-sync.snapshot-interval"
 	FlagStateSyncSnapshotKeepRecent = "state-sync.snapshot-keep-recent"
 
+	// rate limit-related flags
+	FlagRateLimitEnable = "rate-limit.enable"
+	FlagRateLimitWindow = "rate-limit.window"
+	FlagRateLimitMaxTxs = "rate-limit.max-txs"
+	FlagRateLimitMaxGas = "rate-limit.max-gas"
+
 	// api-related flags
 	FlagAPIEnable             = "api.enable"
 	FlagAPISwagger            = "api.swagger"
@@ -193,6 +199,11 @@ is performed. Note, when enabled, gRPC will also be automatically enabled.
 	cmd.Flags().Uint64(FlagStateSyncSnapshotInterval, 0, "State sync snapshot interval")
 	cmd.Flags().Uint32(FlagStateSyncSnapshotKeepRecent, 2, "State sync snapshot to keep")
 
+	cmd.Flags().Bool(FlagRateLimitEnable, false, "Enable the per-signer rate limits of the txs submitted to the mempool")
+	cmd.Flags().Duration(FlagRateLimitWindow, time.Minute, "Duration of the sliding window of the rate limits")
+	cmd.Flags().Uint64(FlagRateLimitMaxTxs, 100, "Max number of txs of a signer in the rate limit window (0 to disable)")
+	cmd.Flags().Uint64(FlagRateLimitMaxGas, 100_000_000, "Max sum of the gas limits of the txs of a signer in the rate limit window (0 to disable)")
+
 	cmd.Flags().Bool(FlagDisableIAVLFastNode, false, "Disable fast node for IAVL tree")
 
 	// add support for all Tendermint-specific command line options
@@ -1,6 +1,7 @@
 package simapp
 
 import (
+	"context"
 	"encoding/json"
 	"os"
 	"testing"
@@ -13,10 +14,19 @@ import (
 	dbm "github.com/tendermint/tm-db"
 
 	"github.com/cosmos/cosmos-sdk/baseapp"
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
+	"github.com/cosmos/cosmos-sdk/simapp/helpers"
 	"github.com/cosmos/cosmos-sdk/tests/mocks"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	"github.com/cosmos/cosmos-sdk/types/module"
+	"github.com/cosmos/cosmos-sdk/types/tx/signing"
 	"github.com/cosmos/cosmos-sdk/x/auth"
+	authsigning "github.com/cosmos/cosmos-sdk/x/auth/signing"
+	authtx "github.com/cosmos/cosmos-sdk/x/auth/tx"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
 	"github.com/cosmos/cosmos-sdk/x/auth/vesting"
 	authzmodule "github.com/cosmos/cosmos-sdk/x/authz/module"
 	"github.com/cosmos/cosmos-sdk/x/bank"
@@ -24,8 +34,11 @@ import (
 	"github.com/cosmos/cosmos-sdk/x/capability"
 	"github.com/cosmos/cosmos-sdk/x/crisis"
 	"github.com/cosmos/cosmos-sdk/x/distribution"
+	"github.com/cosmos/cosmos-sdk/x/epoching"
 	"github.com/cosmos/cosmos-sdk/x/evidence"
 	feegrantmodule "github.com/cosmos/cosmos-sdk/x/feegrant/module"
+	"github.com/cosmos/cosmos-sdk/x/feemarket"
+	"github.com/cosmos/cosmos-sdk/x/freeze"
 	"github.com/cosmos/cosmos-sdk/x/genutil"
 	"github.com/cosmos/cosmos-sdk/x/gov"
 	group "github.com/cosmos/cosmos-sdk/x/group/module"
@@ -33,6 +46,7 @@ import (
 	"github.com/cosmos/cosmos-sdk/x/params"
 	"github.com/cosmos/cosmos-sdk/x/slashing"
 	"github.com/cosmos/cosmos-sdk/x/staking"
+	"github.com/cosmos/cosmos-sdk/x/tokenfactory"
 	"github.com/cosmos/cosmos-sdk/x/upgrade"
 )
 
@@ -67,6 +81,61 @@ func TestSimAppExportAndBlockedAddrs(t *testing.T) {
 	require.NoError(t, err, "ExportAppStateAndValidators should not have an error")
 }
 
+func TestTxConfigTextual(t *testing.T) {
+	priv := secp256k1.GenPrivKey()
+	addr := sdk.AccAddress(priv.PubKey().Address())
+	coins := sdk.NewCoins(sdk.NewInt64Coin("uatom", 2_500_000))
+	acc := &authtypes.BaseAccount{Address: addr.String()}
+	app := SetupWithGenesisAccounts(t, []authtypes.GenesisAccount{acc}, banktypes.Balance{Address: addr.String(), Coins: coins})
+
+	header := tmproto.Header{Height: app.LastBlockHeight() + 1}
+	app.BeginBlock(abci.RequestBeginBlock{Header: header})
+	app.BankKeeper.SetDenomMetaData(app.BaseApp.NewContext(false, header), banktypes.Metadata{
+		Base:       "uatom",
+		Display:    "atom",
+		DenomUnits: []*banktypes.DenomUnit{{Denom: "uatom"}, {Denom: "atom", Exponent: 6}},
+	})
+	app.EndBlock(abci.RequestEndBlock{})
+	app.Commit()
+
+	ctx := app.BaseApp.NewContext(true, tmproto.Header{})
+	accNum := app.AccountKeeper.GetAccount(ctx, addr).GetAccountNumber()
+
+	// signTextual signs a MsgSend in SIGN_MODE_TEXTUAL, rendering it with the
+	// SignModeHandler of the provided TxConfig
+	signTextual := func(txConfig client.TxConfig) sdk.Tx {
+		txBuilder := app.TxConfig().NewTxBuilder()
+		require.NoError(t, txBuilder.SetMsgs(banktypes.NewMsgSend(addr, addr, sdk.NewCoins(sdk.NewInt64Coin("uatom", 1_500_000)))))
+		txBuilder.SetGasLimit(helpers.DefaultGenTxGas)
+
+		sigData := &signing.SingleSignatureData{SignMode: signing.SignMode_SIGN_MODE_TEXTUAL}
+		sig := signing.SignatureV2{PubKey: priv.PubKey(), Data: sigData}
+		require.NoError(t, txBuilder.SetSignatures(sig))
+
+		signerData := authsigning.SignerData{Address: addr.String(), AccountNumber: accNum, PubKey: priv.PubKey()}
+		signBytes, err := authsigning.GetSignBytesWithContext(sdk.WrapSDKContext(ctx), txConfig.SignModeHandler(), signing.SignMode_SIGN_MODE_TEXTUAL, signerData, txBuilder.GetTx())
+		require.NoError(t, err)
+		sigData.Signature, err = priv.Sign(signBytes)
+		require.NoError(t, err)
+		require.NoError(t, txBuilder.SetSignatures(sig))
+
+		return txBuilder.GetTx()
+	}
+
+	// the sign bytes rendered without the denom metadata of the chain differ
+	// from the ones of the ante handler
+	noMetadataTxConfig := authtx.NewTxConfigWithTextual(
+		codec.NewProtoCodec(app.InterfaceRegistry()),
+		authtx.DefaultSignModes,
+		func(context.Context, string) (*banktypes.Metadata, error) { return nil, nil },
+	)
+	_, _, err := app.SimCheck(app.TxConfig().TxEncoder(), signTextual(noMetadataTxConfig))
+	require.ErrorIs(t, err, sdkerrors.ErrUnauthorized)
+
+	_, _, err = app.SimCheck(app.TxConfig().TxEncoder(), signTextual(app.TxConfig()))
+	require.NoError(t, err)
+}
+
 func TestGetMaccPerms(t *testing.T) {
 	dup := GetMaccPerms()
 	require.Equal(t, maccPerms, dup, "duplicated module account permissions differed from actual module account permissions")
@@ -128,8 +197,13 @@ func TestRunMigrations(t *testing.T) {
 			false, "", true, "no migration found for module bank from version 2 to version 3: not found", 0,
 		},
 		{
-			"can register 2->3 migration handler for x/bank, can run migration",
+			"can register 2->3 migration handler for x/bank, cannot run migration",
 			"bank", 2,
+			false, "", true, "no migration found for module bank from version 3 to version 4: not found", 0,
+		},
+		{
+			"can register 3->4 migration handler for x/bank, can run migration",
+			"bank", 3,
 			false, "", false, "", 1,
 		},
 		{
@@ -188,6 +262,10 @@ func TestRunMigrations(t *testing.T) {
 					"crisis":       crisis.AppModule{}.ConsensusVersion(),
 					"genutil":      genutil.AppModule{}.ConsensusVersion(),
 					"capability":   capability.AppModule{}.ConsensusVersion(),
+					"epoching":     epoching.AppModule{}.ConsensusVersion(),
+					"feemarket":    feemarket.AppModule{}.ConsensusVersion(),
+					"tokenfactory": tokenfactory.AppModule{}.ConsensusVersion(),
+					"freeze":       freeze.AppModule{}.ConsensusVersion(),
 				},
 			)
 			if tc.expRunErr {
@@ -25,14 +25,19 @@ import (
 	authzkeeper "github.com/cosmos/cosmos-sdk/x/authz/keeper"
 	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
 	capabilitytypes "github.com/cosmos/cosmos-sdk/x/capability/types"
+	circuittypes "github.com/cosmos/cosmos-sdk/x/circuit/types"
 	distrtypes "github.com/cosmos/cosmos-sdk/x/distribution/types"
+	epochingtypes "github.com/cosmos/cosmos-sdk/x/epoching/types"
 	evidencetypes "github.com/cosmos/cosmos-sdk/x/evidence/types"
+	feemarkettypes "github.com/cosmos/cosmos-sdk/x/feemarket/types"
+	freezetypes "github.com/cosmos/cosmos-sdk/x/freeze/types"
 	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
 	minttypes "github.com/cosmos/cosmos-sdk/x/mint/types"
 	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
 	"github.com/cosmos/cosmos-sdk/x/simulation"
 	slashingtypes "github.com/cosmos/cosmos-sdk/x/slashing/types"
 	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+	tokenfactorytypes "github.com/cosmos/cosmos-sdk/x/tokenfactory/types"
 )
 
 // Get flags every time the simulator is run
@@ -191,6 +196,14 @@ func TestAppImportExport(t *testing.T) {
 		{app.keys[evidencetypes.StoreKey], newApp.keys[evidencetypes.StoreKey], [][]byte{}},
 		{app.keys[capabilitytypes.StoreKey], newApp.keys[capabilitytypes.StoreKey], [][]byte{}},
 		{app.keys[authzkeeper.StoreKey], newApp.keys[authzkeeper.StoreKey], [][]byte{authzkeeper.GrantKey, authzkeeper.GrantQueuePrefix}},
+		{app.keys[circuittypes.StoreKey], newApp.keys[circuittypes.StoreKey], [][]byte{}},
+		{
+			app.keys[epochingtypes.StoreKey], newApp.keys[epochingtypes.StoreKey],
+			[][]byte{epochingtypes.NextEpochActionID, epochingtypes.EpochActionQueuePrefix},
+		}, // the queued actions are renumbered by InitGenesis
+		{app.keys[feemarkettypes.StoreKey], newApp.keys[feemarkettypes.StoreKey], [][]byte{}},
+		{app.keys[freezetypes.StoreKey], newApp.keys[freezetypes.StoreKey], [][]byte{}},
+		{app.keys[tokenfactorytypes.StoreKey], newApp.keys[tokenfactorytypes.StoreKey], [][]byte{}},
 	}
 
 	for _, skp := range storeKeysPrefixes {
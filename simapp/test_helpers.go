@@ -203,7 +203,7 @@ func genesisStateWithValSet(t *testing.T,
 	})
 
 	// update total supply
-	bankGenesis := banktypes.NewGenesisState(banktypes.DefaultGenesisState().Params, balances, totalSupply, []banktypes.Metadata{})
+	bankGenesis := banktypes.NewGenesisState(banktypes.DefaultGenesisState().Params, balances, totalSupply, []banktypes.Metadata{}, []banktypes.SendEnabled{})
 	genesisState[banktypes.ModuleName] = app.AppCodec().MustMarshalJSON(bankGenesis)
 
 	// update distribution moderator and base address
@@ -215,8 +215,8 @@ func genesisStateWithValSet(t *testing.T,
 	if distrGenesis.ModeratorAddress == "" {
 		distrGenesis.ModeratorAddress = addrStr
 	}
-	if distrGenesis.BaseAddress == "" {
-		distrGenesis.BaseAddress = addrStr
+	if len(distrGenesis.BaseRecipients) == 0 && distrGenesis.BaseAddress == "" {
+		distrGenesis.BaseRecipients = []distrtypes.BaseRecipient{{Address: addrStr, Weight: sdk.OneDec()}}
 	}
 	genesisState[distrtypes.ModuleName] = app.AppCodec().MustMarshalJSON(distrGenesis)
 
@@ -534,6 +534,21 @@ func NewPubKeyFromHex(pk string) (res cryptotypes.PubKey) {
 	return &ed25519.PubKey{Key: pkBytes}
 }
 
+// EndEpoch commits empty blocks until the end of the current epoch, which
+// executes the staking msgs queued through the epoching module.
+func EndEpoch(app *SimApp) {
+	for {
+		header := tmproto.Header{Height: app.LastBlockHeight() + 1}
+		app.BeginBlock(abci.RequestBeginBlock{Header: header})
+		app.EndBlock(abci.RequestEndBlock{Height: header.Height})
+		app.Commit()
+
+		if app.EpochingKeeper.IsEpochEnd(app.BaseApp.NewContext(true, header)) {
+			return
+		}
+	}
+}
+
 // EmptyAppOptions is a stub implementing AppOptions
 type EmptyAppOptions struct{}
 
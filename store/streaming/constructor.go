@@ -12,6 +12,7 @@ import (
 	"github.com/cosmos/cosmos-sdk/codec"
 	serverTypes "github.com/cosmos/cosmos-sdk/server/types"
 	"github.com/cosmos/cosmos-sdk/store/streaming/file"
+	"github.com/cosmos/cosmos-sdk/store/streaming/grpc"
 	"github.com/cosmos/cosmos-sdk/store/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
@@ -27,6 +28,7 @@ type ServiceType int
 const (
 	Unknown ServiceType = iota
 	File
+	GRPC
 )
 
 // Streaming option keys
@@ -36,6 +38,15 @@ const (
 	OptStreamersFileOutputMetadata  = "streamers.file.output-metadata"
 	OptStreamersFileStopNodeOnError = "streamers.file.stop-node-on-error"
 	OptStreamersFileFsync           = "streamers.file.fsync"
+	OptStreamersFileCompression     = "streamers.file.compression"
+	OptStreamersFileMaxFileSize     = "streamers.file.max-file-size"
+	OptStreamersFileMaxFileBlocks   = "streamers.file.max-file-blocks"
+	OptStreamersFileRetainBlocks    = "streamers.file.retain-blocks"
+
+	OptStreamersGRPCAddress         = "streamers.grpc.address"
+	OptStreamersGRPCBufferSize      = "streamers.grpc.buffer-size"
+	OptStreamersGRPCTimeout         = "streamers.grpc.timeout"
+	OptStreamersGRPCStopNodeOnError = "streamers.grpc.stop-node-on-error"
 
 	OptStoreStreamers = "store.streamers"
 )
@@ -47,6 +58,9 @@ func ServiceTypeFromString(name string) ServiceType {
 	case "file", "f":
 		return File
 
+	case "grpc":
+		return GRPC
+
 	default:
 		return Unknown
 	}
@@ -58,6 +72,9 @@ func (sst ServiceType) String() string {
 	case File:
 		return "file"
 
+	case GRPC:
+		return "grpc"
+
 	default:
 		return "unknown"
 	}
@@ -67,6 +84,7 @@ func (sst ServiceType) String() string {
 // streaming.ServiceConstructors types.
 var ServiceConstructorLookupTable = map[ServiceType]ServiceConstructor{
 	File: NewFileStreamingService,
+	GRPC: NewGRPCStreamingService,
 }
 
 // NewServiceConstructor returns the streaming.ServiceConstructor corresponding
@@ -97,6 +115,14 @@ func NewFileStreamingService(
 	outputMetadata := cast.ToBool(opts.Get(OptStreamersFileOutputMetadata))
 	stopNodeOnErr := cast.ToBool(opts.Get(OptStreamersFileStopNodeOnError))
 	fsync := cast.ToBool(opts.Get(OptStreamersFileFsync))
+	maxFileSize := cast.ToInt64(opts.Get(OptStreamersFileMaxFileSize))
+	maxFileBlocks := cast.ToInt64(opts.Get(OptStreamersFileMaxFileBlocks))
+	retainBlocks := cast.ToInt64(opts.Get(OptStreamersFileRetainBlocks))
+
+	compression, err := file.ParseCompression(cast.ToString(opts.Get(OptStreamersFileCompression)))
+	if err != nil {
+		return nil, err
+	}
 
 	// relative path is based on node home directory.
 	if !path.IsAbs(fileDir) {
@@ -110,7 +136,27 @@ func NewFileStreamingService(
 		}
 	}
 
-	return file.NewStreamingService(fileDir, filePrefix, keys, marshaller, outputMetadata, stopNodeOnErr, fsync)
+	return file.NewStreamingService(
+		fileDir, filePrefix, keys, marshaller, outputMetadata, stopNodeOnErr, fsync,
+		file.WithCompression(compression),
+		file.WithRotation(maxFileSize, maxFileBlocks),
+		file.WithRetention(retainBlocks),
+	)
+}
+
+// NewGRPCStreamingService is the streaming.ServiceConstructor function for
+// creating a gRPC StreamingService.
+func NewGRPCStreamingService(
+	opts serverTypes.AppOptions,
+	keys []types.StoreKey,
+	_ codec.BinaryCodec,
+) (baseapp.StreamingService, error) {
+	address := cast.ToString(opts.Get(OptStreamersGRPCAddress))
+	bufferSize := cast.ToInt(opts.Get(OptStreamersGRPCBufferSize))
+	timeout := cast.ToDuration(opts.Get(OptStreamersGRPCTimeout))
+	stopNodeOnErr := cast.ToBool(opts.Get(OptStreamersGRPCStopNodeOnError))
+
+	return grpc.NewStreamingService(address, keys, bufferSize, timeout, stopNodeOnErr)
 }
 
 // LoadStreamingServices is a function for loading StreamingServices onto the
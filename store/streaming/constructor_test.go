@@ -10,6 +10,7 @@ import (
 	"github.com/cosmos/cosmos-sdk/simapp"
 	"github.com/cosmos/cosmos-sdk/store/streaming"
 	"github.com/cosmos/cosmos-sdk/store/streaming/file"
+	"github.com/cosmos/cosmos-sdk/store/streaming/grpc"
 	"github.com/cosmos/cosmos-sdk/store/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/tendermint/tendermint/libs/log"
@@ -54,6 +55,41 @@ func TestStreamingServiceConstructor(t *testing.T) {
 	}
 }
 
+type grpcOptions struct{}
+
+func (f grpcOptions) Get(key string) interface{} {
+	switch key {
+	case "streamers.grpc.address":
+		return "unix:///tmp/listener.sock"
+	case "streamers.grpc.buffer-size":
+		return 10
+	case "streamers.grpc.timeout":
+		return "5s"
+	default:
+		return nil
+	}
+}
+
+func TestGRPCStreamingServiceConstructor(t *testing.T) {
+	constructor, err := streaming.NewServiceConstructor("grpc")
+	require.NoError(t, err)
+
+	serv, err := constructor(grpcOptions{}, mockKeys, testMarshaller)
+	require.NoError(t, err)
+	require.IsType(t, &grpc.StreamingService{}, serv)
+	defer serv.Close()
+
+	listeners := serv.Listeners()
+	for _, key := range mockKeys {
+		_, ok := listeners[key]
+		require.True(t, ok)
+	}
+
+	// the listener address is required
+	_, err = constructor(mockOptions, mockKeys, testMarshaller)
+	require.Error(t, err)
+}
+
 func TestLoadStreamingServices(t *testing.T) {
 	db := dbm.NewMemDB()
 	encCdc := simapp.MakeTestEncodingConfig()
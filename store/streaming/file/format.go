@@ -0,0 +1,243 @@
+package file
+
+import (
+	"compress/gzip"
+	"encoding/binary"
+	"fmt"
+	"io"
+	"os"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/klauspost/compress/zstd"
+)
+
+// Compression is the compression applied to the streamed files.
+type Compression string
+
+const (
+	CompressionNone Compression = ""
+	CompressionGzip Compression = "gzip"
+	CompressionZstd Compression = "zstd"
+)
+
+// ParseCompression returns the Compression corresponding to the provided name.
+func ParseCompression(name string) (Compression, error) {
+	switch strings.ToLower(name) {
+	case "", "none":
+		return CompressionNone, nil
+	case "gzip", "gz":
+		return CompressionGzip, nil
+	case "zstd", "zst":
+		return CompressionZstd, nil
+	default:
+		return CompressionNone, fmt.Errorf("unrecognized compression %s", name)
+	}
+}
+
+// Extension returns the file name extension of the compression.
+func (c Compression) Extension() string {
+	switch c {
+	case CompressionGzip:
+		return ".gz"
+	case CompressionZstd:
+		return ".zst"
+	default:
+		return ""
+	}
+}
+
+// flushWriteCloser is a compressing writer. Flush writes the pending data
+// through so that it can be decompressed, Close also ends the stream.
+type flushWriteCloser interface {
+	io.WriteCloser
+	Flush() error
+}
+
+type nopFlushWriteCloser struct{ io.Writer }
+
+func (nopFlushWriteCloser) Flush() error { return nil }
+func (nopFlushWriteCloser) Close() error { return nil }
+
+func (c Compression) newWriter(w io.Writer) (flushWriteCloser, error) {
+	switch c {
+	case CompressionGzip:
+		return gzip.NewWriter(w), nil
+	case CompressionZstd:
+		return zstd.NewWriter(w)
+	default:
+		return nopFlushWriteCloser{w}, nil
+	}
+}
+
+// NewReader returns a reader decompressing r.
+func (c Compression) NewReader(r io.Reader) (io.ReadCloser, error) {
+	switch c {
+	case CompressionGzip:
+		return gzip.NewReader(r)
+	case CompressionZstd:
+		dec, err := zstd.NewReader(r)
+		if err != nil {
+			return nil, err
+		}
+		return dec.IOReadCloser(), nil
+	default:
+		return io.NopCloser(r), nil
+	}
+}
+
+// FileInfo describes a file written by the StreamingService.
+//
+// Without rotation, the data and the metadata of each block are written to
+// their own files, named [<prefix>-]block-<height>-{data,meta}, which contain
+// a big endian uint64 length followed by the payload. With rotation, several
+// blocks are written to segment files, named [<prefix>-]segment-<height>-{data,meta}
+// after their first block, which contain for each block its big endian int64
+// height, a big endian uint64 length and the payload. Compressed files have
+// the extension of the compression, their whole content is compressed.
+//
+// The data payload of a block is the sequence of its StoreKVPairs, each
+// prefixed with its uvarint length. The metadata payload is a BlockMetadata.
+type FileInfo struct {
+	Name        string
+	Height      int64
+	Segment     bool
+	Meta        bool
+	Compression Compression
+}
+
+// NewFileInfo returns the FileInfo of a file, including its name.
+func NewFileInfo(prefix string, height int64, segment, meta bool, compression Compression) FileInfo {
+	kind, content := "block", "data"
+	if segment {
+		kind = "segment"
+	}
+	if meta {
+		content = "meta"
+	}
+
+	name := fmt.Sprintf("%s-%d-%s%s", kind, height, content, compression.Extension())
+	if prefix != "" {
+		name = fmt.Sprintf("%s-%s", prefix, name)
+	}
+
+	return FileInfo{
+		Name:        name,
+		Height:      height,
+		Segment:     segment,
+		Meta:        meta,
+		Compression: compression,
+	}
+}
+
+// ParseFileName parses the name of a file written by a StreamingService with
+// the given prefix.
+func ParseFileName(prefix, name string) (FileInfo, bool) {
+	if prefix != "" {
+		if !strings.HasPrefix(name, prefix+"-") {
+			return FileInfo{}, false
+		}
+		name = strings.TrimPrefix(name, prefix+"-")
+	}
+
+	compression := CompressionNone
+	for _, c := range []Compression{CompressionGzip, CompressionZstd} {
+		if strings.HasSuffix(name, c.Extension()) {
+			compression = c
+			name = strings.TrimSuffix(name, c.Extension())
+		}
+	}
+
+	parts := strings.Split(name, "-")
+	if len(parts) != 3 || (parts[0] != "block" && parts[0] != "segment") || (parts[2] != "data" && parts[2] != "meta") {
+		return FileInfo{}, false
+	}
+
+	height, err := strconv.ParseInt(parts[1], 10, 64)
+	if err != nil {
+		return FileInfo{}, false
+	}
+
+	info := NewFileInfo(prefix, height, parts[0] == "segment", parts[2] == "meta", compression)
+	return info, true
+}
+
+// ListFiles returns the files written by a StreamingService with the given
+// prefix in dir, ordered by height, data files first.
+func ListFiles(dir, prefix string) ([]FileInfo, error) {
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		return nil, err
+	}
+
+	var files []FileInfo
+	for _, entry := range entries {
+		if entry.IsDir() {
+			continue
+		}
+		if info, ok := ParseFileName(prefix, entry.Name()); ok {
+			files = append(files, info)
+		}
+	}
+
+	sort.SliceStable(files, func(i, j int) bool {
+		if files[i].Height != files[j].Height {
+			return files[i].Height < files[j].Height
+		}
+		return !files[i].Meta && files[j].Meta
+	})
+	return files, nil
+}
+
+// writeSegmentEntry writes the payload of a block to a segment.
+func writeSegmentEntry(w io.Writer, height int64, payload []byte) error {
+	var header [16]byte
+	binary.BigEndian.PutUint64(header[:8], uint64(height))
+	binary.BigEndian.PutUint64(header[8:], uint64(len(payload)))
+	if _, err := w.Write(header[:]); err != nil {
+		return err
+	}
+
+	_, err := w.Write(payload)
+	return err
+}
+
+// ReadSegmentEntry reads the payload of the next block of a segment. It returns
+// io.EOF at the end of the segment and io.ErrUnexpectedEOF if the last entry is
+// incomplete, which happens when a node stops while writing it.
+func ReadSegmentEntry(r io.Reader) (height int64, payload []byte, err error) {
+	var header [16]byte
+	if _, err := io.ReadFull(r, header[:]); err != nil {
+		return 0, nil, err
+	}
+
+	height = int64(binary.BigEndian.Uint64(header[:8]))
+	payload = make([]byte, binary.BigEndian.Uint64(header[8:]))
+	if _, err := io.ReadFull(r, payload); err != nil {
+		if err == io.EOF {
+			err = io.ErrUnexpectedEOF
+		}
+		return 0, nil, err
+	}
+
+	return height, payload, nil
+}
+
+// ReadBlockFile reads the payload of a file written without rotation.
+func ReadBlockFile(r io.Reader) ([]byte, error) {
+	var header [8]byte
+	if _, err := io.ReadFull(r, header[:]); err != nil {
+		return nil, err
+	}
+
+	payload := make([]byte, binary.BigEndian.Uint64(header[:]))
+	if _, err := io.ReadFull(r, payload); err != nil {
+		if err == io.EOF {
+			err = io.ErrUnexpectedEOF
+		}
+		return nil, err
+	}
+
+	return payload, nil
+}
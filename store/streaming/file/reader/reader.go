@@ -0,0 +1,234 @@
+// Package reader reads the files written by the file streaming service back
+// into typed state changes.
+package reader
+
+import (
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+
+	"github.com/cosmos/cosmos-sdk/store/streaming/file"
+	"github.com/cosmos/cosmos-sdk/store/types"
+)
+
+// Block is the data streamed for a block.
+type Block struct {
+	Height int64
+	// Meta is the block metadata, nil if the metadata files were not written.
+	Meta *types.BlockMetadata
+	// Changes are the state changes of the block, in the order of the store
+	// key names.
+	Changes []types.StoreKVPair
+}
+
+// Reader reads the blocks streamed to a directory by a file streaming service.
+type Reader struct {
+	dir       string
+	prefix    string
+	storeKeys map[string]bool
+}
+
+// NewReader creates a Reader for the files with the given prefix in dir. If
+// storeKeys are provided, only the changes of these stores are returned.
+func NewReader(dir, prefix string, storeKeys ...string) *Reader {
+	r := &Reader{dir: dir, prefix: prefix}
+	if len(storeKeys) > 0 {
+		r.storeKeys = make(map[string]bool, len(storeKeys))
+		for _, key := range storeKeys {
+			r.storeKeys[key] = true
+		}
+	}
+
+	return r
+}
+
+// Iterate calls fn for each block from fromHeight to toHeight, in height order,
+// until fn returns an error. A zero toHeight means no upper bound.
+//
+// A block which was not completely written, because the node stopped while
+// writing it, is skipped: the node writes it again when it restarts. If a
+// block was written several times, only the first one is returned.
+func (r *Reader) Iterate(fromHeight, toHeight int64, fn func(Block) error) error {
+	files, err := file.ListFiles(r.dir, r.prefix)
+	if err != nil {
+		return err
+	}
+
+	metaFiles := map[string]file.FileInfo{}
+	var dataFiles []file.FileInfo
+	for _, info := range files {
+		if info.Meta {
+			metaFiles[fileKey(info)] = info
+		} else {
+			dataFiles = append(dataFiles, info)
+		}
+	}
+
+	lastHeight := int64(0)
+	for i, info := range dataFiles {
+		if toHeight > 0 && info.Height > toHeight {
+			break
+		}
+		// skip the segments which end before fromHeight
+		if info.Segment && i+1 < len(dataFiles) && dataFiles[i+1].Height <= fromHeight {
+			continue
+		}
+
+		blocks, err := r.readFile(info, metaFiles)
+		if err != nil {
+			return err
+		}
+
+		for _, block := range blocks {
+			if block.Height <= lastHeight || block.Height < fromHeight {
+				continue
+			}
+			if toHeight > 0 && block.Height > toHeight {
+				return nil
+			}
+
+			lastHeight = block.Height
+			if err := fn(block); err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
+// readFile reads the completely written blocks of a data file and of the
+// corresponding metadata file.
+func (r *Reader) readFile(info file.FileInfo, metaFiles map[string]file.FileInfo) ([]Block, error) {
+	payloads, err := r.readPayloads(info)
+	if err != nil {
+		return nil, err
+	}
+
+	var metas map[int64][]byte
+	if metaInfo, ok := metaFiles[fileKey(info)]; ok {
+		metaPayloads, err := r.readPayloads(metaInfo)
+		if err != nil {
+			return nil, err
+		}
+
+		metas = make(map[int64][]byte, len(metaPayloads))
+		for _, payload := range metaPayloads {
+			metas[payload.height] = payload.bz
+		}
+	}
+
+	blocks := make([]Block, 0, len(payloads))
+	for _, payload := range payloads {
+		block := Block{Height: payload.height}
+
+		if metas != nil {
+			bz, ok := metas[payload.height]
+			if !ok {
+				// the metadata of the last block was not written
+				break
+			}
+			block.Meta = &types.BlockMetadata{}
+			if err := block.Meta.Unmarshal(bz); err != nil {
+				return nil, fmt.Errorf("decode metadata of block %d: %w", payload.height, err)
+			}
+		}
+
+		block.Changes, err = r.decodeChanges(payload.bz)
+		if err != nil {
+			return nil, fmt.Errorf("decode changes of block %d: %w", payload.height, err)
+		}
+
+		blocks = append(blocks, block)
+	}
+
+	return blocks, nil
+}
+
+type payload struct {
+	height int64
+	bz     []byte
+}
+
+// readPayloads reads the payloads of the completely written blocks of a file.
+func (r *Reader) readPayloads(info file.FileInfo) ([]payload, error) {
+	f, err := os.Open(filepath.Join(r.dir, info.Name))
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+
+	reader, err := info.Compression.NewReader(f)
+	if err != nil {
+		if isTruncated(err) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("%s: %w", info.Name, err)
+	}
+	defer reader.Close()
+
+	if !info.Segment {
+		bz, err := file.ReadBlockFile(reader)
+		if err != nil {
+			if isTruncated(err) {
+				return nil, nil
+			}
+			return nil, fmt.Errorf("%s: %w", info.Name, err)
+		}
+
+		return []payload{{height: info.Height, bz: bz}}, nil
+	}
+
+	var payloads []payload
+	for {
+		height, bz, err := file.ReadSegmentEntry(reader)
+		switch {
+		case isTruncated(err):
+			return payloads, nil
+		case err != nil:
+			return nil, fmt.Errorf("%s: %w", info.Name, err)
+		}
+
+		payloads = append(payloads, payload{height: height, bz: bz})
+	}
+}
+
+// decodeChanges decodes the length prefixed StoreKVPairs of a data payload,
+// keeping the ones of the selected stores.
+func (r *Reader) decodeChanges(bz []byte) ([]types.StoreKVPair, error) {
+	var changes []types.StoreKVPair
+	for len(bz) > 0 {
+		size, n := binary.Uvarint(bz)
+		if n <= 0 || uint64(len(bz)-n) < size {
+			return nil, io.ErrUnexpectedEOF
+		}
+		bz = bz[n:]
+
+		var pair types.StoreKVPair
+		if err := pair.Unmarshal(bz[:size]); err != nil {
+			return nil, err
+		}
+		bz = bz[size:]
+
+		if r.storeKeys == nil || r.storeKeys[pair.StoreKey] {
+			changes = append(changes, pair)
+		}
+	}
+
+	return changes, nil
+}
+
+// isTruncated reports whether err is caused by a file which was not completely
+// written. Compressed streams which were flushed but not closed also end with
+// an unexpected EOF.
+func isTruncated(err error) bool {
+	return errors.Is(err, io.ErrUnexpectedEOF) || errors.Is(err, io.EOF)
+}
+
+// fileKey identifies the files of the same blocks.
+func fileKey(info file.FileInfo) string {
+	return fmt.Sprintf("%t-%d", info.Segment, info.Height)
+}
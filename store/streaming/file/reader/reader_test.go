@@ -0,0 +1,163 @@
+package reader_test
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	abci "github.com/tendermint/tendermint/abci/types"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	"github.com/cosmos/cosmos-sdk/store/streaming/file"
+	"github.com/cosmos/cosmos-sdk/store/streaming/file/reader"
+	"github.com/cosmos/cosmos-sdk/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+var (
+	storeKey1 = sdk.NewKVStoreKey("store1")
+	storeKey2 = sdk.NewKVStoreKey("store2")
+	cdc       = codec.NewProtoCodec(codectypes.NewInterfaceRegistry())
+)
+
+// streamBlocks writes the blocks fromHeight to toHeight with the file
+// streaming service, each block sets its height in store1 and deletes it in
+// store2.
+func streamBlocks(t *testing.T, dir string, fromHeight, toHeight int64, opts ...file.Option) {
+	service, err := file.NewStreamingService(dir, "test", []types.StoreKey{storeKey2, storeKey1}, cdc, true, true, false, opts...)
+	require.NoError(t, err)
+	defer service.Close()
+
+	writeBlocks(t, service, fromHeight, toHeight)
+}
+
+func writeBlocks(t *testing.T, service *file.StreamingService, fromHeight, toHeight int64) {
+	listeners := service.Listeners()
+	ctx := context.Background()
+	for height := fromHeight; height <= toHeight; height++ {
+		require.NoError(t, service.ListenBeginBlock(ctx, abci.RequestBeginBlock{Header: tmproto.Header{Height: height}}, abci.ResponseBeginBlock{}))
+		listeners[storeKey1][0].OnWrite(storeKey1, sdk.Uint64ToBigEndian(uint64(height)), []byte{1}, false)
+		listeners[storeKey2][0].OnWrite(storeKey2, sdk.Uint64ToBigEndian(uint64(height)), nil, true)
+		require.NoError(t, service.ListenDeliverTx(ctx, abci.RequestDeliverTx{Tx: []byte{byte(height)}}, abci.ResponseDeliverTx{}))
+		require.NoError(t, service.ListenEndBlock(ctx, abci.RequestEndBlock{Height: height}, abci.ResponseEndBlock{}))
+		require.NoError(t, service.ListenCommit(ctx, abci.ResponseCommit{}))
+	}
+}
+
+func readBlocks(t *testing.T, r *reader.Reader, fromHeight, toHeight int64) []reader.Block {
+	var blocks []reader.Block
+	require.NoError(t, r.Iterate(fromHeight, toHeight, func(block reader.Block) error {
+		blocks = append(blocks, block)
+		return nil
+	}))
+	return blocks
+}
+
+func TestReader(t *testing.T) {
+	testCases := map[string][]file.Option{
+		"one file per block":       nil,
+		"gzip":                     {file.WithCompression(file.CompressionGzip)},
+		"zstd":                     {file.WithCompression(file.CompressionZstd)},
+		"rotation by blocks":       {file.WithRotation(0, 3)},
+		"rotation by size":         {file.WithRotation(100, 0)},
+		"rotation with gzip":       {file.WithRotation(0, 3), file.WithCompression(file.CompressionGzip)},
+		"rotation with zstd":       {file.WithRotation(0, 3), file.WithCompression(file.CompressionZstd)},
+		"retention":                {file.WithRetention(4)},
+		"rotation with retention":  {file.WithRotation(0, 3), file.WithRetention(4)},
+		"rotation, gzip, retained": {file.WithRotation(0, 2), file.WithCompression(file.CompressionGzip), file.WithRetention(3)},
+	}
+
+	for name, opts := range testCases {
+		t.Run(name, func(t *testing.T) {
+			dir := t.TempDir()
+			streamBlocks(t, dir, 1, 10, opts...)
+
+			blocks := readBlocks(t, reader.NewReader(dir, "test"), 0, 0)
+			require.NotEmpty(t, blocks)
+			require.Equal(t, int64(10), blocks[len(blocks)-1].Height)
+			for i, block := range blocks {
+				if i > 0 {
+					require.Equal(t, blocks[i-1].Height+1, block.Height)
+				}
+				require.Equal(t, block.Height, block.Meta.RequestBeginBlock.Header.Height)
+				require.Len(t, block.Meta.DeliverTxs, 1)
+				require.Equal(t, []types.StoreKVPair{
+					{StoreKey: "store1", Key: sdk.Uint64ToBigEndian(uint64(block.Height)), Value: []byte{1}},
+					{StoreKey: "store2", Key: sdk.Uint64ToBigEndian(uint64(block.Height)), Delete: true},
+				}, block.Changes)
+			}
+
+			// filter by height and store key
+			blocks = readBlocks(t, reader.NewReader(dir, "test", "store2"), 8, 9)
+			require.Len(t, blocks, 2)
+			require.Equal(t, int64(8), blocks[0].Height)
+			require.Equal(t, []types.StoreKVPair{
+				{StoreKey: "store2", Key: sdk.Uint64ToBigEndian(9), Delete: true},
+			}, blocks[1].Changes)
+		})
+	}
+}
+
+func TestReaderRetention(t *testing.T) {
+	dir := t.TempDir()
+	streamBlocks(t, dir, 1, 10, file.WithRotation(0, 3), file.WithRetention(4))
+
+	// blocks 7 to 10 are retained, which are in the segments starting at 7 and 10
+	files, err := file.ListFiles(dir, "test")
+	require.NoError(t, err)
+	var names []string
+	for _, info := range files {
+		names = append(names, info.Name)
+	}
+	require.Equal(t, []string{
+		"test-segment-7-data", "test-segment-7-meta",
+		"test-segment-10-data", "test-segment-10-meta",
+	}, names)
+
+	blocks := readBlocks(t, reader.NewReader(dir, "test"), 0, 0)
+	require.Len(t, blocks, 4)
+	require.Equal(t, int64(7), blocks[0].Height)
+}
+
+func TestReaderTruncated(t *testing.T) {
+	dir := t.TempDir()
+	streamBlocks(t, dir, 1, 4, file.WithRotation(0, 10))
+
+	// the node stopped while writing the last block
+	path := filepath.Join(dir, file.NewFileInfo("test", 1, true, false, file.CompressionNone).Name)
+	info, err := os.Stat(path)
+	require.NoError(t, err)
+	require.NoError(t, os.Truncate(path, info.Size()-5))
+
+	blocks := readBlocks(t, reader.NewReader(dir, "test"), 0, 0)
+	require.Len(t, blocks, 3)
+
+	// the node restarts at the height of the truncated block
+	streamBlocks(t, dir, 4, 5, file.WithRotation(0, 10))
+	blocks = readBlocks(t, reader.NewReader(dir, "test"), 0, 0)
+	require.Len(t, blocks, 5)
+	require.Equal(t, int64(5), blocks[4].Height)
+}
+
+func TestReaderOpenSegment(t *testing.T) {
+	for _, compression := range []file.Compression{file.CompressionNone, file.CompressionGzip, file.CompressionZstd} {
+		t.Run(string(compression), func(t *testing.T) {
+			dir := t.TempDir()
+			service, err := file.NewStreamingService(dir, "test", []types.StoreKey{storeKey1, storeKey2}, cdc, true, true, false,
+				file.WithRotation(0, 10), file.WithCompression(compression))
+			require.NoError(t, err)
+			defer service.Close()
+
+			// the blocks of the segment being written can be read
+			writeBlocks(t, service, 1, 2)
+			require.Len(t, readBlocks(t, reader.NewReader(dir, "test"), 0, 0), 2)
+
+			writeBlocks(t, service, 3, 3)
+			require.Len(t, readBlocks(t, reader.NewReader(dir, "test"), 0, 0), 3)
+		})
+	}
+}
@@ -0,0 +1,83 @@
+package file
+
+import (
+	"os"
+
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// segment is a file to which the blocks are appended until it is rotated.
+type segment struct {
+	path   string
+	file   *os.File
+	writer flushWriteCloser
+	size   int64 // bytes written to the file
+	blocks int64
+}
+
+func openSegment(path string, compression Compression) (*segment, error) {
+	f, err := os.OpenFile(path, os.O_WRONLY|os.O_CREATE|os.O_TRUNC, 0o600)
+	if err != nil {
+		return nil, sdkerrors.Wrapf(err, "open file failed: %s", path)
+	}
+
+	seg := &segment{path: path, file: f}
+	seg.writer, err = compression.newWriter(countingWriter{seg})
+	if err != nil {
+		f.Close()
+		return nil, sdkerrors.Wrapf(err, "create compressor failed: %s", path)
+	}
+
+	return seg, nil
+}
+
+// full reports whether the segment reached one of the limits, zero disables a
+// limit.
+func (s *segment) full(maxSize, maxBlocks int64) bool {
+	return (maxSize > 0 && s.size >= maxSize) || (maxBlocks > 0 && s.blocks >= maxBlocks)
+}
+
+// append writes the payload of a block to the segment and flushes it, so that
+// the block can be read before the segment is closed.
+func (s *segment) append(height int64, payload []byte, fsync bool) error {
+	if err := writeSegmentEntry(s.writer, height, payload); err != nil {
+		return sdkerrors.Wrapf(err, "write block data failed: %s", s.path)
+	}
+
+	if err := s.writer.Flush(); err != nil {
+		return sdkerrors.Wrapf(err, "flush block data failed: %s", s.path)
+	}
+
+	if fsync {
+		if err := s.file.Sync(); err != nil {
+			return sdkerrors.Wrapf(err, "fsync failed: %s", s.path)
+		}
+	}
+
+	s.blocks++
+	return nil
+}
+
+func (s *segment) close() error {
+	if err := s.writer.Close(); err != nil {
+		s.file.Close()
+		return sdkerrors.Wrapf(err, "close compressor failed: %s", s.path)
+	}
+
+	if err := s.file.Close(); err != nil {
+		return sdkerrors.Wrapf(err, "close file failed: %s", s.path)
+	}
+
+	return nil
+}
+
+// countingWriter writes to the file of a segment, counting the written bytes.
+type countingWriter struct {
+	s *segment
+}
+
+func (w countingWriter) Write(p []byte) (int, error) {
+	n, err := w.s.file.Write(p)
+	w.s.size += int64(n)
+	return n, err
+}
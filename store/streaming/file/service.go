@@ -43,6 +43,50 @@ type StreamingService struct {
 	// fsync, if true, will execute file Sync to make sure the data is persisted
 	// onto disk, otherwise there is a risk of data loss during any crash.
 	fsync bool
+
+	// compression is applied to the written files.
+	compression Compression
+
+	// maxFileSize and maxFileBlocks, if not zero, enable the rotation: the
+	// blocks are appended to segment files, a new segment is started once the
+	// current one reaches maxFileSize bytes or maxFileBlocks blocks.
+	maxFileSize   int64
+	maxFileBlocks int64
+
+	// retainBlocks, if not zero, is the number of most recent blocks for which
+	// the files are kept, the older files are deleted.
+	retainBlocks int64
+
+	dataSegment *segment
+	metaSegment *segment
+}
+
+// Option configures the optional features of a StreamingService.
+type Option func(*StreamingService)
+
+// WithCompression compresses the written files.
+func WithCompression(compression Compression) Option {
+	return func(fss *StreamingService) {
+		fss.compression = compression
+	}
+}
+
+// WithRotation writes the blocks to segment files, starting a new segment
+// once the current one reaches maxFileSize bytes or maxFileBlocks blocks.
+// Zero disables the corresponding limit.
+func WithRotation(maxFileSize, maxFileBlocks int64) Option {
+	return func(fss *StreamingService) {
+		fss.maxFileSize = maxFileSize
+		fss.maxFileBlocks = maxFileBlocks
+	}
+}
+
+// WithRetention deletes the files which only contain blocks older than the
+// retainBlocks most recent ones. Zero keeps all the files.
+func WithRetention(retainBlocks int64) Option {
+	return func(fss *StreamingService) {
+		fss.retainBlocks = retainBlocks
+	}
 }
 
 func NewStreamingService(
@@ -50,6 +94,7 @@ func NewStreamingService(
 	storeKeys []types.StoreKey,
 	cdc codec.BinaryCodec,
 	outputMetadata, stopNodeOnErr, fsync bool,
+	opts ...Option,
 ) (*StreamingService, error) {
 	// sort storeKeys for deterministic output
 	sort.SliceStable(storeKeys, func(i, j int) bool {
@@ -69,7 +114,7 @@ func NewStreamingService(
 		return nil, err
 	}
 
-	return &StreamingService{
+	fss := &StreamingService{
 		storeListeners: listeners,
 		filePrefix:     filePrefix,
 		writeDir:       writeDir,
@@ -77,7 +122,21 @@ func NewStreamingService(
 		outputMetadata: outputMetadata,
 		stopNodeOnErr:  stopNodeOnErr,
 		fsync:          fsync,
-	}, nil
+	}
+	for _, opt := range opts {
+		opt(fss)
+	}
+
+	if fss.maxFileSize < 0 || fss.maxFileBlocks < 0 || fss.retainBlocks < 0 {
+		return nil, fmt.Errorf("rotation and retention limits must not be negative")
+	}
+
+	return fss, nil
+}
+
+// rotate reports whether the blocks are written to segment files.
+func (fss *StreamingService) rotate() bool {
+	return fss.maxFileSize > 0 || fss.maxFileBlocks > 0
 }
 
 // Listeners satisfies the StreamingService interface. It returns the
@@ -141,34 +200,145 @@ func (fss *StreamingService) ListenCommit(ctx context.Context, res abci.Response
 
 func (fss *StreamingService) doListenCommit(ctx context.Context, res abci.ResponseCommit) (err error) {
 	fss.blockMetadata.ResponseCommit = &res
+	defer func() {
+		fss.blockMetadata = types.BlockMetadata{}
+	}()
 
-	// Write to target files, the file size is written at the beginning, which can
-	// be used to detect completeness.
-	metaFileName := fmt.Sprintf("block-%d-meta", fss.currentBlockNumber)
-	dataFileName := fmt.Sprintf("block-%d-data", fss.currentBlockNumber)
+	var metaBz []byte
+	if fss.outputMetadata {
+		metaBz, err = fss.codec.Marshal(&fss.blockMetadata)
+		if err != nil {
+			return err
+		}
+	}
+
+	var buf bytes.Buffer
+	if err := fss.writeBlockData(&buf); err != nil {
+		return err
+	}
 
-	if fss.filePrefix != "" {
-		metaFileName = fmt.Sprintf("%s-%s", fss.filePrefix, metaFileName)
-		dataFileName = fmt.Sprintf("%s-%s", fss.filePrefix, dataFileName)
+	if fss.rotate() {
+		err = fss.writeSegments(metaBz, buf.Bytes())
+	} else {
+		err = fss.writeBlockFiles(metaBz, buf.Bytes())
+	}
+	if err != nil {
+		return err
 	}
 
+	return fss.prune()
+}
+
+// writeBlockFiles writes the data and metadata of the current block to their
+// own files, the file size is written at the beginning, which can be used to
+// detect completeness.
+func (fss *StreamingService) writeBlockFiles(metaBz, dataBz []byte) error {
 	if fss.outputMetadata {
-		bz, err := fss.codec.Marshal(&fss.blockMetadata)
-		if err != nil {
+		info := NewFileInfo(fss.filePrefix, fss.currentBlockNumber, false, true, fss.compression)
+		if err := writeLengthPrefixedFile(path.Join(fss.writeDir, info.Name), metaBz, fss.fsync, fss.compression); err != nil {
 			return err
 		}
+	}
+
+	info := NewFileInfo(fss.filePrefix, fss.currentBlockNumber, false, false, fss.compression)
+	return writeLengthPrefixedFile(path.Join(fss.writeDir, info.Name), dataBz, fss.fsync, fss.compression)
+}
 
-		if err := writeLengthPrefixedFile(path.Join(fss.writeDir, metaFileName), bz, fss.fsync); err != nil {
+// writeSegments appends the data and metadata of the current block to the
+// current segments, starting new segments when the limits are reached.
+func (fss *StreamingService) writeSegments(metaBz, dataBz []byte) error {
+	if fss.dataSegment != nil && fss.dataSegment.full(fss.maxFileSize, fss.maxFileBlocks) {
+		if err := fss.closeSegments(); err != nil {
 			return err
 		}
 	}
 
-	var buf bytes.Buffer
-	if err := fss.writeBlockData(&buf); err != nil {
+	if fss.dataSegment == nil {
+		var err error
+		if fss.dataSegment, err = fss.openSegment(false); err != nil {
+			return err
+		}
+		if fss.outputMetadata {
+			if fss.metaSegment, err = fss.openSegment(true); err != nil {
+				return err
+			}
+		}
+	}
+
+	if fss.metaSegment != nil {
+		if err := fss.metaSegment.append(fss.currentBlockNumber, metaBz, fss.fsync); err != nil {
+			return err
+		}
+	}
+
+	return fss.dataSegment.append(fss.currentBlockNumber, dataBz, fss.fsync)
+}
+
+func (fss *StreamingService) openSegment(meta bool) (*segment, error) {
+	info := NewFileInfo(fss.filePrefix, fss.currentBlockNumber, true, meta, fss.compression)
+	return openSegment(path.Join(fss.writeDir, info.Name), fss.compression)
+}
+
+func (fss *StreamingService) closeSegments() error {
+	var err error
+	for _, seg := range []*segment{fss.dataSegment, fss.metaSegment} {
+		if seg == nil {
+			continue
+		}
+		if err1 := seg.close(); err1 != nil && err == nil {
+			err = err1
+		}
+	}
+
+	fss.dataSegment, fss.metaSegment = nil, nil
+	return err
+}
+
+// prune deletes the files which only contain blocks older than the retained
+// ones.
+func (fss *StreamingService) prune() error {
+	if fss.retainBlocks == 0 {
+		return nil
+	}
+
+	// blocks up to cutoff are not retained
+	cutoff := fss.currentBlockNumber - fss.retainBlocks
+	if cutoff < 1 {
+		return nil
+	}
+
+	files, err := ListFiles(fss.writeDir, fss.filePrefix)
+	if err != nil {
 		return err
 	}
 
-	return writeLengthPrefixedFile(path.Join(fss.writeDir, dataFileName), buf.Bytes(), fss.fsync)
+	// the last height of a segment is the one before the next segment
+	var segmentHeights []int64
+	for _, file := range files {
+		if file.Segment && !file.Meta {
+			segmentHeights = append(segmentHeights, file.Height)
+		}
+	}
+
+	for _, file := range files {
+		lastHeight := file.Height
+		if file.Segment {
+			i := sort.Search(len(segmentHeights), func(i int) bool { return segmentHeights[i] > file.Height })
+			if i == len(segmentHeights) {
+				// the current segment
+				continue
+			}
+			lastHeight = segmentHeights[i] - 1
+		}
+
+		if lastHeight <= cutoff {
+			if err := os.Remove(path.Join(fss.writeDir, file.Name)); err != nil && !os.IsNotExist(err) {
+				return err
+			}
+		}
+	}
+
+	return nil
 }
 
 func (fss *StreamingService) writeBlockData(writer io.Writer) error {
@@ -193,8 +363,9 @@ func (fss *StreamingService) writeBlockData(writer io.Writer) error {
 // Stream satisfies the StreamingService interface. It performs a no-op.
 func (fss *StreamingService) Stream(wg *sync.WaitGroup) error { return nil }
 
-// Close satisfies the StreamingService interface. It performs a no-op.
-func (fss *StreamingService) Close() error { return nil }
+// Close satisfies the StreamingService interface. It closes the current
+// segments.
+func (fss *StreamingService) Close() error { return fss.closeSegments() }
 
 // isDirWriteable checks if dir is writable by writing and removing a file
 // to dir. It returns nil if dir is writable. We have to do this as there is no
@@ -208,7 +379,7 @@ func isDirWriteable(dir string) error {
 	return os.Remove(f)
 }
 
-func writeLengthPrefixedFile(path string, data []byte, fsync bool) (err error) {
+func writeLengthPrefixedFile(path string, data []byte, fsync bool, compression Compression) (err error) {
 	var f *os.File
 	f, err = os.OpenFile(path, os.O_WRONLY|os.O_CREATE|os.O_TRUNC, 0o600)
 	if err != nil {
@@ -222,16 +393,25 @@ func writeLengthPrefixedFile(path string, data []byte, fsync bool) (err error) {
 		}
 	}()
 
-	_, err = f.Write(sdk.Uint64ToBigEndian(uint64(len(data))))
+	w, err := compression.newWriter(f)
+	if err != nil {
+		return sdkerrors.Wrapf(err, "create compressor failed: %s", path)
+	}
+
+	_, err = w.Write(sdk.Uint64ToBigEndian(uint64(len(data))))
 	if err != nil {
 		return sdkerrors.Wrapf(err, "write length prefix failed: %s", path)
 	}
 
-	_, err = f.Write(data)
+	_, err = w.Write(data)
 	if err != nil {
 		return sdkerrors.Wrapf(err, "write block data failed: %s", path)
 	}
 
+	if err = w.Close(); err != nil {
+		return sdkerrors.Wrapf(err, "compress block data failed: %s", path)
+	}
+
 	if fsync {
 		err = f.Sync()
 		if err != nil {
@@ -0,0 +1,104 @@
+package grpc
+
+import (
+	"context"
+	"net"
+	"strings"
+	"sync"
+
+	"google.golang.org/grpc"
+)
+
+var _ ABCIListenerServiceServer = &RecordingServer{}
+
+// RecordingServer is a reference ABCIListenerServiceServer which keeps the
+// received messages in memory. It is meant for tests and as a starting point
+// for external indexers.
+type RecordingServer struct {
+	mtx sync.Mutex
+
+	BeginBlocks []*ListenBeginBlockRequest
+	DeliverTxs  []*ListenDeliverTxRequest
+	EndBlocks   []*ListenEndBlockRequest
+	Commits     []*ListenCommitRequest
+
+	// Err, if set, is returned by every method instead of recording the
+	// message.
+	Err error
+}
+
+// ListenBeginBlock implements ABCIListenerServiceServer.
+func (s *RecordingServer) ListenBeginBlock(_ context.Context, req *ListenBeginBlockRequest) (*ListenBeginBlockResponse, error) {
+	s.mtx.Lock()
+	defer s.mtx.Unlock()
+	if s.Err != nil {
+		return nil, s.Err
+	}
+
+	s.BeginBlocks = append(s.BeginBlocks, req)
+	return &ListenBeginBlockResponse{}, nil
+}
+
+// ListenDeliverTx implements ABCIListenerServiceServer.
+func (s *RecordingServer) ListenDeliverTx(_ context.Context, req *ListenDeliverTxRequest) (*ListenDeliverTxResponse, error) {
+	s.mtx.Lock()
+	defer s.mtx.Unlock()
+	if s.Err != nil {
+		return nil, s.Err
+	}
+
+	s.DeliverTxs = append(s.DeliverTxs, req)
+	return &ListenDeliverTxResponse{}, nil
+}
+
+// ListenEndBlock implements ABCIListenerServiceServer.
+func (s *RecordingServer) ListenEndBlock(_ context.Context, req *ListenEndBlockRequest) (*ListenEndBlockResponse, error) {
+	s.mtx.Lock()
+	defer s.mtx.Unlock()
+	if s.Err != nil {
+		return nil, s.Err
+	}
+
+	s.EndBlocks = append(s.EndBlocks, req)
+	return &ListenEndBlockResponse{}, nil
+}
+
+// ListenCommit implements ABCIListenerServiceServer.
+func (s *RecordingServer) ListenCommit(_ context.Context, req *ListenCommitRequest) (*ListenCommitResponse, error) {
+	s.mtx.Lock()
+	defer s.mtx.Unlock()
+	if s.Err != nil {
+		return nil, s.Err
+	}
+
+	s.Commits = append(s.Commits, req)
+	return &ListenCommitResponse{}, nil
+}
+
+// SetErr sets the error returned by the server, nil resumes recording.
+func (s *RecordingServer) SetErr(err error) {
+	s.mtx.Lock()
+	defer s.mtx.Unlock()
+	s.Err = err
+}
+
+// Serve starts a gRPC server for srv listening on address, which uses the
+// same format as the streaming service address. The returned server must be
+// stopped by the caller.
+func Serve(srv ABCIListenerServiceServer, address string) (*grpc.Server, net.Addr, error) {
+	network, addr := "tcp", strings.TrimPrefix(address, "tcp://")
+	if strings.HasPrefix(address, "unix://") {
+		network, addr = "unix", strings.TrimPrefix(address, "unix://")
+	}
+
+	listener, err := net.Listen(network, addr)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	server := grpc.NewServer()
+	RegisterABCIListenerServiceServer(server, srv)
+	go server.Serve(listener) //nolint:errcheck
+
+	return server, listener.Addr(), nil
+}
@@ -0,0 +1,267 @@
+package grpc
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	abci "github.com/tendermint/tendermint/abci/types"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
+
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	"github.com/cosmos/cosmos-sdk/store/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+var _ baseapp.StreamingService = &StreamingService{}
+
+// delivery is a call to the external listener.
+type delivery func(ctx context.Context, client ABCIListenerServiceClient) error
+
+// StreamingService is a concrete implementation of StreamingService that
+// streams the ABCI messages and state changes to an external process over gRPC.
+//
+// The messages are delivered in order by a background routine started by
+// Stream. At most bufferSize messages are queued, after which the ABCI hooks
+// block until the external listener catches up.
+type StreamingService struct {
+	storeListeners []*types.MemoryListener // a series of KVStore listeners for each KVStore
+	conn           *grpc.ClientConn
+	client         ABCIListenerServiceClient
+
+	currentBlockNumber int64
+
+	// timeout bounds the delivery of a single message.
+	timeout time.Duration
+
+	// stopNodeOnErr, if true, makes ListenCommit wait until all the messages
+	// of the block are delivered and return the first delivery error, which
+	// halts the node. Otherwise, the messages which fail to be delivered are
+	// dropped.
+	stopNodeOnErr bool
+
+	queue     chan delivery
+	pending   sync.WaitGroup
+	closeOnce sync.Once
+	done      chan struct{}
+
+	mtx sync.Mutex
+	err error // first delivery error
+}
+
+// NewStreamingService creates a StreamingService which streams to the
+// listener served at address, either "unix://<path>" or "[tcp://]<host>:<port>".
+func NewStreamingService(
+	address string,
+	storeKeys []types.StoreKey,
+	bufferSize int,
+	timeout time.Duration,
+	stopNodeOnErr bool,
+) (*StreamingService, error) {
+	if address == "" {
+		return nil, fmt.Errorf("grpc streaming service address is required")
+	}
+	if bufferSize < 0 {
+		return nil, fmt.Errorf("grpc streaming service buffer size must not be negative: %d", bufferSize)
+	}
+
+	// sort storeKeys for deterministic output
+	sort.SliceStable(storeKeys, func(i, j int) bool {
+		return storeKeys[i].Name() < storeKeys[j].Name()
+	})
+
+	listeners := make([]*types.MemoryListener, len(storeKeys))
+	for i, key := range storeKeys {
+		listeners[i] = types.NewMemoryListener(key)
+	}
+
+	// the connection is established lazily, so that the node can start before
+	// the external listener.
+	conn, err := grpc.Dial(dialTarget(address), grpc.WithTransportCredentials(insecure.NewCredentials()))
+	if err != nil {
+		return nil, err
+	}
+
+	return &StreamingService{
+		storeListeners: listeners,
+		conn:           conn,
+		client:         NewABCIListenerServiceClient(conn),
+		timeout:        timeout,
+		stopNodeOnErr:  stopNodeOnErr,
+		queue:          make(chan delivery, bufferSize),
+		done:           make(chan struct{}),
+	}, nil
+}
+
+// dialTarget converts a listener address to a gRPC dial target, which
+// supports unix sockets natively.
+func dialTarget(address string) string {
+	return strings.TrimPrefix(address, "tcp://")
+}
+
+// Listeners satisfies the StreamingService interface. It returns the
+// StreamingService's underlying WriteListeners. Use for registering the
+// underlying WriteListeners with the BaseApp.
+func (gss *StreamingService) Listeners() map[types.StoreKey][]types.WriteListener {
+	listeners := make(map[types.StoreKey][]types.WriteListener, len(gss.storeListeners))
+	for _, listener := range gss.storeListeners {
+		listeners[listener.StoreKey()] = []types.WriteListener{listener}
+	}
+
+	return listeners
+}
+
+// ListenBeginBlock satisfies the ABCIListener interface. It queues the received
+// BeginBlock request and response.
+func (gss *StreamingService) ListenBeginBlock(ctx context.Context, req abci.RequestBeginBlock, res abci.ResponseBeginBlock) error {
+	gss.currentBlockNumber = req.Header.Height
+	return gss.enqueue(func(ctx context.Context, client ABCIListenerServiceClient) error {
+		_, err := client.ListenBeginBlock(ctx, &ListenBeginBlockRequest{Req: &req, Res: &res})
+		return err
+	})
+}
+
+// ListenDeliverTx satisfies the ABCIListener interface. It queues the received
+// DeliverTx request and response.
+func (gss *StreamingService) ListenDeliverTx(ctx context.Context, req abci.RequestDeliverTx, res abci.ResponseDeliverTx) error {
+	height := gss.currentBlockNumber
+	return gss.enqueue(func(ctx context.Context, client ABCIListenerServiceClient) error {
+		_, err := client.ListenDeliverTx(ctx, &ListenDeliverTxRequest{BlockHeight: height, Req: &req, Res: &res})
+		return err
+	})
+}
+
+// ListenEndBlock satisfies the ABCIListener interface. It queues the received
+// EndBlock request and response.
+func (gss *StreamingService) ListenEndBlock(ctx context.Context, req abci.RequestEndBlock, res abci.ResponseEndBlock) error {
+	height := gss.currentBlockNumber
+	return gss.enqueue(func(ctx context.Context, client ABCIListenerServiceClient) error {
+		_, err := client.ListenEndBlock(ctx, &ListenEndBlockRequest{BlockHeight: height, Req: &req, Res: &res})
+		return err
+	})
+}
+
+// ListenCommit satisfies the ABCIListener interface. It queues the Commit
+// response together with the state changes of the block. If stopNodeOnErr is
+// set, it waits until the messages of the block are delivered and returns the
+// first delivery error.
+func (gss *StreamingService) ListenCommit(ctx context.Context, res abci.ResponseCommit) error {
+	var changeSet []*types.StoreKVPair
+	for _, listener := range gss.storeListeners {
+		cache := listener.PopStateCache()
+		for i := range cache {
+			changeSet = append(changeSet, &cache[i])
+		}
+	}
+
+	height := gss.currentBlockNumber
+	if err := gss.enqueue(func(ctx context.Context, client ABCIListenerServiceClient) error {
+		_, err := client.ListenCommit(ctx, &ListenCommitRequest{BlockHeight: height, Res: &res, ChangeSet: changeSet})
+		return err
+	}); err != nil {
+		return err
+	}
+
+	if !gss.stopNodeOnErr {
+		return nil
+	}
+
+	gss.pending.Wait()
+	return gss.Err()
+}
+
+// enqueue queues a delivery, blocking while the queue is full. If
+// stopNodeOnErr is set, it fails once a delivery failed.
+func (gss *StreamingService) enqueue(d delivery) error {
+	if gss.stopNodeOnErr {
+		if err := gss.Err(); err != nil {
+			return err
+		}
+	}
+
+	gss.pending.Add(1)
+	select {
+	case gss.queue <- d:
+		return nil
+	case <-gss.done:
+		gss.pending.Done()
+		return fmt.Errorf("grpc streaming service is closed")
+	}
+}
+
+// Err returns the first error which occurred while delivering a message.
+func (gss *StreamingService) Err() error {
+	gss.mtx.Lock()
+	defer gss.mtx.Unlock()
+	return gss.err
+}
+
+func (gss *StreamingService) setErr(err error) {
+	gss.mtx.Lock()
+	defer gss.mtx.Unlock()
+	if gss.err == nil {
+		gss.err = err
+	}
+}
+
+// Stream satisfies the StreamingService interface. It starts the routine which
+// delivers the queued messages to the external listener until the service is
+// closed.
+func (gss *StreamingService) Stream(wg *sync.WaitGroup) error {
+	wg.Add(1)
+	go func() {
+		defer wg.Done()
+		for {
+			select {
+			case d := <-gss.queue:
+				gss.deliver(d)
+			case <-gss.done:
+				gss.drop()
+				return
+			}
+		}
+	}()
+
+	return nil
+}
+
+func (gss *StreamingService) deliver(d delivery) {
+	defer gss.pending.Done()
+
+	ctx := context.Background()
+	if gss.timeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, gss.timeout)
+		defer cancel()
+	}
+
+	if err := d(ctx, gss.client); err != nil {
+		gss.setErr(sdkerrors.Wrap(err, "grpc streaming delivery failed"))
+	}
+}
+
+// drop discards the queued deliveries.
+func (gss *StreamingService) drop() {
+	for {
+		select {
+		case <-gss.queue:
+			gss.pending.Done()
+		default:
+			return
+		}
+	}
+}
+
+// Close satisfies the StreamingService interface. It stops the delivery
+// routine, dropping the messages not delivered yet, and closes the connection.
+func (gss *StreamingService) Close() error {
+	gss.closeOnce.Do(func() {
+		close(gss.done)
+	})
+
+	return gss.conn.Close()
+}
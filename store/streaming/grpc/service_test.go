@@ -0,0 +1,141 @@
+package grpc
+
+import (
+	"context"
+	"errors"
+	"path/filepath"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	abci "github.com/tendermint/tendermint/abci/types"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+
+	"github.com/cosmos/cosmos-sdk/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+var (
+	mockStoreKey1 = sdk.NewKVStoreKey("mockStore1")
+	mockStoreKey2 = sdk.NewKVStoreKey("mockStore2")
+)
+
+func setupStreamingService(t *testing.T, address string, stopNodeOnErr bool) (*StreamingService, *RecordingServer) {
+	srv := &RecordingServer{}
+	server, addr, err := Serve(srv, address)
+	require.NoError(t, err)
+	t.Cleanup(server.Stop)
+
+	if !strings.HasPrefix(address, "unix://") {
+		address = addr.String()
+	}
+
+	service, err := NewStreamingService(address, []types.StoreKey{mockStoreKey2, mockStoreKey1}, 1, time.Second, stopNodeOnErr)
+	require.NoError(t, err)
+
+	wg := new(sync.WaitGroup)
+	require.NoError(t, service.Stream(wg))
+	t.Cleanup(func() {
+		service.Close()
+		wg.Wait()
+	})
+
+	return service, srv
+}
+
+func streamBlock(t *testing.T, service *StreamingService, height int64) error {
+	ctx := context.Background()
+
+	if err := service.ListenBeginBlock(ctx, abci.RequestBeginBlock{Header: tmproto.Header{Height: height}}, abci.ResponseBeginBlock{}); err != nil {
+		return err
+	}
+
+	listeners := service.Listeners()
+	listeners[mockStoreKey2][0].OnWrite(mockStoreKey2, []byte{2}, []byte{3}, false)
+	listeners[mockStoreKey1][0].OnWrite(mockStoreKey1, []byte{1}, nil, true)
+
+	if err := service.ListenDeliverTx(ctx, abci.RequestDeliverTx{Tx: []byte{1}}, abci.ResponseDeliverTx{Code: 1}); err != nil {
+		return err
+	}
+	if err := service.ListenEndBlock(ctx, abci.RequestEndBlock{Height: height}, abci.ResponseEndBlock{}); err != nil {
+		return err
+	}
+	return service.ListenCommit(ctx, abci.ResponseCommit{Data: []byte{byte(height)}})
+}
+
+func TestStreamingService(t *testing.T) {
+	testCases := map[string]string{
+		"tcp":  "127.0.0.1:0",
+		"unix": "unix://" + filepath.Join(t.TempDir(), "listener.sock"),
+	}
+
+	for name, address := range testCases {
+		t.Run(name, func(t *testing.T) {
+			service, srv := setupStreamingService(t, address, true)
+			require.NoError(t, streamBlock(t, service, 1))
+			require.NoError(t, streamBlock(t, service, 2))
+
+			srv.mtx.Lock()
+			defer srv.mtx.Unlock()
+			require.Len(t, srv.BeginBlocks, 2)
+			require.Len(t, srv.DeliverTxs, 2)
+			require.Len(t, srv.EndBlocks, 2)
+			require.Len(t, srv.Commits, 2)
+
+			require.Equal(t, int64(2), srv.BeginBlocks[1].Req.Header.Height)
+			require.Equal(t, int64(2), srv.DeliverTxs[1].BlockHeight)
+			require.Equal(t, uint32(1), srv.DeliverTxs[1].Res.Code)
+			require.Equal(t, int64(2), srv.EndBlocks[1].BlockHeight)
+
+			commit := srv.Commits[1]
+			require.Equal(t, int64(2), commit.BlockHeight)
+			require.Equal(t, []byte{2}, commit.Res.Data)
+			// the change set is ordered by store key name
+			require.Equal(t, []*types.StoreKVPair{
+				{StoreKey: mockStoreKey1.Name(), Key: []byte{1}, Delete: true},
+				{StoreKey: mockStoreKey2.Name(), Key: []byte{2}, Value: []byte{3}},
+			}, commit.ChangeSet)
+		})
+	}
+}
+
+func TestStreamingServiceStopNodeOnError(t *testing.T) {
+	service, srv := setupStreamingService(t, "127.0.0.1:0", true)
+	srv.SetErr(errors.New("indexer failure"))
+
+	err := streamBlock(t, service, 1)
+	require.ErrorContains(t, err, "indexer failure")
+
+	// the service keeps failing once a delivery failed
+	srv.SetErr(nil)
+	require.Error(t, streamBlock(t, service, 2))
+}
+
+func TestStreamingServiceDropOnError(t *testing.T) {
+	service, srv := setupStreamingService(t, "127.0.0.1:0", false)
+	srv.SetErr(errors.New("indexer failure"))
+	require.NoError(t, streamBlock(t, service, 1))
+
+	// the messages of the first block are dropped
+	service.pending.Wait()
+	require.ErrorContains(t, service.Err(), "indexer failure")
+
+	srv.SetErr(nil)
+	require.NoError(t, streamBlock(t, service, 2))
+	service.pending.Wait()
+
+	srv.mtx.Lock()
+	defer srv.mtx.Unlock()
+	require.Len(t, srv.Commits, 1)
+	require.Equal(t, int64(2), srv.Commits[0].BlockHeight)
+}
+
+func TestNewStreamingServiceInvalidOptions(t *testing.T) {
+	_, err := NewStreamingService("", nil, 1, 0, true)
+	require.Error(t, err)
+
+	_, err = NewStreamingService("127.0.0.1:0", nil, -1, 0, true)
+	require.Error(t, err)
+}
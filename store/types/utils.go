@@ -17,7 +17,8 @@ func KVStoreReversePrefixIterator(kvs KVStore, prefix []byte) Iterator {
 }
 
 // DiffKVStores compares two KVstores and returns all the key/value pairs
-// that differ from one another. It also skips value comparison for a set of provided prefixes.
+// that differ from one another. It also skips the keys of a set of provided
+// prefixes, which may have a different number of entries in each store.
 func DiffKVStores(a KVStore, b KVStore, prefixesToSkip [][]byte) (kvAs, kvBs []kv.Pair) {
 	iterA := a.Iterator(nil, nil)
 
@@ -28,6 +29,9 @@ func DiffKVStores(a KVStore, b KVStore, prefixesToSkip [][]byte) (kvAs, kvBs []k
 	defer iterB.Close()
 
 	for {
+		skipPrefixedKeys(iterA, prefixesToSkip)
+		skipPrefixedKeys(iterB, prefixesToSkip)
+
 		if !iterA.Valid() && !iterB.Valid() {
 			return kvAs, kvBs
 		}
@@ -45,19 +49,27 @@ func DiffKVStores(a KVStore, b KVStore, prefixesToSkip [][]byte) (kvAs, kvBs []k
 			iterB.Next()
 		}
 
-		compareValue := true
+		if !bytes.Equal(kvA.Key, kvB.Key) || !bytes.Equal(kvA.Value, kvB.Value) {
+			kvAs = append(kvAs, kvA)
+			kvBs = append(kvBs, kvB)
+		}
+	}
+}
 
-		for _, prefix := range prefixesToSkip {
-			// Skip value comparison if we matched a prefix
-			if bytes.HasPrefix(kvA.Key, prefix) || bytes.HasPrefix(kvB.Key, prefix) {
-				compareValue = false
+// skipPrefixedKeys advances the iterator past the keys starting with any of the
+// prefixes.
+func skipPrefixedKeys(iter Iterator, prefixes [][]byte) {
+	for ; iter.Valid(); iter.Next() {
+		skip := false
+		for _, prefix := range prefixes {
+			if bytes.HasPrefix(iter.Key(), prefix) {
+				skip = true
 				break
 			}
 		}
 
-		if compareValue && (!bytes.Equal(kvA.Key, kvB.Key) || !bytes.Equal(kvA.Value, kvB.Value)) {
-			kvAs = append(kvAs, kvA)
-			kvBs = append(kvBs, kvB)
+		if !skip {
+			return
 		}
 	}
 }
@@ -69,6 +69,16 @@ func TestDiffKVStores(t *testing.T) {
 	kvAs, kvBs = sdk.DiffKVStores(store1, store2, [][]byte{prefix})
 	require.Equal(t, 0, len(kvAs))
 	require.Equal(t, len(kvAs), len(kvBs))
+
+	// Different number of prefixed keys. The keys after the prefixed keys are
+	// still compared pairwise.
+	k3 := []byte("z3")
+	store1.Set(append(prefix, k2...), v1)
+	store1.Set(k3, v1)
+	store2.Set(k3, v1)
+	kvAs, kvBs = sdk.DiffKVStores(store1, store2, [][]byte{prefix})
+	require.Equal(t, 0, len(kvAs))
+	require.Equal(t, len(kvAs), len(kvBs))
 }
 
 func TestPrefixEndBytes(t *testing.T) {
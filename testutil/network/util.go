@@ -18,6 +18,7 @@ import (
 	"github.com/cosmos/cosmos-sdk/server/api"
 	servergrpc "github.com/cosmos/cosmos-sdk/server/grpc"
 	srvtypes "github.com/cosmos/cosmos-sdk/server/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
 	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
 	distrtypes "github.com/cosmos/cosmos-sdk/x/distribution/types"
@@ -176,8 +177,8 @@ func initGenFiles(cfg Config, genAccounts []authtypes.GenesisAccount, genBalance
 	if distrGenState.ModeratorAddress == "" {
 		distrGenState.ModeratorAddress = addrStr
 	}
-	if distrGenState.BaseAddress == "" {
-		distrGenState.BaseAddress = addrStr
+	if len(distrGenState.BaseRecipients) == 0 && distrGenState.BaseAddress == "" {
+		distrGenState.BaseRecipients = []distrtypes.BaseRecipient{{Address: addrStr, Weight: sdk.OneDec()}}
 	}
 	cfg.GenesisState[distrtypes.ModuleName] = cfg.Codec.MustMarshalJSON(&distrGenState)
 
@@ -167,6 +167,14 @@ var (
 	// supplied.
 	ErrInvalidGasLimit = Register(RootCodespace, 41, "invalid gas limit")
 
+	// ErrRateLimited defines an error when a tx is rejected by the node, its
+	// signer having exceeded the rate limits of the node's mempool.
+	ErrRateLimited = Register(RootCodespace, 42, "rate limited")
+
+	// ErrTxTimeout defines an error for when a tx is rejected out due to an
+	// explicitly set timeout timestamp.
+	ErrTxTimeout = Register(RootCodespace, 43, "tx timeout")
+
 	// ErrPanic is only set when we recover from a panic, so we know to
 	// redact potentially sensitive system info
 	ErrPanic = errorsmod.ErrPanic
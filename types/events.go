@@ -237,6 +237,9 @@ const (
 	AttributeKeySignature       = "signature"
 	AttributeKeyFee             = "fee"
 	AttributeKeyFeePayer        = "fee_payer"
+	AttributeKeyFeeRefund       = "fee_refund"
+	AttributeKeyRefundReceiver  = "refund_receiver"
+	AttributeKeyGasUnused       = "gas_unused"
 
 	EventTypeMessage = "message"
 
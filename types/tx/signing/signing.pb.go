@@ -41,9 +41,10 @@ const (
 	// SIGN_MODE_DIRECT specifies a signing mode which uses SignDoc and is
 	// verified with raw bytes from Tx.
 	SignMode_SIGN_MODE_DIRECT SignMode = 1
-	// SIGN_MODE_TEXTUAL is a future signing mode that will verify some
-	// human-readable textual representation on top of the binary representation
-	// from SIGN_MODE_DIRECT. It is currently not supported.
+	// SIGN_MODE_TEXTUAL specifies a signing mode which signs the hash of a
+	// human-readable textual representation of the transaction, suitable for
+	// hardware wallets, which includes the hash of the binary representation
+	// from SIGN_MODE_DIRECT.
 	SignMode_SIGN_MODE_TEXTUAL SignMode = 2
 	// SIGN_MODE_DIRECT_AUX specifies a signing mode which uses
 	// SignDocDirectAux. As opposed to SIGN_MODE_DIRECT, this sign mode does not
@@ -58,11 +59,10 @@ const (
 	// SIGN_MODE_EIP_191 specifies the sign mode for EIP 191 signing on the Cosmos
 	// SDK. Ref: https://eips.ethereum.org/EIPS/eip-191
 	//
-	// Currently, SIGN_MODE_EIP_191 is registered as a SignMode enum variant,
-	// but is not implemented on the SDK by default. To enable EIP-191, you need
-	// to pass a custom `TxConfig` that has an implementation of
-	// `SignModeHandler` for EIP-191. The SDK may decide to fully support
-	// EIP-191 in the future.
+	// The sign bytes are the SIGN_MODE_LEGACY_AMINO_JSON sign bytes wrapped in
+	// the "\x19Ethereum Signed Message:\n" personal_sign envelope, and the
+	// signature is the 65 bytes R || S || V secp256k1 signature of their
+	// keccak256 hash, as made by Ethereum wallets.
 	//
 	// Since: cosmos-sdk 0.45.2
 	SignMode_SIGN_MODE_EIP_191 SignMode = 191
@@ -420,43 +420,43 @@ func init() {
 }
 
 var fileDescriptor_9a54958ff3d0b1b9 = []byte{
-	// 573 bytes of a gzipped FileDescriptorProto
+	// 569 bytes of a gzipped FileDescriptorProto
 	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x94, 0xc1, 0x6e, 0xd3, 0x4c,
-	0x10, 0xc7, 0xed, 0x26, 0xad, 0xda, 0xe9, 0xa7, 0x4f, 0x66, 0x49, 0x51, 0x6a, 0x90, 0xa9, 0xca,
+	0x10, 0xc7, 0xed, 0x3a, 0xad, 0xda, 0xe9, 0xa7, 0x4f, 0x66, 0x49, 0x51, 0x6a, 0x90, 0x89, 0xca,
 	0x81, 0x0a, 0xa9, 0x6b, 0xa5, 0x3d, 0xa0, 0x72, 0x73, 0x13, 0x93, 0x9a, 0x36, 0x69, 0xb1, 0x53,
 	0xa9, 0x70, 0xb1, 0x6c, 0x67, 0x6b, 0xac, 0xc6, 0x5e, 0xe3, 0x5d, 0xa3, 0xfa, 0xc4, 0x2b, 0xf0,
-	0x12, 0x1c, 0x78, 0x0a, 0x0e, 0x5c, 0x38, 0xf6, 0xc8, 0x11, 0x25, 0xcf, 0xc0, 0x1d, 0xc5, 0x8e,
+	0x12, 0x1c, 0x78, 0x0a, 0x0e, 0x5c, 0x38, 0xf6, 0xc8, 0x11, 0xb5, 0xcf, 0xc0, 0x1d, 0xd5, 0x8e,
 	0x93, 0x80, 0x8a, 0x10, 0x39, 0x59, 0x33, 0xf3, 0xdf, 0xdf, 0xfc, 0x57, 0x33, 0x6b, 0x78, 0xec,
-	0x51, 0x16, 0x52, 0xa6, 0xf2, 0x6b, 0x95, 0x05, 0x7e, 0x14, 0x44, 0xbe, 0xfa, 0xae, 0xe1, 0x12,
-	0xee, 0x34, 0xca, 0x18, 0xc7, 0x09, 0xe5, 0x14, 0x6d, 0x16, 0x42, 0xcc, 0xaf, 0x71, 0x59, 0x98,
-	0x08, 0xe5, 0xdd, 0x09, 0xc3, 0x4b, 0xb2, 0x98, 0x53, 0x35, 0x4c, 0x07, 0x3c, 0x60, 0xc1, 0x0c,
-	0x54, 0x26, 0x0a, 0x92, 0xbc, 0xe9, 0x53, 0xea, 0x0f, 0x88, 0x9a, 0x47, 0x6e, 0x7a, 0xa9, 0x3a,
-	0x51, 0x56, 0x94, 0xb6, 0x2f, 0xa1, 0x66, 0x05, 0x7e, 0xe4, 0xf0, 0x34, 0x21, 0x2d, 0xc2, 0xbc,
-	0x24, 0x88, 0x39, 0x4d, 0x18, 0xea, 0x02, 0xb0, 0x32, 0xcf, 0xea, 0xe2, 0x56, 0x65, 0x67, 0x7d,
-	0x0f, 0xe3, 0x3f, 0x3a, 0xc2, 0xb7, 0x40, 0xcc, 0x39, 0xc2, 0xf6, 0x8f, 0x2a, 0xdc, 0xbd, 0x45,
-	0x83, 0xf6, 0x01, 0xe2, 0xd4, 0x1d, 0x04, 0x9e, 0x7d, 0x45, 0xb2, 0xba, 0xb8, 0x25, 0xee, 0xac,
-	0xef, 0xd5, 0x70, 0xe1, 0x17, 0x97, 0x7e, 0xb1, 0x16, 0x65, 0xe6, 0x5a, 0xa1, 0x3b, 0x26, 0x19,
-	0x6a, 0x43, 0xb5, 0xef, 0x70, 0xa7, 0xbe, 0x94, 0xcb, 0xf7, 0xff, 0xcd, 0x16, 0x6e, 0x39, 0xdc,
-	0x31, 0x73, 0x00, 0x92, 0x61, 0x95, 0x91, 0xb7, 0x29, 0x89, 0x3c, 0x52, 0xaf, 0x6c, 0x89, 0x3b,
-	0x55, 0x73, 0x1a, 0xcb, 0x5f, 0x2a, 0x50, 0x1d, 0x4b, 0x51, 0x0f, 0x56, 0x58, 0x10, 0xf9, 0x03,
-	0x32, 0xb1, 0xf7, 0x6c, 0x81, 0x7e, 0xd8, 0xca, 0x09, 0x47, 0x82, 0x39, 0x61, 0xa1, 0x97, 0xb0,
-	0x9c, 0x4f, 0x69, 0x72, 0x89, 0x83, 0x45, 0xa0, 0x9d, 0x31, 0xe0, 0x48, 0x30, 0x0b, 0x92, 0x6c,
-	0xc3, 0x4a, 0xd1, 0x06, 0x3d, 0x85, 0x6a, 0x48, 0xfb, 0x85, 0xe1, 0xff, 0xf7, 0x1e, 0xfd, 0x85,
-	0xdd, 0xa1, 0x7d, 0x62, 0xe6, 0x07, 0xd0, 0x03, 0x58, 0x9b, 0x0e, 0x2d, 0x77, 0xf6, 0x9f, 0x39,
-	0x4b, 0xc8, 0x9f, 0x44, 0x58, 0xce, 0x7b, 0xa2, 0x63, 0x58, 0x75, 0x03, 0xee, 0x24, 0x89, 0x53,
-	0x0e, 0x4d, 0x2d, 0x9b, 0x14, 0x3b, 0x89, 0xa7, 0x2b, 0x58, 0x76, 0x6a, 0xd2, 0x30, 0x76, 0x3c,
-	0x7e, 0x18, 0x70, 0x6d, 0x7c, 0xcc, 0x9c, 0x02, 0x90, 0xf5, 0xcb, 0xae, 0x2d, 0xe5, 0xbb, 0xb6,
-	0xd0, 0x50, 0xe7, 0x30, 0x87, 0xcb, 0x50, 0x61, 0x69, 0xf8, 0xe4, 0xa3, 0x08, 0xab, 0xe5, 0x1d,
-	0xd1, 0x26, 0x6c, 0x58, 0x46, 0xbb, 0x6b, 0x77, 0x4e, 0x5b, 0xba, 0x7d, 0xde, 0xb5, 0xce, 0xf4,
-	0xa6, 0xf1, 0xdc, 0xd0, 0x5b, 0x92, 0x80, 0x6a, 0x20, 0xcd, 0x4a, 0x2d, 0xc3, 0xd4, 0x9b, 0x3d,
-	0x49, 0x44, 0x1b, 0x70, 0x67, 0x96, 0xed, 0xe9, 0x17, 0xbd, 0x73, 0xed, 0x44, 0x5a, 0x42, 0x75,
-	0xa8, 0xfd, 0x2e, 0xb6, 0xb5, 0xf3, 0x0b, 0xa9, 0x82, 0x1e, 0xc2, 0xfd, 0x59, 0xe5, 0x44, 0x6f,
-	0x6b, 0xcd, 0x57, 0xb6, 0xd6, 0x31, 0xba, 0xa7, 0xf6, 0x0b, 0xeb, 0xb4, 0x2b, 0xbd, 0x47, 0xf7,
-	0xe6, 0x89, 0xba, 0x71, 0x66, 0x37, 0x0e, 0x1a, 0xd2, 0x67, 0xf1, 0xb0, 0xfd, 0x75, 0xa8, 0x88,
-	0x37, 0x43, 0x45, 0xfc, 0x3e, 0x54, 0xc4, 0x0f, 0x23, 0x45, 0xb8, 0x19, 0x29, 0xc2, 0xb7, 0x91,
-	0x22, 0xbc, 0xde, 0xf5, 0x03, 0xfe, 0x26, 0x75, 0xb1, 0x47, 0x43, 0xb5, 0x7c, 0xf6, 0xf9, 0x67,
-	0x97, 0xf5, 0xaf, 0x54, 0x9e, 0xc5, 0x64, 0xfe, 0x5f, 0xe2, 0xae, 0xe4, 0x8f, 0x66, 0xff, 0x67,
-	0x00, 0x00, 0x00, 0xff, 0xff, 0x02, 0x3d, 0xad, 0x03, 0x67, 0x04, 0x00, 0x00,
+	0x53, 0x16, 0x51, 0xa6, 0xf1, 0x0b, 0x8d, 0x85, 0x41, 0x1c, 0xc6, 0x81, 0xf6, 0xae, 0xe5, 0x11,
+	0xee, 0xb6, 0xaa, 0x18, 0x27, 0x29, 0xe5, 0x14, 0xad, 0x97, 0x42, 0xcc, 0x2f, 0x70, 0x55, 0x18,
+	0x0b, 0x95, 0xad, 0x31, 0xc3, 0x4f, 0xf3, 0x84, 0x53, 0x2d, 0xca, 0x46, 0x3c, 0x64, 0xe1, 0x14,
+	0x54, 0x25, 0x4a, 0x92, 0xb2, 0x1e, 0x50, 0x1a, 0x8c, 0x88, 0x56, 0x44, 0x5e, 0x76, 0xa6, 0xb9,
+	0x71, 0x5e, 0x96, 0x36, 0xce, 0xa0, 0x6e, 0x87, 0x41, 0xec, 0xf2, 0x2c, 0x25, 0x1d, 0xc2, 0xfc,
+	0x34, 0x4c, 0x38, 0x4d, 0x19, 0xea, 0x03, 0xb0, 0x2a, 0xcf, 0x1a, 0x62, 0x53, 0xda, 0x5c, 0xdd,
+	0xc6, 0xf8, 0x8f, 0x8e, 0xf0, 0x2d, 0x10, 0x6b, 0x86, 0xb0, 0xf1, 0xa3, 0x06, 0x77, 0x6f, 0xd1,
+	0xa0, 0x1d, 0x80, 0x24, 0xf3, 0x46, 0xa1, 0xef, 0x9c, 0x93, 0xbc, 0x21, 0x36, 0xc5, 0xcd, 0xd5,
+	0xed, 0x3a, 0x2e, 0xfd, 0xe2, 0xca, 0x2f, 0xd6, 0xe3, 0xdc, 0x5a, 0x29, 0x75, 0x07, 0x24, 0x47,
+	0x5d, 0xa8, 0x0d, 0x5d, 0xee, 0x36, 0x16, 0x0a, 0xf9, 0xce, 0xbf, 0xd9, 0xc2, 0x1d, 0x97, 0xbb,
+	0x56, 0x01, 0x40, 0x0a, 0x2c, 0x33, 0xf2, 0x36, 0x23, 0xb1, 0x4f, 0x1a, 0x52, 0x53, 0xdc, 0xac,
+	0x59, 0x93, 0x58, 0xf9, 0x22, 0x41, 0xed, 0x46, 0x8a, 0x06, 0xb0, 0xc4, 0xc2, 0x38, 0x18, 0x91,
+	0xb1, 0xbd, 0x67, 0x73, 0xf4, 0xc3, 0x76, 0x41, 0xd8, 0x17, 0xac, 0x31, 0x0b, 0xbd, 0x84, 0xc5,
+	0x62, 0x4a, 0xe3, 0x4b, 0xec, 0xce, 0x03, 0xed, 0xdd, 0x00, 0xf6, 0x05, 0xab, 0x24, 0x29, 0x0e,
+	0x2c, 0x95, 0x6d, 0xd0, 0x53, 0xa8, 0x45, 0x74, 0x58, 0x1a, 0xfe, 0x7f, 0xfb, 0xd1, 0x5f, 0xd8,
+	0x3d, 0x3a, 0x24, 0x56, 0x71, 0x00, 0x3d, 0x80, 0x95, 0xc9, 0xd0, 0x0a, 0x67, 0xff, 0x59, 0xd3,
+	0x84, 0xf2, 0x49, 0x84, 0xc5, 0xa2, 0x27, 0x3a, 0x80, 0x65, 0x2f, 0xe4, 0x6e, 0x9a, 0xba, 0xd5,
+	0xd0, 0xb4, 0xaa, 0x49, 0xb9, 0x93, 0x78, 0xb2, 0x82, 0x55, 0xa7, 0x36, 0x8d, 0x12, 0xd7, 0xe7,
+	0x7b, 0x21, 0xd7, 0x6f, 0x8e, 0x59, 0x13, 0x00, 0xb2, 0x7f, 0xd9, 0xb5, 0x85, 0xa6, 0x34, 0xef,
+	0x50, 0x67, 0x30, 0x7b, 0x8b, 0x20, 0xb1, 0x2c, 0x7a, 0xf2, 0x51, 0x84, 0xe5, 0xea, 0x8e, 0x68,
+	0x1d, 0xd6, 0x6c, 0xb3, 0xdb, 0x77, 0x7a, 0x47, 0x1d, 0xc3, 0x39, 0xe9, 0xdb, 0xc7, 0x46, 0xdb,
+	0x7c, 0x6e, 0x1a, 0x1d, 0x59, 0x40, 0x75, 0x90, 0xa7, 0xa5, 0x8e, 0x69, 0x19, 0xed, 0x81, 0x2c,
+	0xa2, 0x35, 0xb8, 0x33, 0xcd, 0x0e, 0x8c, 0xd3, 0xc1, 0x89, 0x7e, 0x28, 0x2f, 0xa0, 0x06, 0xd4,
+	0x7f, 0x17, 0x3b, 0xfa, 0xc9, 0xa9, 0x2c, 0xa1, 0x87, 0x70, 0x7f, 0x5a, 0x39, 0x34, 0xba, 0x7a,
+	0xfb, 0x95, 0xa3, 0xf7, 0xcc, 0xfe, 0x91, 0xf3, 0xc2, 0x3e, 0xea, 0xcb, 0xef, 0xd1, 0xbd, 0x59,
+	0xa2, 0x61, 0x1e, 0x3b, 0xad, 0xdd, 0x96, 0xfc, 0x59, 0xdc, 0xeb, 0x7e, 0xbd, 0x52, 0xc5, 0xcb,
+	0x2b, 0x55, 0xfc, 0x7e, 0xa5, 0x8a, 0x1f, 0xae, 0x55, 0xe1, 0xf2, 0x5a, 0x15, 0xbe, 0x5d, 0xab,
+	0xc2, 0xeb, 0xad, 0x20, 0xe4, 0x6f, 0x32, 0x0f, 0xfb, 0x34, 0xd2, 0xaa, 0x67, 0x5f, 0x7c, 0xb6,
+	0xd8, 0xf0, 0x5c, 0xe3, 0x79, 0x42, 0x66, 0xff, 0x25, 0xde, 0x52, 0xf1, 0x68, 0x76, 0x7e, 0x0e,
+	0x00, 0x02, 0x3d, 0xad, 0x03, 0x67, 0x04, 0x00, 0x00,
 }
 
 func (m *SignatureDescriptors) Marshal() (dAtA []byte, err error) {
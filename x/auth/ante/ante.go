@@ -1,6 +1,8 @@
 package ante
 
 import (
+	"time"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	"github.com/cosmos/cosmos-sdk/types/tx/signing"
@@ -17,6 +19,19 @@ type HandlerOptions struct {
 	SignModeHandler        authsigning.SignModeHandler
 	SigGasConsumer         func(meter sdk.GasMeter, sig signing.SignatureV2, params types.Params) error
 	TxFeeChecker           TxFeeChecker
+
+	// MaxUnorderedTxTimeoutDelta is the max number of blocks between the
+	// current block and the timeout height of an unordered tx. It defaults to
+	// DefaultMaxUnorderedTxTimeoutDelta.
+	MaxUnorderedTxTimeoutDelta uint64
+
+	// MaxUnorderedTxTimeoutDuration is the max duration between the current
+	// block time and the timeout timestamp of an unordered tx. It defaults to
+	// DefaultMaxUnorderedTxTimeoutDuration.
+	MaxUnorderedTxTimeoutDuration time.Duration
+
+	// RateLimit enables the RateLimitDecorator with the given limits if set.
+	RateLimit *RateLimitOptions
 }
 
 // NewAnteHandler returns an AnteHandler that checks and increments sequence
@@ -35,11 +50,22 @@ func NewAnteHandler(options HandlerOptions) (sdk.AnteHandler, error) {
 		return nil, sdkerrors.Wrap(sdkerrors.ErrLogic, "sign mode handler is required for ante builder")
 	}
 
+	maxUnorderedTxTimeoutDelta := options.MaxUnorderedTxTimeoutDelta
+	if maxUnorderedTxTimeoutDelta == 0 {
+		maxUnorderedTxTimeoutDelta = DefaultMaxUnorderedTxTimeoutDelta
+	}
+
+	maxUnorderedTxTimeoutDuration := options.MaxUnorderedTxTimeoutDuration
+	if maxUnorderedTxTimeoutDuration == 0 {
+		maxUnorderedTxTimeoutDuration = DefaultMaxUnorderedTxTimeoutDuration
+	}
+
 	anteDecorators := []sdk.AnteDecorator{
 		NewSetUpContextDecorator(), // outermost AnteDecorator. SetUpContext must be called first
 		NewExtensionOptionsDecorator(options.ExtensionOptionChecker),
 		NewValidateBasicDecorator(),
 		NewTxTimeoutHeightDecorator(),
+		NewUnorderedTxDecorator(options.AccountKeeper, maxUnorderedTxTimeoutDelta, maxUnorderedTxTimeoutDuration),
 		NewValidateMemoDecorator(options.AccountKeeper),
 		NewConsumeGasForTxSizeDecorator(options.AccountKeeper),
 		NewDeductFeeDecorator(options.AccountKeeper, options.BankKeeper, options.FeegrantKeeper, options.TxFeeChecker),
@@ -47,8 +73,13 @@ func NewAnteHandler(options HandlerOptions) (sdk.AnteHandler, error) {
 		NewValidateSigCountDecorator(options.AccountKeeper),
 		NewSigGasConsumeDecorator(options.AccountKeeper, options.SigGasConsumer),
 		NewSigVerificationDecorator(options.AccountKeeper, options.SignModeHandler),
-		NewIncrementSequenceDecorator(options.AccountKeeper),
 	}
 
+	if options.RateLimit != nil {
+		anteDecorators = append(anteDecorators, NewRateLimitDecorator(*options.RateLimit))
+	}
+
+	anteDecorators = append(anteDecorators, NewIncrementSequenceDecorator(options.AccountKeeper))
+
 	return sdk.ChainAnteDecorators(anteDecorators...), nil
 }
@@ -1,6 +1,8 @@
 package ante
 
 import (
+	"time"
+
 	"github.com/cosmos/cosmos-sdk/codec/legacy"
 	"github.com/cosmos/cosmos-sdk/crypto/keys/multisig"
 	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
@@ -167,7 +169,7 @@ func isIncompleteSignature(data signing.SignatureData) bool {
 
 type (
 	// TxTimeoutHeightDecorator defines an AnteHandler decorator that checks for a
-	// tx height timeout.
+	// tx height timeout, and for a tx timestamp timeout.
 	TxTimeoutHeightDecorator struct{}
 
 	// TxWithTimeoutHeight defines the interface a tx must implement in order for
@@ -177,6 +179,14 @@ type (
 
 		GetTimeoutHeight() uint64
 	}
+
+	// TxWithTimeoutTimestamp defines the interface a tx must implement in order
+	// for TxHeightTimeoutDecorator to check its timeout timestamp.
+	TxWithTimeoutTimestamp interface {
+		sdk.Tx
+
+		GetTimeoutTimestamp() time.Time
+	}
 )
 
 // TxTimeoutHeightDecorator defines an AnteHandler decorator that checks for a
@@ -188,7 +198,9 @@ func NewTxTimeoutHeightDecorator() TxTimeoutHeightDecorator {
 // AnteHandle implements an AnteHandler decorator for the TxHeightTimeoutDecorator
 // type where the current block height is checked against the tx's height timeout.
 // If a height timeout is provided (non-zero) and is less than the current block
-// height, then an error is returned.
+// height, then an error is returned. Likewise, if the tx implements
+// TxWithTimeoutTimestamp and a timestamp timeout is provided (non-zero) and is
+// before the current block time, then an error is returned.
 func (txh TxTimeoutHeightDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate bool, next sdk.AnteHandler) (sdk.Context, error) {
 	timeoutTx, ok := tx.(TxWithTimeoutHeight)
 	if !ok {
@@ -202,5 +214,14 @@ func (txh TxTimeoutHeightDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simul
 		)
 	}
 
+	if timestampTx, ok := tx.(TxWithTimeoutTimestamp); ok {
+		timeoutTimestamp := timestampTx.GetTimeoutTimestamp()
+		if !timeoutTimestamp.IsZero() && ctx.BlockTime().After(timeoutTimestamp) {
+			return ctx, sdkerrors.Wrapf(
+				sdkerrors.ErrTxTimeout, "block time: %s, timeout timestamp: %s", ctx.BlockTime(), timeoutTimestamp,
+			)
+		}
+	}
+
 	return next(ctx, tx, simulate)
 }
@@ -1,6 +1,8 @@
 package ante
 
 import (
+	"time"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/x/auth/types"
 )
@@ -12,6 +14,8 @@ type AccountKeeper interface {
 	GetAccount(ctx sdk.Context, addr sdk.AccAddress) types.AccountI
 	SetAccount(ctx sdk.Context, acc types.AccountI)
 	GetModuleAddress(moduleName string) sdk.AccAddress
+	ContainsUnorderedTx(ctx sdk.Context, timeoutHeight uint64, timeoutTimestamp time.Time, txHash []byte) bool
+	AddUnorderedTx(ctx sdk.Context, timeoutHeight uint64, timeoutTimestamp time.Time, txHash []byte)
 }
 
 // FeegrantKeeper defines the expected feegrant keeper.
@@ -0,0 +1,169 @@
+package ante
+
+import (
+	"sync"
+	"time"
+
+	"github.com/armon/go-metrics"
+
+	"github.com/cosmos/cosmos-sdk/telemetry"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	authsigning "github.com/cosmos/cosmos-sdk/x/auth/signing"
+)
+
+// RateLimitOptions defines the limits of the RateLimitDecorator, which apply to
+// each signer over a sliding window.
+type RateLimitOptions struct {
+	// Window is the duration of the sliding window.
+	Window time.Duration
+	// MaxTxs is the max number of txs of a signer in the window, 0 disables
+	// the limit.
+	MaxTxs uint64
+	// MaxGas is the max sum of the gas limits of the txs of a signer in the
+	// window, 0 disables the limit.
+	MaxGas uint64
+	// Now returns the current time, it defaults to time.Now.
+	Now func() time.Time
+}
+
+// RateLimitDecorator defines an AnteHandler decorator that limits the number of
+// txs and the amount of gas that each signer can submit to the mempool of the
+// node over a sliding window, rejecting the txs in excess with ErrRateLimited.
+//
+// The limits are local to the node and kept in memory, they only apply in
+// CheckTx, and never in DeliverTx, as they are not part of the consensus. The
+// txs are recorded in CheckTx only, so that rechecking the txs of the mempool
+// after each block neither counts them again nor evicts them.
+//
+// CONTRACT: the SigVerificationDecorator must run before, so that a tx is only
+// counted against the limits of the signers who actually signed it.
+type RateLimitDecorator struct {
+	limiter *rateLimiter
+}
+
+func NewRateLimitDecorator(opts RateLimitOptions) RateLimitDecorator {
+	if opts.Now == nil {
+		opts.Now = time.Now
+	}
+
+	return RateLimitDecorator{
+		limiter: &rateLimiter{
+			opts:    opts,
+			signers: make(map[string]*signerWindow),
+		},
+	}
+}
+
+func (rld RateLimitDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate bool, next sdk.AnteHandler) (sdk.Context, error) {
+	if !ctx.IsCheckTx() || ctx.IsReCheckTx() || simulate {
+		return next(ctx, tx, simulate)
+	}
+
+	sigTx, ok := tx.(authsigning.SigVerifiableTx)
+	if !ok {
+		return ctx, sdkerrors.Wrap(sdkerrors.ErrTxDecode, "invalid transaction type")
+	}
+
+	var gas uint64
+	if feeTx, ok := tx.(sdk.FeeTx); ok {
+		gas = feeTx.GetGas()
+	}
+
+	if err := rld.limiter.record(sigTx.GetSigners(), gas); err != nil {
+		return ctx, err
+	}
+
+	return next(ctx, tx, simulate)
+}
+
+// rateLimiter keeps track of the txs of each signer over the sliding window.
+type rateLimiter struct {
+	opts RateLimitOptions
+
+	mtx       sync.Mutex
+	signers   map[string]*signerWindow
+	lastSweep time.Time
+}
+
+// signerWindow holds the txs of a signer in the window, in time order.
+type signerWindow struct {
+	times []time.Time
+	gas   []uint64
+	total uint64
+}
+
+// prune removes the txs which are before the start of the window.
+func (sw *signerWindow) prune(start time.Time) {
+	i := 0
+	for ; i < len(sw.times) && !sw.times[i].After(start); i++ {
+		sw.total -= sw.gas[i]
+	}
+	sw.times = sw.times[i:]
+	sw.gas = sw.gas[i:]
+}
+
+// record checks that a tx with the given gas limit is within the limits of all
+// its signers, and records it if so.
+func (rl *rateLimiter) record(signers []sdk.AccAddress, gas uint64) error {
+	rl.mtx.Lock()
+	defer rl.mtx.Unlock()
+
+	now := rl.opts.Now()
+	start := now.Add(-rl.opts.Window)
+	rl.sweep(now, start)
+
+	windows := make([]*signerWindow, len(signers))
+	for i, signer := range signers {
+		sw, ok := rl.signers[string(signer)]
+		if !ok {
+			sw = &signerWindow{}
+		}
+		sw.prune(start)
+
+		var limit string
+		switch {
+		case rl.opts.MaxTxs > 0 && uint64(len(sw.times)) >= rl.opts.MaxTxs:
+			limit = "txs"
+		case rl.opts.MaxGas > 0 && sw.total+gas > rl.opts.MaxGas:
+			limit = "gas"
+		}
+		if limit != "" {
+			telemetry.IncrCounterWithLabels(
+				[]string{"tx", "ante", "rate_limited"}, 1,
+				[]metrics.Label{telemetry.NewLabel("limit", limit)},
+			)
+			return sdkerrors.Wrapf(
+				sdkerrors.ErrRateLimited, "signer %s exceeded the limit of %d txs and %d gas per %s",
+				signer, rl.opts.MaxTxs, rl.opts.MaxGas, rl.opts.Window,
+			)
+		}
+		windows[i] = sw
+	}
+
+	for i, sw := range windows {
+		sw.times = append(sw.times, now)
+		sw.gas = append(sw.gas, gas)
+		sw.total += gas
+		rl.signers[string(signers[i])] = sw
+	}
+
+	telemetry.SetGauge(float32(len(rl.signers)), "tx", "ante", "rate_limit_signers")
+	return nil
+}
+
+// sweep removes the signers without txs in the window, at most once per
+// window, to bound the memory used by the idle signers.
+func (rl *rateLimiter) sweep(now, start time.Time) {
+	if now.Sub(rl.lastSweep) < rl.opts.Window {
+		return
+	}
+
+	for signer, sw := range rl.signers {
+		sw.prune(start)
+		if len(sw.times) == 0 {
+			delete(rl.signers, signer)
+		}
+	}
+	rl.lastSweep = now
+}
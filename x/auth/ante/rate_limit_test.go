@@ -0,0 +1,89 @@
+package ante_test
+
+import (
+	"time"
+
+	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
+	"github.com/cosmos/cosmos-sdk/testutil/testdata"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/types/tx/signing"
+	"github.com/cosmos/cosmos-sdk/x/auth/ante"
+)
+
+func (suite *AnteTestSuite) TestRateLimitDecorator() {
+	suite.SetupTest(false) // setup
+	ctx := suite.ctx.WithIsCheckTx(true)
+
+	now := time.Unix(1_000_000, 0)
+	rld := ante.NewRateLimitDecorator(ante.RateLimitOptions{
+		Window: time.Minute,
+		MaxTxs: 3,
+		MaxGas: 250_000,
+		Now:    func() time.Time { return now },
+	})
+	antehandler := sdk.ChainAnteDecorators(rld)
+
+	privs := make([]cryptotypes.PrivKey, 2)
+	addrs := make([]sdk.AccAddress, 2)
+	for i := range privs {
+		privs[i], _, addrs[i] = testdata.KeyTestPubAddr()
+	}
+
+	newTx := func(gas uint64, signers ...int) sdk.Tx {
+		txBuilder := suite.clientCtx.TxConfig.NewTxBuilder()
+		msgSigners := make([]sdk.AccAddress, len(signers))
+		sigs := make([]signing.SignatureV2, len(signers))
+		for i, signer := range signers {
+			msgSigners[i] = addrs[signer]
+			sigs[i] = signing.SignatureV2{
+				PubKey: privs[signer].PubKey(),
+				Data:   &signing.SingleSignatureData{SignMode: signing.SignMode_SIGN_MODE_DIRECT},
+			}
+		}
+		suite.Require().NoError(txBuilder.SetMsgs(testdata.NewTestMsg(msgSigners...)))
+		suite.Require().NoError(txBuilder.SetSignatures(sigs...))
+		txBuilder.SetGasLimit(gas)
+		return txBuilder.GetTx()
+	}
+
+	// require the txs of a signer to be limited in CheckTx
+	for i := 0; i < 3; i++ {
+		_, err := antehandler(ctx, newTx(50_000, 0), false)
+		suite.Require().NoError(err)
+	}
+	_, err := antehandler(ctx, newTx(50_000, 0), false)
+	suite.Require().ErrorIs(err, sdkerrors.ErrRateLimited)
+
+	// require the limits to never apply in ReCheckTx, DeliverTx and simulation
+	_, err = antehandler(ctx.WithIsReCheckTx(true), newTx(50_000, 0), false)
+	suite.Require().NoError(err)
+	_, err = antehandler(ctx.WithIsCheckTx(false), newTx(50_000, 0), false)
+	suite.Require().NoError(err)
+	_, err = antehandler(ctx, newTx(50_000, 0), true)
+	suite.Require().NoError(err)
+
+	// require a tx to be rejected if one of its signers is limited, without
+	// being counted for the others
+	_, err = antehandler(ctx, newTx(50_000, 1, 0), false)
+	suite.Require().ErrorIs(err, sdkerrors.ErrRateLimited)
+
+	// require the gas of a signer to be limited
+	_, err = antehandler(ctx, newTx(200_000, 1), false)
+	suite.Require().NoError(err)
+	_, err = antehandler(ctx, newTx(60_000, 1), false)
+	suite.Require().ErrorIs(err, sdkerrors.ErrRateLimited)
+	_, err = antehandler(ctx, newTx(50_000, 1), false)
+	suite.Require().NoError(err)
+
+	// require the limits to be restored as the window slides
+	now = now.Add(30 * time.Second)
+	_, err = antehandler(ctx, newTx(50_000, 0), false)
+	suite.Require().ErrorIs(err, sdkerrors.ErrRateLimited)
+
+	now = now.Add(31 * time.Second)
+	_, err = antehandler(ctx, newTx(50_000, 0), false)
+	suite.Require().NoError(err)
+	_, err = antehandler(ctx, newTx(200_000, 1), false)
+	suite.Require().NoError(err)
+}
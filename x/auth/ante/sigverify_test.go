@@ -4,6 +4,7 @@ import (
 	"fmt"
 
 	"github.com/cosmos/cosmos-sdk/client"
+	clienttx "github.com/cosmos/cosmos-sdk/client/tx"
 	"github.com/cosmos/cosmos-sdk/codec"
 	"github.com/cosmos/cosmos-sdk/crypto/keys/ed25519"
 	kmultisig "github.com/cosmos/cosmos-sdk/crypto/keys/multisig"
@@ -14,9 +15,12 @@ import (
 	"github.com/cosmos/cosmos-sdk/simapp"
 	"github.com/cosmos/cosmos-sdk/testutil/testdata"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	"github.com/cosmos/cosmos-sdk/types/tx/signing"
 	"github.com/cosmos/cosmos-sdk/x/auth/ante"
 	"github.com/cosmos/cosmos-sdk/x/auth/migrations/legacytx"
+	xauthsigning "github.com/cosmos/cosmos-sdk/x/auth/signing"
+	authtx "github.com/cosmos/cosmos-sdk/x/auth/tx"
 	"github.com/cosmos/cosmos-sdk/x/auth/types"
 )
 
@@ -64,6 +68,49 @@ func (suite *AnteTestSuite) TestSetPubKey() {
 	}
 }
 
+func (suite *AnteTestSuite) TestSetPubKeyChangedPubKey() {
+	suite.SetupTest(true) // setup
+	require := suite.Require()
+
+	priv1, pub1, addr1 := testdata.KeyTestPubAddr()
+	priv2, _, _ := testdata.KeyTestPubAddr()
+
+	acc := suite.app.AccountKeeper.NewAccountWithAddress(suite.ctx, addr1)
+	require.NoError(acc.SetPubKey(pub1))
+	suite.app.AccountKeeper.SetAccount(suite.ctx, acc)
+	require.NoError(suite.app.AccountKeeper.ChangePubKey(suite.ctx, addr1, priv2.PubKey()))
+
+	antehandler := sdk.ChainAnteDecorators(ante.NewSetPubKeyDecorator(suite.app.AccountKeeper))
+
+	testCases := []struct {
+		name   string
+		priv   cryptotypes.PrivKey
+		expErr bool
+	}{
+		{"replaced pubkey matching the address", priv1, true},
+		{"current pubkey not matching the address", priv2, false},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			suite.txBuilder = suite.clientCtx.TxConfig.NewTxBuilder()
+			require.NoError(suite.txBuilder.SetMsgs(testdata.NewTestMsg(addr1)))
+			suite.txBuilder.SetFeeAmount(testdata.NewTestFeeAmount())
+			suite.txBuilder.SetGasLimit(testdata.NewTestGasLimit())
+
+			tx, err := suite.CreateTestTx([]cryptotypes.PrivKey{tc.priv}, []uint64{acc.GetAccountNumber()}, []uint64{0}, suite.ctx.ChainID())
+			require.NoError(err)
+
+			_, err = antehandler(suite.ctx, tx, false)
+			if tc.expErr {
+				require.ErrorIs(err, sdkerrors.ErrInvalidPubKey)
+			} else {
+				require.NoError(err)
+			}
+		})
+	}
+}
+
 func (suite *AnteTestSuite) TestConsumeSignatureVerificationGas() {
 	params := types.DefaultParams()
 	msg := []byte{1, 2, 3, 4}
@@ -297,6 +344,111 @@ func (suite *AnteTestSuite) TestSigVerification_ExplicitAmino() {
 	}
 }
 
+// TestSigVerification_EIP191 checks the verification of the signatures made by
+// Ethereum wallets with the EIP-191 personal_sign method.
+func (suite *AnteTestSuite) TestSigVerification_EIP191() {
+	suite.app, suite.ctx = createTestApp(suite.T(), true)
+	suite.ctx = suite.ctx.WithBlockHeight(1)
+
+	// Set up TxConfig, with SIGN_MODE_EIP_191 as the default sign mode.
+	encodingConfig := simapp.MakeTestEncodingConfig()
+	testdata.RegisterInterfaces(encodingConfig.InterfaceRegistry)
+	txConfig := authtx.NewTxConfig(codec.NewProtoCodec(encodingConfig.InterfaceRegistry), []signing.SignMode{signing.SignMode_SIGN_MODE_EIP_191})
+
+	suite.clientCtx = client.Context{}.
+		WithTxConfig(txConfig)
+
+	// keys and addresses
+	priv1, _, addr1 := testdata.KeyTestPubAddr()
+	priv2, _, addr2 := testdata.KeyTestPubAddr()
+	priv3, _, addr3 := testdata.KeyTestPubAddrSecp256R1(suite.Require())
+
+	addrs := []sdk.AccAddress{addr1, addr2}
+
+	msgs := make([]sdk.Msg, len(addrs))
+	// set accounts and create msg for each address
+	for i, addr := range addrs {
+		acc := suite.app.AccountKeeper.NewAccountWithAddress(suite.ctx, addr)
+		suite.Require().NoError(acc.SetAccountNumber(uint64(i)))
+		suite.app.AccountKeeper.SetAccount(suite.ctx, acc)
+		msgs[i] = testdata.NewTestMsg(addr)
+	}
+	acc3 := suite.app.AccountKeeper.NewAccountWithAddress(suite.ctx, addr3)
+	suite.Require().NoError(acc3.SetAccountNumber(2))
+	suite.app.AccountKeeper.SetAccount(suite.ctx, acc3)
+
+	feeAmount := testdata.NewTestFeeAmount()
+	gasLimit := testdata.NewTestGasLimit()
+
+	spkd := ante.NewSetPubKeyDecorator(suite.app.AccountKeeper)
+	svd := ante.NewSigVerificationDecorator(suite.app.AccountKeeper, suite.clientCtx.TxConfig.SignModeHandler())
+	antehandler := sdk.ChainAnteDecorators(spkd, svd)
+
+	testCases := []struct {
+		name      string
+		msgs      []sdk.Msg
+		privs     []cryptotypes.PrivKey
+		accNums   []uint64
+		accSeqs   []uint64
+		shouldErr bool
+	}{
+		{"wrong order signers", msgs, []cryptotypes.PrivKey{priv2, priv1}, []uint64{1, 0}, []uint64{0, 0}, true},
+		{"wrong accnums", msgs, []cryptotypes.PrivKey{priv1, priv2}, []uint64{7, 8}, []uint64{0, 0}, true},
+		{"wrong sequences", msgs, []cryptotypes.PrivKey{priv1, priv2}, []uint64{0, 1}, []uint64{3, 4}, true},
+		{"secp256r1 signer", []sdk.Msg{testdata.NewTestMsg(addr3)}, []cryptotypes.PrivKey{priv3}, []uint64{2}, []uint64{0}, true},
+		{"valid tx", msgs, []cryptotypes.PrivKey{priv1, priv2}, []uint64{0, 1}, []uint64{0, 0}, false},
+	}
+	for i, tc := range testCases {
+		suite.txBuilder = suite.clientCtx.TxConfig.NewTxBuilder() // Create new txBuilder for each test
+
+		suite.Require().NoError(suite.txBuilder.SetMsgs(tc.msgs...))
+		suite.txBuilder.SetFeeAmount(feeAmount)
+		suite.txBuilder.SetGasLimit(gasLimit)
+
+		// The SIGN_MODE_EIP_191 sign bytes include the signer address, so the
+		// signatures are set here instead of with CreateTestTx.
+		var sigsV2 []signing.SignatureV2
+		for j, priv := range tc.privs {
+			sigsV2 = append(sigsV2, signing.SignatureV2{
+				PubKey:   priv.PubKey(),
+				Data:     &signing.SingleSignatureData{SignMode: signing.SignMode_SIGN_MODE_EIP_191},
+				Sequence: tc.accSeqs[j],
+			})
+		}
+		suite.Require().NoError(suite.txBuilder.SetSignatures(sigsV2...))
+
+		var err error
+		sigsV2 = []signing.SignatureV2{}
+		for j, priv := range tc.privs {
+			signerData := xauthsigning.SignerData{
+				Address:       sdk.AccAddress(priv.PubKey().Address()).String(),
+				ChainID:       suite.ctx.ChainID(),
+				AccountNumber: tc.accNums[j],
+				Sequence:      tc.accSeqs[j],
+			}
+			var sigV2 signing.SignatureV2
+			sigV2, err = clienttx.SignWithPrivKey(signing.SignMode_SIGN_MODE_EIP_191, signerData, suite.txBuilder, priv, txConfig, tc.accSeqs[j])
+			if err != nil {
+				break
+			}
+			sigsV2 = append(sigsV2, sigV2)
+		}
+		if err != nil {
+			// only secp256k1 keys can make EIP-191 signatures
+			suite.Require().True(tc.shouldErr, "TestCase %d: %s errored unexpectedly. Err: %v", i, tc.name, err)
+			continue
+		}
+		suite.Require().NoError(suite.txBuilder.SetSignatures(sigsV2...))
+
+		_, err = antehandler(suite.ctx, suite.txBuilder.GetTx(), false)
+		if tc.shouldErr {
+			suite.Require().NotNil(err, "TestCase %d: %s did not error as expected", i, tc.name)
+		} else {
+			suite.Require().Nil(err, "TestCase %d: %s errored unexpectedly. Err: %v", i, tc.name, err)
+		}
+	}
+}
+
 func (suite *AnteTestSuite) TestSigIntegration() {
 	// generate private keys
 	privs := []cryptotypes.PrivKey{
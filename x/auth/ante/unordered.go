@@ -0,0 +1,136 @@
+package ante
+
+import (
+	"crypto/sha256"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/types/tx/signing"
+	authsigning "github.com/cosmos/cosmos-sdk/x/auth/signing"
+)
+
+// DefaultMaxUnorderedTxTimeoutDelta is the default max number of blocks
+// between the current block and the timeout height of an unordered tx.
+const DefaultMaxUnorderedTxTimeoutDelta uint64 = 1200
+
+// DefaultMaxUnorderedTxTimeoutDuration is the default max duration between the
+// current block time and the timeout timestamp of an unordered tx.
+const DefaultMaxUnorderedTxTimeoutDuration = 10 * time.Minute
+
+// TxWithUnordered defines the interface a tx must implement in order to be
+// processed as an unordered tx.
+type TxWithUnordered interface {
+	TxWithTimeoutHeight
+	TxWithTimeoutTimestamp
+
+	GetUnordered() bool
+}
+
+// IsUnorderedTx returns whether tx is an unordered tx.
+func IsUnorderedTx(tx sdk.Tx) bool {
+	unorderedTx, ok := tx.(TxWithUnordered)
+	return ok && unorderedTx.GetUnordered()
+}
+
+// UnorderedTxDecorator defines an AnteHandler decorator that provides replay
+// protection to unordered txs, whose signers' sequences are neither checked
+// nor incremented. An unordered tx must set a timeout height, at most
+// maxTimeoutDelta blocks after the current block, or a timeout timestamp, at
+// most maxTimeoutDuration after the current block time, and is deduplicated by
+// the hash of its bytes until its timeout height, or its timeout timestamp if
+// it sets no timeout height. The tx hashes are removed by the auth module
+// EndBlock once they expire.
+//
+// Unordered txs cannot be signed with SIGN_MODE_LEGACY_AMINO_JSON or
+// SIGN_MODE_EIP_191, whose Amino JSON sign bytes do not include the unordered
+// field: the signature of an ordered tx would otherwise be replayable as an
+// unordered tx.
+//
+// CONTRACT: the TxTimeoutHeightDecorator must run before, to reject the
+// timed out txs.
+type UnorderedTxDecorator struct {
+	ak                 AccountKeeper
+	maxTimeoutDelta    uint64
+	maxTimeoutDuration time.Duration
+}
+
+func NewUnorderedTxDecorator(ak AccountKeeper, maxTimeoutDelta uint64, maxTimeoutDuration time.Duration) UnorderedTxDecorator {
+	return UnorderedTxDecorator{
+		ak:                 ak,
+		maxTimeoutDelta:    maxTimeoutDelta,
+		maxTimeoutDuration: maxTimeoutDuration,
+	}
+}
+
+func (utd UnorderedTxDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate bool, next sdk.AnteHandler) (sdk.Context, error) {
+	if !IsUnorderedTx(tx) {
+		return next(ctx, tx, simulate)
+	}
+
+	unorderedTx := tx.(TxWithUnordered)
+	timeoutHeight := unorderedTx.GetTimeoutHeight()
+	timeoutTimestamp := unorderedTx.GetTimeoutTimestamp()
+	if timeoutHeight == 0 && timeoutTimestamp.IsZero() {
+		return ctx, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "unordered tx must set a timeout height or a timeout timestamp")
+	}
+
+	maxTimeoutHeight := uint64(ctx.BlockHeight()) + utd.maxTimeoutDelta
+	if timeoutHeight > maxTimeoutHeight {
+		return ctx, sdkerrors.Wrapf(
+			sdkerrors.ErrInvalidRequest, "unordered tx timeout height %d exceeds max timeout height %d", timeoutHeight, maxTimeoutHeight,
+		)
+	}
+
+	maxTimeoutTimestamp := ctx.BlockTime().Add(utd.maxTimeoutDuration)
+	if timeoutTimestamp.After(maxTimeoutTimestamp) {
+		return ctx, sdkerrors.Wrapf(
+			sdkerrors.ErrInvalidRequest, "unordered tx timeout timestamp %s exceeds max timeout timestamp %s", timeoutTimestamp, maxTimeoutTimestamp,
+		)
+	}
+
+	sigTx, ok := tx.(authsigning.SigVerifiableTx)
+	if !ok {
+		return ctx, sdkerrors.Wrap(sdkerrors.ErrTxDecode, "invalid transaction type")
+	}
+
+	sigs, err := sigTx.GetSignaturesV2()
+	if err != nil {
+		return ctx, err
+	}
+
+	for _, sig := range sigs {
+		if hasAminoJSONSigner(sig.Data) {
+			return ctx, sdkerrors.Wrap(sdkerrors.ErrNotSupported, "unordered tx cannot be signed with SIGN_MODE_LEGACY_AMINO_JSON or SIGN_MODE_EIP_191")
+		}
+	}
+
+	txHash := sha256.Sum256(ctx.TxBytes())
+	if utd.ak.ContainsUnorderedTx(ctx, timeoutHeight, timeoutTimestamp, txHash[:]) {
+		return ctx, sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "duplicate unordered tx %X", txHash)
+	}
+
+	utd.ak.AddUnorderedTx(ctx, timeoutHeight, timeoutTimestamp, txHash[:])
+
+	return next(ctx, tx, simulate)
+}
+
+// hasAminoJSONSigner checks if any of the signers, including the nested
+// multisig signers, is using a sign mode signing the Amino JSON of the tx, i.e.
+// SIGN_MODE_LEGACY_AMINO_JSON or SIGN_MODE_EIP_191.
+func hasAminoJSONSigner(sigData signing.SignatureData) bool {
+	switch v := sigData.(type) {
+	case *signing.SingleSignatureData:
+		return v.SignMode == signing.SignMode_SIGN_MODE_LEGACY_AMINO_JSON ||
+			v.SignMode == signing.SignMode_SIGN_MODE_EIP_191
+	case *signing.MultiSignatureData:
+		for _, s := range v.Signatures {
+			if hasAminoJSONSigner(s) {
+				return true
+			}
+		}
+		return false
+	default:
+		return false
+	}
+}
@@ -0,0 +1,210 @@
+package ante_test
+
+import (
+	"time"
+
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	kmultisig "github.com/cosmos/cosmos-sdk/crypto/keys/multisig"
+	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
+	"github.com/cosmos/cosmos-sdk/crypto/types/multisig"
+	"github.com/cosmos/cosmos-sdk/testutil/testdata"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/types/tx/signing"
+	"github.com/cosmos/cosmos-sdk/x/auth/ante"
+	authsigning "github.com/cosmos/cosmos-sdk/x/auth/signing"
+)
+
+func (suite *AnteTestSuite) TestUnorderedTx() {
+	suite.SetupTest(false) // setup
+	suite.txBuilder = suite.clientCtx.TxConfig.NewTxBuilder()
+	suite.ctx = suite.ctx.WithBlockHeight(10)
+
+	accounts := suite.CreateTestAccounts(1)
+	addr := accounts[0].acc.GetAddress()
+	privs := []cryptotypes.PrivKey{accounts[0].priv}
+	accNums := []uint64{accounts[0].acc.GetAccountNumber()}
+
+	suite.Require().NoError(suite.txBuilder.SetMsgs(testdata.NewTestMsg(addr)))
+	suite.txBuilder.SetFeeAmount(testdata.NewTestFeeAmount())
+	suite.txBuilder.SetGasLimit(testdata.NewTestGasLimit())
+	suite.txBuilder.SetTimeoutHeight(20)
+	suite.txBuilder.SetUnordered(true)
+
+	// the sequence of an unordered tx is not checked
+	tx, err := suite.CreateTestTx(privs, accNums, []uint64{5}, suite.ctx.ChainID())
+	suite.Require().NoError(err)
+	txBytes, err := suite.clientCtx.TxConfig.TxEncoder()(tx)
+	suite.Require().NoError(err)
+
+	ctx := suite.ctx.WithTxBytes(txBytes)
+	_, err = suite.anteHandler(ctx, tx, false)
+	suite.Require().NoError(err)
+	suite.Require().Equal(uint64(0), suite.app.AccountKeeper.GetAccount(ctx, addr).GetSequence())
+
+	// the same tx cannot be included twice before its timeout
+	_, err = suite.anteHandler(ctx, tx, false)
+	suite.Require().ErrorIs(err, sdkerrors.ErrInvalidRequest)
+
+	// the tx is removed once expired, when it can no longer be included
+	suite.app.AccountKeeper.RemoveExpiredUnorderedTxs(ctx.WithBlockHeight(19))
+	_, err = suite.anteHandler(ctx, tx, false)
+	suite.Require().ErrorIs(err, sdkerrors.ErrInvalidRequest)
+
+	suite.app.AccountKeeper.RemoveExpiredUnorderedTxs(ctx.WithBlockHeight(20))
+	_, err = suite.anteHandler(ctx.WithBlockHeight(21), tx, false)
+	suite.Require().ErrorIs(err, sdkerrors.ErrTxTimeoutHeight)
+}
+
+func (suite *AnteTestSuite) TestUnorderedTxTimeoutTimestamp() {
+	suite.SetupTest(false) // setup
+	suite.txBuilder = suite.clientCtx.TxConfig.NewTxBuilder()
+	blockTime := time.Date(2023, 1, 1, 0, 0, 0, 0, time.UTC)
+	suite.ctx = suite.ctx.WithBlockHeight(10).WithBlockTime(blockTime)
+
+	accounts := suite.CreateTestAccounts(1)
+	privs := []cryptotypes.PrivKey{accounts[0].priv}
+	accNums := []uint64{accounts[0].acc.GetAccountNumber()}
+
+	timeoutTimestamp := blockTime.Add(time.Minute)
+	suite.Require().NoError(suite.txBuilder.SetMsgs(testdata.NewTestMsg(accounts[0].acc.GetAddress())))
+	suite.txBuilder.SetFeeAmount(testdata.NewTestFeeAmount())
+	suite.txBuilder.SetGasLimit(testdata.NewTestGasLimit())
+	suite.txBuilder.SetTimeoutTimestamp(timeoutTimestamp)
+	suite.txBuilder.SetUnordered(true)
+
+	tx, err := suite.CreateTestTx(privs, accNums, []uint64{0}, suite.ctx.ChainID())
+	suite.Require().NoError(err)
+	txBytes, err := suite.clientCtx.TxConfig.TxEncoder()(tx)
+	suite.Require().NoError(err)
+
+	ctx := suite.ctx.WithTxBytes(txBytes)
+	_, err = suite.anteHandler(ctx, tx, false)
+	suite.Require().NoError(err)
+
+	// the same tx cannot be included twice before its timeout
+	_, err = suite.anteHandler(ctx.WithBlockHeight(1000), tx, false)
+	suite.Require().ErrorIs(err, sdkerrors.ErrInvalidRequest)
+
+	// the tx is removed once expired, when it can no longer be included
+	suite.app.AccountKeeper.RemoveExpiredUnorderedTxs(ctx.WithBlockTime(timeoutTimestamp.Add(-time.Second)))
+	_, err = suite.anteHandler(ctx, tx, false)
+	suite.Require().ErrorIs(err, sdkerrors.ErrInvalidRequest)
+
+	suite.app.AccountKeeper.RemoveExpiredUnorderedTxs(ctx.WithBlockTime(timeoutTimestamp))
+	_, err = suite.anteHandler(ctx.WithBlockTime(timeoutTimestamp.Add(time.Second)), tx, false)
+	suite.Require().ErrorIs(err, sdkerrors.ErrTxTimeout)
+}
+
+func (suite *AnteTestSuite) TestUnorderedTxReplayEIP191() {
+	suite.SetupTest(false) // setup
+	suite.txBuilder = suite.clientCtx.TxConfig.NewTxBuilder()
+	suite.ctx = suite.ctx.WithBlockHeight(10)
+
+	accounts := suite.CreateTestAccounts(1)
+	priv := accounts[0].priv
+	addr := accounts[0].acc.GetAddress()
+
+	suite.Require().NoError(suite.txBuilder.SetMsgs(testdata.NewTestMsg(addr)))
+	suite.txBuilder.SetFeeAmount(testdata.NewTestFeeAmount())
+	suite.txBuilder.SetGasLimit(testdata.NewTestGasLimit())
+	suite.txBuilder.SetTimeoutHeight(20)
+
+	// sign an ordered tx with SIGN_MODE_EIP_191
+	signMode := signing.SignMode_SIGN_MODE_EIP_191
+	suite.Require().NoError(suite.txBuilder.SetSignatures(signing.SignatureV2{
+		PubKey: priv.PubKey(),
+		Data:   &signing.SingleSignatureData{SignMode: signMode},
+	}))
+	signerData := authsigning.SignerData{
+		Address:       addr.String(),
+		ChainID:       suite.ctx.ChainID(),
+		AccountNumber: accounts[0].acc.GetAccountNumber(),
+		Sequence:      0,
+	}
+	sig, err := tx.SignWithPrivKey(signMode, signerData, suite.txBuilder, priv, suite.clientCtx.TxConfig, 0)
+	suite.Require().NoError(err)
+	suite.Require().NoError(suite.txBuilder.SetSignatures(sig))
+
+	txBytes, err := suite.clientCtx.TxConfig.TxEncoder()(suite.txBuilder.GetTx())
+	suite.Require().NoError(err)
+	_, err = suite.anteHandler(suite.ctx.WithTxBytes(txBytes), suite.txBuilder.GetTx(), false)
+	suite.Require().NoError(err)
+	suite.Require().Equal(uint64(1), suite.app.AccountKeeper.GetAccount(suite.ctx, addr).GetSequence())
+
+	// the Amino JSON sign bytes don't include the unordered field, so the
+	// signature is still valid once the included tx is marked as unordered,
+	// which must not allow it to be replayed
+	suite.txBuilder.SetUnordered(true)
+	txBytes, err = suite.clientCtx.TxConfig.TxEncoder()(suite.txBuilder.GetTx())
+	suite.Require().NoError(err)
+	_, err = suite.anteHandler(suite.ctx.WithTxBytes(txBytes), suite.txBuilder.GetTx(), false)
+	suite.Require().ErrorIs(err, sdkerrors.ErrNotSupported)
+	suite.Require().Equal(uint64(1), suite.app.AccountKeeper.GetAccount(suite.ctx, addr).GetSequence())
+}
+
+func (suite *AnteTestSuite) TestUnorderedTxDecorator() {
+	suite.SetupTest(false) // setup
+	blockTime := time.Date(2023, 1, 1, 0, 0, 0, 0, time.UTC)
+	suite.ctx = suite.ctx.WithBlockHeight(10).WithBlockTime(blockTime)
+
+	priv, _, addr := testdata.KeyTestPubAddr()
+	utd := ante.NewUnorderedTxDecorator(suite.app.AccountKeeper, 100, time.Minute)
+	antehandler := sdk.ChainAnteDecorators(utd)
+
+	testCases := []struct {
+		desc          string
+		unordered     bool
+		timeoutHeight uint64
+		timeoutAfter  time.Duration
+		signMode      signing.SignMode
+		multisig      bool
+		expErr        error
+	}{
+		{"ordered tx", false, 0, 0, signing.SignMode_SIGN_MODE_DIRECT, false, nil},
+		{"unordered tx", true, 110, 0, signing.SignMode_SIGN_MODE_DIRECT, false, nil},
+		{"timeout timestamp", true, 0, time.Minute, signing.SignMode_SIGN_MODE_DIRECT, false, nil},
+		{"timeout height and timestamp", true, 110, time.Minute, signing.SignMode_SIGN_MODE_DIRECT, false, nil},
+		{"no timeout height nor timestamp", true, 0, 0, signing.SignMode_SIGN_MODE_DIRECT, false, sdkerrors.ErrInvalidRequest},
+		{"timeout height too far", true, 111, 0, signing.SignMode_SIGN_MODE_DIRECT, false, sdkerrors.ErrInvalidRequest},
+		{"timeout timestamp too far", true, 0, time.Minute + time.Second, signing.SignMode_SIGN_MODE_DIRECT, false, sdkerrors.ErrInvalidRequest},
+		{"legacy amino json signer", true, 110, 0, signing.SignMode_SIGN_MODE_LEGACY_AMINO_JSON, false, sdkerrors.ErrNotSupported},
+		{"eip191 signer", true, 110, 0, signing.SignMode_SIGN_MODE_EIP_191, false, sdkerrors.ErrNotSupported},
+		{"multisig direct signer", true, 110, 0, signing.SignMode_SIGN_MODE_DIRECT, true, nil},
+		{"multisig legacy amino json signer", true, 110, 0, signing.SignMode_SIGN_MODE_LEGACY_AMINO_JSON, true, sdkerrors.ErrNotSupported},
+		{"multisig eip191 signer", true, 110, 0, signing.SignMode_SIGN_MODE_EIP_191, true, sdkerrors.ErrNotSupported},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.desc, func() {
+			txBuilder := suite.clientCtx.TxConfig.NewTxBuilder()
+			suite.Require().NoError(txBuilder.SetMsgs(testdata.NewTestMsg(addr)))
+			txBuilder.SetTimeoutHeight(tc.timeoutHeight)
+			if tc.timeoutAfter != 0 {
+				txBuilder.SetTimeoutTimestamp(blockTime.Add(tc.timeoutAfter))
+			}
+			txBuilder.SetUnordered(tc.unordered)
+			sig := signing.SignatureV2{
+				PubKey: priv.PubKey(),
+				Data:   &signing.SingleSignatureData{SignMode: tc.signMode},
+			}
+			if tc.multisig {
+				multisigKey := kmultisig.NewLegacyAminoPubKey(1, []cryptotypes.PubKey{priv.PubKey()})
+				multisigData := multisig.NewMultisig(1)
+				suite.Require().NoError(multisig.AddSignatureV2(multisigData, sig, multisigKey.GetPubKeys()))
+				sig = signing.SignatureV2{PubKey: multisigKey, Data: multisigData}
+			}
+			suite.Require().NoError(txBuilder.SetSignatures(sig))
+
+			txBytes, err := suite.clientCtx.TxConfig.TxEncoder()(txBuilder.GetTx())
+			suite.Require().NoError(err)
+
+			_, err = antehandler(suite.ctx.WithTxBytes(txBytes), txBuilder.GetTx(), false)
+			if tc.expErr != nil {
+				suite.Require().ErrorIs(err, tc.expErr)
+			} else {
+				suite.Require().NoError(err)
+			}
+		})
+	}
+}
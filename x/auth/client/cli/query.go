@@ -45,8 +45,11 @@ func GetQueryCmd() *cobra.Command {
 		GetAccountAddressByIDCmd(),
 		GetAccountsCmd(),
 		QueryParamsCmd(),
+		QueryPubKeyChangeParamsCmd(),
+		QueryGasRefundParamsCmd(),
 		QueryModuleAccountsCmd(),
 		QueryModuleAccountByNameCmd(),
+		GetPubKeyHistoryCmd(),
 	)
 
 	return cmd
@@ -83,6 +86,70 @@ $ <appd> query auth params
 	return cmd
 }
 
+// QueryPubKeyChangeParamsCmd returns the command handler for querying the
+// parameters of MsgChangePubKey.
+func QueryPubKeyChangeParamsCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "pubkey-change-params",
+		Short: "Query the current parameters of the public key changes",
+		Args:  cobra.NoArgs,
+		Long: strings.TrimSpace(`Query the current parameters of the public key changes:
+
+$ <appd> query auth pubkey-change-params
+`),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+			res, err := queryClient.PubKeyChangeParams(cmd.Context(), &types.QueryPubKeyChangeParamsRequest{})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(&res.Params)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// QueryGasRefundParamsCmd returns the command handler for querying the
+// parameters of the gas refunds.
+func QueryGasRefundParamsCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "gas-refund-params",
+		Short: "Query the current parameters of the gas refunds",
+		Args:  cobra.NoArgs,
+		Long: strings.TrimSpace(`Query the current parameters of the gas refunds:
+
+$ <appd> query auth gas-refund-params
+`),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+			res, err := queryClient.GasRefundParams(cmd.Context(), &types.QueryGasRefundParamsRequest{})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(&res.Params)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
 // GetAccountCmd returns a query account that will display the state of the
 // account at a given address.
 func GetAccountCmd() *cobra.Command {
@@ -161,6 +228,45 @@ func GetAccountAddressByIDCmd() *cobra.Command {
 	return cmd
 }
 
+// GetPubKeyHistoryCmd returns a query command that will display the public
+// keys previously used by the account at a given address.
+func GetPubKeyHistoryCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "pubkey-history [address]",
+		Short:   "Query the public keys previously used by an account",
+		Args:    cobra.ExactArgs(1),
+		Example: fmt.Sprintf("%s q auth pubkey-history cosmos1...", version.AppName),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			addr, err := sdk.AccAddressFromBech32(args[0])
+			if err != nil {
+				return err
+			}
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+			res, err := queryClient.PubKeyHistory(cmd.Context(), &types.QueryPubKeyHistoryRequest{Address: addr.String(), Pagination: pageReq})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "pubkey-history")
+
+	return cmd
+}
+
 // GetAccountsCmd returns a query command that will display a list of accounts
 func GetAccountsCmd() *cobra.Command {
 	cmd := &cobra.Command{
@@ -0,0 +1,81 @@
+package cli
+
+import (
+	"fmt"
+
+	"github.com/spf13/cobra"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	"github.com/cosmos/cosmos-sdk/version"
+	"github.com/cosmos/cosmos-sdk/x/auth/types"
+)
+
+// GetTxCmd returns the transaction commands for the auth module.
+func GetTxCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:                        types.ModuleName,
+		Short:                      "Auth transaction subcommands",
+		DisableFlagParsing:         true,
+		SuggestionsMinimumDistance: 2,
+		RunE:                       client.ValidateCmd,
+	}
+
+	cmd.AddCommand(
+		NewChangePubKeyCmd(),
+	)
+
+	return cmd
+}
+
+// NewChangePubKeyCmd returns a CLI command handler for creating a
+// MsgChangePubKey transaction.
+func NewChangePubKeyCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "change-pubkey [new-key-name]",
+		Short: "Replace the public key of the sender account, keeping its address",
+		Long: `Replace the public key of the sender account, keeping its address. The
+transaction must be signed with the current public key of the account, and the
+following transactions must be signed with the new one. The new key is read from
+the keyring, and signs the address and account number of the account to prove
+that its owner controls it. In offline mode, the account number is read from
+--account-number.`,
+		Example: fmt.Sprintf(`%s tx auth change-pubkey mynewkey --from mykey`, version.AppName),
+		Args:    cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			addr := clientCtx.GetFromAddress()
+			accNum, err := cmd.Flags().GetUint64(flags.FlagAccountNumber)
+			if err != nil {
+				return err
+			}
+			if !clientCtx.Offline {
+				accNum, _, err = clientCtx.AccountRetriever.GetAccountNumberSequence(clientCtx, addr)
+				if err != nil {
+					return err
+				}
+			}
+
+			sig, pk, err := clientCtx.Keyring.Sign(args[0], types.ChangePubKeySignBytes(addr, accNum))
+			if err != nil {
+				return err
+			}
+
+			msg, err := types.NewMsgChangePubKey(addr, pk, sig)
+			if err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
@@ -299,6 +299,7 @@ func (s *IntegrationTestSuite) TestCLISignBatch() {
 		val,
 		addr,
 		sdk.NewCoins(sdk.NewInt64Coin(s.cfg.BondDenom, 1000)),
+		fmt.Sprintf("--%s=200000", flags.FlagGas),
 	)
 	s.Require().NoError(err)
 	s.Require().NoError(s.network.WaitForNextBlock())
@@ -479,6 +480,7 @@ func (s *IntegrationTestSuite) TestCLIQueryTxCmdByHash() {
 	out, err := s.createBankMsg(
 		val, addr,
 		sdk.NewCoins(sendTokens),
+		fmt.Sprintf("--%s=200000", flags.FlagGas),
 	)
 	s.Require().NoError(err)
 	s.Require().NoError(s.network.WaitForNextBlock())
@@ -551,6 +553,7 @@ func (s *IntegrationTestSuite) TestCLIQueryTxCmdByEvents() {
 	out, err := s.createBankMsg(
 		val, addr2,
 		sdk.NewCoins(sendTokens),
+		fmt.Sprintf("--%s=200000", flags.FlagGas),
 	)
 	s.Require().NoError(err)
 	var txRes sdk.TxResponse
@@ -668,6 +671,7 @@ func (s *IntegrationTestSuite) TestCLIQueryTxsCmdByEvents() {
 		val,
 		addr2,
 		sdk.NewCoins(sendTokens),
+		fmt.Sprintf("--%s=200000", flags.FlagGas),
 	)
 	s.Require().NoError(err)
 	var txRes sdk.TxResponse
@@ -779,7 +783,7 @@ func (s *IntegrationTestSuite) TestCLISendGenerateSignAndBroadcast() {
 
 	// Test generate sendTx, estimate gas
 	finalGeneratedTx, err := s.createBankMsg(val1, addr,
-		sdk.NewCoins(sendTokens), fmt.Sprintf("--gas=%d", flags.DefaultGasLimit),
+		sdk.NewCoins(sendTokens), fmt.Sprintf("--%s=200000", flags.FlagGas),
 		fmt.Sprintf("--%s=true", flags.FlagGenerateOnly))
 	s.Require().NoError(err)
 
@@ -787,7 +791,7 @@ func (s *IntegrationTestSuite) TestCLISendGenerateSignAndBroadcast() {
 	s.Require().NoError(err)
 	txBuilder, err = txCfg.WrapTxBuilder(finalStdTx)
 	s.Require().NoError(err)
-	s.Require().Equal(uint64(flags.DefaultGasLimit), txBuilder.GetTx().GetGas())
+	s.Require().Equal(uint64(200000), txBuilder.GetTx().GetGas())
 	s.Require().Equal(len(finalStdTx.GetMsgs()), 1)
 
 	// Write the output to disk
@@ -888,6 +892,7 @@ func (s *IntegrationTestSuite) TestCLIMultisignInsufficientCosigners() {
 		sdk.NewCoins(
 			sdk.NewInt64Coin(s.cfg.BondDenom, 10),
 		),
+		fmt.Sprintf("--%s=200000", flags.FlagGas),
 	)
 	s.Require().NoError(err)
 
@@ -904,6 +909,7 @@ func (s *IntegrationTestSuite) TestCLIMultisignInsufficientCosigners() {
 		fmt.Sprintf("--%s=true", flags.FlagSkipConfirmation),
 		fmt.Sprintf("--%s=%s", flags.FlagBroadcastMode, flags.BroadcastSync),
 		fmt.Sprintf("--%s=%s", flags.FlagFees, sdk.NewCoins(sdk.NewCoin(s.cfg.BondDenom, sdk.NewInt(10))).String()),
+		fmt.Sprintf("--%s=200000", flags.FlagGas),
 		fmt.Sprintf("--%s=true", flags.FlagGenerateOnly),
 	)
 	s.Require().NoError(err)
@@ -995,6 +1001,7 @@ func (s *IntegrationTestSuite) TestCLIMultisignSortSignatures() {
 		val1,
 		addr,
 		sdk.NewCoins(sendTokens),
+		fmt.Sprintf("--%s=200000", flags.FlagGas),
 	)
 	s.Require().NoError(err)
 
@@ -1019,6 +1026,7 @@ func (s *IntegrationTestSuite) TestCLIMultisignSortSignatures() {
 		fmt.Sprintf("--%s=true", flags.FlagSkipConfirmation),
 		fmt.Sprintf("--%s=%s", flags.FlagBroadcastMode, flags.BroadcastSync),
 		fmt.Sprintf("--%s=%s", flags.FlagFees, sdk.NewCoins(sdk.NewCoin(s.cfg.BondDenom, sdk.NewInt(10))).String()),
+		fmt.Sprintf("--%s=200000", flags.FlagGas),
 		fmt.Sprintf("--%s=true", flags.FlagGenerateOnly),
 	)
 	s.Require().NoError(err)
@@ -1092,6 +1100,7 @@ func (s *IntegrationTestSuite) TestSignWithMultisig() {
 		fmt.Sprintf("--%s=true", flags.FlagSkipConfirmation),
 		fmt.Sprintf("--%s=%s", flags.FlagBroadcastMode, flags.BroadcastSync),
 		fmt.Sprintf("--%s=%s", flags.FlagFees, sdk.NewCoins(sdk.NewCoin(s.cfg.BondDenom, sdk.NewInt(10))).String()),
+		fmt.Sprintf("--%s=200000", flags.FlagGas),
 		fmt.Sprintf("--%s=true", flags.FlagGenerateOnly),
 	)
 	s.Require().NoError(err)
@@ -1129,6 +1138,7 @@ func (s *IntegrationTestSuite) TestCLIMultisign() {
 	_, err = s.createBankMsg(
 		val1, addr,
 		sdk.NewCoins(sendTokens),
+		fmt.Sprintf("--%s=200000", flags.FlagGas),
 	)
 	s.Require().NoError(s.network.WaitForNextBlock())
 	s.Require().NoError(err)
@@ -1153,6 +1163,7 @@ func (s *IntegrationTestSuite) TestCLIMultisign() {
 		fmt.Sprintf("--%s=true", flags.FlagSkipConfirmation),
 		fmt.Sprintf("--%s=%s", flags.FlagBroadcastMode, flags.BroadcastSync),
 		fmt.Sprintf("--%s=%s", flags.FlagFees, sdk.NewCoins(sdk.NewCoin(s.cfg.BondDenom, sdk.NewInt(10))).String()),
+		fmt.Sprintf("--%s=200000", flags.FlagGas),
 		fmt.Sprintf("--%s=true", flags.FlagGenerateOnly),
 	)
 	s.Require().NoError(err)
@@ -1217,6 +1228,7 @@ func (s *IntegrationTestSuite) TestSignBatchMultisig() {
 		val,
 		addr,
 		sdk.NewCoins(sendTokens),
+		fmt.Sprintf("--%s=200000", flags.FlagGas),
 	)
 	s.Require().NoError(err)
 	s.Require().NoError(s.network.WaitForNextBlock())
@@ -1231,6 +1243,7 @@ func (s *IntegrationTestSuite) TestSignBatchMultisig() {
 		fmt.Sprintf("--%s=true", flags.FlagSkipConfirmation),
 		fmt.Sprintf("--%s=%s", flags.FlagBroadcastMode, flags.BroadcastSync),
 		fmt.Sprintf("--%s=%s", flags.FlagFees, sdk.NewCoins(sdk.NewCoin(s.cfg.BondDenom, sdk.NewInt(10))).String()),
+		fmt.Sprintf("--%s=200000", flags.FlagGas),
 		fmt.Sprintf("--%s=true", flags.FlagGenerateOnly),
 	)
 	s.Require().NoError(err)
@@ -1279,6 +1292,7 @@ func (s *IntegrationTestSuite) TestMultisignBatch() {
 		val,
 		addr,
 		sdk.NewCoins(sendTokens),
+		fmt.Sprintf("--%s=200000", flags.FlagGas),
 	)
 	s.Require().NoError(err)
 	s.Require().NoError(s.network.WaitForNextBlock())
@@ -1293,6 +1307,7 @@ func (s *IntegrationTestSuite) TestMultisignBatch() {
 		fmt.Sprintf("--%s=true", flags.FlagSkipConfirmation),
 		fmt.Sprintf("--%s=%s", flags.FlagBroadcastMode, flags.BroadcastSync),
 		fmt.Sprintf("--%s=%s", flags.FlagFees, sdk.NewCoins(sdk.NewCoin(s.cfg.BondDenom, sdk.NewInt(10))).String()),
+		fmt.Sprintf("--%s=200000", flags.FlagGas),
 		fmt.Sprintf("--%s=true", flags.FlagGenerateOnly),
 	)
 	s.Require().NoError(err)
@@ -1738,7 +1753,7 @@ func (s *IntegrationTestSuite) TestAuxToFeeWithTips() {
 	tip := sdk.NewCoin(fmt.Sprintf("%stoken", val.Moniker), sdk.NewInt(1000))
 
 	require.NoError(s.network.WaitForNextBlock())
-	_, err = s.createBankMsg(val, tipper, sdk.NewCoins(tipperInitialBal))
+	_, err = s.createBankMsg(val, tipper, sdk.NewCoins(tipperInitialBal), fmt.Sprintf("--%s=200000", flags.FlagGas))
 	require.NoError(err)
 	require.NoError(s.network.WaitForNextBlock())
 
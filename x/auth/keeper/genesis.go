@@ -11,6 +11,8 @@ import (
 // a genesis port script to the new fee collector account
 func (ak AccountKeeper) InitGenesis(ctx sdk.Context, data types.GenesisState) {
 	ak.SetParams(ctx, data.Params)
+	ak.SetPubKeyChangeParams(ctx, data.PubKeyChangeParams)
+	ak.SetGasRefundParams(ctx, data.GetGasRefundParamsOrDefault())
 
 	accounts, err := types.UnpackAccounts(data.Accounts)
 	if err != nil {
@@ -23,6 +25,13 @@ func (ak AccountKeeper) InitGenesis(ctx sdk.Context, data types.GenesisState) {
 		ak.SetAccount(ctx, acc)
 	}
 
+	for _, history := range data.PubKeyHistories {
+		addr := sdk.MustAccAddressFromBech32(history.Address)
+		for _, entry := range history.Entries {
+			ak.SetPubKeyHistoryEntry(ctx, addr, entry)
+		}
+	}
+
 	ak.GetModuleAccount(ctx, types.FeeCollectorName)
 }
 
@@ -37,5 +46,21 @@ func (ak AccountKeeper) ExportGenesis(ctx sdk.Context) *types.GenesisState {
 		return false
 	})
 
-	return types.NewGenesisState(params, genAccounts)
+	var histories []types.PubKeyHistory
+	ak.IteratePubKeyHistories(ctx, func(addr sdk.AccAddress, entry types.PubKeyHistoryEntry) bool {
+		// entries are grouped by address, as the store is indexed by address first
+		if n := len(histories); n > 0 && histories[n-1].Address == addr.String() {
+			histories[n-1].Entries = append(histories[n-1].Entries, entry)
+		} else {
+			histories = append(histories, types.PubKeyHistory{Address: addr.String(), Entries: []types.PubKeyHistoryEntry{entry}})
+		}
+		return false
+	})
+
+	genState := types.NewGenesisState(params, genAccounts)
+	genState.PubKeyHistories = histories
+	genState.PubKeyChangeParams = ak.GetPubKeyChangeParams(ctx)
+	genState.GasRefundParams = ak.GetGasRefundParams(ctx)
+
+	return genState
 }
@@ -103,6 +103,28 @@ func (ak AccountKeeper) Params(c context.Context, req *types.QueryParamsRequest)
 	return &types.QueryParamsResponse{Params: params}, nil
 }
 
+// PubKeyChangeParams returns the parameters of MsgChangePubKey
+func (ak AccountKeeper) PubKeyChangeParams(c context.Context, req *types.QueryPubKeyChangeParamsRequest) (*types.QueryPubKeyChangeParamsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+	params := ak.GetPubKeyChangeParams(ctx)
+
+	return &types.QueryPubKeyChangeParamsResponse{Params: params}, nil
+}
+
+// GasRefundParams returns the parameters of the gas refunds
+func (ak AccountKeeper) GasRefundParams(c context.Context, req *types.QueryGasRefundParamsRequest) (*types.QueryGasRefundParamsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+	params := ak.GetGasRefundParams(ctx)
+
+	return &types.QueryGasRefundParamsResponse{Params: params}, nil
+}
+
 // ModuleAccounts returns all the existing Module Accounts
 func (ak AccountKeeper) ModuleAccounts(c context.Context, req *types.QueryModuleAccountsRequest) (*types.QueryModuleAccountsResponse, error) {
 	if req == nil {
@@ -207,3 +229,38 @@ func (ak AccountKeeper) AddressStringToBytes(ctx context.Context, req *types.Add
 
 	return &types.AddressStringToBytesResponse{AddressBytes: bz}, nil
 }
+
+// PubKeyHistory returns the public keys previously used by an account
+func (ak AccountKeeper) PubKeyHistory(c context.Context, req *types.QueryPubKeyHistoryRequest) (*types.QueryPubKeyHistoryResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	if req.Address == "" {
+		return nil, status.Error(codes.InvalidArgument, "Address cannot be empty")
+	}
+
+	addr, err := sdk.AccAddressFromBech32(req.Address)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	historyStore := prefix.NewStore(ctx.KVStore(ak.key), types.PubKeyHistoryPrefix(addr))
+
+	var entries []types.PubKeyHistoryEntry
+	pageRes, err := query.Paginate(historyStore, req.Pagination, func(key, value []byte) error {
+		var entry types.PubKeyHistoryEntry
+		if err := ak.cdc.Unmarshal(value, &entry); err != nil {
+			return err
+		}
+
+		entries = append(entries, entry)
+		return nil
+	})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "paginate: %v", err)
+	}
+
+	return &types.QueryPubKeyHistoryResponse{Entries: entries, Pagination: pageRes}, nil
+}
@@ -7,6 +7,8 @@ import (
 	"math"
 	"sort"
 
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
+	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
 	"github.com/cosmos/cosmos-sdk/testutil/testdata"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/x/auth/types"
@@ -498,3 +500,77 @@ func (suite *KeeperTestSuite) TestAddressStringToBytes() {
 		})
 	}
 }
+
+func (suite *KeeperTestSuite) TestGRPCQueryPubKeyHistory() {
+	oldPubKey := secp256k1.GenPrivKey().PubKey()
+	addr := sdk.AccAddress(oldPubKey.Address())
+	var req *types.QueryPubKeyHistoryRequest
+
+	testCases := []struct {
+		msg        string
+		malleate   func()
+		expPass    bool
+		expEntries int
+	}{
+		{
+			"empty request",
+			func() {
+				req = &types.QueryPubKeyHistoryRequest{}
+			},
+			false,
+			0,
+		},
+		{
+			"invalid address",
+			func() {
+				req = &types.QueryPubKeyHistoryRequest{Address: "invalid"}
+			},
+			false,
+			0,
+		},
+		{
+			"no history",
+			func() {
+				req = &types.QueryPubKeyHistoryRequest{Address: addr.String()}
+			},
+			true,
+			0,
+		},
+		{
+			"success",
+			func() {
+				ctx := suite.ctx.WithBlockHeight(10)
+				acc := suite.app.AccountKeeper.NewAccountWithAddress(ctx, addr)
+				suite.Require().NoError(acc.SetPubKey(oldPubKey))
+				suite.app.AccountKeeper.SetAccount(ctx, acc)
+				suite.Require().NoError(suite.app.AccountKeeper.ChangePubKey(ctx, addr, secp256k1.GenPrivKey().PubKey()))
+				req = &types.QueryPubKeyHistoryRequest{Address: addr.String()}
+			},
+			true,
+			1,
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(fmt.Sprintf("Case %s", tc.msg), func() {
+			suite.SetupTest() // reset
+
+			tc.malleate()
+			ctx := sdk.WrapSDKContext(suite.ctx)
+
+			res, err := suite.queryClient.PubKeyHistory(ctx, req)
+
+			if tc.expPass {
+				suite.Require().NoError(err)
+				suite.Require().NotNil(res)
+				suite.Require().Len(res.Entries, tc.expEntries)
+				if tc.expEntries > 0 {
+					suite.Require().True(oldPubKey.Equals(res.Entries[0].PubKey.GetCachedValue().(cryptotypes.PubKey)))
+				}
+			} else {
+				suite.Require().Error(err)
+				suite.Require().Nil(res)
+			}
+		})
+	}
+}
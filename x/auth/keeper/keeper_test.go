@@ -118,6 +118,16 @@ func TestGetSetParams(t *testing.T) {
 	require.Equal(t, params, actualParams)
 }
 
+func TestInitGenesisWithoutGasRefundParams(t *testing.T) {
+	app, ctx := createTestApp(t, true)
+	app.AccountKeeper.SetGasRefundParams(ctx, types.NewGasRefundParams(sdk.NewDecWithPrec(5, 1)))
+
+	// an auth genesis exported before the gas refund params were added
+	genState := types.GenesisState{Params: types.DefaultParams()}
+	app.AccountKeeper.InitGenesis(ctx, genState)
+	require.Equal(t, types.DefaultGasRefundParams(), app.AccountKeeper.GetGasRefundParams(ctx))
+}
+
 func TestSupply_ValidatePermissions(t *testing.T) {
 	app, _ := createTestApp(t, true)
 
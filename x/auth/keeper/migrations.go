@@ -5,6 +5,7 @@ import (
 
 	v043 "github.com/cosmos/cosmos-sdk/x/auth/migrations/v043"
 	v046 "github.com/cosmos/cosmos-sdk/x/auth/migrations/v046"
+	v4 "github.com/cosmos/cosmos-sdk/x/auth/migrations/v4"
 
 	"github.com/cosmos/cosmos-sdk/x/auth/types"
 
@@ -50,6 +51,12 @@ func (m Migrator) Migrate2to3(ctx sdk.Context) error {
 	return v046.MigrateStore(ctx, m.keeper.key, m.keeper.cdc)
 }
 
+// Migrate3to4 migrates from consensus version 3 to version 4. Specifically, it
+// sets the params introduced for public key changes.
+func (m Migrator) Migrate3to4(ctx sdk.Context) error {
+	return v4.MigrateStore(ctx, m.keeper.paramSubspace)
+}
+
 // V45_SetAccount implements V45_SetAccount
 // set the account without map to accAddr to accNumber.
 //
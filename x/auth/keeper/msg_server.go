@@ -0,0 +1,70 @@
+package keeper
+
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/x/auth/types"
+)
+
+type msgServer struct {
+	AccountKeeper
+}
+
+// NewMsgServerImpl returns an implementation of the auth MsgServer interface
+// for the provided AccountKeeper.
+func NewMsgServerImpl(ak AccountKeeper) types.MsgServer {
+	return &msgServer{AccountKeeper: ak}
+}
+
+var _ types.MsgServer = msgServer{}
+
+// ChangePubKey implements the Msg/ChangePubKey method. The new public key must
+// sign the address and account number of the account, so that the account
+// cannot be handed over to a key its owner does not control.
+func (s msgServer) ChangePubKey(goCtx context.Context, msg *types.MsgChangePubKey) (*types.MsgChangePubKeyResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	addr, err := sdk.AccAddressFromBech32(msg.Address)
+	if err != nil {
+		return nil, err
+	}
+
+	pubKey, err := msg.GetPubKey()
+	if err != nil {
+		return nil, err
+	}
+
+	ctx.GasMeter().ConsumeGas(s.GetPubKeyChangeParams(ctx).Cost, "pubkey change")
+
+	acc := s.GetAccount(ctx, addr)
+	if acc == nil {
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrUnknownAddress, "account %s does not exist", addr)
+	}
+
+	if !pubKey.VerifySignature(types.ChangePubKeySignBytes(addr, acc.GetAccountNumber()), msg.Signature) {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrUnauthorized, "invalid signature of the new pubkey")
+	}
+
+	oldPubKey, err := s.GetPubKey(ctx, addr)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := s.AccountKeeper.ChangePubKey(ctx, addr, pubKey); err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeChangePubKey,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.ModuleName),
+			sdk.NewAttribute(types.AttributeKeyAddress, msg.Address),
+			sdk.NewAttribute(types.AttributeKeyOldPubKey, oldPubKey.String()),
+			sdk.NewAttribute(types.AttributeKeyNewPubKey, pubKey.String()),
+		),
+	)
+
+	return &types.MsgChangePubKeyResponse{}, nil
+}
@@ -0,0 +1,131 @@
+package keeper_test
+
+import (
+	"time"
+
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
+	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/x/auth/keeper"
+	"github.com/cosmos/cosmos-sdk/x/auth/types"
+)
+
+func (suite *KeeperTestSuite) TestChangePubKey() {
+	app := suite.app
+	msgServer := keeper.NewMsgServerImpl(app.AccountKeeper)
+
+	changePubKey := func(ctx sdk.Context, addr sdk.AccAddress, priv cryptotypes.PrivKey, accNum uint64) error {
+		sig, err := priv.Sign(types.ChangePubKeySignBytes(addr, accNum))
+		suite.Require().NoError(err)
+		msg, err := types.NewMsgChangePubKey(addr, priv.PubKey(), sig)
+		suite.Require().NoError(err)
+		_, err = msgServer.ChangePubKey(sdk.WrapSDKContext(ctx), msg)
+		return err
+	}
+
+	oldPriv := secp256k1.GenPrivKey()
+	newPriv := secp256k1.GenPrivKey()
+	lastPriv := secp256k1.GenPrivKey()
+	oldPubKey, newPubKey := oldPriv.PubKey(), newPriv.PubKey()
+	addr := sdk.AccAddress(oldPubKey.Address())
+
+	start := time.Date(2022, 1, 1, 0, 0, 0, 0, time.UTC)
+	ctx := suite.ctx.WithBlockHeight(10).WithBlockTime(start)
+	params := app.AccountKeeper.GetPubKeyChangeParams(ctx)
+
+	// the account does not exist
+	err := changePubKey(ctx, addr, newPriv, 0)
+	suite.Require().ErrorIs(err, sdkerrors.ErrUnknownAddress)
+
+	// the account has no pubkey to change yet
+	acc := app.AccountKeeper.NewAccountWithAddress(ctx, addr)
+	app.AccountKeeper.SetAccount(ctx, acc)
+	accNum := acc.GetAccountNumber()
+	err = changePubKey(ctx, addr, newPriv, accNum)
+	suite.Require().ErrorIs(err, sdkerrors.ErrInvalidPubKey)
+
+	// the new pubkey must differ from the current one
+	suite.Require().NoError(acc.SetPubKey(oldPubKey))
+	app.AccountKeeper.SetAccount(ctx, acc)
+	err = changePubKey(ctx, addr, oldPriv, accNum)
+	suite.Require().ErrorIs(err, sdkerrors.ErrInvalidPubKey)
+
+	// the new pubkey must sign the address and account number of the account
+	err = changePubKey(ctx, addr, newPriv, accNum+1)
+	suite.Require().ErrorIs(err, sdkerrors.ErrUnauthorized)
+
+	sig, err := lastPriv.Sign(types.ChangePubKeySignBytes(addr, accNum))
+	suite.Require().NoError(err)
+	msg, err := types.NewMsgChangePubKey(addr, newPubKey, sig)
+	suite.Require().NoError(err)
+	_, err = msgServer.ChangePubKey(sdk.WrapSDKContext(ctx), msg)
+	suite.Require().ErrorIs(err, sdkerrors.ErrUnauthorized)
+
+	ctx = ctx.WithGasMeter(sdk.NewInfiniteGasMeter()).WithEventManager(sdk.NewEventManager())
+	suite.Require().NoError(changePubKey(ctx, addr, newPriv, accNum))
+	suite.Require().GreaterOrEqual(ctx.GasMeter().GasConsumed(), params.Cost)
+
+	pk, err := app.AccountKeeper.GetPubKey(ctx, addr)
+	suite.Require().NoError(err)
+	suite.Require().True(newPubKey.Equals(pk))
+
+	suite.Require().Equal(sdk.Events{sdk.NewEvent(
+		types.EventTypeChangePubKey,
+		sdk.NewAttribute(sdk.AttributeKeyModule, types.ModuleName),
+		sdk.NewAttribute(types.AttributeKeyAddress, addr.String()),
+		sdk.NewAttribute(types.AttributeKeyOldPubKey, oldPubKey.String()),
+		sdk.NewAttribute(types.AttributeKeyNewPubKey, newPubKey.String()),
+	)}, ctx.EventManager().Events())
+
+	// the cooldown has not elapsed yet
+	ctx = ctx.WithBlockHeight(11).WithBlockTime(start.Add(params.Cooldown - time.Second))
+	err = changePubKey(ctx, addr, lastPriv, accNum)
+	suite.Require().ErrorIs(err, types.ErrPubKeyChangeCooldown)
+
+	ctx = ctx.WithBlockHeight(12).WithBlockTime(start.Add(params.Cooldown))
+	suite.Require().NoError(changePubKey(ctx, addr, lastPriv, accNum))
+
+	history := app.AccountKeeper.GetPubKeyHistory(ctx, addr)
+	suite.Require().Len(history, 2)
+	suite.Require().True(oldPubKey.Equals(history[0].PubKey.GetCachedValue().(cryptotypes.PubKey)))
+	suite.Require().Equal(int64(10), history[0].ReplacedAtHeight)
+	suite.Require().Equal(start, history[0].ReplacedAtTime)
+	suite.Require().True(newPubKey.Equals(history[1].PubKey.GetCachedValue().(cryptotypes.PubKey)))
+	suite.Require().Equal(int64(12), history[1].ReplacedAtHeight)
+}
+
+func (suite *KeeperTestSuite) TestChangePubKeyWithoutCooldown() {
+	app := suite.app
+	ctx := suite.ctx.WithBlockHeight(10)
+
+	params := app.AccountKeeper.GetPubKeyChangeParams(ctx)
+	params.Cooldown = 0
+	app.AccountKeeper.SetPubKeyChangeParams(ctx, params)
+
+	pubKey := secp256k1.GenPrivKey().PubKey()
+	addr := sdk.AccAddress(pubKey.Address())
+	acc := app.AccountKeeper.NewAccountWithAddress(ctx, addr)
+	suite.Require().NoError(acc.SetPubKey(pubKey))
+	app.AccountKeeper.SetAccount(ctx, acc)
+
+	suite.Require().NoError(app.AccountKeeper.ChangePubKey(ctx, addr, secp256k1.GenPrivKey().PubKey()))
+
+	// at most one change per block
+	err := app.AccountKeeper.ChangePubKey(ctx, addr, secp256k1.GenPrivKey().PubKey())
+	suite.Require().ErrorIs(err, types.ErrPubKeyChangeCooldown)
+
+	suite.Require().NoError(app.AccountKeeper.ChangePubKey(ctx.WithBlockHeight(11), addr, secp256k1.GenPrivKey().PubKey()))
+	suite.Require().Len(app.AccountKeeper.GetPubKeyHistory(ctx, addr), 2)
+
+	// the history is exported and imported with the genesis
+	genState := app.AccountKeeper.ExportGenesis(ctx)
+	suite.Require().Len(genState.PubKeyHistories, 1)
+	suite.Require().Equal(addr.String(), genState.PubKeyHistories[0].Address)
+	suite.Require().Equal(app.AccountKeeper.GetPubKeyHistory(ctx, addr), genState.PubKeyHistories[0].Entries)
+	suite.Require().NoError(types.ValidateGenesis(*genState))
+
+	suite.SetupTest()
+	suite.app.AccountKeeper.InitGenesis(suite.ctx, *genState)
+	suite.Require().Equal(genState.PubKeyHistories[0].Entries, suite.app.AccountKeeper.GetPubKeyHistory(suite.ctx, addr))
+}
@@ -15,3 +15,29 @@ func (ak AccountKeeper) GetParams(ctx sdk.Context) (params types.Params) {
 	ak.paramSubspace.GetParamSet(ctx, &params)
 	return
 }
+
+// SetPubKeyChangeParams sets the parameters of MsgChangePubKey.
+func (ak AccountKeeper) SetPubKeyChangeParams(ctx sdk.Context, params types.PubKeyChangeParams) {
+	ak.paramSubspace.SetParamSet(ctx, &params)
+}
+
+// GetPubKeyChangeParams gets the parameters of MsgChangePubKey. They are not
+// part of the params read by the ante handler of every tx, so that only the
+// MsgChangePubKey pays the gas of reading them.
+func (ak AccountKeeper) GetPubKeyChangeParams(ctx sdk.Context) (params types.PubKeyChangeParams) {
+	ak.paramSubspace.GetParamSet(ctx, &params)
+	return
+}
+
+// SetGasRefundParams sets the parameters of the gas refunds.
+func (ak AccountKeeper) SetGasRefundParams(ctx sdk.Context, params types.GasRefundParams) {
+	ak.paramSubspace.SetParamSet(ctx, &params)
+}
+
+// GetGasRefundParams gets the parameters of the gas refunds. They are not part
+// of the params read by the ante handler of every tx, and are only read by the
+// RefundDecorator post handler.
+func (ak AccountKeeper) GetGasRefundParams(ctx sdk.Context) (params types.GasRefundParams) {
+	ak.paramSubspace.GetParamSet(ctx, &params)
+	return
+}
@@ -0,0 +1,115 @@
+package keeper
+
+import (
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/x/auth/types"
+)
+
+// ChangePubKey replaces the public key of the account at the given address,
+// keeping its address, and records the replaced public key in the account's
+// public key history. It fails if the account has no public key yet, or if
+// its public key was changed less than the cooldown period ago.
+func (ak AccountKeeper) ChangePubKey(ctx sdk.Context, addr sdk.AccAddress, pubKey cryptotypes.PubKey) error {
+	acc := ak.GetAccount(ctx, addr)
+	if acc == nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrUnknownAddress, "account %s does not exist", addr)
+	}
+
+	oldPubKey := acc.GetPubKey()
+	if oldPubKey == nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidPubKey, "account %s has no pubkey to change", addr)
+	}
+
+	if oldPubKey.Equals(pubKey) {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidPubKey, "new pubkey is the same as the current one")
+	}
+
+	if last, found := ak.GetLastPubKeyChange(ctx, addr); found {
+		// at most one change per block, as the history is indexed by height
+		nextChange := last.ReplacedAtTime.Add(ak.GetPubKeyChangeParams(ctx).Cooldown)
+		if last.ReplacedAtHeight >= ctx.BlockHeight() || ctx.BlockTime().Before(nextChange) {
+			return sdkerrors.Wrapf(types.ErrPubKeyChangeCooldown, "pubkey of %s cannot be changed before %s", addr, nextChange)
+		}
+	}
+
+	pkAny, err := codectypes.NewAnyWithValue(oldPubKey)
+	if err != nil {
+		return err
+	}
+
+	if err := acc.SetPubKey(pubKey); err != nil {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidPubKey, err.Error())
+	}
+	ak.SetAccount(ctx, acc)
+
+	ak.SetPubKeyHistoryEntry(ctx, addr, types.PubKeyHistoryEntry{
+		PubKey:           pkAny,
+		ReplacedAtHeight: ctx.BlockHeight(),
+		ReplacedAtTime:   ctx.BlockTime(),
+	})
+
+	return nil
+}
+
+// SetPubKeyHistoryEntry records a public key replaced by the account at the
+// given address.
+func (ak AccountKeeper) SetPubKeyHistoryEntry(ctx sdk.Context, addr sdk.AccAddress, entry types.PubKeyHistoryEntry) {
+	store := ctx.KVStore(ak.key)
+	store.Set(types.PubKeyHistoryStoreKey(addr, entry.ReplacedAtHeight), ak.cdc.MustMarshal(&entry))
+}
+
+// GetPubKeyHistory returns the public keys replaced by the account at the
+// given address, oldest first.
+func (ak AccountKeeper) GetPubKeyHistory(ctx sdk.Context, addr sdk.AccAddress) (entries []types.PubKeyHistoryEntry) {
+	store := prefix.NewStore(ctx.KVStore(ak.key), types.PubKeyHistoryPrefix(addr))
+	iterator := store.Iterator(nil, nil)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var entry types.PubKeyHistoryEntry
+		ak.cdc.MustUnmarshal(iterator.Value(), &entry)
+		entries = append(entries, entry)
+	}
+
+	return entries
+}
+
+// GetLastPubKeyChange returns the public key most recently replaced by the
+// account at the given address, if any.
+func (ak AccountKeeper) GetLastPubKeyChange(ctx sdk.Context, addr sdk.AccAddress) (entry types.PubKeyHistoryEntry, found bool) {
+	store := prefix.NewStore(ctx.KVStore(ak.key), types.PubKeyHistoryPrefix(addr))
+	iterator := store.ReverseIterator(nil, nil)
+	defer iterator.Close()
+
+	if !iterator.Valid() {
+		return entry, false
+	}
+
+	ak.cdc.MustUnmarshal(iterator.Value(), &entry)
+	return entry, true
+}
+
+// IteratePubKeyHistories iterates over the public keys replaced by all the
+// accounts, calling the provided function. Stop iteration when it returns
+// true.
+func (ak AccountKeeper) IteratePubKeyHistories(ctx sdk.Context, cb func(addr sdk.AccAddress, entry types.PubKeyHistoryEntry) (stop bool)) {
+	store := prefix.NewStore(ctx.KVStore(ak.key), types.PubKeyHistoryStoreKeyPrefix)
+	iterator := store.Iterator(nil, nil)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		key := iterator.Key()
+		addrLen := key[0]
+		addr := sdk.AccAddress(key[1 : 1+addrLen])
+
+		var entry types.PubKeyHistoryEntry
+		ak.cdc.MustUnmarshal(iterator.Value(), &entry)
+		if cb(addr, entry) {
+			break
+		}
+	}
+}
@@ -0,0 +1,60 @@
+package keeper
+
+import (
+	"time"
+
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/auth/types"
+)
+
+// ContainsUnorderedTx returns whether the unordered tx with the given timeouts
+// and hash has already been included in a block.
+func (ak AccountKeeper) ContainsUnorderedTx(ctx sdk.Context, timeoutHeight uint64, timeoutTimestamp time.Time, txHash []byte) bool {
+	store := ctx.KVStore(ak.key)
+	return store.Has(unorderedTxStoreKey(timeoutHeight, timeoutTimestamp, txHash))
+}
+
+// AddUnorderedTx records the unordered tx with the given timeouts and hash,
+// until its timeout height, or its timeout timestamp if it has no timeout
+// height.
+func (ak AccountKeeper) AddUnorderedTx(ctx sdk.Context, timeoutHeight uint64, timeoutTimestamp time.Time, txHash []byte) {
+	store := ctx.KVStore(ak.key)
+	store.Set(unorderedTxStoreKey(timeoutHeight, timeoutTimestamp, txHash), []byte{0x01})
+}
+
+// unorderedTxStoreKey returns the key of an unordered tx, indexed by its
+// timeout height if it is set, as the tx cannot be included after it whatever
+// its timeout timestamp, and by its timeout timestamp otherwise.
+func unorderedTxStoreKey(timeoutHeight uint64, timeoutTimestamp time.Time, txHash []byte) []byte {
+	if timeoutHeight != 0 {
+		return types.UnorderedTxStoreKey(timeoutHeight, txHash)
+	}
+	return types.UnorderedTxByTimestampStoreKey(timeoutTimestamp, txHash)
+}
+
+// RemoveExpiredUnorderedTxs removes the unordered txs with a timeout height
+// lower than or equal to the current block height, or a timeout timestamp
+// before or equal to the current block time, which cannot be included in a
+// later block.
+func (ak AccountKeeper) RemoveExpiredUnorderedTxs(ctx sdk.Context) {
+	ak.removeUnorderedTxs(ctx, types.UnorderedTxStoreKeyPrefix, sdk.Uint64ToBigEndian(uint64(ctx.BlockHeight())+1))
+	ak.removeUnorderedTxs(ctx, types.UnorderedTxByTimestampStoreKeyPrefix, sdk.PrefixEndBytes(sdk.FormatTimeBytes(ctx.BlockTime())))
+}
+
+// removeUnorderedTxs removes the unordered txs of the given index whose key,
+// without the index prefix, is before end.
+func (ak AccountKeeper) removeUnorderedTxs(ctx sdk.Context, indexPrefix, end []byte) {
+	store := prefix.NewStore(ctx.KVStore(ak.key), indexPrefix)
+	iterator := store.Iterator(nil, end)
+	defer iterator.Close()
+
+	var keys [][]byte
+	for ; iterator.Valid(); iterator.Next() {
+		keys = append(keys, iterator.Key())
+	}
+
+	for _, key := range keys {
+		store.Delete(key)
+	}
+}
@@ -2,6 +2,7 @@ package legacytx
 
 import (
 	"fmt"
+	"time"
 
 	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/codec"
@@ -71,6 +72,21 @@ func (s *StdTxBuilder) SetTimeoutHeight(height uint64) {
 	s.TimeoutHeight = height
 }
 
+// SetUnordered panics for unordered txs, which are not supported by stdtx.
+func (s *StdTxBuilder) SetUnordered(unordered bool) {
+	if unordered {
+		panic("StdTxBuilder does not support unordered txs")
+	}
+}
+
+// SetTimeoutTimestamp panics for timeout timestamps, which are not supported
+// by stdtx.
+func (s *StdTxBuilder) SetTimeoutTimestamp(timestamp time.Time) {
+	if !timestamp.IsZero() {
+		panic("StdTxBuilder does not support timeout timestamps")
+	}
+}
+
 // SetFeeGranter does nothing for stdtx
 func (s *StdTxBuilder) SetFeeGranter(_ sdk.AccAddress) {}
 
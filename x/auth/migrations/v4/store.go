@@ -0,0 +1,26 @@
+package v4
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/auth/types"
+	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
+)
+
+// MigrateStore performs in-place store migrations from version 3 to 4. The
+// migration includes:
+//
+// - Setting the PubKeyChangeParams in the paramstore
+// - Setting the GasRefundParams in the paramstore
+func MigrateStore(ctx sdk.Context, paramstore paramtypes.Subspace) error {
+	if !paramstore.HasKeyTable() {
+		paramstore = paramstore.WithKeyTable(types.ParamKeyTable())
+	}
+
+	params := types.DefaultPubKeyChangeParams()
+	paramstore.SetParamSet(ctx, &params)
+
+	gasRefundParams := types.DefaultGasRefundParams()
+	paramstore.SetParamSet(ctx, &gasRefundParams)
+
+	return nil
+}
@@ -0,0 +1,44 @@
+package v4_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/cosmos-sdk/simapp"
+	"github.com/cosmos/cosmos-sdk/testutil"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	v4auth "github.com/cosmos/cosmos-sdk/x/auth/migrations/v4"
+	"github.com/cosmos/cosmos-sdk/x/auth/types"
+	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
+)
+
+func TestStoreMigration(t *testing.T) {
+	encCfg := simapp.MakeTestEncodingConfig()
+	authKey := sdk.NewKVStoreKey("auth")
+	tAuthKey := sdk.NewTransientStoreKey("transient_test")
+	ctx := testutil.DefaultContext(authKey, tAuthKey)
+	paramstore := paramtypes.NewSubspace(encCfg.Codec, encCfg.Amino, authKey, tAuthKey, "auth")
+
+	// Check no params
+	require.False(t, paramstore.Has(ctx, types.KeyPubKeyChangeCooldown))
+	require.False(t, paramstore.Has(ctx, types.KeyPubKeyChangeCost))
+	require.False(t, paramstore.Has(ctx, types.KeyGasRefundRatio))
+
+	// Run migrations.
+	err := v4auth.MigrateStore(ctx, paramstore)
+	require.NoError(t, err)
+
+	// Make sure the new params are set.
+	require.True(t, paramstore.Has(ctx, types.KeyPubKeyChangeCooldown))
+	require.True(t, paramstore.Has(ctx, types.KeyPubKeyChangeCost))
+	require.True(t, paramstore.Has(ctx, types.KeyGasRefundRatio))
+
+	var params types.PubKeyChangeParams
+	paramstore.GetParamSetIfExists(ctx, &params)
+	require.Equal(t, types.DefaultPubKeyChangeParams(), params)
+
+	var gasRefundParams types.GasRefundParams
+	paramstore.GetParamSetIfExists(ctx, &gasRefundParams)
+	require.Equal(t, types.DefaultGasRefundParams(), gasRefundParams)
+}
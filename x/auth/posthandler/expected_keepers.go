@@ -0,0 +1,17 @@
+package posthandler
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/auth/types"
+)
+
+// AccountKeeper defines the contract needed for AccountKeeper related APIs.
+// Interface provides support to use non-sdk AccountKeeper for PostHandler's decorators.
+type AccountKeeper interface {
+	GetGasRefundParams(ctx sdk.Context) types.GasRefundParams
+}
+
+// FeegrantKeeper defines the expected feegrant keeper.
+type FeegrantKeeper interface {
+	RefundGrantedFees(ctx sdk.Context, granter, grantee sdk.AccAddress, refund sdk.Coins) error
+}
@@ -2,14 +2,34 @@ package posthandler
 
 import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/x/auth/types"
 )
 
 // HandlerOptions are the options required for constructing a default SDK PostHandler.
-type HandlerOptions struct{}
+type HandlerOptions struct {
+	// AccountKeeper enables the gas refunds, whose ratio is read from the
+	// GasRefundParams of the auth module. No refund is made if it is nil.
+	AccountKeeper AccountKeeper
+	BankKeeper    types.BankKeeper
+	// FeegrantKeeper restores the allowances of the fee grants by the gas
+	// refunds made to their granters. The allowances are not restored if it
+	// is nil.
+	FeegrantKeeper FeegrantKeeper
+}
 
-// NewPostHandler returns an empty posthandler chain.
+// NewPostHandler returns a posthandler chain refunding the unused gas when an
+// account keeper is set, and an empty chain otherwise.
 func NewPostHandler(options HandlerOptions) (sdk.AnteHandler, error) {
 	postDecorators := []sdk.AnteDecorator{}
 
+	if options.AccountKeeper != nil {
+		if options.BankKeeper == nil {
+			return nil, sdkerrors.Wrap(sdkerrors.ErrLogic, "bank keeper is required for gas refunds")
+		}
+
+		postDecorators = append(postDecorators, NewRefundDecorator(options.AccountKeeper, options.BankKeeper, options.FeegrantKeeper))
+	}
+
 	return sdk.ChainAnteDecorators(postDecorators...), nil
 }
@@ -0,0 +1,126 @@
+package posthandler
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/x/auth/types"
+)
+
+// RefundDecorator refunds a fraction of the fee paid for the gas wanted but
+// not used by the tx, to the fee granter if the fee was granted and to the fee
+// payer otherwise. When the fee was granted, the allowance of the fee payer is
+// restored by the refund too, unless the fee used it up and removed it. It
+// must be the last post decorator, as the gas consumed after it is not taken
+// into account.
+//
+// The refund of each fee coin is
+//
+//	floor(fee * refundRatio * (gasWanted - gasUsed) / gasWanted)
+//
+// where refundRatio is the RefundRatio of the GasRefundParams of the auth
+// module, which are read in every tx, including in simulations, so that the
+// estimated gas accounts for the read. The refund is sent from the fee
+// collector, before the fees are allocated at the next block. Sending it and
+// restoring the allowance do not consume gas, so that the gas estimated by a simulation does not depend on
+// the refund. No refund is made in simulations, where the gas wanted is not
+// meaningful, or when the tx messages fail.
+//
+// CONTRACT: the whole fee must have been deducted by the ante handler.
+type RefundDecorator struct {
+	ak             AccountKeeper
+	bankKeeper     types.BankKeeper
+	feegrantKeeper FeegrantKeeper
+}
+
+// NewRefundDecorator returns a new decorator refunding the fee paid for the
+// unused gas by the ratio of the gas refund params. The allowances of the fee
+// grants are not restored if fk is nil.
+func NewRefundDecorator(ak AccountKeeper, bankKeeper types.BankKeeper, fk FeegrantKeeper) RefundDecorator {
+	return RefundDecorator{
+		ak:             ak,
+		bankKeeper:     bankKeeper,
+		feegrantKeeper: fk,
+	}
+}
+
+func (rd RefundDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate bool, next sdk.AnteHandler) (sdk.Context, error) {
+	feeTx, ok := tx.(sdk.FeeTx)
+	if !ok {
+		return ctx, sdkerrors.Wrap(sdkerrors.ErrTxDecode, "Tx must be a FeeTx")
+	}
+
+	refundRatio := rd.ak.GetGasRefundParams(ctx).RefundRatio
+	if !simulate && refundRatio.IsPositive() {
+		if err := rd.refundUnusedGas(ctx, feeTx, refundRatio); err != nil {
+			return ctx, err
+		}
+	}
+
+	return next(ctx, tx, simulate)
+}
+
+// refundUnusedGas sends the refund of the unused gas back to the fee granter
+// or payer, and restores the allowance of a granted fee.
+func (rd RefundDecorator) refundUnusedGas(ctx sdk.Context, feeTx sdk.FeeTx, refundRatio sdk.Dec) error {
+	gasWanted := feeTx.GetGas()
+	gasUsed := ctx.GasMeter().GasConsumed()
+	if gasWanted == 0 || gasUsed >= gasWanted {
+		return nil
+	}
+
+	gasUnused := gasWanted - gasUsed
+	refund := ComputeRefund(feeTx.GetFee(), refundRatio, gasWanted, gasUnused)
+	if refund.IsZero() {
+		return nil
+	}
+
+	receiver := feeTx.FeePayer()
+	granter := feeTx.FeeGranter()
+	if granter != nil {
+		receiver = granter
+	}
+
+	ctx = ctx.WithGasMeter(sdk.NewInfiniteGasMeter())
+	if err := rd.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.FeeCollectorName, receiver, refund); err != nil {
+		return sdkerrors.Wrap(err, "failed to refund unused gas")
+	}
+
+	if granter != nil && rd.feegrantKeeper != nil {
+		if err := rd.feegrantKeeper.RefundGrantedFees(ctx, granter, feeTx.FeePayer(), refund); err != nil {
+			return sdkerrors.Wrap(err, "failed to restore the fee allowance")
+		}
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			sdk.EventTypeTx,
+			sdk.NewAttribute(sdk.AttributeKeyFeeRefund, refund.String()),
+			sdk.NewAttribute(sdk.AttributeKeyRefundReceiver, receiver.String()),
+			sdk.NewAttribute(sdk.AttributeKeyGasUnused, fmt.Sprint(gasUnused)),
+		),
+	)
+
+	return nil
+}
+
+// ComputeRefund returns the refund of the fee paid for gasUnused out of
+// gasWanted, where each coin is refunded
+// floor(amount * refundRatio * gasUnused / gasWanted).
+func ComputeRefund(fee sdk.Coins, refundRatio sdk.Dec, gasWanted, gasUnused uint64) sdk.Coins {
+	if gasWanted == 0 {
+		return sdk.NewCoins()
+	}
+
+	refund := make([]sdk.Coin, len(fee))
+	for i, coin := range fee {
+		amount := refundRatio.
+			MulInt(coin.Amount.Mul(sdk.NewIntFromUint64(gasUnused))).
+			QuoInt(sdk.NewIntFromUint64(gasWanted)).
+			TruncateInt()
+		refund[i] = sdk.NewCoin(coin.Denom, amount)
+	}
+
+	return sdk.NewCoins(refund...)
+}
@@ -0,0 +1,197 @@
+package posthandler_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+
+	"github.com/cosmos/cosmos-sdk/simapp"
+	"github.com/cosmos/cosmos-sdk/testutil/testdata"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/auth/posthandler"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	banktestutil "github.com/cosmos/cosmos-sdk/x/bank/testutil"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	"github.com/cosmos/cosmos-sdk/x/feegrant"
+)
+
+func TestComputeRefund(t *testing.T) {
+	fee := sdk.NewCoins(sdk.NewInt64Coin("atom", 1000), sdk.NewInt64Coin("stake", 7))
+
+	tests := []struct {
+		name      string
+		ratio     sdk.Dec
+		gasWanted uint64
+		gasUnused uint64
+		exp       sdk.Coins
+	}{
+		{"all gas unused", sdk.OneDec(), 100, 100, fee},
+		{"half gas unused", sdk.OneDec(), 100, 50, sdk.NewCoins(sdk.NewInt64Coin("atom", 500), sdk.NewInt64Coin("stake", 3))},
+		{"half refund ratio", sdk.NewDecWithPrec(5, 1), 100, 50, sdk.NewCoins(sdk.NewInt64Coin("atom", 250), sdk.NewInt64Coin("stake", 1))},
+		{"rounded down", sdk.OneDec(), 3, 1, sdk.NewCoins(sdk.NewInt64Coin("atom", 333), sdk.NewInt64Coin("stake", 2))},
+		{"zero refund ratio", sdk.ZeroDec(), 100, 50, sdk.NewCoins()},
+		{"no gas wanted", sdk.OneDec(), 0, 0, sdk.NewCoins()},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			require.Equal(t, tc.exp, posthandler.ComputeRefund(fee, tc.ratio, tc.gasWanted, tc.gasUnused))
+		})
+	}
+}
+
+func TestRefundDecorator(t *testing.T) {
+	app := simapp.Setup(t, false)
+	encCfg := simapp.MakeTestEncodingConfig()
+	feeCollector := app.AccountKeeper.GetModuleAddress(authtypes.FeeCollectorName)
+
+	_, _, payer := testdata.KeyTestPubAddr()
+	_, _, granter := testdata.KeyTestPubAddr()
+	fee := sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 1000))
+
+	// reading the gas refund params is the only gas consumed by the decorator
+	readCtx := app.BaseApp.NewContext(false, tmproto.Header{Height: 1}).WithGasMeter(sdk.NewInfiniteGasMeter())
+	app.AccountKeeper.GetGasRefundParams(readCtx)
+	readGas := readCtx.GasMeter().GasConsumed()
+
+	tests := []struct {
+		name        string
+		refundRatio sdk.Dec
+		granter     sdk.AccAddress
+		gasUsed     uint64
+		simulate    bool
+		expReceiver sdk.AccAddress
+		expRefund   sdk.Coins
+	}{
+		{"refund to fee payer", sdk.NewDecWithPrec(5, 1), nil, 400, false, payer, sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 300))},
+		{"refund to fee granter", sdk.NewDecWithPrec(5, 1), granter, 400, false, granter, sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 300))},
+		{"all gas used", sdk.NewDecWithPrec(5, 1), nil, 1000, false, payer, sdk.NewCoins()},
+		{"simulate", sdk.NewDecWithPrec(5, 1), nil, 400, true, payer, sdk.NewCoins()},
+		{"zero refund ratio", sdk.ZeroDec(), nil, 400, false, payer, sdk.NewCoins()},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			ctx := app.BaseApp.NewContext(false, tmproto.Header{Height: 1})
+			app.AccountKeeper.SetGasRefundParams(ctx, authtypes.NewGasRefundParams(tc.refundRatio))
+			require.NoError(t, banktestutil.FundModuleAccount(app.BankKeeper, ctx, authtypes.FeeCollectorName, fee))
+			collected := app.BankKeeper.GetAllBalances(ctx, feeCollector)
+			before := app.BankKeeper.GetAllBalances(ctx, tc.expReceiver)
+
+			txBuilder := encCfg.TxConfig.NewTxBuilder()
+			require.NoError(t, txBuilder.SetMsgs(banktypes.NewMsgSend(payer, granter, fee)))
+			txBuilder.SetGasLimit(1000)
+			txBuilder.SetFeeAmount(fee)
+			txBuilder.SetFeeGranter(tc.granter)
+
+			postCtx := ctx.WithGasMeter(sdk.NewGasMeter(1000))
+			postCtx.GasMeter().ConsumeGas(tc.gasUsed-readGas, "test")
+
+			postHandler := sdk.ChainAnteDecorators(posthandler.NewRefundDecorator(app.AccountKeeper, app.BankKeeper, app.FeeGrantKeeper))
+			newCtx, err := postHandler(postCtx, txBuilder.GetTx(), tc.simulate)
+			require.NoError(t, err)
+
+			// the refund does not consume gas
+			require.Equal(t, tc.gasUsed, newCtx.GasMeter().GasConsumed())
+			require.Equal(t, before.Add(tc.expRefund...), app.BankKeeper.GetAllBalances(ctx, tc.expReceiver))
+			require.Equal(t, collected.Sub(tc.expRefund...), app.BankKeeper.GetAllBalances(ctx, feeCollector))
+
+			var refundEvent bool
+			for _, event := range newCtx.EventManager().Events() {
+				for _, attr := range event.Attributes {
+					if string(attr.Key) == sdk.AttributeKeyFeeRefund {
+						refundEvent = true
+						require.Equal(t, tc.expRefund.String(), string(attr.Value))
+					}
+				}
+			}
+			require.Equal(t, !tc.expRefund.IsZero(), refundEvent)
+		})
+	}
+}
+
+func TestRefundDecoratorFeeGrant(t *testing.T) {
+	app := simapp.Setup(t, false)
+	encCfg := simapp.MakeTestEncodingConfig()
+
+	_, _, payer := testdata.KeyTestPubAddr()
+	_, _, granter := testdata.KeyTestPubAddr()
+	fee := sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 1000))
+	refund := sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 300))
+
+	readCtx := app.BaseApp.NewContext(false, tmproto.Header{Height: 1}).WithGasMeter(sdk.NewInfiniteGasMeter())
+	app.AccountKeeper.GetGasRefundParams(readCtx)
+	readGas := readCtx.GasMeter().GasConsumed()
+
+	tests := []struct {
+		name       string
+		spendLimit sdk.Coins
+		expLimit   sdk.Coins
+	}{
+		{"allowance restored", sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 1500)), sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 800))},
+		{"allowance used up", fee, nil},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			ctx, _ := app.BaseApp.NewContext(false, tmproto.Header{Height: 1}).CacheContext()
+			app.AccountKeeper.SetGasRefundParams(ctx, authtypes.NewGasRefundParams(sdk.NewDecWithPrec(5, 1)))
+			require.NoError(t, banktestutil.FundModuleAccount(app.BankKeeper, ctx, authtypes.FeeCollectorName, fee))
+
+			txBuilder := encCfg.TxConfig.NewTxBuilder()
+			require.NoError(t, txBuilder.SetMsgs(banktypes.NewMsgSend(payer, granter, fee)))
+			txBuilder.SetGasLimit(1000)
+			txBuilder.SetFeeAmount(fee)
+			txBuilder.SetFeeGranter(granter)
+			tx := txBuilder.GetTx()
+
+			// the fee is paid with the grant by the ante handler
+			require.NoError(t, app.FeeGrantKeeper.GrantAllowance(ctx, granter, payer, &feegrant.BasicAllowance{SpendLimit: tc.spendLimit}))
+			require.NoError(t, app.FeeGrantKeeper.UseGrantedFees(ctx, granter, payer, fee, tx.GetMsgs()))
+
+			postCtx := ctx.WithGasMeter(sdk.NewGasMeter(1000))
+			postCtx.GasMeter().ConsumeGas(400-readGas, "test")
+
+			postHandler := sdk.ChainAnteDecorators(posthandler.NewRefundDecorator(app.AccountKeeper, app.BankKeeper, app.FeeGrantKeeper))
+			_, err := postHandler(postCtx, tx, false)
+			require.NoError(t, err)
+
+			// the granter is refunded, and the grantee can spend the refund again
+			// unless the fee used up the allowance
+			require.Equal(t, refund, app.BankKeeper.GetAllBalances(ctx, granter))
+			allowance, err := app.FeeGrantKeeper.GetAllowance(ctx, granter, payer)
+			if tc.expLimit == nil {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.expLimit, allowance.(*feegrant.BasicAllowance).SpendLimit)
+		})
+	}
+}
+
+func TestNewPostHandler(t *testing.T) {
+	app := simapp.Setup(t, false)
+
+	tests := []struct {
+		name    string
+		options posthandler.HandlerOptions
+		expErr  bool
+	}{
+		{"no refund", posthandler.HandlerOptions{}, false},
+		{"refund", posthandler.HandlerOptions{AccountKeeper: app.AccountKeeper, BankKeeper: app.BankKeeper}, false},
+		{"refund without bank keeper", posthandler.HandlerOptions{AccountKeeper: app.AccountKeeper}, true},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			_, err := posthandler.NewPostHandler(tc.options)
+			if tc.expErr {
+				require.Error(t, err)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
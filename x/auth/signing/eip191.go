@@ -0,0 +1,94 @@
+package signing
+
+import (
+	"bytes"
+	"fmt"
+	"strconv"
+
+	btcsecp256k1 "github.com/btcsuite/btcd/btcec/v2"
+	"github.com/btcsuite/btcd/btcec/v2/ecdsa"
+	"golang.org/x/crypto/sha3"
+
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
+	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
+)
+
+const (
+	// EIP191MessagePrefix is the prefix of the messages signed with the EIP-191
+	// personal_sign method of Ethereum wallets.
+	EIP191MessagePrefix = "\x19Ethereum Signed Message:\n"
+
+	// EIP191SignatureLength is the length of an Ethereum signature, in the
+	// R || S || V format.
+	EIP191SignatureLength = 65
+
+	// compactSigMagicOffset is the offset of the recovery id in the header byte
+	// of the btcec compact signatures, for compressed public keys.
+	compactSigMagicOffset = 27 + 4
+)
+
+// EIP191Message wraps a message in the EIP-191 personal_sign envelope, i.e. the
+// EIP191MessagePrefix followed by the decimal length of the message and the
+// message itself.
+func EIP191Message(msg []byte) []byte {
+	bz := append([]byte(EIP191MessagePrefix), strconv.Itoa(len(msg))...)
+	return append(bz, msg...)
+}
+
+// SignEIP191 signs the sign bytes like an Ethereum wallet, returning the
+// R || S || V signature of their keccak256 hash, where V is 27 or 28. Only
+// secp256k1 private keys are supported.
+func SignEIP191(privKey cryptotypes.PrivKey, signBytes []byte) ([]byte, error) {
+	secpPrivKey, ok := privKey.(*secp256k1.PrivKey)
+	if !ok {
+		return nil, fmt.Errorf("EIP-191 signatures require a secp256k1 private key, got %T", privKey)
+	}
+
+	priv, _ := btcsecp256k1.PrivKeyFromBytes(secpPrivKey.Key)
+	compactSig, err := ecdsa.SignCompact(priv, keccak256(signBytes), true)
+	if err != nil {
+		return nil, err
+	}
+
+	// convert the header || R || S compact signature to R || S || V
+	sig := append(compactSig[1:], compactSig[0]-compactSigMagicOffset+27)
+	return sig, nil
+}
+
+// VerifyEIP191Signature verifies an Ethereum R || S || V signature of the sign
+// bytes, by recovering the secp256k1 public key from the signature of their
+// keccak256 hash and comparing it to the provided public key. V must be 27 or
+// 28, as returned by the Ethereum wallets, and signatures with a high S value
+// are rejected, so that a signature has a single valid encoding.
+func VerifyEIP191Signature(pubKey cryptotypes.PubKey, signBytes, sig []byte) bool {
+	secpPubKey, ok := pubKey.(*secp256k1.PubKey)
+	if !ok || len(sig) != EIP191SignatureLength {
+		return false
+	}
+
+	v := sig[64]
+	if v != 27 && v != 28 {
+		return false
+	}
+	v -= 27
+
+	// reject malleable signatures, as the signature verification of secp256k1
+	var s btcsecp256k1.ModNScalar
+	if overflow := s.SetByteSlice(sig[32:64]); overflow || s.IsOverHalfOrder() {
+		return false
+	}
+
+	compactSig := append([]byte{v + compactSigMagicOffset}, sig[:64]...)
+	recovered, _, err := ecdsa.RecoverCompact(compactSig, keccak256(signBytes))
+	if err != nil {
+		return false
+	}
+
+	return bytes.Equal(recovered.SerializeCompressed(), secpPubKey.Key)
+}
+
+func keccak256(bz []byte) []byte {
+	hasher := sha3.NewLegacyKeccak256()
+	hasher.Write(bz)
+	return hasher.Sum(nil)
+}
@@ -0,0 +1,65 @@
+package signing_test
+
+import (
+	"encoding/hex"
+	"math/big"
+	"testing"
+
+	btcsecp256k1 "github.com/btcsuite/btcd/btcec/v2"
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/cosmos-sdk/crypto/keys/ed25519"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
+	"github.com/cosmos/cosmos-sdk/x/auth/signing"
+)
+
+func TestEIP191Message(t *testing.T) {
+	require.Equal(t, []byte("\x19Ethereum Signed Message:\n9Some data"), signing.EIP191Message([]byte("Some data")))
+	require.Equal(t, []byte("\x19Ethereum Signed Message:\n0"), signing.EIP191Message(nil))
+}
+
+func TestSignEIP191(t *testing.T) {
+	// test vector of the web3.js eth.accounts.sign documentation
+	key, err := hex.DecodeString("4c0883a69102937d6231471b5dbb6204fe5129617082792ae468d01a3f362318")
+	require.NoError(t, err)
+	priv := &secp256k1.PrivKey{Key: key}
+	msg := signing.EIP191Message([]byte("Some data"))
+
+	sig, err := signing.SignEIP191(priv, msg)
+	require.NoError(t, err)
+	require.Equal(t,
+		"b91467e570a6466aa9e9876cbcd013baba02900b8979d43fe208a4a4f339f5fd6007e74cd82e037b800186422fc2da167c747ef045e5d18a5f5d4300f8e1a0291c",
+		hex.EncodeToString(sig),
+	)
+	require.True(t, signing.VerifyEIP191Signature(priv.PubKey(), msg, sig))
+
+	_, err = signing.SignEIP191(ed25519.GenPrivKey(), msg)
+	require.Error(t, err)
+}
+
+func TestVerifyEIP191Signature(t *testing.T) {
+	priv := secp256k1.GenPrivKey()
+	msg := signing.EIP191Message([]byte("sign bytes"))
+	sig, err := signing.SignEIP191(priv, msg)
+	require.NoError(t, err)
+	require.True(t, signing.VerifyEIP191Signature(priv.PubKey(), msg, sig))
+
+	// the raw recovery id is rejected, V has a single encoding
+	rawV := append(append([]byte{}, sig[:64]...), sig[64]-27)
+	require.False(t, signing.VerifyEIP191Signature(priv.PubKey(), msg, rawV))
+
+	invalidV := append(append([]byte{}, sig[:64]...), 29)
+	require.False(t, signing.VerifyEIP191Signature(priv.PubKey(), msg, invalidV))
+
+	// the high S signature with the flipped recovery id recovers the same key,
+	// but is rejected as malleable
+	n := btcsecp256k1.S256().N
+	highS := new(big.Int).Sub(n, new(big.Int).SetBytes(sig[32:64])).FillBytes(make([]byte, 32))
+	malleable := append(append(append([]byte{}, sig[:32]...), highS...), 27+(28-sig[64]))
+	require.False(t, signing.VerifyEIP191Signature(priv.PubKey(), msg, malleable))
+
+	require.False(t, signing.VerifyEIP191Signature(secp256k1.GenPrivKey().PubKey(), msg, sig))
+	require.False(t, signing.VerifyEIP191Signature(priv.PubKey(), []byte("other bytes"), sig))
+	require.False(t, signing.VerifyEIP191Signature(priv.PubKey(), msg, sig[:64]))
+	require.False(t, signing.VerifyEIP191Signature(ed25519.GenPrivKey().PubKey(), msg, sig))
+}
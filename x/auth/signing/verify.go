@@ -1,6 +1,7 @@
 package signing
 
 import (
+	"context"
 	"fmt"
 
 	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
@@ -12,12 +13,27 @@ import (
 // VerifySignature verifies a transaction signature contained in SignatureData abstracting over different signing modes
 // and single vs multi-signatures.
 func VerifySignature(pubKey cryptotypes.PubKey, signerData SignerData, sigData signing.SignatureData, handler SignModeHandler, tx sdk.Tx) error {
+	return VerifySignatureWithContext(context.Background(), pubKey, signerData, sigData, handler, tx)
+}
+
+// VerifySignatureWithContext verifies a transaction signature like VerifySignature, generating the sign bytes with the
+// provided context, which is required by the sign modes depending on the state, i.e. SIGN_MODE_TEXTUAL.
+func VerifySignatureWithContext(ctx context.Context, pubKey cryptotypes.PubKey, signerData SignerData, sigData signing.SignatureData, handler SignModeHandler, tx sdk.Tx) error {
 	switch data := sigData.(type) {
 	case *signing.SingleSignatureData:
-		signBytes, err := handler.GetSignBytes(data.SignMode, signerData, tx)
+		signBytes, err := GetSignBytesWithContext(ctx, handler, data.SignMode, signerData, tx)
 		if err != nil {
 			return err
 		}
+		// EIP-191 signatures made by Ethereum wallets are over the keccak256
+		// hash of the sign bytes, and hold the recovery id of the public key.
+		// The keyrings sign the sign bytes as in the other modes.
+		if data.SignMode == signing.SignMode_SIGN_MODE_EIP_191 && len(data.Signature) == EIP191SignatureLength {
+			if !VerifyEIP191Signature(pubKey, signBytes, data.Signature) {
+				return fmt.Errorf("unable to verify single signer EIP-191 signature")
+			}
+			return nil
+		}
 		if !pubKey.VerifySignature(signBytes, data.Signature) {
 			return fmt.Errorf("unable to verify single signer signature")
 		}
@@ -29,7 +45,7 @@ func VerifySignature(pubKey cryptotypes.PubKey, signerData SignerData, sigData s
 			return fmt.Errorf("expected %T, got %T", (multisig.PubKey)(nil), pubKey)
 		}
 		err := multiPK.VerifyMultisignature(func(mode signing.SignMode) ([]byte, error) {
-			return handler.GetSignBytes(mode, signerData, tx)
+			return GetSignBytesWithContext(ctx, handler, mode, signerData, tx)
 		}, data)
 		if err != nil {
 			return err
@@ -56,6 +56,20 @@ func NewDecodeStore(ak AuthUnmarshaler) func(kvA, kvB kv.Pair) string {
 
 			return fmt.Sprintf("AccNumA: %s\nAccNumB: %s", accNumA, accNumB)
 
+		case bytes.HasPrefix(kvA.Key, types.UnorderedTxStoreKeyPrefix):
+			return fmt.Sprintf("UnorderedTxA: %X\nUnorderedTxB: %X", kvA.Key[9:], kvB.Key[9:])
+
+		case bytes.HasPrefix(kvA.Key, types.UnorderedTxByTimestampStoreKeyPrefix):
+			timestampLen := len(types.UnorderedTxByTimestampStoreKeyPrefix) + len(sdk.SortableTimeFormat)
+			return fmt.Sprintf("UnorderedTxA: %X\nUnorderedTxB: %X", kvA.Key[timestampLen:], kvB.Key[timestampLen:])
+
+		case bytes.HasPrefix(kvA.Key, types.PubKeyHistoryStoreKeyPrefix):
+			var entryA, entryB types.PubKeyHistoryEntry
+			ak.GetCodec().MustUnmarshal(kvA.Value, &entryA)
+			ak.GetCodec().MustUnmarshal(kvB.Value, &entryB)
+
+			return fmt.Sprintf("%v\n%v", entryA, entryB)
+
 		default:
 			panic(fmt.Sprintf("unexpected %s key %X (%s)", types.ModuleName, kvA.Key, kvA.Key))
 		}
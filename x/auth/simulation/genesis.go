@@ -4,6 +4,7 @@ import (
 	"encoding/json"
 	"fmt"
 	"math/rand"
+	"time"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/module"
@@ -19,6 +20,9 @@ const (
 	TxSizeCostPerByte      = "tx_size_cost_per_byte"
 	SigVerifyCostED25519   = "sig_verify_cost_ed25519"
 	SigVerifyCostSECP256K1 = "sig_verify_cost_secp256k1"
+	PubKeyChangeCooldown   = "pubkey_change_cooldown"
+	PubKeyChangeCost       = "pubkey_change_cost"
+	GasRefundRatio         = "gas_refund_ratio"
 )
 
 // RandomGenesisAccounts defines the default RandomGenesisAccountsFn used on the SDK.
@@ -87,6 +91,21 @@ func GenSigVerifyCostSECP256K1(r *rand.Rand) uint64 {
 	return uint64(simulation.RandIntBetween(r, 500, 1000))
 }
 
+// GenPubKeyChangeCooldown randomized PubKeyChangeCooldown
+func GenPubKeyChangeCooldown(r *rand.Rand) time.Duration {
+	return time.Duration(simulation.RandIntBetween(r, 0, 60*60*24*7)) * time.Second
+}
+
+// GenPubKeyChangeCost randomized PubKeyChangeCost
+func GenPubKeyChangeCost(r *rand.Rand) uint64 {
+	return uint64(simulation.RandIntBetween(r, 1000, 20000))
+}
+
+// GenGasRefundRatio randomized GasRefundRatio
+func GenGasRefundRatio(r *rand.Rand) sdk.Dec {
+	return sdk.NewDecWithPrec(int64(simulation.RandIntBetween(r, 0, 51)), 2)
+}
+
 // RandomizedGenState generates a random GenesisState for auth
 func RandomizedGenState(simState *module.SimulationState, randGenAccountsFn types.RandomGenesisAccountsFn) {
 	var maxMemoChars uint64
@@ -119,11 +138,31 @@ func RandomizedGenState(simState *module.SimulationState, randGenAccountsFn type
 		func(r *rand.Rand) { sigVerifyCostSECP256K1 = GenSigVerifyCostSECP256K1(r) },
 	)
 
+	var pubKeyChangeCooldown time.Duration
+	simState.AppParams.GetOrGenerate(
+		simState.Cdc, PubKeyChangeCooldown, &pubKeyChangeCooldown, simState.Rand,
+		func(r *rand.Rand) { pubKeyChangeCooldown = GenPubKeyChangeCooldown(r) },
+	)
+
+	var pubKeyChangeCost uint64
+	simState.AppParams.GetOrGenerate(
+		simState.Cdc, PubKeyChangeCost, &pubKeyChangeCost, simState.Rand,
+		func(r *rand.Rand) { pubKeyChangeCost = GenPubKeyChangeCost(r) },
+	)
+
+	var gasRefundRatio sdk.Dec
+	simState.AppParams.GetOrGenerate(
+		simState.Cdc, GasRefundRatio, &gasRefundRatio, simState.Rand,
+		func(r *rand.Rand) { gasRefundRatio = GenGasRefundRatio(r) },
+	)
+
 	params := types.NewParams(maxMemoChars, txSigLimit, txSizeCostPerByte,
 		sigVerifyCostED25519, sigVerifyCostSECP256K1)
 	genesisAccs := randGenAccountsFn(simState)
 
 	authGenesis := types.NewGenesisState(params, genesisAccs)
+	authGenesis.PubKeyChangeParams = types.NewPubKeyChangeParams(pubKeyChangeCooldown, pubKeyChangeCost)
+	authGenesis.GasRefundParams = types.NewGasRefundParams(gasRefundRatio)
 
 	bz, err := json.MarshalIndent(&authGenesis.Params, "", " ")
 	if err != nil {
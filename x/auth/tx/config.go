@@ -10,6 +10,7 @@ import (
 	"github.com/cosmos/cosmos-sdk/client"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/x/auth/signing"
+	"github.com/cosmos/cosmos-sdk/x/auth/tx/textual"
 )
 
 type config struct {
@@ -22,11 +23,22 @@ type config struct {
 }
 
 // NewTxConfig returns a new protobuf TxConfig using the provided ProtoCodec and sign modes. The
-// first enabled sign mode will become the default sign mode.
+// first enabled sign mode will become the default sign mode. SIGN_MODE_TEXTUAL renders the coins
+// in their base denom, so its sign bytes differ from the ones of an app rendering them with its
+// denom metadata: use NewTxConfigWithTextual.
 // NOTE: Use NewTxConfigWithHandler to provide a custom signing handler in case the sign mode
-// is not supported by default (eg: SignMode_SIGN_MODE_EIP_191).
+// is not supported by default.
 func NewTxConfig(protoCodec codec.ProtoCodecMarshaler, enabledSignModes []signingtypes.SignMode) client.TxConfig {
-	return NewTxConfigWithHandler(protoCodec, makeSignModeHandler(enabledSignModes))
+	return NewTxConfigWithHandler(protoCodec, makeSignModeHandler(enabledSignModes, nil))
+}
+
+// NewTxConfigWithTextual returns a new protobuf TxConfig like NewTxConfig, with SIGN_MODE_TEXTUAL
+// rendering the coins in their display denom with the metadata returned by coinMetadataQueryFn,
+// see the textual package for the functions querying it from the bank keeper or from a node.
+// The sign bytes of SIGN_MODE_TEXTUAL depend on the denom metadata, an app and its clients must
+// thus render them with the same metadata, i.e. the x/bank metadata of the chain.
+func NewTxConfigWithTextual(protoCodec codec.ProtoCodecMarshaler, enabledSignModes []signingtypes.SignMode, coinMetadataQueryFn textual.CoinMetadataQueryFn) client.TxConfig {
+	return NewTxConfigWithHandler(protoCodec, makeSignModeHandler(enabledSignModes, coinMetadataQueryFn))
 }
 
 // NewTxConfig returns a new protobuf TxConfig using the provided ProtoCodec and signing handler.
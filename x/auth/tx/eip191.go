@@ -0,0 +1,40 @@
+package tx
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	signingtypes "github.com/cosmos/cosmos-sdk/types/tx/signing"
+	"github.com/cosmos/cosmos-sdk/x/auth/signing"
+)
+
+var _ signing.SignModeHandler = signModeEIP191Handler{}
+
+// signModeEIP191Handler defines the SIGN_MODE_EIP_191 SignModeHandler, which
+// wraps the SIGN_MODE_LEGACY_AMINO_JSON sign bytes in the EIP-191 personal_sign
+// envelope, so that they can be signed by Ethereum wallets.
+type signModeEIP191Handler struct{}
+
+// DefaultMode implements SignModeHandler.DefaultMode
+func (signModeEIP191Handler) DefaultMode() signingtypes.SignMode {
+	return signingtypes.SignMode_SIGN_MODE_EIP_191
+}
+
+// Modes implements SignModeHandler.Modes
+func (signModeEIP191Handler) Modes() []signingtypes.SignMode {
+	return []signingtypes.SignMode{signingtypes.SignMode_SIGN_MODE_EIP_191}
+}
+
+// GetSignBytes implements SignModeHandler.GetSignBytes
+func (signModeEIP191Handler) GetSignBytes(mode signingtypes.SignMode, data signing.SignerData, tx sdk.Tx) ([]byte, error) {
+	if mode != signingtypes.SignMode_SIGN_MODE_EIP_191 {
+		return nil, fmt.Errorf("expected %s, got %s", signingtypes.SignMode_SIGN_MODE_EIP_191, mode)
+	}
+
+	aminoJSONBz, err := signModeLegacyAminoJSONHandler{}.GetSignBytes(signingtypes.SignMode_SIGN_MODE_LEGACY_AMINO_JSON, data, tx)
+	if err != nil {
+		return nil, err
+	}
+
+	return signing.EIP191Message(aminoJSONBz), nil
+}
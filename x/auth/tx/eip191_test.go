@@ -0,0 +1,87 @@
+package tx
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/cosmos-sdk/testutil/testdata"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	signingtypes "github.com/cosmos/cosmos-sdk/types/tx/signing"
+	"github.com/cosmos/cosmos-sdk/x/auth/migrations/legacytx"
+	"github.com/cosmos/cosmos-sdk/x/auth/signing"
+)
+
+func TestEIP191Handler_GetSignBytes(t *testing.T) {
+	var (
+		chainId        = "test-chain"
+		accNum  uint64 = 7
+		seqNum  uint64 = 7
+	)
+
+	handler := signModeEIP191Handler{}
+	bldr := newBuilder(nil)
+	buildTx(t, bldr)
+	tx := bldr.GetTx()
+	signingData := signing.SignerData{
+		Address:       addr1.String(),
+		ChainID:       chainId,
+		AccountNumber: accNum,
+		Sequence:      seqNum,
+		PubKey:        pubkey1,
+	}
+
+	signBz, err := handler.GetSignBytes(signingtypes.SignMode_SIGN_MODE_EIP_191, signingData, tx)
+	require.NoError(t, err)
+
+	aminoJSONBz := legacytx.StdSignBytes(chainId, accNum, seqNum, timeout, legacytx.StdFee{Amount: coins, Gas: gas}, []sdk.Msg{msg}, memo, nil)
+	require.Equal(t, signing.EIP191Message(aminoJSONBz), signBz)
+
+	// expect error with wrong sign mode
+	_, err = handler.GetSignBytes(signingtypes.SignMode_SIGN_MODE_LEGACY_AMINO_JSON, signingData, tx)
+	require.Error(t, err)
+
+	// expect error with the errors of SIGN_MODE_LEGACY_AMINO_JSON
+	signingData.Address = ""
+	_, err = handler.GetSignBytes(signingtypes.SignMode_SIGN_MODE_EIP_191, signingData, tx)
+	require.Error(t, err)
+}
+
+func TestEIP191Handler_DefaultMode(t *testing.T) {
+	handler := signModeEIP191Handler{}
+	require.Equal(t, signingtypes.SignMode_SIGN_MODE_EIP_191, handler.DefaultMode())
+}
+
+func TestEIP191Handler_Modes(t *testing.T) {
+	handler := signModeEIP191Handler{}
+	require.Equal(t, []signingtypes.SignMode{signingtypes.SignMode_SIGN_MODE_EIP_191}, handler.Modes())
+}
+
+func TestEIP191Handler_SignVerify(t *testing.T) {
+	priv, pubKey, addr := testdata.KeyTestPubAddr()
+
+	handler := signModeEIP191Handler{}
+	bldr := newBuilder(nil)
+	buildTx(t, bldr)
+	tx := bldr.GetTx()
+	signingData := signing.SignerData{
+		Address:       addr.String(),
+		ChainID:       "test-chain",
+		AccountNumber: 1,
+		Sequence:      2,
+		PubKey:        pubKey,
+	}
+
+	signBz, err := handler.GetSignBytes(signingtypes.SignMode_SIGN_MODE_EIP_191, signingData, tx)
+	require.NoError(t, err)
+	sig, err := signing.SignEIP191(priv, signBz)
+	require.NoError(t, err)
+
+	sigData := &signingtypes.SingleSignatureData{SignMode: signingtypes.SignMode_SIGN_MODE_EIP_191, Signature: sig}
+	modeHandler := signing.NewSignModeHandlerMap(signingtypes.SignMode_SIGN_MODE_EIP_191, []signing.SignModeHandler{handler})
+	require.NoError(t, signing.VerifySignature(pubKey, signingData, sigData, modeHandler, tx))
+
+	// expect error with other signer data
+	signingData.Sequence = 3
+	require.Error(t, signing.VerifySignature(pubKey, signingData, sigData, modeHandler, tx))
+}
@@ -5,6 +5,7 @@ import (
 
 	signingtypes "github.com/cosmos/cosmos-sdk/types/tx/signing"
 	"github.com/cosmos/cosmos-sdk/x/auth/signing"
+	"github.com/cosmos/cosmos-sdk/x/auth/tx/textual"
 )
 
 // DefaultSignModes are the default sign modes enabled for protobuf transactions.
@@ -12,32 +13,41 @@ var DefaultSignModes = []signingtypes.SignMode{
 	signingtypes.SignMode_SIGN_MODE_DIRECT,
 	signingtypes.SignMode_SIGN_MODE_DIRECT_AUX,
 	signingtypes.SignMode_SIGN_MODE_LEGACY_AMINO_JSON,
+	signingtypes.SignMode_SIGN_MODE_TEXTUAL,
+	signingtypes.SignMode_SIGN_MODE_EIP_191,
 }
 
 // makeSignModeHandler returns the default protobuf SignModeHandler supporting
-// SIGN_MODE_DIRECT, SIGN_MODE_DIRECT_AUX and SIGN_MODE_LEGACY_AMINO_JSON.
-func makeSignModeHandler(modes []signingtypes.SignMode) signing.SignModeHandler {
-	if len(modes) < 1 {
-		panic(fmt.Errorf("no sign modes enabled"))
-	}
-
-	handlers := make([]signing.SignModeHandler, len(modes))
+// SIGN_MODE_DIRECT, SIGN_MODE_DIRECT_AUX, SIGN_MODE_LEGACY_AMINO_JSON,
+// SIGN_MODE_TEXTUAL and SIGN_MODE_EIP_191. SIGN_MODE_TEXTUAL renders the coins
+// with the metadata returned by coinMetadataQueryFn, or in their base denom if
+// coinMetadataQueryFn is nil.
+func makeSignModeHandler(modes []signingtypes.SignMode, coinMetadataQueryFn textual.CoinMetadataQueryFn) signing.SignModeHandler {
+	handlers := make([]signing.SignModeHandler, 0, len(modes))
 
-	for i, mode := range modes {
+	for _, mode := range modes {
 		switch mode {
 		case signingtypes.SignMode_SIGN_MODE_DIRECT:
-			handlers[i] = signModeDirectHandler{}
+			handlers = append(handlers, signModeDirectHandler{})
 		case signingtypes.SignMode_SIGN_MODE_LEGACY_AMINO_JSON:
-			handlers[i] = signModeLegacyAminoJSONHandler{}
+			handlers = append(handlers, signModeLegacyAminoJSONHandler{})
 		case signingtypes.SignMode_SIGN_MODE_DIRECT_AUX:
-			handlers[i] = signModeDirectAuxHandler{}
+			handlers = append(handlers, signModeDirectAuxHandler{})
+		case signingtypes.SignMode_SIGN_MODE_TEXTUAL:
+			handlers = append(handlers, signModeTextualHandler{t: textual.NewSignModeHandler(coinMetadataQueryFn)})
+		case signingtypes.SignMode_SIGN_MODE_EIP_191:
+			handlers = append(handlers, signModeEIP191Handler{})
 		default:
 			panic(fmt.Errorf("unsupported sign mode %+v", mode))
 		}
 	}
 
+	if len(handlers) < 1 {
+		panic(fmt.Errorf("no sign modes enabled"))
+	}
+
 	return signing.NewSignModeHandlerMap(
-		modes[0],
+		handlers[0].DefaultMode(),
 		handlers,
 	)
 }
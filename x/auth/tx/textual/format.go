@@ -0,0 +1,182 @@
+package textual
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"strings"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+)
+
+// maxRenderedBytes is the maximum length of the bytes rendered as hex, longer
+// ones are rendered as their hash.
+const maxRenderedBytes = 32
+
+// FormatInteger formats an integer string with a thousands separator, e.g.
+// "1234567" as "1'234'567".
+func FormatInteger(v string) (string, error) {
+	sign := ""
+	if strings.HasPrefix(v, "-") {
+		sign, v = "-", v[1:]
+	}
+
+	if v == "" || strings.Trim(v, "0123456789") != "" {
+		return "", fmt.Errorf("invalid integer: %s", v)
+	}
+
+	// remove the leading zeros, but keep "0"
+	v = strings.TrimLeft(v, "0")
+	if v == "" {
+		return "0", nil
+	}
+
+	var sb strings.Builder
+	sb.WriteString(sign)
+	for i, c := range v {
+		if i > 0 && (len(v)-i)%3 == 0 {
+			sb.WriteByte('\'')
+		}
+		sb.WriteRune(c)
+	}
+
+	return sb.String(), nil
+}
+
+// FormatDecimal formats a decimal string with a thousands separator in its
+// integer part and without the trailing zeros of its fractional part, e.g.
+// "1234.500" as "1'234.5".
+func FormatDecimal(v string) (string, error) {
+	intPart, fracPart, _ := strings.Cut(v, ".")
+	if strings.Trim(fracPart, "0123456789") != "" {
+		return "", fmt.Errorf("invalid decimal: %s", v)
+	}
+
+	intPart, err := FormatInteger(intPart)
+	if err != nil {
+		return "", fmt.Errorf("invalid decimal: %s", v)
+	}
+
+	fracPart = strings.TrimRight(fracPart, "0")
+	if fracPart == "" {
+		if intPart == "-0" {
+			return "0", nil
+		}
+		return intPart, nil
+	}
+
+	return intPart + "." + fracPart, nil
+}
+
+// shiftDecimal divides the decimal string by 10^exp, by moving its decimal
+// point, e.g. "1500" shifted by 3 is "1.500".
+func shiftDecimal(v string, exp int64) string {
+	sign := ""
+	if strings.HasPrefix(v, "-") {
+		sign, v = "-", v[1:]
+	}
+	intPart, fracPart, _ := strings.Cut(v, ".")
+
+	if exp < 0 {
+		// multiply by moving the decimal point to the right
+		for ; exp < 0; exp++ {
+			if fracPart == "" {
+				intPart += "0"
+			} else {
+				intPart, fracPart = intPart+fracPart[:1], fracPart[1:]
+			}
+		}
+		return sign + intPart + "." + fracPart
+	}
+
+	if n := int64(len(intPart)); n <= exp {
+		intPart = strings.Repeat("0", int(exp-n+1)) + intPart
+	}
+	split := int64(len(intPart)) - exp
+
+	return sign + intPart[:split] + "." + intPart[split:] + fracPart
+}
+
+// FormatCoin formats a coin in the display denom of its metadata, e.g.
+// "1500000uatom" as "1.5 ATOM". The coin is formatted in its own denom if the
+// metadata is nil or does not define both denoms.
+func FormatCoin(amount, denom string, metadata *banktypes.Metadata) (string, error) {
+	displayDenom, exp := denom, int64(0)
+
+	if metadata != nil && metadata.Display != "" {
+		var coinExp, displayExp int64
+		var foundCoin, foundDisplay bool
+		for _, unit := range metadata.DenomUnits {
+			if unit.Denom == denom {
+				coinExp, foundCoin = int64(unit.Exponent), true
+			}
+			if unit.Denom == metadata.Display {
+				displayExp, foundDisplay = int64(unit.Exponent), true
+			}
+		}
+
+		if foundCoin && foundDisplay {
+			displayDenom, exp = metadata.Display, displayExp-coinExp
+		}
+	}
+
+	formatted, err := FormatDecimal(shiftDecimal(amount, exp))
+	if err != nil {
+		return "", err
+	}
+
+	return formatted + " " + displayDenom, nil
+}
+
+// formatCoins formats the coins with FormatCoin, querying their metadata with
+// the provided function if any, and joins them with a comma.
+func formatCoins(ctx context.Context, coins []sdk.DecCoin, queryFn CoinMetadataQueryFn) (string, error) {
+	if len(coins) == 0 {
+		return "zero", nil
+	}
+
+	formatted := make([]string, len(coins))
+	for i, coin := range coins {
+		var (
+			metadata *banktypes.Metadata
+			err      error
+		)
+		if queryFn != nil {
+			metadata, err = queryFn(ctx, coin.Denom)
+			if err != nil {
+				return "", err
+			}
+		}
+
+		amount := coin.Amount.String()
+		if coin.Amount.IsInteger() {
+			amount = coin.Amount.TruncateInt().String()
+		}
+
+		formatted[i], err = FormatCoin(amount, coin.Denom, metadata)
+		if err != nil {
+			return "", err
+		}
+	}
+
+	return strings.Join(formatted, ", "), nil
+}
+
+// FormatTimestamp formats a timestamp in UTC with the RFC 3339 format.
+func FormatTimestamp(t time.Time) string {
+	return t.UTC().Format(time.RFC3339Nano)
+}
+
+// FormatBytes formats bytes in uppercase hex, or as the hex of their SHA-256
+// hash if they are longer than 32 bytes.
+func FormatBytes(bz []byte) string {
+	if len(bz) > maxRenderedBytes {
+		hash := sha256.Sum256(bz)
+		return "SHA-256=" + strings.ToUpper(hex.EncodeToString(hash[:]))
+	}
+
+	return strings.ToUpper(hex.EncodeToString(bz))
+}
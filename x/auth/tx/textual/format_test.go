@@ -0,0 +1,83 @@
+package textual_test
+
+import (
+	"encoding/json"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/auth/tx/textual"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+)
+
+func readTestVectors(t *testing.T, file string, v interface{}) {
+	bz, err := os.ReadFile("testdata/" + file)
+	require.NoError(t, err)
+	require.NoError(t, json.Unmarshal(bz, v))
+}
+
+func TestFormatInteger(t *testing.T) {
+	var vectors [][2]string
+	readTestVectors(t, "integers.json", &vectors)
+
+	for _, vector := range vectors {
+		text, err := textual.FormatInteger(vector[0])
+		require.NoError(t, err)
+		require.Equal(t, vector[1], text, vector[0])
+	}
+
+	for _, invalid := range []string{"", "-", "1.5", "1e3", "0x10"} {
+		_, err := textual.FormatInteger(invalid)
+		require.Error(t, err, invalid)
+	}
+}
+
+func TestFormatDecimal(t *testing.T) {
+	var vectors [][2]string
+	readTestVectors(t, "decimals.json", &vectors)
+
+	for _, vector := range vectors {
+		text, err := textual.FormatDecimal(vector[0])
+		require.NoError(t, err)
+		require.Equal(t, vector[1], text, vector[0])
+	}
+
+	for _, invalid := range []string{"", ".5", "1.5.0", "1,5"} {
+		_, err := textual.FormatDecimal(invalid)
+		require.Error(t, err, invalid)
+	}
+}
+
+type coinVector struct {
+	Coin     sdk.Coin            `json:"coin"`
+	Metadata *banktypes.Metadata `json:"metadata"`
+	Text     string              `json:"text"`
+}
+
+func TestFormatCoin(t *testing.T) {
+	var vectors []coinVector
+	readTestVectors(t, "coins.json", &vectors)
+
+	for _, vector := range vectors {
+		text, err := textual.FormatCoin(vector.Coin.Amount.String(), vector.Coin.Denom, vector.Metadata)
+		require.NoError(t, err)
+		require.Equal(t, vector.Text, text, vector.Coin.String())
+	}
+}
+
+func TestFormatTimestamp(t *testing.T) {
+	ts := time.Date(2022, 8, 5, 13, 4, 15, 120000000, time.FixedZone("KST", 9*60*60))
+	require.Equal(t, "2022-08-05T04:04:15.12Z", textual.FormatTimestamp(ts))
+}
+
+func TestFormatBytes(t *testing.T) {
+	require.Equal(t, "", textual.FormatBytes(nil))
+	require.Equal(t, "00FF10", textual.FormatBytes([]byte{0x00, 0xff, 0x10}))
+	require.Equal(t,
+		"SHA-256=7F9C9E31AC8256CA2F258583DF262DBC7D6F68F2A03043D5C99A4AE5A7396CE9",
+		textual.FormatBytes(make([]byte, 33)),
+	)
+}
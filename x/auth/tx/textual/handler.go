@@ -0,0 +1,152 @@
+package textual
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/binary"
+	"fmt"
+	"reflect"
+
+	"github.com/cosmos/cosmos-sdk/types/tx"
+	"github.com/cosmos/cosmos-sdk/x/auth/signing"
+)
+
+// TxData is the transaction data rendered by SIGN_MODE_TEXTUAL.
+type TxData struct {
+	Body          *tx.TxBody
+	AuthInfo      *tx.AuthInfo
+	BodyBytes     []byte
+	AuthInfoBytes []byte
+}
+
+// SignModeHandler renders transactions into human readable screens, whose
+// hash are the sign bytes of SIGN_MODE_TEXTUAL.
+type SignModeHandler struct {
+	coinMetadataQueryFn CoinMetadataQueryFn
+}
+
+// NewSignModeHandler returns a SignModeHandler rendering the coins with the
+// metadata returned by the provided function. The coins are rendered in their
+// base denom if the function is nil.
+func NewSignModeHandler(coinMetadataQueryFn CoinMetadataQueryFn) SignModeHandler {
+	return SignModeHandler{coinMetadataQueryFn: coinMetadataQueryFn}
+}
+
+// GetSignBytes returns the hash of the screens rendering the transaction.
+func (h SignModeHandler) GetSignBytes(ctx context.Context, data signing.SignerData, txData TxData) ([]byte, error) {
+	screens, err := h.GetScreens(ctx, data, txData)
+	if err != nil {
+		return nil, err
+	}
+
+	return HashScreens(screens), nil
+}
+
+// GetScreens renders the transaction into screens. The screens start with the
+// signer data and the messages, followed by the other body and auth info
+// fields, and end with the hash of the raw transaction bytes, which binds the
+// signature to the exact encoding of the transaction.
+func (h SignModeHandler) GetScreens(ctx context.Context, data signing.SignerData, txData TxData) ([]Screen, error) {
+	body, authInfo := txData.Body, txData.AuthInfo
+	if body == nil || authInfo == nil {
+		return nil, fmt.Errorf("cannot render a transaction without body or auth info")
+	}
+
+	r := valueRenderer{ctx: ctx, queryFn: h.coinMetadataQueryFn}
+	var screens []Screen
+	add := func(text string, expert bool) {
+		screens = append(screens, Screen{Text: text, Expert: expert})
+	}
+
+	add("Chain id: "+data.ChainID, false)
+	add(fmt.Sprintf("Account number: %d", data.AccountNumber), false)
+	add(fmt.Sprintf("Sequence: %d", data.Sequence), false)
+	add("Address: "+data.Address, false)
+
+	n := len(body.Messages)
+	add(fmt.Sprintf("This transaction has %d %s", n, pluralize("Message", n)), false)
+	for i, msg := range body.Messages {
+		text, nested, err := r.any(msg, 1, false)
+		if err != nil {
+			return nil, err
+		}
+		add(fmt.Sprintf("Message (%d/%d): %s", i+1, n, text), false)
+		screens = append(screens, nested...)
+	}
+	add("End of Messages", false)
+
+	if body.Memo != "" {
+		add("Memo: "+body.Memo, false)
+	}
+
+	if fee := authInfo.Fee; fee != nil {
+		fees, err := r.coins(decCoins(fee.Amount))
+		if err != nil {
+			return nil, err
+		}
+		add("Fees: "+fees, false)
+		if fee.Payer != "" {
+			add("Fee payer: "+fee.Payer, true)
+		}
+		if fee.Granter != "" {
+			add("Fee granter: "+fee.Granter, true)
+		}
+		gasLimit, err := FormatInteger(fmt.Sprint(fee.GasLimit))
+		if err != nil {
+			return nil, err
+		}
+		add("Gas limit: "+gasLimit, true)
+	}
+
+	if tip := authInfo.Tip; tip != nil {
+		amount, err := r.coins(decCoins(tip.Amount))
+		if err != nil {
+			return nil, err
+		}
+		add("Tip: "+amount, false)
+		add("Tipper: "+tip.Tipper, true)
+	}
+
+	if body.TimeoutHeight != 0 {
+		add(fmt.Sprintf("Timeout height: %d", body.TimeoutHeight), true)
+	}
+
+	if body.TimeoutTimestamp != nil {
+		add("Timeout timestamp: "+FormatTimestamp(*body.TimeoutTimestamp), true)
+	}
+
+	if body.Unordered {
+		add("Unordered: True", true)
+	}
+
+	if len(body.ExtensionOptions) > 0 {
+		optScreens, err := r.field("Extension options", reflect.ValueOf(body.ExtensionOptions), 0, true)
+		if err != nil {
+			return nil, err
+		}
+		screens = append(screens, optScreens...)
+	}
+
+	if len(body.NonCriticalExtensionOptions) > 0 {
+		optScreens, err := r.field("Non critical extension options", reflect.ValueOf(body.NonCriticalExtensionOptions), 0, true)
+		if err != nil {
+			return nil, err
+		}
+		screens = append(screens, optScreens...)
+	}
+
+	add("Hash of raw bytes: "+FormatBytes(rawBytesHash(txData.BodyBytes, txData.AuthInfoBytes)), true)
+
+	return screens, nil
+}
+
+// rawBytesHash returns the SHA-256 hash of the body bytes, prefixed with their
+// uvarint length, concatenated with the auth info bytes.
+func rawBytesHash(bodyBytes, authInfoBytes []byte) []byte {
+	bz := binary.AppendUvarint(nil, uint64(len(bodyBytes)))
+	bz = append(bz, bodyBytes...)
+	bz = append(bz, authInfoBytes...)
+	hash := sha256.Sum256(bz)
+
+	return hash[:]
+}
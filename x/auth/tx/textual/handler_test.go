@@ -0,0 +1,80 @@
+package textual_test
+
+import (
+	"context"
+	"encoding/hex"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/cosmos-sdk/simapp"
+	"github.com/cosmos/cosmos-sdk/types/tx"
+	"github.com/cosmos/cosmos-sdk/x/auth/signing"
+	"github.com/cosmos/cosmos-sdk/x/auth/tx/textual"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+)
+
+type txVector struct {
+	Name          string               `json:"name"`
+	ChainID       string               `json:"chain_id"`
+	AccountNumber uint64               `json:"account_number"`
+	Sequence      uint64               `json:"sequence"`
+	Address       string               `json:"address"`
+	Metadata      []banktypes.Metadata `json:"metadata"`
+	BodyBytes     []byte               `json:"body_bytes"`
+	AuthInfoBytes []byte               `json:"auth_info_bytes"`
+	Screens       []textual.Screen     `json:"screens"`
+	SignBytes     string               `json:"sign_bytes"`
+}
+
+func metadataQueryFn(metadata []banktypes.Metadata) textual.CoinMetadataQueryFn {
+	return func(_ context.Context, denom string) (*banktypes.Metadata, error) {
+		for _, m := range metadata {
+			for _, unit := range m.DenomUnits {
+				if unit.Denom == denom {
+					return &m, nil
+				}
+			}
+		}
+		return nil, nil
+	}
+}
+
+func TestSignModeHandler(t *testing.T) {
+	var vectors []txVector
+	readTestVectors(t, "tx.json", &vectors)
+
+	cdc := simapp.MakeTestEncodingConfig().Codec
+	for _, vector := range vectors {
+		t.Run(vector.Name, func(t *testing.T) {
+			var txData textual.TxData
+			txData.Body, txData.AuthInfo = &tx.TxBody{}, &tx.AuthInfo{}
+			require.NoError(t, cdc.Unmarshal(vector.BodyBytes, txData.Body))
+			require.NoError(t, cdc.Unmarshal(vector.AuthInfoBytes, txData.AuthInfo))
+			txData.BodyBytes, txData.AuthInfoBytes = vector.BodyBytes, vector.AuthInfoBytes
+
+			signerData := signing.SignerData{
+				Address:       vector.Address,
+				ChainID:       vector.ChainID,
+				AccountNumber: vector.AccountNumber,
+				Sequence:      vector.Sequence,
+			}
+
+			handler := textual.NewSignModeHandler(metadataQueryFn(vector.Metadata))
+			screens, err := handler.GetScreens(context.Background(), signerData, txData)
+			require.NoError(t, err)
+			require.Equal(t, vector.Screens, screens)
+
+			signBytes, err := handler.GetSignBytes(context.Background(), signerData, txData)
+			require.NoError(t, err)
+			require.Equal(t, vector.SignBytes, hex.EncodeToString(signBytes))
+			require.Equal(t, signBytes, textual.HashScreens(screens))
+		})
+	}
+}
+
+func TestSignModeHandlerInvalidTx(t *testing.T) {
+	handler := textual.NewSignModeHandler(nil)
+	_, err := handler.GetScreens(context.Background(), signing.SignerData{}, textual.TxData{Body: &tx.TxBody{}})
+	require.Error(t, err)
+}
@@ -0,0 +1,60 @@
+package textual
+
+import (
+	"context"
+
+	gogogrpc "github.com/gogo/protobuf/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+)
+
+// CoinMetadataQueryFn returns the bank metadata of a denom, used to render
+// coins in their display denom. It returns a nil metadata, and no error, if the
+// denom has no metadata.
+type CoinMetadataQueryFn func(ctx context.Context, denom string) (*banktypes.Metadata, error)
+
+// DenomMetadataKeeper defines the x/bank keeper methods used to query the denom
+// metadata on chain.
+type DenomMetadataKeeper interface {
+	GetDenomMetaData(ctx sdk.Context, denom string) (banktypes.Metadata, bool)
+}
+
+// NewBankKeeperCoinMetadataQueryFn returns a CoinMetadataQueryFn querying the
+// denom metadata from the bank keeper, which requires the context to wrap an
+// sdk.Context. It is meant to be used by the ante handler.
+func NewBankKeeperCoinMetadataQueryFn(bk DenomMetadataKeeper) CoinMetadataQueryFn {
+	return func(ctx context.Context, denom string) (*banktypes.Metadata, error) {
+		sdkCtx, ok := ctx.Value(sdk.SdkContextKey).(sdk.Context)
+		if !ok {
+			return nil, sdkerrors.Wrap(sdkerrors.ErrLogic, "querying denom metadata from the bank keeper requires an sdk.Context")
+		}
+
+		metadata, found := bk.GetDenomMetaData(sdkCtx, denom)
+		if !found {
+			return nil, nil
+		}
+
+		return &metadata, nil
+	}
+}
+
+// NewGRPCCoinMetadataQueryFn returns a CoinMetadataQueryFn querying the denom
+// metadata from a node with the x/bank gRPC query service. It is meant to be
+// used by clients.
+func NewGRPCCoinMetadataQueryFn(conn gogogrpc.ClientConn) CoinMetadataQueryFn {
+	return func(ctx context.Context, denom string) (*banktypes.Metadata, error) {
+		res, err := banktypes.NewQueryClient(conn).DenomMetadata(ctx, &banktypes.QueryDenomMetadataRequest{Denom: denom})
+		if status.Code(err) == codes.NotFound {
+			return nil, nil
+		}
+		if err != nil {
+			return nil, err
+		}
+
+		return &res.Metadata, nil
+	}
+}
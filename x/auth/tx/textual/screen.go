@@ -0,0 +1,45 @@
+package textual
+
+import (
+	"crypto/sha256"
+	"encoding/binary"
+)
+
+// Screen is a line of the textual representation of a transaction, as
+// displayed on a hardware wallet.
+type Screen struct {
+	// Text is the content of the screen.
+	Text string `json:"text"`
+
+	// Indent is the indentation level of the screen, for nested values.
+	Indent int `json:"indent,omitempty"`
+
+	// Expert tells if the screen is only displayed in the expert mode of the
+	// wallet.
+	Expert bool `json:"expert,omitempty"`
+}
+
+// EncodeScreens returns the deterministic binary encoding of the screens,
+// concatenating for each screen its indentation and expert flag as a byte each,
+// and its text prefixed with its uvarint length.
+func EncodeScreens(screens []Screen) []byte {
+	var bz []byte
+	for _, screen := range screens {
+		expert := byte(0)
+		if screen.Expert {
+			expert = 1
+		}
+		bz = append(bz, byte(screen.Indent), expert)
+		bz = binary.AppendUvarint(bz, uint64(len(screen.Text)))
+		bz = append(bz, screen.Text...)
+	}
+
+	return bz
+}
+
+// HashScreens returns the SHA-256 hash of the encoding of the screens, which
+// are the sign bytes of SIGN_MODE_TEXTUAL.
+func HashScreens(screens []Screen) []byte {
+	hash := sha256.Sum256(EncodeScreens(screens))
+	return hash[:]
+}
@@ -0,0 +1,337 @@
+package textual
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/gogo/protobuf/proto"
+	gogotypes "github.com/gogo/protobuf/types"
+
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+var (
+	coinType     = reflect.TypeOf(sdk.Coin{})
+	coinsType    = reflect.TypeOf(sdk.Coins{})
+	decCoinType  = reflect.TypeOf(sdk.DecCoin{})
+	decCoinsType = reflect.TypeOf(sdk.DecCoins{})
+	intType      = reflect.TypeOf(sdk.Int{})
+	uintType     = reflect.TypeOf(sdk.Uint{})
+	decType      = reflect.TypeOf(sdk.Dec{})
+	timeType     = reflect.TypeOf(time.Time{})
+	durationType = reflect.TypeOf(time.Duration(0))
+	anyType      = reflect.TypeOf(codectypes.Any{})
+	bytesType    = reflect.TypeOf([]byte(nil))
+	stringerType = reflect.TypeOf((*fmt.Stringer)(nil)).Elem()
+
+	gogoTimestampType = reflect.TypeOf(gogotypes.Timestamp{})
+	gogoDurationType  = reflect.TypeOf(gogotypes.Duration{})
+)
+
+// valueRenderer renders protobuf messages into screens.
+type valueRenderer struct {
+	ctx     context.Context
+	queryFn CoinMetadataQueryFn
+}
+
+// RenderMessage renders the non-empty fields of a protobuf message as screens
+// at the given indentation, one screen per scalar field followed by the
+// screens of its nested values.
+func RenderMessage(ctx context.Context, msg proto.Message, indent int, expert bool, queryFn CoinMetadataQueryFn) ([]Screen, error) {
+	r := valueRenderer{ctx: ctx, queryFn: queryFn}
+	return r.message(reflect.ValueOf(msg), indent, expert)
+}
+
+// message renders the fields of a struct, or of a pointer to a struct.
+func (r valueRenderer) message(v reflect.Value, indent int, expert bool) ([]Screen, error) {
+	for v.Kind() == reflect.Ptr {
+		if v.IsNil() {
+			return nil, nil
+		}
+		v = v.Elem()
+	}
+
+	if v.Kind() != reflect.Struct {
+		return nil, fmt.Errorf("cannot render %s as a message", v.Type())
+	}
+
+	var screens []Screen
+	for i := 0; i < v.NumField(); i++ {
+		field, fv := v.Type().Field(i), v.Field(i)
+		if !field.IsExported() || strings.HasPrefix(field.Name, "XXX_") || fv.IsZero() {
+			continue
+		}
+
+		// a oneof field holds a wrapper struct with a single field
+		if _, ok := field.Tag.Lookup("protobuf_oneof"); ok {
+			fv = fv.Elem().Elem()
+			field, fv = fv.Type().Field(0), fv.Field(0)
+		}
+
+		// embedded messages are rendered inline
+		if field.Anonymous {
+			nested, err := r.message(fv, indent, expert)
+			if err != nil {
+				return nil, err
+			}
+			screens = append(screens, nested...)
+			continue
+		}
+
+		fieldScreens, err := r.field(fieldLabel(field), fv, indent, expert)
+		if err != nil {
+			return nil, err
+		}
+		screens = append(screens, fieldScreens...)
+	}
+
+	return screens, nil
+}
+
+// field renders a field of a message with the given label.
+func (r valueRenderer) field(label string, v reflect.Value, indent int, expert bool) ([]Screen, error) {
+	// repeated fields are rendered element by element, except for the types
+	// with their own rendering
+	isRepeated := (v.Kind() == reflect.Slice && v.Type() != bytesType && !isScalarSlice(v.Type())) || v.Kind() == reflect.Map
+	if !isRepeated {
+		text, nested, err := r.value(v, indent+1, expert)
+		if err != nil {
+			return nil, err
+		}
+		return append([]Screen{{Text: label + ": " + text, Indent: indent, Expert: expert}}, nested...), nil
+	}
+
+	n := v.Len()
+	screens := []Screen{{Text: fmt.Sprintf("%s: %d %s", label, n, pluralize("element", n)), Indent: indent, Expert: expert}}
+
+	elements := make([]reflect.Value, 0, n)
+	labels := make([]string, 0, n)
+	if v.Kind() == reflect.Map {
+		keys := v.MapKeys()
+		sort.Slice(keys, func(i, j int) bool { return fmt.Sprint(keys[i]) < fmt.Sprint(keys[j]) })
+		for _, key := range keys {
+			elements = append(elements, v.MapIndex(key))
+			labels = append(labels, fmt.Sprintf("%s (%v)", label, key))
+		}
+	} else {
+		for i := 0; i < n; i++ {
+			elements = append(elements, v.Index(i))
+			labels = append(labels, fmt.Sprintf("%s (%d/%d)", label, i+1, n))
+		}
+	}
+
+	for i, element := range elements {
+		text, nested, err := r.value(element, indent+1, expert)
+		if err != nil {
+			return nil, err
+		}
+		screens = append(screens, Screen{Text: labels[i] + ": " + text, Indent: indent, Expert: expert})
+		screens = append(screens, nested...)
+	}
+
+	return append(screens, Screen{Text: "End of " + label, Indent: indent, Expert: expert}), nil
+}
+
+// value renders a single value, returning its text and the screens of its
+// nested values, at the given indentation.
+func (r valueRenderer) value(v reflect.Value, indent int, expert bool) (string, []Screen, error) {
+	if v.Kind() == reflect.Interface || v.Kind() == reflect.Ptr {
+		if v.IsNil() {
+			return "nil", nil, nil
+		}
+		// only dereference the pointers to the types rendered as scalars, the
+		// other pointers are kept to look up their proto name
+		if v.Kind() == reflect.Interface || isScalarType(v.Type().Elem()) {
+			return r.value(v.Elem(), indent, expert)
+		}
+	}
+
+	switch t := v.Type(); t {
+	case coinType:
+		text, err := r.coins(sdk.DecCoins{decCoin(v.Interface().(sdk.Coin))})
+		return text, nil, err
+
+	case coinsType:
+		text, err := r.coins(decCoins(v.Interface().(sdk.Coins)))
+		return text, nil, err
+
+	case decCoinType:
+		text, err := r.coins(sdk.DecCoins{v.Interface().(sdk.DecCoin)})
+		return text, nil, err
+
+	case decCoinsType:
+		text, err := r.coins(v.Interface().(sdk.DecCoins))
+		return text, nil, err
+
+	case intType, uintType:
+		text, err := FormatInteger(fmt.Sprint(v.Interface()))
+		return text, nil, err
+
+	case decType:
+		dec := v.Interface().(sdk.Dec)
+		if dec.IsNil() {
+			return "0", nil, nil
+		}
+		text, err := FormatDecimal(dec.String())
+		return text, nil, err
+
+	case timeType:
+		return FormatTimestamp(v.Interface().(time.Time)), nil, nil
+
+	case durationType:
+		return v.Interface().(time.Duration).String(), nil, nil
+
+	case gogoTimestampType:
+		ts := v.Interface().(gogotypes.Timestamp)
+		t, err := gogotypes.TimestampFromProto(&ts)
+		return FormatTimestamp(t), nil, err
+
+	case gogoDurationType:
+		d := v.Interface().(gogotypes.Duration)
+		duration, err := gogotypes.DurationFromProto(&d)
+		return duration.String(), nil, err
+
+	case anyType:
+		any := v.Interface().(codectypes.Any)
+		return r.any(&any, indent, expert)
+
+	case reflect.PtrTo(anyType):
+		return r.any(v.Interface().(*codectypes.Any), indent, expert)
+
+	case bytesType:
+		return FormatBytes(v.Bytes()), nil, nil
+	}
+
+	// addresses and other named bytes are rendered with their own format
+	if v.Kind() == reflect.Slice && v.Type().Elem().Kind() == reflect.Uint8 {
+		if v.Type().Implements(stringerType) {
+			return v.Interface().(fmt.Stringer).String(), nil, nil
+		}
+		return FormatBytes(v.Bytes()), nil, nil
+	}
+
+	switch v.Kind() {
+	case reflect.String:
+		return v.String(), nil, nil
+
+	case reflect.Bool:
+		if v.Bool() {
+			return "True", nil, nil
+		}
+		return "False", nil, nil
+
+	case reflect.Int32:
+		// enums are rendered with their name
+		if v.Type().Implements(stringerType) {
+			return v.Interface().(fmt.Stringer).String(), nil, nil
+		}
+		text, err := FormatInteger(fmt.Sprint(v.Interface()))
+		return text, nil, err
+
+	case reflect.Int, reflect.Int64, reflect.Uint32, reflect.Uint64:
+		text, err := FormatInteger(fmt.Sprint(v.Interface()))
+		return text, nil, err
+
+	case reflect.Float32, reflect.Float64:
+		return fmt.Sprint(v.Interface()), nil, nil
+
+	case reflect.Struct, reflect.Ptr:
+		nested, err := r.message(v, indent, expert)
+		return messageName(v) + " object", nested, err
+	}
+
+	return "", nil, fmt.Errorf("cannot render a value of type %s", v.Type())
+}
+
+// any renders a packed message as its type URL, followed by the screens of the
+// message.
+func (r valueRenderer) any(any *codectypes.Any, indent int, expert bool) (string, []Screen, error) {
+	msg, ok := any.GetCachedValue().(proto.Message)
+	if !ok {
+		return any.TypeUrl, []Screen{{Text: "Value: " + FormatBytes(any.Value), Indent: indent, Expert: expert}}, nil
+	}
+
+	nested, err := r.message(reflect.ValueOf(msg), indent, expert)
+	return any.TypeUrl, nested, err
+}
+
+// coins renders the coins in their display denom.
+func (r valueRenderer) coins(coins sdk.DecCoins) (string, error) {
+	return formatCoins(r.ctx, coins, r.queryFn)
+}
+
+func decCoin(coin sdk.Coin) sdk.DecCoin {
+	if coin.Amount.IsNil() {
+		return sdk.DecCoin{Denom: coin.Denom, Amount: sdk.ZeroDec()}
+	}
+
+	return sdk.DecCoin{Denom: coin.Denom, Amount: sdk.NewDecFromInt(coin.Amount)}
+}
+
+func decCoins(coins sdk.Coins) sdk.DecCoins {
+	decCoins := make(sdk.DecCoins, len(coins))
+	for i, coin := range coins {
+		decCoins[i] = decCoin(coin)
+	}
+
+	return decCoins
+}
+
+// isScalarType tells if the type is rendered as a single screen.
+func isScalarType(t reflect.Type) bool {
+	switch t {
+	case coinType, decCoinType, intType, uintType, decType, timeType, durationType, gogoTimestampType, gogoDurationType:
+		return true
+	}
+
+	return t.Kind() != reflect.Struct
+}
+
+// isScalarSlice tells if the slice type is rendered as a single screen.
+func isScalarSlice(t reflect.Type) bool {
+	return t == coinsType || t == decCoinsType || t.Elem().Kind() == reflect.Uint8
+}
+
+// messageName returns the protobuf name of a message value.
+func messageName(v reflect.Value) string {
+	if v.Kind() != reflect.Ptr {
+		ptr := reflect.New(v.Type())
+		ptr.Elem().Set(v)
+		v = ptr
+	}
+
+	if msg, ok := v.Interface().(proto.Message); ok {
+		if name := proto.MessageName(msg); name != "" {
+			return name
+		}
+	}
+
+	return v.Type().Elem().Name()
+}
+
+// fieldLabel returns the human readable label of a message field, from its
+// protobuf name, e.g. "from_address" is labelled "From address".
+func fieldLabel(field reflect.StructField) string {
+	name := field.Name
+	for _, part := range strings.Split(field.Tag.Get("protobuf"), ",") {
+		if strings.HasPrefix(part, "name=") {
+			name = strings.TrimPrefix(part, "name=")
+		}
+	}
+
+	name = strings.ReplaceAll(name, "_", " ")
+	return strings.ToUpper(name[:1]) + name[1:]
+}
+
+func pluralize(word string, n int) string {
+	if n == 1 {
+		return word
+	}
+
+	return word + "s"
+}
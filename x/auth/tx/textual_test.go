@@ -0,0 +1,158 @@
+package tx
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	"github.com/cosmos/cosmos-sdk/testutil/testdata"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	signingtypes "github.com/cosmos/cosmos-sdk/types/tx/signing"
+	"github.com/cosmos/cosmos-sdk/x/auth/signing"
+	"github.com/cosmos/cosmos-sdk/x/auth/tx/textual"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+)
+
+func TestTextualHandler(t *testing.T) {
+	privKey, pubkey, addr := testdata.KeyTestPubAddr()
+	interfaceRegistry := codectypes.NewInterfaceRegistry()
+	interfaceRegistry.RegisterImplementations((*sdk.Msg)(nil), &testdata.TestMsg{})
+	marshaler := codec.NewProtoCodec(interfaceRegistry)
+
+	metadata := banktypes.Metadata{
+		Base:       "uatom",
+		Display:    "atom",
+		DenomUnits: []*banktypes.DenomUnit{{Denom: "uatom"}, {Denom: "atom", Exponent: 6}},
+	}
+	queryFn := func(_ context.Context, denom string) (*banktypes.Metadata, error) {
+		if denom == metadata.Base {
+			return &metadata, nil
+		}
+		return nil, nil
+	}
+
+	txConfig := NewTxConfigWithTextual(marshaler, []signingtypes.SignMode{signingtypes.SignMode_SIGN_MODE_TEXTUAL}, queryFn)
+	txBuilder := txConfig.NewTxBuilder()
+
+	require.NoError(t, txBuilder.SetMsgs(testdata.NewTestMsg(addr)))
+	txBuilder.SetMemo("sometestmemo")
+	txBuilder.SetFeeAmount(sdk.NewCoins(sdk.NewInt64Coin("uatom", 150)))
+	txBuilder.SetGasLimit(20000)
+
+	sigData := &signingtypes.SingleSignatureData{SignMode: signingtypes.SignMode_SIGN_MODE_TEXTUAL}
+	sig := signingtypes.SignatureV2{PubKey: pubkey, Data: sigData, Sequence: 2}
+	require.NoError(t, txBuilder.SetSignatures(sig))
+
+	signingData := signing.SignerData{
+		Address:       addr.String(),
+		ChainID:       "test-chain",
+		AccountNumber: 1,
+		Sequence:      2,
+		PubKey:        pubkey,
+	}
+
+	modeHandler := txConfig.SignModeHandler()
+	require.Equal(t, signingtypes.SignMode_SIGN_MODE_TEXTUAL, modeHandler.DefaultMode())
+
+	signBytes, err := modeHandler.GetSignBytes(signingtypes.SignMode_SIGN_MODE_TEXTUAL, signingData, txBuilder.GetTx())
+	require.NoError(t, err)
+
+	t.Log("verify the sign bytes are the hash of the screens")
+	w := txBuilder.(*wrapper)
+	screens, err := textual.NewSignModeHandler(queryFn).GetScreens(context.Background(), signingData, textual.TxData{
+		Body:          w.tx.Body,
+		AuthInfo:      w.tx.AuthInfo,
+		BodyBytes:     w.getBodyBytes(),
+		AuthInfoBytes: w.getAuthInfoBytes(),
+	})
+	require.NoError(t, err)
+	require.Equal(t, textual.HashScreens(screens), signBytes)
+	require.Contains(t, screens, textual.Screen{Text: "Fees: 0.00015 atom"})
+	require.Contains(t, screens, textual.Screen{Text: "Memo: sometestmemo"})
+
+	t.Log("verify the signature with the context of the handler")
+	sigData.Signature, err = privKey.Sign(signBytes)
+	require.NoError(t, err)
+	require.NoError(t, txBuilder.SetSignatures(sig))
+	require.NoError(t, signing.VerifySignatureWithContext(context.Background(), pubkey, signingData, sigData, modeHandler, txBuilder.GetTx()))
+
+	t.Log("verify the sign bytes change with the tx")
+	txBuilder.SetMemo("othermemo")
+	newSignBytes, err := modeHandler.GetSignBytes(signingtypes.SignMode_SIGN_MODE_TEXTUAL, signingData, txBuilder.GetTx())
+	require.NoError(t, err)
+	require.NotEqual(t, signBytes, newSignBytes)
+}
+
+func TestTxConfigWithoutTextual(t *testing.T) {
+	privKey, pubkey, addr := testdata.KeyTestPubAddr()
+	interfaceRegistry := codectypes.NewInterfaceRegistry()
+	interfaceRegistry.RegisterImplementations((*sdk.Msg)(nil), &testdata.TestMsg{})
+	marshaler := codec.NewProtoCodec(interfaceRegistry)
+
+	// without the denom metadata, SIGN_MODE_TEXTUAL renders the base denoms
+	txConfig := NewTxConfig(marshaler, DefaultSignModes)
+	require.ElementsMatch(t, DefaultSignModes, txConfig.SignModeHandler().Modes())
+
+	txBuilder := txConfig.NewTxBuilder()
+	require.NoError(t, txBuilder.SetMsgs(testdata.NewTestMsg(addr)))
+	txBuilder.SetFeeAmount(sdk.NewCoins(sdk.NewInt64Coin("uatom", 150)))
+	txBuilder.SetGasLimit(20000)
+
+	sigData := &signingtypes.SingleSignatureData{SignMode: signingtypes.SignMode_SIGN_MODE_TEXTUAL}
+	sig := signingtypes.SignatureV2{PubKey: pubkey, Data: sigData}
+	require.NoError(t, txBuilder.SetSignatures(sig))
+
+	signingData := signing.SignerData{Address: addr.String(), ChainID: "test-chain", PubKey: pubkey}
+	signBytes, err := txConfig.SignModeHandler().GetSignBytes(signingtypes.SignMode_SIGN_MODE_TEXTUAL, signingData, txBuilder.GetTx())
+	require.NoError(t, err)
+
+	w := txBuilder.(*wrapper)
+	screens, err := textual.NewSignModeHandler(nil).GetScreens(context.Background(), signingData, textual.TxData{
+		Body:          w.tx.Body,
+		AuthInfo:      w.tx.AuthInfo,
+		BodyBytes:     w.getBodyBytes(),
+		AuthInfoBytes: w.getAuthInfoBytes(),
+	})
+	require.NoError(t, err)
+	require.Equal(t, textual.HashScreens(screens), signBytes)
+	require.Contains(t, screens, textual.Screen{Text: "Fees: 150 uatom"})
+
+	sigData.Signature, err = privKey.Sign(signBytes)
+	require.NoError(t, err)
+	require.NoError(t, signing.VerifySignature(pubkey, signingData, sigData, txConfig.SignModeHandler(), txBuilder.GetTx()))
+
+	require.Equal(t, signingtypes.SignMode_SIGN_MODE_TEXTUAL, NewTxConfig(marshaler, []signingtypes.SignMode{signingtypes.SignMode_SIGN_MODE_TEXTUAL}).SignModeHandler().DefaultMode())
+}
+
+func TestTextualModeHandler_nonTEXTUAL_MODE(t *testing.T) {
+	invalidModes := []signingtypes.SignMode{
+		signingtypes.SignMode_SIGN_MODE_DIRECT,
+		signingtypes.SignMode_SIGN_MODE_DIRECT_AUX,
+		signingtypes.SignMode_SIGN_MODE_LEGACY_AMINO_JSON,
+		signingtypes.SignMode_SIGN_MODE_UNSPECIFIED,
+	}
+	for _, invalidMode := range invalidModes {
+		t.Run(invalidMode.String(), func(t *testing.T) {
+			var th signModeTextualHandler
+			var signingData signing.SignerData
+			_, err := th.GetSignBytes(invalidMode, signingData, nil)
+			require.Error(t, err)
+			wantErr := fmt.Errorf("expected %s, got %s", signingtypes.SignMode_SIGN_MODE_TEXTUAL, invalidMode)
+			require.Equal(t, err, wantErr)
+		})
+	}
+}
+
+func TestTextualModeHandler_nonProtoTx(t *testing.T) {
+	var th signModeTextualHandler
+	var signingData signing.SignerData
+	tx := new(nonProtoTx)
+	_, err := th.GetSignBytes(signingtypes.SignMode_SIGN_MODE_TEXTUAL, signingData, tx)
+	require.Error(t, err)
+	wantErr := fmt.Errorf("can only handle a protobuf Tx, got %T", tx)
+	require.Equal(t, err, wantErr)
+}
@@ -7,17 +7,23 @@ import (
 	fmt "fmt"
 	_ "github.com/cosmos/cosmos-proto"
 	types "github.com/cosmos/cosmos-sdk/codec/types"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
 	_ "github.com/gogo/protobuf/gogoproto"
 	proto "github.com/gogo/protobuf/proto"
+	github_com_gogo_protobuf_types "github.com/gogo/protobuf/types"
+	_ "google.golang.org/protobuf/types/known/durationpb"
+	_ "google.golang.org/protobuf/types/known/timestamppb"
 	io "io"
 	math "math"
 	math_bits "math/bits"
+	time "time"
 )
 
 // Reference imports to suppress errors if they are not otherwise used.
 var _ = proto.Marshal
 var _ = fmt.Errorf
 var _ = math.Inf
+var _ = time.Kitchen
 
 // This is a compile-time assertion to ensure that this generated file
 // is compatible with the proto package it is being compiled against.
@@ -182,55 +188,291 @@ func (m *Params) GetSigVerifyCostSecp256k1() uint64 {
 	return 0
 }
 
+// PubKeyChangeParams defines the parameters of MsgChangePubKey. They are kept
+// out of Params, which are read by the ante handler of every tx, and are only
+// read when processing a MsgChangePubKey.
+type PubKeyChangeParams struct {
+	// cooldown is the minimum duration between two changes of the public key of
+	// the same account.
+	Cooldown time.Duration `protobuf:"bytes,1,opt,name=cooldown,proto3,stdduration" json:"cooldown"`
+	// cost is the gas consumed by a MsgChangePubKey.
+	Cost uint64 `protobuf:"varint,2,opt,name=cost,proto3" json:"cost,omitempty"`
+}
+
+func (m *PubKeyChangeParams) Reset()      { *m = PubKeyChangeParams{} }
+func (*PubKeyChangeParams) ProtoMessage() {}
+func (*PubKeyChangeParams) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7e1f7e915d020d2d, []int{3}
+}
+func (m *PubKeyChangeParams) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PubKeyChangeParams) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PubKeyChangeParams.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *PubKeyChangeParams) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PubKeyChangeParams.Merge(m, src)
+}
+func (m *PubKeyChangeParams) XXX_Size() int {
+	return m.Size()
+}
+func (m *PubKeyChangeParams) XXX_DiscardUnknown() {
+	xxx_messageInfo_PubKeyChangeParams.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PubKeyChangeParams proto.InternalMessageInfo
+
+func (m *PubKeyChangeParams) GetCooldown() time.Duration {
+	if m != nil {
+		return m.Cooldown
+	}
+	return 0
+}
+
+func (m *PubKeyChangeParams) GetCost() uint64 {
+	if m != nil {
+		return m.Cost
+	}
+	return 0
+}
+
+// GasRefundParams defines the parameters of the gas refunds. They are kept out
+// of Params, which are read by the ante handler of every tx, and are only read
+// by the RefundDecorator post handler.
+type GasRefundParams struct {
+	// refund_ratio is the fraction of the fee paid for the unused gas which is
+	// refunded. No refund is made if it is zero.
+	RefundRatio github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,1,opt,name=refund_ratio,json=refundRatio,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"refund_ratio"`
+}
+
+func (m *GasRefundParams) Reset()      { *m = GasRefundParams{} }
+func (*GasRefundParams) ProtoMessage() {}
+func (*GasRefundParams) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7e1f7e915d020d2d, []int{4}
+}
+func (m *GasRefundParams) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *GasRefundParams) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_GasRefundParams.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *GasRefundParams) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GasRefundParams.Merge(m, src)
+}
+func (m *GasRefundParams) XXX_Size() int {
+	return m.Size()
+}
+func (m *GasRefundParams) XXX_DiscardUnknown() {
+	xxx_messageInfo_GasRefundParams.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GasRefundParams proto.InternalMessageInfo
+
+// PubKeyHistoryEntry defines a public key previously used by an account,
+// before it was replaced by a MsgChangePubKey.
+type PubKeyHistoryEntry struct {
+	// pub_key is the replaced public key.
+	PubKey *types.Any `protobuf:"bytes,1,opt,name=pub_key,json=pubKey,proto3" json:"pub_key,omitempty"`
+	// replaced_at_height is the block height at which the public key was replaced.
+	ReplacedAtHeight int64 `protobuf:"varint,2,opt,name=replaced_at_height,json=replacedAtHeight,proto3" json:"replaced_at_height,omitempty"`
+	// replaced_at_time is the block time at which the public key was replaced.
+	ReplacedAtTime time.Time `protobuf:"bytes,3,opt,name=replaced_at_time,json=replacedAtTime,proto3,stdtime" json:"replaced_at_time"`
+}
+
+func (m *PubKeyHistoryEntry) Reset()         { *m = PubKeyHistoryEntry{} }
+func (m *PubKeyHistoryEntry) String() string { return proto.CompactTextString(m) }
+func (*PubKeyHistoryEntry) ProtoMessage()    {}
+func (*PubKeyHistoryEntry) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7e1f7e915d020d2d, []int{5}
+}
+func (m *PubKeyHistoryEntry) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PubKeyHistoryEntry) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PubKeyHistoryEntry.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *PubKeyHistoryEntry) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PubKeyHistoryEntry.Merge(m, src)
+}
+func (m *PubKeyHistoryEntry) XXX_Size() int {
+	return m.Size()
+}
+func (m *PubKeyHistoryEntry) XXX_DiscardUnknown() {
+	xxx_messageInfo_PubKeyHistoryEntry.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PubKeyHistoryEntry proto.InternalMessageInfo
+
+func (m *PubKeyHistoryEntry) GetPubKey() *types.Any {
+	if m != nil {
+		return m.PubKey
+	}
+	return nil
+}
+
+func (m *PubKeyHistoryEntry) GetReplacedAtHeight() int64 {
+	if m != nil {
+		return m.ReplacedAtHeight
+	}
+	return 0
+}
+
+func (m *PubKeyHistoryEntry) GetReplacedAtTime() time.Time {
+	if m != nil {
+		return m.ReplacedAtTime
+	}
+	return time.Time{}
+}
+
+// PubKeyHistory defines the public keys previously used by an account.
+type PubKeyHistory struct {
+	Address string               `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	Entries []PubKeyHistoryEntry `protobuf:"bytes,2,rep,name=entries,proto3" json:"entries"`
+}
+
+func (m *PubKeyHistory) Reset()         { *m = PubKeyHistory{} }
+func (m *PubKeyHistory) String() string { return proto.CompactTextString(m) }
+func (*PubKeyHistory) ProtoMessage()    {}
+func (*PubKeyHistory) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7e1f7e915d020d2d, []int{6}
+}
+func (m *PubKeyHistory) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PubKeyHistory) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PubKeyHistory.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *PubKeyHistory) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PubKeyHistory.Merge(m, src)
+}
+func (m *PubKeyHistory) XXX_Size() int {
+	return m.Size()
+}
+func (m *PubKeyHistory) XXX_DiscardUnknown() {
+	xxx_messageInfo_PubKeyHistory.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PubKeyHistory proto.InternalMessageInfo
+
+func (m *PubKeyHistory) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+func (m *PubKeyHistory) GetEntries() []PubKeyHistoryEntry {
+	if m != nil {
+		return m.Entries
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*BaseAccount)(nil), "cosmos.auth.v1beta1.BaseAccount")
 	proto.RegisterType((*ModuleAccount)(nil), "cosmos.auth.v1beta1.ModuleAccount")
 	proto.RegisterType((*Params)(nil), "cosmos.auth.v1beta1.Params")
+	proto.RegisterType((*PubKeyChangeParams)(nil), "cosmos.auth.v1beta1.PubKeyChangeParams")
+	proto.RegisterType((*GasRefundParams)(nil), "cosmos.auth.v1beta1.GasRefundParams")
+	proto.RegisterType((*PubKeyHistoryEntry)(nil), "cosmos.auth.v1beta1.PubKeyHistoryEntry")
+	proto.RegisterType((*PubKeyHistory)(nil), "cosmos.auth.v1beta1.PubKeyHistory")
 }
 
 func init() { proto.RegisterFile("cosmos/auth/v1beta1/auth.proto", fileDescriptor_7e1f7e915d020d2d) }
 
 var fileDescriptor_7e1f7e915d020d2d = []byte{
-	// 609 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x6c, 0x53, 0x3f, 0x6f, 0xd3, 0x4e,
-	0x18, 0x8e, 0xdb, 0xfc, 0xfa, 0xe7, 0xd2, 0x56, 0xea, 0x35, 0xbf, 0xe2, 0x66, 0xb0, 0xad, 0x4a,
-	0x48, 0x41, 0x22, 0x0e, 0x09, 0x2a, 0x12, 0xdd, 0xea, 0x82, 0x50, 0x05, 0x85, 0xca, 0x11, 0x0c,
-	0x2c, 0xd6, 0xd9, 0x79, 0xeb, 0x9e, 0x9a, 0xf3, 0x19, 0xdf, 0xb9, 0x8a, 0xfb, 0x09, 0x18, 0x19,
-	0x19, 0xfb, 0x01, 0x18, 0x3b, 0x33, 0xa3, 0x4e, 0x15, 0x13, 0x53, 0x84, 0xd2, 0x01, 0xc4, 0xa7,
-	0x40, 0xb9, 0x73, 0xaa, 0x16, 0x75, 0xf2, 0xbd, 0xcf, 0xf3, 0xdc, 0xf3, 0xfe, 0xf3, 0x21, 0x2b,
-	0xe2, 0x82, 0x71, 0xd1, 0x26, 0xb9, 0x3c, 0x6a, 0x9f, 0x74, 0x42, 0x90, 0xa4, 0xa3, 0x02, 0x37,
-	0xcd, 0xb8, 0xe4, 0x78, 0x4d, 0xf3, 0xae, 0x82, 0x4a, 0xbe, 0xb1, 0xa1, 0xc1, 0x40, 0x49, 0xda,
-	0xa5, 0x42, 0x05, 0x8d, 0x7a, 0xcc, 0x63, 0xae, 0xf1, 0xc9, 0xa9, 0x44, 0x37, 0x62, 0xce, 0xe3,
-	0x01, 0xb4, 0x55, 0x14, 0xe6, 0x87, 0x6d, 0x92, 0x14, 0x9a, 0xda, 0xfc, 0x65, 0xa0, 0x9a, 0x47,
-	0x04, 0xec, 0x44, 0x11, 0xcf, 0x13, 0x89, 0xbb, 0x68, 0x9e, 0xf4, 0xfb, 0x19, 0x08, 0x61, 0x1a,
-	0x8e, 0xd1, 0x5c, 0xf4, 0xcc, 0xef, 0xe7, 0xad, 0x7a, 0x99, 0x63, 0x47, 0x33, 0x3d, 0x99, 0xd1,
-	0x24, 0xf6, 0xa7, 0x42, 0xfc, 0x02, 0xcd, 0xa7, 0x79, 0x18, 0x1c, 0x43, 0x61, 0xce, 0x38, 0x46,
-	0xb3, 0xd6, 0xad, 0xbb, 0x3a, 0xa1, 0x3b, 0x4d, 0xe8, 0xee, 0x24, 0x85, 0x67, 0xfe, 0x19, 0xd9,
-	0xf5, 0x34, 0x0f, 0x07, 0x34, 0x9a, 0x68, 0x1f, 0x72, 0x46, 0x25, 0xb0, 0x54, 0x16, 0xfe, 0x5c,
-	0x9a, 0x87, 0x2f, 0xa1, 0xc0, 0xf7, 0xd1, 0x0a, 0xd1, 0x75, 0x04, 0x49, 0xce, 0x42, 0xc8, 0xcc,
-	0x59, 0xc7, 0x68, 0x56, 0xfd, 0xe5, 0x12, 0x7d, 0xad, 0x40, 0xdc, 0x40, 0x0b, 0x02, 0x3e, 0xe4,
-	0x90, 0x44, 0x60, 0x56, 0x95, 0xe0, 0x3a, 0xde, 0x36, 0x3f, 0x9e, 0xd9, 0x95, 0xcf, 0x67, 0x76,
-	0xe5, 0xf7, 0x99, 0x5d, 0xb9, 0x38, 0x6f, 0x2d, 0x94, 0x8d, 0xed, 0x6d, 0x7e, 0x31, 0xd0, 0xf2,
-	0x3e, 0xef, 0xe7, 0x83, 0xeb, 0x5e, 0xf7, 0xd0, 0x52, 0x48, 0x04, 0x04, 0xa5, 0xbb, 0x6a, 0xb8,
-	0xd6, 0x75, 0xdc, 0x3b, 0x66, 0xee, 0xde, 0x98, 0x91, 0x57, 0xbd, 0x1c, 0xd9, 0x86, 0x5f, 0x0b,
-	0x6f, 0x8c, 0x0d, 0xa3, 0x6a, 0x42, 0x18, 0xa8, 0xfe, 0x17, 0x7d, 0x75, 0xc6, 0x0e, 0xaa, 0xa5,
-	0x90, 0x31, 0x2a, 0x04, 0xe5, 0x89, 0x30, 0x67, 0x9d, 0xd9, 0xe6, 0xa2, 0x7f, 0x13, 0xda, 0x6e,
-	0x4c, 0x8b, 0xbd, 0x38, 0x6f, 0xad, 0xdc, 0xaa, 0x6d, 0x6f, 0xf3, 0xeb, 0x0c, 0x9a, 0x3b, 0x20,
-	0x19, 0x61, 0x02, 0xbb, 0x68, 0x8d, 0x91, 0x61, 0xc0, 0x80, 0xf1, 0x20, 0x3a, 0x22, 0x19, 0x89,
-	0x24, 0x64, 0x7a, 0x3f, 0x55, 0x7f, 0x95, 0x91, 0xe1, 0x3e, 0x30, 0xbe, 0x7b, 0x4d, 0x60, 0x07,
-	0x2d, 0xc9, 0x61, 0x20, 0x68, 0x1c, 0x0c, 0x28, 0xa3, 0x52, 0x15, 0x55, 0xf5, 0x91, 0x1c, 0xf6,
-	0x68, 0xfc, 0x6a, 0x82, 0xe0, 0x47, 0xe8, 0x7f, 0xa5, 0x38, 0x85, 0x20, 0xe2, 0x42, 0x06, 0x29,
-	0x64, 0x41, 0x58, 0x48, 0x28, 0xe7, 0xbd, 0x3a, 0x91, 0x9e, 0xc2, 0x2e, 0x17, 0xf2, 0x00, 0x32,
-	0xaf, 0x90, 0x80, 0xdf, 0xa0, 0x7b, 0x13, 0xc3, 0x13, 0xc8, 0xe8, 0x61, 0xa1, 0x2f, 0x41, 0xbf,
-	0xbb, 0xb5, 0xd5, 0x79, 0xaa, 0x57, 0xe0, 0x99, 0xe3, 0x91, 0x5d, 0xef, 0xd1, 0xf8, 0x9d, 0x52,
-	0x4c, 0xae, 0x3e, 0x7f, 0xa6, 0x78, 0xbf, 0x2e, 0x6e, 0xa1, 0xfa, 0x16, 0x7e, 0x8b, 0x36, 0xfe,
-	0x35, 0x14, 0x10, 0xa5, 0xdd, 0xad, 0x27, 0xc7, 0x1d, 0xf3, 0x3f, 0x65, 0xd9, 0x18, 0x8f, 0xec,
-	0xf5, 0x5b, 0x96, 0xbd, 0xa9, 0xc2, 0x5f, 0x17, 0x77, 0xe2, 0xdb, 0x0b, 0xe5, 0xee, 0x0d, 0x6f,
-	0xf7, 0xdb, 0xd8, 0x32, 0x2e, 0xc7, 0x96, 0xf1, 0x73, 0x6c, 0x19, 0x9f, 0xae, 0xac, 0xca, 0xe5,
-	0x95, 0x55, 0xf9, 0x71, 0x65, 0x55, 0xde, 0x3f, 0x88, 0xa9, 0x3c, 0xca, 0x43, 0x37, 0xe2, 0xac,
-	0x7c, 0x3d, 0xe5, 0xa7, 0x25, 0xfa, 0xc7, 0xed, 0xa1, 0x7e, 0x8c, 0xb2, 0x48, 0x41, 0x84, 0x73,
-	0xea, 0x0f, 0x7e, 0xfc, 0x37, 0x00, 0x00, 0xff, 0xff, 0x35, 0x25, 0xef, 0x58, 0xa8, 0x03, 0x00,
-	0x00,
+	// 876 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x55, 0x4d, 0x6f, 0xe3, 0x44,
+	0x18, 0x8e, 0x9b, 0xd0, 0xa6, 0x93, 0xb6, 0x74, 0xbd, 0x61, 0x71, 0x73, 0x88, 0xa3, 0x48, 0x40,
+	0x90, 0x36, 0x0e, 0x0d, 0x2a, 0x12, 0x15, 0x12, 0xaa, 0xdb, 0xd5, 0x6e, 0x17, 0x76, 0xa9, 0x5c,
+	0xe0, 0xc0, 0xc5, 0x1a, 0xdb, 0x6f, 0x1d, 0xab, 0xb1, 0xc7, 0xcc, 0x8c, 0x97, 0x78, 0xc5, 0x0f,
+	0xe0, 0xc0, 0x61, 0x8f, 0x7b, 0xec, 0x0f, 0xe0, 0xd8, 0x1f, 0xb1, 0xea, 0xa9, 0xda, 0x13, 0x02,
+	0x29, 0xa0, 0xf4, 0x00, 0xe2, 0x57, 0x20, 0xcf, 0x4c, 0xd2, 0xf4, 0x43, 0x7b, 0xd8, 0x53, 0x3c,
+	0xcf, 0xf3, 0xcc, 0x33, 0xef, 0xc7, 0xbc, 0x13, 0xd4, 0xf4, 0x09, 0x8b, 0x09, 0xeb, 0xe1, 0x8c,
+	0x0f, 0x7a, 0xcf, 0x36, 0x3d, 0xe0, 0x78, 0x53, 0x2c, 0xac, 0x94, 0x12, 0x4e, 0xf4, 0xbb, 0x92,
+	0xb7, 0x04, 0xa4, 0xf8, 0xc6, 0x86, 0x04, 0x5d, 0x21, 0xe9, 0x29, 0x85, 0x58, 0x34, 0xea, 0x21,
+	0x09, 0x89, 0xc4, 0x8b, 0x2f, 0x85, 0x6e, 0x84, 0x84, 0x84, 0x43, 0xe8, 0x89, 0x95, 0x97, 0x1d,
+	0xf5, 0x70, 0x92, 0x2b, 0xaa, 0x79, 0x9d, 0x0a, 0x32, 0x8a, 0x79, 0x44, 0x12, 0xc5, 0x9b, 0xd7,
+	0x79, 0x1e, 0xc5, 0xc0, 0x38, 0x8e, 0x53, 0x29, 0x68, 0xff, 0xa3, 0xa1, 0x9a, 0x8d, 0x19, 0xec,
+	0xf8, 0x3e, 0xc9, 0x12, 0xae, 0xf7, 0xd1, 0x12, 0x0e, 0x02, 0x0a, 0x8c, 0x19, 0x5a, 0x4b, 0xeb,
+	0x2c, 0xdb, 0xc6, 0xeb, 0xd3, 0x6e, 0x5d, 0x05, 0xb9, 0x23, 0x99, 0x43, 0x4e, 0xa3, 0x24, 0x74,
+	0xa6, 0x42, 0xfd, 0x21, 0x5a, 0x4a, 0x33, 0xcf, 0x3d, 0x86, 0xdc, 0x58, 0x68, 0x69, 0x9d, 0x5a,
+	0xbf, 0x6e, 0xc9, 0x63, 0xad, 0xe9, 0xb1, 0xd6, 0x4e, 0x92, 0xdb, 0xc6, 0x7f, 0x63, 0xb3, 0x9e,
+	0x66, 0xde, 0x30, 0xf2, 0x0b, 0xed, 0x7d, 0x12, 0x47, 0x1c, 0xe2, 0x94, 0xe7, 0xce, 0x62, 0x9a,
+	0x79, 0x5f, 0x41, 0xae, 0x7f, 0x80, 0xd6, 0xb0, 0x8c, 0xc3, 0x4d, 0xb2, 0xd8, 0x03, 0x6a, 0x94,
+	0x5b, 0x5a, 0xa7, 0xe2, 0xac, 0x2a, 0xf4, 0xa9, 0x00, 0xf5, 0x06, 0xaa, 0x32, 0xf8, 0x31, 0x83,
+	0xc4, 0x07, 0xa3, 0x22, 0x04, 0xb3, 0xf5, 0xb6, 0xf1, 0xcb, 0x89, 0x59, 0x7a, 0x79, 0x62, 0x96,
+	0xfe, 0x3d, 0x31, 0x4b, 0x67, 0xa7, 0xdd, 0xaa, 0x4a, 0x6c, 0xbf, 0xfd, 0x9b, 0x86, 0x56, 0x9f,
+	0x90, 0x20, 0x1b, 0xce, 0x72, 0xdd, 0x47, 0x2b, 0x1e, 0x66, 0xe0, 0x2a, 0x77, 0x91, 0x70, 0xad,
+	0xdf, 0xb2, 0x6e, 0x69, 0x9a, 0x35, 0x57, 0x23, 0xbb, 0x72, 0x3e, 0x36, 0x35, 0xa7, 0xe6, 0xcd,
+	0x95, 0x4d, 0x47, 0x95, 0x04, 0xc7, 0x20, 0xf2, 0x5f, 0x76, 0xc4, 0xb7, 0xde, 0x42, 0xb5, 0x14,
+	0x68, 0x1c, 0x31, 0x16, 0x91, 0x84, 0x19, 0xe5, 0x56, 0xb9, 0xb3, 0xec, 0xcc, 0x43, 0xdb, 0x8d,
+	0x69, 0xb0, 0x67, 0xa7, 0xdd, 0xb5, 0x2b, 0xb1, 0xed, 0xb7, 0xcf, 0x16, 0xd0, 0xe2, 0x01, 0xa6,
+	0x38, 0x66, 0xba, 0x85, 0xee, 0xc6, 0x78, 0xe4, 0xc6, 0x10, 0x13, 0xd7, 0x1f, 0x60, 0x8a, 0x7d,
+	0x0e, 0x54, 0xf6, 0xa7, 0xe2, 0xdc, 0x89, 0xf1, 0xe8, 0x09, 0xc4, 0x64, 0x77, 0x46, 0xe8, 0x2d,
+	0xb4, 0xc2, 0x47, 0x2e, 0x8b, 0x42, 0x77, 0x18, 0xc5, 0x11, 0x17, 0x41, 0x55, 0x1c, 0xc4, 0x47,
+	0x87, 0x51, 0xf8, 0x75, 0x81, 0xe8, 0x9f, 0xa0, 0xf7, 0x84, 0xe2, 0x39, 0xb8, 0x3e, 0x61, 0xdc,
+	0x4d, 0x81, 0xba, 0x5e, 0xce, 0x41, 0xd5, 0xfb, 0x4e, 0x21, 0x7d, 0x0e, 0xbb, 0x84, 0xf1, 0x03,
+	0xa0, 0x76, 0xce, 0x41, 0xff, 0x06, 0xbd, 0x5f, 0x18, 0x3e, 0x03, 0x1a, 0x1d, 0xe5, 0x72, 0x13,
+	0x04, 0xfd, 0xad, 0xad, 0xcd, 0xcf, 0x65, 0x0b, 0x6c, 0x63, 0x32, 0x36, 0xeb, 0x87, 0x51, 0xf8,
+	0xbd, 0x50, 0x14, 0x5b, 0x1f, 0xec, 0x09, 0xde, 0xa9, 0xb3, 0x2b, 0xa8, 0xdc, 0xa5, 0x7f, 0x87,
+	0x36, 0xae, 0x1b, 0x32, 0xf0, 0xd3, 0xfe, 0xd6, 0x67, 0xc7, 0x9b, 0xc6, 0x3b, 0xc2, 0xb2, 0x31,
+	0x19, 0x9b, 0xf7, 0xae, 0x58, 0x1e, 0x4e, 0x15, 0xce, 0x3d, 0x76, 0x2b, 0xbe, 0x5d, 0x55, 0xbd,
+	0xd7, 0x1e, 0x57, 0xaa, 0x8b, 0xeb, 0x4b, 0x8f, 0x2b, 0xd5, 0xa5, 0xf5, 0x6a, 0x9b, 0x21, 0xfd,
+	0x40, 0x5c, 0xb1, 0xdd, 0x01, 0x4e, 0x42, 0x50, 0x75, 0xfd, 0x12, 0x55, 0x7d, 0x42, 0x86, 0x01,
+	0xf9, 0x29, 0x51, 0xbd, 0xdf, 0xb8, 0x71, 0x71, 0xf7, 0xd4, 0x3c, 0xd9, 0xd5, 0x57, 0x63, 0xb3,
+	0xf4, 0xf2, 0x2f, 0x53, 0x73, 0x66, 0x9b, 0x8a, 0xae, 0x17, 0x81, 0xab, 0x02, 0x8b, 0xef, 0xcb,
+	0x00, 0xda, 0x3f, 0xa3, 0x77, 0x1f, 0x62, 0xe6, 0xc0, 0x51, 0x96, 0x04, 0xea, 0x44, 0x17, 0xad,
+	0x50, 0xb1, 0x76, 0x85, 0xab, 0x1a, 0xb1, 0x2f, 0x0a, 0xeb, 0x3f, 0xc6, 0xe6, 0x87, 0x61, 0xc4,
+	0x07, 0x99, 0x67, 0xf9, 0x24, 0x56, 0xcf, 0x82, 0xfa, 0xe9, 0xb2, 0xe0, 0xb8, 0xc7, 0xf3, 0x14,
+	0x98, 0xb5, 0x07, 0xfe, 0xeb, 0xd3, 0x2e, 0x92, 0x78, 0xb1, 0x72, 0x6a, 0xd2, 0xd1, 0x29, 0x0c,
+	0xe7, 0x4e, 0xff, 0x53, 0x9b, 0xe6, 0xfc, 0x28, 0x62, 0x9c, 0xd0, 0xfc, 0x41, 0xc2, 0x69, 0x3e,
+	0x3f, 0xab, 0xda, 0x9b, 0x66, 0xf5, 0xec, 0x72, 0xea, 0x7d, 0x9a, 0xa7, 0x9c, 0x58, 0xd2, 0x6c,
+	0x36, 0xab, 0xf7, 0x91, 0x4e, 0x21, 0x1d, 0x62, 0x1f, 0x02, 0x17, 0x73, 0x77, 0x00, 0x51, 0x38,
+	0x90, 0x95, 0x28, 0x3b, 0xeb, 0x53, 0x66, 0x87, 0x3f, 0x12, 0xb8, 0xfe, 0x14, 0xad, 0xcf, 0xab,
+	0x8b, 0x57, 0x48, 0xdc, 0xb5, 0x5a, 0xbf, 0x71, 0xe3, 0xfc, 0x6f, 0xa7, 0x4f, 0x94, 0xac, 0xf9,
+	0x8b, 0xa2, 0xe6, 0x6b, 0x97, 0x8e, 0x05, 0xdd, 0xfe, 0x55, 0x43, 0xab, 0x57, 0xb2, 0x7b, 0xdb,
+	0x87, 0x0b, 0x12, 0x4e, 0x23, 0x60, 0xc6, 0x42, 0xab, 0xdc, 0xa9, 0xf5, 0x3f, 0xba, 0x75, 0xf6,
+	0x6f, 0x96, 0xd1, 0xae, 0x14, 0x91, 0x39, 0xd3, 0xdd, 0xf6, 0xee, 0xab, 0x49, 0x53, 0x3b, 0x9f,
+	0x34, 0xb5, 0xbf, 0x27, 0x4d, 0xed, 0xc5, 0x45, 0xb3, 0x74, 0x7e, 0xd1, 0x2c, 0xfd, 0x7e, 0xd1,
+	0x2c, 0xfd, 0xf0, 0xf1, 0x1b, 0x7b, 0x3a, 0x92, 0xff, 0x1c, 0xa2, 0xb5, 0xde, 0xa2, 0xa8, 0xc0,
+	0xa7, 0xff, 0x0f, 0x00, 0xaa, 0x97, 0x22, 0x8b, 0x55, 0x06, 0x00, 0x00,
 }
 
 func (this *Params) Equal(that interface{}) bool {
@@ -269,6 +511,57 @@ func (this *Params) Equal(that interface{}) bool {
 	}
 	return true
 }
+func (this *PubKeyChangeParams) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*PubKeyChangeParams)
+	if !ok {
+		that2, ok := that.(PubKeyChangeParams)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Cooldown != that1.Cooldown {
+		return false
+	}
+	if this.Cost != that1.Cost {
+		return false
+	}
+	return true
+}
+func (this *GasRefundParams) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*GasRefundParams)
+	if !ok {
+		that2, ok := that.(GasRefundParams)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if !this.RefundRatio.Equal(that1.RefundRatio) {
+		return false
+	}
+	return true
+}
 func (m *BaseAccount) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -420,76 +713,237 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintAuth(dAtA []byte, offset int, v uint64) int {
-	offset -= sovAuth(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *PubKeyChangeParams) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *BaseAccount) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+
+func (m *PubKeyChangeParams) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PubKeyChangeParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Address)
-	if l > 0 {
-		n += 1 + l + sovAuth(uint64(l))
-	}
-	if m.PubKey != nil {
-		l = m.PubKey.Size()
-		n += 1 + l + sovAuth(uint64(l))
-	}
-	if m.AccountNumber != 0 {
-		n += 1 + sovAuth(uint64(m.AccountNumber))
+	if m.Cost != 0 {
+		i = encodeVarintAuth(dAtA, i, uint64(m.Cost))
+		i--
+		dAtA[i] = 0x10
 	}
-	if m.Sequence != 0 {
-		n += 1 + sovAuth(uint64(m.Sequence))
+	n3, err3 := github_com_gogo_protobuf_types.StdDurationMarshalTo(m.Cooldown, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(m.Cooldown):])
+	if err3 != nil {
+		return 0, err3
 	}
-	return n
+	i -= n3
+	i = encodeVarintAuth(dAtA, i, uint64(n3))
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
 }
 
-func (m *ModuleAccount) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *GasRefundParams) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *GasRefundParams) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GasRefundParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.BaseAccount != nil {
-		l = m.BaseAccount.Size()
-		n += 1 + l + sovAuth(uint64(l))
-	}
-	l = len(m.Name)
-	if l > 0 {
-		n += 1 + l + sovAuth(uint64(l))
-	}
-	if len(m.Permissions) > 0 {
-		for _, s := range m.Permissions {
-			l = len(s)
-			n += 1 + l + sovAuth(uint64(l))
+	{
+		size := m.RefundRatio.Size()
+		i -= size
+		if _, err := m.RefundRatio.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
 		}
+		i = encodeVarintAuth(dAtA, i, uint64(size))
 	}
-	return n
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
 }
 
-func (m *Params) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *PubKeyHistoryEntry) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *PubKeyHistoryEntry) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PubKeyHistoryEntry) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.MaxMemoCharacters != 0 {
-		n += 1 + sovAuth(uint64(m.MaxMemoCharacters))
+	n4, err4 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.ReplacedAtTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.ReplacedAtTime):])
+	if err4 != nil {
+		return 0, err4
 	}
-	if m.TxSigLimit != 0 {
-		n += 1 + sovAuth(uint64(m.TxSigLimit))
+	i -= n4
+	i = encodeVarintAuth(dAtA, i, uint64(n4))
+	i--
+	dAtA[i] = 0x1a
+	if m.ReplacedAtHeight != 0 {
+		i = encodeVarintAuth(dAtA, i, uint64(m.ReplacedAtHeight))
+		i--
+		dAtA[i] = 0x10
 	}
-	if m.TxSizeCostPerByte != 0 {
+	if m.PubKey != nil {
+		{
+			size, err := m.PubKey.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintAuth(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *PubKeyHistory) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PubKeyHistory) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PubKeyHistory) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Entries) > 0 {
+		for iNdEx := len(m.Entries) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Entries[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintAuth(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintAuth(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintAuth(dAtA []byte, offset int, v uint64) int {
+	offset -= sovAuth(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *BaseAccount) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovAuth(uint64(l))
+	}
+	if m.PubKey != nil {
+		l = m.PubKey.Size()
+		n += 1 + l + sovAuth(uint64(l))
+	}
+	if m.AccountNumber != 0 {
+		n += 1 + sovAuth(uint64(m.AccountNumber))
+	}
+	if m.Sequence != 0 {
+		n += 1 + sovAuth(uint64(m.Sequence))
+	}
+	return n
+}
+
+func (m *ModuleAccount) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.BaseAccount != nil {
+		l = m.BaseAccount.Size()
+		n += 1 + l + sovAuth(uint64(l))
+	}
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovAuth(uint64(l))
+	}
+	if len(m.Permissions) > 0 {
+		for _, s := range m.Permissions {
+			l = len(s)
+			n += 1 + l + sovAuth(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *Params) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.MaxMemoCharacters != 0 {
+		n += 1 + sovAuth(uint64(m.MaxMemoCharacters))
+	}
+	if m.TxSigLimit != 0 {
+		n += 1 + sovAuth(uint64(m.TxSigLimit))
+	}
+	if m.TxSizeCostPerByte != 0 {
 		n += 1 + sovAuth(uint64(m.TxSizeCostPerByte))
 	}
 	if m.SigVerifyCostED25519 != 0 {
@@ -498,16 +952,529 @@ func (m *Params) Size() (n int) {
 	if m.SigVerifyCostSecp256k1 != 0 {
 		n += 1 + sovAuth(uint64(m.SigVerifyCostSecp256k1))
 	}
-	return n
-}
+	return n
+}
+
+func (m *PubKeyChangeParams) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = github_com_gogo_protobuf_types.SizeOfStdDuration(m.Cooldown)
+	n += 1 + l + sovAuth(uint64(l))
+	if m.Cost != 0 {
+		n += 1 + sovAuth(uint64(m.Cost))
+	}
+	return n
+}
+
+func (m *GasRefundParams) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.RefundRatio.Size()
+	n += 1 + l + sovAuth(uint64(l))
+	return n
+}
+
+func (m *PubKeyHistoryEntry) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.PubKey != nil {
+		l = m.PubKey.Size()
+		n += 1 + l + sovAuth(uint64(l))
+	}
+	if m.ReplacedAtHeight != 0 {
+		n += 1 + sovAuth(uint64(m.ReplacedAtHeight))
+	}
+	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.ReplacedAtTime)
+	n += 1 + l + sovAuth(uint64(l))
+	return n
+}
+
+func (m *PubKeyHistory) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovAuth(uint64(l))
+	}
+	if len(m.Entries) > 0 {
+		for _, e := range m.Entries {
+			l = e.Size()
+			n += 1 + l + sovAuth(uint64(l))
+		}
+	}
+	return n
+}
+
+func sovAuth(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozAuth(x uint64) (n int) {
+	return sovAuth(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *BaseAccount) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAuth
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: BaseAccount: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: BaseAccount: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuth
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAuth
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuth
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PubKey", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuth
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAuth
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuth
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.PubKey == nil {
+				m.PubKey = &types.Any{}
+			}
+			if err := m.PubKey.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AccountNumber", wireType)
+			}
+			m.AccountNumber = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuth
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.AccountNumber |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sequence", wireType)
+			}
+			m.Sequence = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuth
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Sequence |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAuth(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthAuth
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ModuleAccount) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAuth
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ModuleAccount: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ModuleAccount: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BaseAccount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuth
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAuth
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuth
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.BaseAccount == nil {
+				m.BaseAccount = &BaseAccount{}
+			}
+			if err := m.BaseAccount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuth
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAuth
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuth
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Permissions", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuth
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAuth
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuth
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Permissions = append(m.Permissions, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAuth(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthAuth
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Params) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAuth
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Params: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Params: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxMemoCharacters", wireType)
+			}
+			m.MaxMemoCharacters = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuth
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxMemoCharacters |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TxSigLimit", wireType)
+			}
+			m.TxSigLimit = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuth
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TxSigLimit |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TxSizeCostPerByte", wireType)
+			}
+			m.TxSizeCostPerByte = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuth
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TxSizeCostPerByte |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SigVerifyCostED25519", wireType)
+			}
+			m.SigVerifyCostED25519 = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuth
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SigVerifyCostED25519 |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SigVerifyCostSecp256k1", wireType)
+			}
+			m.SigVerifyCostSecp256k1 = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuth
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SigVerifyCostSecp256k1 |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAuth(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthAuth
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
 
-func sovAuth(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozAuth(x uint64) (n int) {
-	return sovAuth(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
 }
-func (m *BaseAccount) Unmarshal(dAtA []byte) error {
+func (m *PubKeyChangeParams) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -530,17 +1497,17 @@ func (m *BaseAccount) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: BaseAccount: wiretype end group for non-group")
+			return fmt.Errorf("proto: PubKeyChangeParams: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: BaseAccount: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: PubKeyChangeParams: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Cooldown", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowAuth
@@ -550,29 +1517,30 @@ func (m *BaseAccount) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthAuth
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthAuth
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Address = string(dAtA[iNdEx:postIndex])
+			if err := github_com_gogo_protobuf_types.StdDurationUnmarshal(&m.Cooldown, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PubKey", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Cost", wireType)
 			}
-			var msglen int
+			m.Cost = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowAuth
@@ -582,33 +1550,66 @@ func (m *BaseAccount) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.Cost |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthAuth
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAuth(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthAuth
 			}
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.PubKey == nil {
-				m.PubKey = &types.Any{}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GasRefundParams) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAuth
 			}
-			if err := m.PubKey.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
 			}
-			iNdEx = postIndex
-		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AccountNumber", wireType)
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
 			}
-			m.AccountNumber = 0
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GasRefundParams: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GasRefundParams: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RefundRatio", wireType)
+			}
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowAuth
@@ -618,30 +1619,26 @@ func (m *BaseAccount) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.AccountNumber |= uint64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 4:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Sequence", wireType)
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAuth
 			}
-			m.Sequence = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowAuth
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.Sequence |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuth
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
 			}
+			if err := m.RefundRatio.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipAuth(dAtA[iNdEx:])
@@ -663,7 +1660,7 @@ func (m *BaseAccount) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ModuleAccount) Unmarshal(dAtA []byte) error {
+func (m *PubKeyHistoryEntry) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -686,15 +1683,15 @@ func (m *ModuleAccount) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ModuleAccount: wiretype end group for non-group")
+			return fmt.Errorf("proto: PubKeyHistoryEntry: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ModuleAccount: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: PubKeyHistoryEntry: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BaseAccount", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PubKey", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -721,18 +1718,18 @@ func (m *ModuleAccount) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.BaseAccount == nil {
-				m.BaseAccount = &BaseAccount{}
+			if m.PubKey == nil {
+				m.PubKey = &types.Any{}
 			}
-			if err := m.BaseAccount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.PubKey.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReplacedAtHeight", wireType)
 			}
-			var stringLen uint64
+			m.ReplacedAtHeight = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowAuth
@@ -742,29 +1739,16 @@ func (m *ModuleAccount) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.ReplacedAtHeight |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthAuth
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthAuth
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Name = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Permissions", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ReplacedAtTime", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowAuth
@@ -774,23 +1758,24 @@ func (m *ModuleAccount) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthAuth
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthAuth
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Permissions = append(m.Permissions, string(dAtA[iNdEx:postIndex]))
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.ReplacedAtTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -813,7 +1798,7 @@ func (m *ModuleAccount) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Params) Unmarshal(dAtA []byte) error {
+func (m *PubKeyHistory) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -836,17 +1821,17 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Params: wiretype end group for non-group")
+			return fmt.Errorf("proto: PubKeyHistory: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Params: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: PubKeyHistory: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MaxMemoCharacters", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
 			}
-			m.MaxMemoCharacters = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowAuth
@@ -856,35 +1841,29 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.MaxMemoCharacters |= uint64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TxSigLimit", wireType)
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAuth
 			}
-			m.TxSigLimit = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowAuth
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.TxSigLimit |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuth
 			}
-		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TxSizeCostPerByte", wireType)
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
 			}
-			m.TxSizeCostPerByte = 0
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Entries", wireType)
+			}
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowAuth
@@ -894,49 +1873,26 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.TxSizeCostPerByte |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 4:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SigVerifyCostED25519", wireType)
+			if msglen < 0 {
+				return ErrInvalidLengthAuth
 			}
-			m.SigVerifyCostED25519 = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowAuth
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.SigVerifyCostED25519 |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuth
 			}
-		case 5:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SigVerifyCostSecp256k1", wireType)
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
 			}
-			m.SigVerifyCostSecp256k1 = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowAuth
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.SigVerifyCostSecp256k1 |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			m.Entries = append(m.Entries, PubKeyHistoryEntry{})
+			if err := m.Entries[len(m.Entries)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipAuth(dAtA[iNdEx:])
@@ -0,0 +1,10 @@
+package types
+
+import (
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// x/auth module sentinel errors
+var (
+	ErrPubKeyChangeCooldown = sdkerrors.Register(ModuleName, 2, "pubkey change cooldown not elapsed")
+)
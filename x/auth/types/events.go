@@ -0,0 +1,10 @@
+package types
+
+// auth module event types
+const (
+	EventTypeChangePubKey = "change_pubkey"
+
+	AttributeKeyAddress   = "address"
+	AttributeKeyOldPubKey = "old_pubkey"
+	AttributeKeyNewPubKey = "new_pubkey"
+)
@@ -9,6 +9,8 @@ import (
 
 	"github.com/cosmos/cosmos-sdk/codec"
 	"github.com/cosmos/cosmos-sdk/codec/types"
+	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/module"
 )
 
@@ -24,11 +26,23 @@ func NewGenesisState(params Params, accounts GenesisAccounts) *GenesisState {
 		panic(err)
 	}
 	return &GenesisState{
-		Params:   params,
-		Accounts: genAccounts,
+		Params:             params,
+		Accounts:           genAccounts,
+		PubKeyChangeParams: DefaultPubKeyChangeParams(),
+		GasRefundParams:    DefaultGasRefundParams(),
 	}
 }
 
+// GetGasRefundParamsOrDefault returns the gas refund params of the genesis
+// state, or the default ones if they are unset, as in a genesis exported
+// before they were added.
+func (g GenesisState) GetGasRefundParamsOrDefault() GasRefundParams {
+	if g.GasRefundParams.RefundRatio.IsNil() {
+		return DefaultGasRefundParams()
+	}
+	return g.GasRefundParams
+}
+
 // UnpackInterfaces implements UnpackInterfacesMessage.UnpackInterfaces
 func (g GenesisState) UnpackInterfaces(unpacker types.AnyUnpacker) error {
 	for _, any := range g.Accounts {
@@ -38,9 +52,30 @@ func (g GenesisState) UnpackInterfaces(unpacker types.AnyUnpacker) error {
 			return err
 		}
 	}
+	for _, history := range g.PubKeyHistories {
+		if err := history.UnpackInterfaces(unpacker); err != nil {
+			return err
+		}
+	}
 	return nil
 }
 
+// UnpackInterfaces implements UnpackInterfacesMessage.UnpackInterfaces
+func (h PubKeyHistory) UnpackInterfaces(unpacker types.AnyUnpacker) error {
+	for _, entry := range h.Entries {
+		if err := entry.UnpackInterfaces(unpacker); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// UnpackInterfaces implements UnpackInterfacesMessage.UnpackInterfaces
+func (e PubKeyHistoryEntry) UnpackInterfaces(unpacker types.AnyUnpacker) error {
+	var pubKey cryptotypes.PubKey
+	return unpacker.UnpackAny(e.PubKey, &pubKey)
+}
+
 // DefaultGenesisState - Return a default genesis state
 func DefaultGenesisState() *GenesisState {
 	return NewGenesisState(DefaultParams(), GenesisAccounts{})
@@ -65,12 +100,59 @@ func ValidateGenesis(data GenesisState) error {
 		return err
 	}
 
+	if err := data.PubKeyChangeParams.Validate(); err != nil {
+		return err
+	}
+
+	if err := data.GetGasRefundParamsOrDefault().Validate(); err != nil {
+		return err
+	}
+
 	genAccs, err := UnpackAccounts(data.Accounts)
 	if err != nil {
 		return err
 	}
 
-	return ValidateGenAccounts(genAccs)
+	if err := ValidatePubKeyHistories(data.PubKeyHistories); err != nil {
+		return err
+	}
+
+	changedPubKeys := make(map[string]bool, len(data.PubKeyHistories))
+	for _, history := range data.PubKeyHistories {
+		changedPubKeys[history.Address] = len(history.Entries) > 0
+	}
+
+	return validateGenAccounts(genAccs, changedPubKeys)
+}
+
+// ValidatePubKeyHistories validates the public key histories of the accounts
+// and checks for duplicates.
+func ValidatePubKeyHistories(histories []PubKeyHistory) error {
+	addrMap := make(map[string]bool, len(histories))
+
+	for _, history := range histories {
+		if _, err := sdk.AccAddressFromBech32(history.Address); err != nil {
+			return fmt.Errorf("invalid pubkey history address %s: %w", history.Address, err)
+		}
+
+		if addrMap[history.Address] {
+			return fmt.Errorf("duplicate pubkey history found in genesis state; address: %s", history.Address)
+		}
+		addrMap[history.Address] = true
+
+		heights := make(map[int64]bool, len(history.Entries))
+		for _, entry := range history.Entries {
+			if _, ok := entry.PubKey.GetCachedValue().(cryptotypes.PubKey); !ok {
+				return fmt.Errorf("invalid pubkey history entry for address %s: expecting cryptotypes.PubKey", history.Address)
+			}
+			if heights[entry.ReplacedAtHeight] {
+				return fmt.Errorf("duplicate pubkey history entry for address %s at height %d", history.Address, entry.ReplacedAtHeight)
+			}
+			heights[entry.ReplacedAtHeight] = true
+		}
+	}
+
+	return nil
 }
 
 // SanitizeGenesisAccounts sorts accounts and coin sets.
@@ -84,6 +166,13 @@ func SanitizeGenesisAccounts(genAccs GenesisAccounts) GenesisAccounts {
 
 // ValidateGenAccounts validates an array of GenesisAccounts and checks for duplicates
 func ValidateGenAccounts(accounts GenesisAccounts) error {
+	return validateGenAccounts(accounts, nil)
+}
+
+// validateGenAccounts validates an array of GenesisAccounts and checks for
+// duplicates. The public keys of the accounts flagged in changedPubKeys were
+// replaced with a MsgChangePubKey, so they no longer derive their address.
+func validateGenAccounts(accounts GenesisAccounts, changedPubKeys map[string]bool) error {
 	addrMap := make(map[string]bool, len(accounts))
 
 	for _, acc := range accounts {
@@ -95,6 +184,14 @@ func ValidateGenAccounts(accounts GenesisAccounts) error {
 
 		addrMap[addrStr] = true
 
+		if changedPubKeys[addrStr] {
+			// validate the account fields other than its public key
+			acc = proto.Clone(acc).(GenesisAccount)
+			if err := acc.SetPubKey(nil); err != nil {
+				return fmt.Errorf("invalid account found in genesis state; address: %s, error: %s", addrStr, err.Error())
+			}
+		}
+
 		// check account specific validation
 		if err := acc.Validate(); err != nil {
 			return fmt.Errorf("invalid account found in genesis state; address: %s, error: %s", addrStr, err.Error())
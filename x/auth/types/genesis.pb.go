@@ -30,6 +30,12 @@ type GenesisState struct {
 	Params Params `protobuf:"bytes,1,opt,name=params,proto3" json:"params"`
 	// accounts are the accounts present at genesis.
 	Accounts []*types.Any `protobuf:"bytes,2,rep,name=accounts,proto3" json:"accounts,omitempty"`
+	// pubkey_histories are the public keys previously used by the accounts.
+	PubKeyHistories []PubKeyHistory `protobuf:"bytes,3,rep,name=pubkey_histories,json=pubkeyHistories,proto3" json:"pubkey_histories"`
+	// pubkey_change_params defines the parameters of MsgChangePubKey.
+	PubKeyChangeParams PubKeyChangeParams `protobuf:"bytes,4,opt,name=pubkey_change_params,json=pubkeyChangeParams,proto3" json:"pubkey_change_params"`
+	// gas_refund_params defines the parameters of the gas refunds.
+	GasRefundParams GasRefundParams `protobuf:"bytes,5,opt,name=gas_refund_params,json=gasRefundParams,proto3" json:"gas_refund_params"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -79,6 +85,27 @@ func (m *GenesisState) GetAccounts() []*types.Any {
 	return nil
 }
 
+func (m *GenesisState) GetPubKeyHistories() []PubKeyHistory {
+	if m != nil {
+		return m.PubKeyHistories
+	}
+	return nil
+}
+
+func (m *GenesisState) GetPubKeyChangeParams() PubKeyChangeParams {
+	if m != nil {
+		return m.PubKeyChangeParams
+	}
+	return PubKeyChangeParams{}
+}
+
+func (m *GenesisState) GetGasRefundParams() GasRefundParams {
+	if m != nil {
+		return m.GasRefundParams
+	}
+	return GasRefundParams{}
+}
+
 func init() {
 	proto.RegisterType((*GenesisState)(nil), "cosmos.auth.v1beta1.GenesisState")
 }
@@ -86,23 +113,31 @@ func init() {
 func init() { proto.RegisterFile("cosmos/auth/v1beta1/genesis.proto", fileDescriptor_d897ccbce9822332) }
 
 var fileDescriptor_d897ccbce9822332 = []byte{
-	// 252 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x52, 0x4c, 0xce, 0x2f, 0xce,
-	0xcd, 0x2f, 0xd6, 0x4f, 0x2c, 0x2d, 0xc9, 0xd0, 0x2f, 0x33, 0x4c, 0x4a, 0x2d, 0x49, 0x34, 0xd4,
-	0x4f, 0x4f, 0xcd, 0x4b, 0x2d, 0xce, 0x2c, 0xd6, 0x2b, 0x28, 0xca, 0x2f, 0xc9, 0x17, 0x12, 0x86,
-	0x28, 0xd1, 0x03, 0x29, 0xd1, 0x83, 0x2a, 0x91, 0x92, 0x4c, 0xcf, 0xcf, 0x4f, 0xcf, 0x49, 0xd5,
-	0x07, 0x2b, 0x49, 0x2a, 0x4d, 0xd3, 0x4f, 0xcc, 0xab, 0x84, 0xa8, 0x97, 0x12, 0x49, 0xcf, 0x4f,
-	0xcf, 0x07, 0x33, 0xf5, 0x41, 0x2c, 0xa8, 0xa8, 0x1c, 0x36, 0x8b, 0xc0, 0x46, 0x82, 0xe5, 0x95,
-	0xaa, 0xb9, 0x78, 0xdc, 0x21, 0xd6, 0x06, 0x97, 0x24, 0x96, 0xa4, 0x0a, 0x59, 0x72, 0xb1, 0x15,
-	0x24, 0x16, 0x25, 0xe6, 0x16, 0x4b, 0x30, 0x2a, 0x30, 0x6a, 0x70, 0x1b, 0x49, 0xeb, 0x61, 0x71,
-	0x86, 0x5e, 0x00, 0x58, 0x89, 0x13, 0xcb, 0x89, 0x7b, 0xf2, 0x0c, 0x41, 0x50, 0x0d, 0x42, 0x06,
-	0x5c, 0x1c, 0x89, 0xc9, 0xc9, 0xf9, 0xa5, 0x79, 0x25, 0xc5, 0x12, 0x4c, 0x0a, 0xcc, 0x1a, 0xdc,
-	0x46, 0x22, 0x7a, 0x10, 0xe7, 0xea, 0xc1, 0x9c, 0xab, 0xe7, 0x98, 0x57, 0x19, 0x04, 0x57, 0xe5,
-	0xe4, 0x7c, 0xe2, 0x91, 0x1c, 0xe3, 0x85, 0x47, 0x72, 0x8c, 0x0f, 0x1e, 0xc9, 0x31, 0x4e, 0x78,
-	0x2c, 0xc7, 0x70, 0xe1, 0xb1, 0x1c, 0xc3, 0x8d, 0xc7, 0x72, 0x0c, 0x51, 0x9a, 0xe9, 0x99, 0x25,
-	0x19, 0xa5, 0x49, 0x7a, 0xc9, 0xf9, 0xb9, 0xfa, 0x50, 0x1f, 0x40, 0x28, 0xdd, 0xe2, 0x94, 0x6c,
-	0xfd, 0x0a, 0x88, 0x77, 0x4a, 0x2a, 0x0b, 0x52, 0x8b, 0x93, 0xd8, 0xc0, 0x86, 0x1b, 0x03, 0x02,
-	0x00, 0x00, 0xff, 0xff, 0xac, 0xe2, 0xe7, 0xb6, 0x53, 0x01, 0x00, 0x00,
+	// 373 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x7c, 0x92, 0xb1, 0x6e, 0xe2, 0x30,
+	0x18, 0xc7, 0x93, 0x83, 0x43, 0xa7, 0x70, 0x12, 0x77, 0x3e, 0xa4, 0xe3, 0x38, 0x29, 0x50, 0x54,
+	0xa9, 0x74, 0xa8, 0x5d, 0xe8, 0xd4, 0xb1, 0x30, 0x50, 0xa9, 0x4b, 0x95, 0x4a, 0x1d, 0xba, 0x20,
+	0x27, 0x18, 0x27, 0xa2, 0xc4, 0x51, 0x6c, 0x57, 0xcd, 0x5b, 0xf4, 0x59, 0xfa, 0x14, 0x8c, 0x8c,
+	0x9d, 0x50, 0x15, 0x5e, 0xa4, 0xc2, 0x36, 0x88, 0xb6, 0x51, 0xa7, 0x58, 0x7f, 0xff, 0xec, 0xdf,
+	0xf7, 0xe5, 0xb3, 0x73, 0x10, 0x30, 0x3e, 0x67, 0x1c, 0x61, 0x29, 0x42, 0xf4, 0xd0, 0xf3, 0x89,
+	0xc0, 0x3d, 0x44, 0x49, 0x4c, 0x78, 0xc4, 0x61, 0x92, 0x32, 0xc1, 0xc0, 0x1f, 0x8d, 0xc0, 0x0d,
+	0x02, 0x0d, 0xd2, 0xfc, 0x47, 0x19, 0xa3, 0xf7, 0x04, 0x29, 0xc4, 0x97, 0x53, 0x84, 0xe3, 0x4c,
+	0xf3, 0xcd, 0x3a, 0x65, 0x94, 0xa9, 0x25, 0xda, 0xac, 0x4c, 0xea, 0x16, 0x89, 0xd4, 0x95, 0x6a,
+	0xbf, 0xf3, 0x5c, 0x72, 0x7e, 0x8e, 0xb4, 0xf7, 0x46, 0x60, 0x41, 0xc0, 0xb9, 0x53, 0x49, 0x70,
+	0x8a, 0xe7, 0xbc, 0x61, 0xb7, 0xed, 0x6e, 0xb5, 0xff, 0x1f, 0x16, 0xd4, 0x01, 0xaf, 0x15, 0x32,
+	0x28, 0x2f, 0x56, 0x2d, 0xcb, 0x33, 0x07, 0xc0, 0xa9, 0xf3, 0x03, 0x07, 0x01, 0x93, 0xb1, 0xe0,
+	0x8d, 0x6f, 0xed, 0x52, 0xb7, 0xda, 0xaf, 0x43, 0x5d, 0x2f, 0xdc, 0xd6, 0x0b, 0x2f, 0xe2, 0xcc,
+	0xdb, 0x51, 0x60, 0xea, 0xfc, 0x4a, 0xa4, 0x3f, 0x23, 0xd9, 0x38, 0x8c, 0xb8, 0x60, 0x69, 0x44,
+	0x78, 0xa3, 0xa4, 0x4e, 0x76, 0x8a, 0xb5, 0xd2, 0xbf, 0x22, 0xd9, 0xa5, 0x62, 0xb3, 0xc1, 0xdf,
+	0x8d, 0x3d, 0x5f, 0xb5, 0x6a, 0xfb, 0x71, 0x44, 0xb8, 0x57, 0xd3, 0x97, 0xee, 0x02, 0x20, 0x9d,
+	0xba, 0xf1, 0x04, 0x21, 0x8e, 0x29, 0x19, 0x9b, 0x16, 0xcb, 0xaa, 0xc5, 0xa3, 0x2f, 0x5c, 0x43,
+	0xc5, 0x9b, 0x76, 0x9b, 0x46, 0x08, 0x3e, 0xef, 0x79, 0x40, 0x0b, 0xf6, 0x33, 0x70, 0xeb, 0xfc,
+	0xa6, 0x98, 0x8f, 0x53, 0x32, 0x95, 0xf1, 0x64, 0xeb, 0xfc, 0xae, 0x9c, 0x87, 0x85, 0xce, 0x11,
+	0xe6, 0x9e, 0x82, 0xdf, 0xfd, 0xdf, 0x1a, 0xfd, 0x10, 0x0f, 0x17, 0xb9, 0x6b, 0x2f, 0x73, 0xd7,
+	0x7e, 0xcd, 0x5d, 0xfb, 0x69, 0xed, 0x5a, 0xcb, 0xb5, 0x6b, 0xbd, 0xac, 0x5d, 0xeb, 0xee, 0x98,
+	0x46, 0x22, 0x94, 0x3e, 0x0c, 0xd8, 0x1c, 0x99, 0xc9, 0xeb, 0xcf, 0x09, 0x9f, 0xcc, 0xd0, 0xa3,
+	0x7e, 0x06, 0x22, 0x4b, 0x08, 0xf7, 0x2b, 0x6a, 0x26, 0x67, 0x6f, 0x03, 0x00, 0x3b, 0xff, 0xa2,
+	0xf9, 0x8b, 0x02, 0x00, 0x00,
 }
 
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -125,6 +160,40 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	{
+		size, err := m.GasRefundParams.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	{
+		size, err := m.PubKeyChangeParams.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	if len(m.PubKeyHistories) > 0 {
+		for iNdEx := len(m.PubKeyHistories) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.PubKeyHistories[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
 	if len(m.Accounts) > 0 {
 		for iNdEx := len(m.Accounts) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -177,6 +246,16 @@ func (m *GenesisState) Size() (n int) {
 			n += 1 + l + sovGenesis(uint64(l))
 		}
 	}
+	if len(m.PubKeyHistories) > 0 {
+		for _, e := range m.PubKeyHistories {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	l = m.PubKeyChangeParams.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	l = m.GasRefundParams.Size()
+	n += 1 + l + sovGenesis(uint64(l))
 	return n
 }
 
@@ -282,6 +361,106 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PubKeyHistories", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PubKeyHistories = append(m.PubKeyHistories, PubKeyHistory{})
+			if err := m.PubKeyHistories[len(m.PubKeyHistories)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PubKeyChangeParams", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.PubKeyChangeParams.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GasRefundParams", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.GasRefundParams.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
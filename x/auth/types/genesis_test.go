@@ -9,6 +9,7 @@ import (
 
 	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
 	"github.com/cosmos/cosmos-sdk/crypto/keys/ed25519"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/x/auth/types"
 )
@@ -50,6 +51,54 @@ func TestValidateGenesisDuplicateAccounts(t *testing.T) {
 	require.Error(t, types.ValidateGenAccounts(genAccs))
 }
 
+func TestValidateGenesisChangedPubKeys(t *testing.T) {
+	oldPubKey := secp256k1.GenPrivKey().PubKey()
+	newPubKey := secp256k1.GenPrivKey().PubKey()
+	addr := sdk.AccAddress(oldPubKey.Address())
+
+	pkAny, err := codectypes.NewAnyWithValue(oldPubKey)
+	require.NoError(t, err)
+
+	genState := types.NewGenesisState(types.DefaultParams(), types.GenesisAccounts{types.NewBaseAccount(addr, newPubKey, 0, 0)})
+	require.Error(t, types.ValidateGenesis(*genState))
+
+	genState.PubKeyHistories = []types.PubKeyHistory{{
+		Address: addr.String(),
+		Entries: []types.PubKeyHistoryEntry{{PubKey: pkAny, ReplacedAtHeight: 10}},
+	}}
+	require.NoError(t, types.ValidateGenesis(*genState))
+
+	genState.PubKeyHistories[0].Entries = append(genState.PubKeyHistories[0].Entries, genState.PubKeyHistories[0].Entries[0])
+	require.Error(t, types.ValidateGenesis(*genState))
+
+	genState.PubKeyHistories[0].Entries = genState.PubKeyHistories[0].Entries[:1]
+	genState.PubKeyHistories[0].Address = "invalid"
+	require.Error(t, types.ValidateGenesis(*genState))
+}
+
+func TestValidateGenesisWithoutGasRefundParams(t *testing.T) {
+	// an auth genesis exported before the gas refund params were added
+	bz := []byte(`{
+		"params": {
+			"max_memo_characters": "256",
+			"tx_sig_limit": "7",
+			"tx_size_cost_per_byte": "10",
+			"sig_verify_cost_ed25519": "590",
+			"sig_verify_cost_secp256k1": "1000"
+		},
+		"accounts": []
+	}`)
+
+	var genState types.GenesisState
+	require.NoError(t, appCodec.UnmarshalJSON(bz, &genState))
+	require.True(t, genState.GasRefundParams.RefundRatio.IsNil())
+	require.NoError(t, types.ValidateGenesis(genState))
+	require.Equal(t, types.DefaultGasRefundParams(), genState.GetGasRefundParamsOrDefault())
+
+	genState.GasRefundParams = types.NewGasRefundParams(sdk.NewDec(2))
+	require.Error(t, types.ValidateGenesis(genState))
+}
+
 func TestGenesisAccountIterator(t *testing.T) {
 	acc1 := types.NewBaseAccountWithAddress(sdk.AccAddress(addr1))
 	acc2 := types.NewBaseAccountWithAddress(sdk.AccAddress(addr2))
@@ -1,7 +1,10 @@
 package types
 
 import (
+	"time"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/address"
 )
 
 const (
@@ -16,6 +19,9 @@ const (
 
 	// QuerierRoute is the querier route for auth
 	QuerierRoute = ModuleName
+
+	// RouterKey defines the module's message routing key
+	RouterKey = ModuleName
 )
 
 var (
@@ -27,6 +33,18 @@ var (
 
 	// AccountNumberStoreKeyPrefix prefix for account-by-id store
 	AccountNumberStoreKeyPrefix = []byte("accountNumber")
+
+	// UnorderedTxStoreKeyPrefix prefix for the unordered tx hashes store,
+	// indexed by timeout height
+	UnorderedTxStoreKeyPrefix = []byte{0x02}
+
+	// PubKeyHistoryStoreKeyPrefix prefix for the replaced public keys store,
+	// indexed by account address and replacement height
+	PubKeyHistoryStoreKeyPrefix = []byte{0x03}
+
+	// UnorderedTxByTimestampStoreKeyPrefix prefix for the hashes store of the
+	// unordered txs without a timeout height, indexed by timeout timestamp
+	UnorderedTxByTimestampStoreKeyPrefix = []byte{0x04}
 )
 
 // AddressStoreKey turn an address to key used to get it from the account store
@@ -38,3 +56,27 @@ func AddressStoreKey(addr sdk.AccAddress) []byte {
 func AccountNumberStoreKey(accountNumber uint64) []byte {
 	return append(AccountNumberStoreKeyPrefix, sdk.Uint64ToBigEndian(accountNumber)...)
 }
+
+// UnorderedTxStoreKey turn the timeout height and the hash of an unordered tx
+// to key used to deduplicate it
+func UnorderedTxStoreKey(timeoutHeight uint64, txHash []byte) []byte {
+	return append(append(UnorderedTxStoreKeyPrefix, sdk.Uint64ToBigEndian(timeoutHeight)...), txHash...)
+}
+
+// UnorderedTxByTimestampStoreKey turn the timeout timestamp and the hash of an
+// unordered tx without a timeout height to key used to deduplicate it
+func UnorderedTxByTimestampStoreKey(timeoutTimestamp time.Time, txHash []byte) []byte {
+	return append(append(UnorderedTxByTimestampStoreKeyPrefix, sdk.FormatTimeBytes(timeoutTimestamp)...), txHash...)
+}
+
+// PubKeyHistoryPrefix turn an address to the prefix of the keys used to get
+// the public keys it replaced
+func PubKeyHistoryPrefix(addr sdk.AccAddress) []byte {
+	return append(PubKeyHistoryStoreKeyPrefix, address.MustLengthPrefix(addr)...)
+}
+
+// PubKeyHistoryStoreKey turn an address and a block height to key used to get
+// the public key the address replaced at that height
+func PubKeyHistoryStoreKey(addr sdk.AccAddress, height int64) []byte {
+	return append(PubKeyHistoryPrefix(addr), sdk.Uint64ToBigEndian(uint64(height))...)
+}
@@ -0,0 +1,111 @@
+package types
+
+import (
+	"github.com/cosmos/cosmos-sdk/codec/legacy"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/x/auth/migrations/legacytx"
+)
+
+// TypeMsgChangePubKey defines the type value for a MsgChangePubKey.
+const TypeMsgChangePubKey = "change_pubkey"
+
+var (
+	_ sdk.Msg                            = &MsgChangePubKey{}
+	_ legacytx.LegacyMsg                 = &MsgChangePubKey{}
+	_ codectypes.UnpackInterfacesMessage = (*MsgChangePubKey)(nil)
+)
+
+// NewMsgChangePubKey returns a reference to a new MsgChangePubKey. The
+// signature is the signature of the new public key over ChangePubKeySignBytes.
+//
+//nolint:interfacer
+func NewMsgChangePubKey(addr sdk.AccAddress, pubKey cryptotypes.PubKey, signature []byte) (*MsgChangePubKey, error) {
+	pkAny, err := codectypes.NewAnyWithValue(pubKey)
+	if err != nil {
+		return nil, err
+	}
+
+	return &MsgChangePubKey{
+		Address:   addr.String(),
+		PubKey:    pkAny,
+		Signature: signature,
+	}, nil
+}
+
+// changePubKeySignDoc is the document signed by the new public key of a
+// MsgChangePubKey.
+type changePubKeySignDoc struct {
+	AccountNumber uint64 `json:"account_number" yaml:"account_number"`
+	Address       string `json:"address" yaml:"address"`
+	Type          string `json:"type" yaml:"type"`
+}
+
+// ChangePubKeySignBytes returns the bytes the new public key of a
+// MsgChangePubKey must sign for the account with the given address and account
+// number. The account number differs if the account is removed and created
+// again at the same address, so the signature cannot be replayed for it.
+func ChangePubKeySignBytes(addr sdk.AccAddress, accNum uint64) []byte {
+	return sdk.MustSortJSON(legacy.Cdc.MustMarshalJSON(changePubKeySignDoc{
+		AccountNumber: accNum,
+		Address:       addr.String(),
+		Type:          TypeMsgChangePubKey,
+	}))
+}
+
+// Route returns the message route for a MsgChangePubKey.
+func (msg MsgChangePubKey) Route() string { return RouterKey }
+
+// Type returns the message type for a MsgChangePubKey.
+func (msg MsgChangePubKey) Type() string { return TypeMsgChangePubKey }
+
+// ValidateBasic Implements Msg.
+func (msg MsgChangePubKey) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Address); err != nil {
+		return sdkerrors.ErrInvalidAddress.Wrapf("invalid address: %s", err)
+	}
+
+	if msg.PubKey == nil {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidPubKey, "empty pubkey")
+	}
+
+	if _, ok := msg.PubKey.GetCachedValue().(cryptotypes.PubKey); !ok {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidPubKey, "expecting cryptotypes.PubKey, got %T", msg.PubKey.GetCachedValue())
+	}
+
+	if len(msg.Signature) == 0 {
+		return sdkerrors.Wrap(sdkerrors.ErrNoSignatures, "empty signature of the new pubkey")
+	}
+
+	return nil
+}
+
+// GetSignBytes returns the bytes all expected signers must sign over for a
+// MsgChangePubKey.
+func (msg MsgChangePubKey) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&msg))
+}
+
+// GetSigners returns the expected signers for a MsgChangePubKey.
+func (msg MsgChangePubKey) GetSigners() []sdk.AccAddress {
+	addr, _ := sdk.AccAddressFromBech32(msg.Address)
+	return []sdk.AccAddress{addr}
+}
+
+// GetPubKey returns the new public key of the account.
+func (msg MsgChangePubKey) GetPubKey() (cryptotypes.PubKey, error) {
+	pk, ok := msg.PubKey.GetCachedValue().(cryptotypes.PubKey)
+	if !ok {
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrInvalidType, "expecting cryptotypes.PubKey, got %T", msg.PubKey.GetCachedValue())
+	}
+
+	return pk, nil
+}
+
+// UnpackInterfaces implements UnpackInterfacesMessage.UnpackInterfaces
+func (msg MsgChangePubKey) UnpackInterfaces(unpacker codectypes.AnyUnpacker) error {
+	var pubKey cryptotypes.PubKey
+	return unpacker.UnpackAny(msg.PubKey, &pubKey)
+}
@@ -2,9 +2,11 @@ package types
 
 import (
 	"fmt"
+	"time"
 
 	"sigs.k8s.io/yaml"
 
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
 )
 
@@ -15,6 +17,8 @@ const (
 	DefaultTxSizeCostPerByte      uint64 = 10
 	DefaultSigVerifyCostED25519   uint64 = 590
 	DefaultSigVerifyCostSecp256k1 uint64 = 1000
+	DefaultPubKeyChangeCooldown          = 24 * time.Hour
+	DefaultPubKeyChangeCost       uint64 = 10000
 )
 
 // Parameter keys
@@ -24,9 +28,22 @@ var (
 	KeyTxSizeCostPerByte      = []byte("TxSizeCostPerByte")
 	KeySigVerifyCostED25519   = []byte("SigVerifyCostED25519")
 	KeySigVerifyCostSecp256k1 = []byte("SigVerifyCostSecp256k1")
+	KeyPubKeyChangeCooldown   = []byte("PubKeyChangeCooldown")
+	KeyPubKeyChangeCost       = []byte("PubKeyChangeCost")
+	KeyGasRefundRatio         = []byte("GasRefundRatio")
 )
 
-var _ paramtypes.ParamSet = &Params{}
+// MaxGasRefundRatio is the max ratio of the gas refunds. Blocks are filled by
+// the gas wanted by their txs, and the fee market adjusts the base fee from the
+// gas they use, so refunding most of the unused gas would let txs wanting a lot
+// of gas fill the blocks almost for free without raising the base fee.
+var MaxGasRefundRatio = sdk.NewDecWithPrec(5, 1)
+
+var (
+	_ paramtypes.ParamSet = &Params{}
+	_ paramtypes.ParamSet = &PubKeyChangeParams{}
+	_ paramtypes.ParamSet = &GasRefundParams{}
+)
 
 // NewParams creates a new Params object
 func NewParams(
@@ -43,7 +60,10 @@ func NewParams(
 
 // ParamKeyTable for auth module
 func ParamKeyTable() paramtypes.KeyTable {
-	return paramtypes.NewKeyTable().RegisterParamSet(&Params{})
+	return paramtypes.NewKeyTable().
+		RegisterParamSet(&Params{}).
+		RegisterParamSet(&PubKeyChangeParams{}).
+		RegisterParamSet(&GasRefundParams{})
 }
 
 // ParamSetPairs implements the ParamSet interface and returns all the key/value pairs
@@ -152,6 +172,40 @@ func validateTxSizeCostPerByte(i interface{}) error {
 	return nil
 }
 
+func validatePubKeyChangeCooldown(i interface{}) error {
+	v, ok := i.(time.Duration)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if v < 0 {
+		return fmt.Errorf("pubkey change cooldown cannot be negative: %s", v)
+	}
+
+	return nil
+}
+
+func validatePubKeyChangeCost(i interface{}) error {
+	if _, ok := i.(uint64); !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	return nil
+}
+
+func validateGasRefundRatio(i interface{}) error {
+	v, ok := i.(sdk.Dec)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if v.IsNil() || v.IsNegative() || v.GT(MaxGasRefundRatio) {
+		return fmt.Errorf("gas refund ratio must be between 0 and %s: %s", MaxGasRefundRatio, v)
+	}
+
+	return nil
+}
+
 // Validate checks that the parameters have valid values.
 func (p Params) Validate() error {
 	if err := validateTxSigLimit(p.TxSigLimit); err != nil {
@@ -172,3 +226,68 @@ func (p Params) Validate() error {
 
 	return nil
 }
+
+// NewPubKeyChangeParams creates a new PubKeyChangeParams object
+func NewPubKeyChangeParams(cooldown time.Duration, cost uint64) PubKeyChangeParams {
+	return PubKeyChangeParams{
+		Cooldown: cooldown,
+		Cost:     cost,
+	}
+}
+
+// DefaultPubKeyChangeParams returns the default parameters of MsgChangePubKey.
+func DefaultPubKeyChangeParams() PubKeyChangeParams {
+	return NewPubKeyChangeParams(DefaultPubKeyChangeCooldown, DefaultPubKeyChangeCost)
+}
+
+// ParamSetPairs implements the ParamSet interface and returns the key/value
+// pairs of the parameters of MsgChangePubKey.
+func (p *PubKeyChangeParams) ParamSetPairs() paramtypes.ParamSetPairs {
+	return paramtypes.ParamSetPairs{
+		paramtypes.NewParamSetPair(KeyPubKeyChangeCooldown, &p.Cooldown, validatePubKeyChangeCooldown),
+		paramtypes.NewParamSetPair(KeyPubKeyChangeCost, &p.Cost, validatePubKeyChangeCost),
+	}
+}
+
+// String implements the stringer interface.
+func (p PubKeyChangeParams) String() string {
+	out, _ := yaml.Marshal(p)
+	return string(out)
+}
+
+// Validate checks that the parameters have valid values.
+func (p PubKeyChangeParams) Validate() error {
+	return validatePubKeyChangeCooldown(p.Cooldown)
+}
+
+// NewGasRefundParams creates a new GasRefundParams object
+func NewGasRefundParams(refundRatio sdk.Dec) GasRefundParams {
+	return GasRefundParams{
+		RefundRatio: refundRatio,
+	}
+}
+
+// DefaultGasRefundParams returns the default parameters of the gas refunds,
+// which disable them.
+func DefaultGasRefundParams() GasRefundParams {
+	return NewGasRefundParams(sdk.ZeroDec())
+}
+
+// ParamSetPairs implements the ParamSet interface and returns the key/value
+// pairs of the parameters of the gas refunds.
+func (p *GasRefundParams) ParamSetPairs() paramtypes.ParamSetPairs {
+	return paramtypes.ParamSetPairs{
+		paramtypes.NewParamSetPair(KeyGasRefundRatio, &p.RefundRatio, validateGasRefundRatio),
+	}
+}
+
+// String implements the stringer interface.
+func (p GasRefundParams) String() string {
+	out, _ := yaml.Marshal(p)
+	return string(out)
+}
+
+// Validate checks that the parameters have valid values.
+func (p GasRefundParams) Validate() error {
+	return validateGasRefundRatio(p.RefundRatio)
+}
@@ -3,9 +3,11 @@ package types_test
 import (
 	"fmt"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/require"
 
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/x/auth/types"
 )
 
@@ -48,3 +50,22 @@ func TestParams_Validate(t *testing.T) {
 		})
 	}
 }
+
+func TestPubKeyChangeParams_Validate(t *testing.T) {
+	require.NoError(t, types.DefaultPubKeyChangeParams().Validate())
+	require.NoError(t, types.NewPubKeyChangeParams(0, 0).Validate())
+
+	err := types.NewPubKeyChangeParams(-time.Second, types.DefaultPubKeyChangeCost).Validate()
+	require.Equal(t, fmt.Errorf("pubkey change cooldown cannot be negative: -1s"), err)
+}
+
+func TestGasRefundParams_Validate(t *testing.T) {
+	require.NoError(t, types.DefaultGasRefundParams().Validate())
+	require.NoError(t, types.NewGasRefundParams(types.MaxGasRefundRatio).Validate())
+
+	err := types.NewGasRefundParams(sdk.NewDecWithPrec(51, 2)).Validate()
+	require.Equal(t, fmt.Errorf("gas refund ratio must be between 0 and 0.500000000000000000: 0.510000000000000000"), err)
+	require.Error(t, types.NewGasRefundParams(sdk.OneDec()).Validate())
+	require.Error(t, types.NewGasRefundParams(sdk.NewDec(-1)).Validate())
+	require.Error(t, types.GasRefundParams{}.Validate())
+}
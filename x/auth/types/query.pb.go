@@ -305,6 +305,172 @@ func (m *QueryParamsResponse) GetParams() Params {
 	return Params{}
 }
 
+// QueryPubKeyChangeParamsRequest is the request type for the Query/PubKeyChangeParams RPC method.
+type QueryPubKeyChangeParamsRequest struct {
+}
+
+func (m *QueryPubKeyChangeParamsRequest) Reset()         { *m = QueryPubKeyChangeParamsRequest{} }
+func (m *QueryPubKeyChangeParamsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryPubKeyChangeParamsRequest) ProtoMessage()    {}
+func (*QueryPubKeyChangeParamsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c451370b3929a27c, []int{6}
+}
+func (m *QueryPubKeyChangeParamsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryPubKeyChangeParamsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryPubKeyChangeParamsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryPubKeyChangeParamsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryPubKeyChangeParamsRequest.Merge(m, src)
+}
+func (m *QueryPubKeyChangeParamsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryPubKeyChangeParamsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryPubKeyChangeParamsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryPubKeyChangeParamsRequest proto.InternalMessageInfo
+
+// QueryPubKeyChangeParamsResponse is the response type for the Query/PubKeyChangeParams RPC method.
+type QueryPubKeyChangeParamsResponse struct {
+	// params defines the parameters of MsgChangePubKey.
+	Params PubKeyChangeParams `protobuf:"bytes,1,opt,name=params,proto3" json:"params"`
+}
+
+func (m *QueryPubKeyChangeParamsResponse) Reset()         { *m = QueryPubKeyChangeParamsResponse{} }
+func (m *QueryPubKeyChangeParamsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryPubKeyChangeParamsResponse) ProtoMessage()    {}
+func (*QueryPubKeyChangeParamsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c451370b3929a27c, []int{7}
+}
+func (m *QueryPubKeyChangeParamsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryPubKeyChangeParamsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryPubKeyChangeParamsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryPubKeyChangeParamsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryPubKeyChangeParamsResponse.Merge(m, src)
+}
+func (m *QueryPubKeyChangeParamsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryPubKeyChangeParamsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryPubKeyChangeParamsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryPubKeyChangeParamsResponse proto.InternalMessageInfo
+
+func (m *QueryPubKeyChangeParamsResponse) GetParams() PubKeyChangeParams {
+	if m != nil {
+		return m.Params
+	}
+	return PubKeyChangeParams{}
+}
+
+// QueryGasRefundParamsRequest is the request type for the Query/GasRefundParams RPC method.
+type QueryGasRefundParamsRequest struct {
+}
+
+func (m *QueryGasRefundParamsRequest) Reset()         { *m = QueryGasRefundParamsRequest{} }
+func (m *QueryGasRefundParamsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryGasRefundParamsRequest) ProtoMessage()    {}
+func (*QueryGasRefundParamsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c451370b3929a27c, []int{8}
+}
+func (m *QueryGasRefundParamsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryGasRefundParamsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryGasRefundParamsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryGasRefundParamsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryGasRefundParamsRequest.Merge(m, src)
+}
+func (m *QueryGasRefundParamsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryGasRefundParamsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryGasRefundParamsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryGasRefundParamsRequest proto.InternalMessageInfo
+
+// QueryGasRefundParamsResponse is the response type for the Query/GasRefundParams RPC method.
+type QueryGasRefundParamsResponse struct {
+	// params defines the parameters of the gas refunds.
+	Params GasRefundParams `protobuf:"bytes,1,opt,name=params,proto3" json:"params"`
+}
+
+func (m *QueryGasRefundParamsResponse) Reset()         { *m = QueryGasRefundParamsResponse{} }
+func (m *QueryGasRefundParamsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryGasRefundParamsResponse) ProtoMessage()    {}
+func (*QueryGasRefundParamsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c451370b3929a27c, []int{9}
+}
+func (m *QueryGasRefundParamsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryGasRefundParamsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryGasRefundParamsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryGasRefundParamsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryGasRefundParamsResponse.Merge(m, src)
+}
+func (m *QueryGasRefundParamsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryGasRefundParamsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryGasRefundParamsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryGasRefundParamsResponse proto.InternalMessageInfo
+
+func (m *QueryGasRefundParamsResponse) GetParams() GasRefundParams {
+	if m != nil {
+		return m.Params
+	}
+	return GasRefundParams{}
+}
+
 // QueryModuleAccountsRequest is the request type for the Query/ModuleAccounts RPC method.
 //
 // Since: cosmos-sdk 0.46
@@ -315,7 +481,7 @@ func (m *QueryModuleAccountsRequest) Reset()         { *m = QueryModuleAccountsR
 func (m *QueryModuleAccountsRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryModuleAccountsRequest) ProtoMessage()    {}
 func (*QueryModuleAccountsRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c451370b3929a27c, []int{6}
+	return fileDescriptor_c451370b3929a27c, []int{10}
 }
 func (m *QueryModuleAccountsRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -355,7 +521,7 @@ func (m *QueryModuleAccountsResponse) Reset()         { *m = QueryModuleAccounts
 func (m *QueryModuleAccountsResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryModuleAccountsResponse) ProtoMessage()    {}
 func (*QueryModuleAccountsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c451370b3929a27c, []int{7}
+	return fileDescriptor_c451370b3929a27c, []int{11}
 }
 func (m *QueryModuleAccountsResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -400,7 +566,7 @@ func (m *QueryModuleAccountByNameRequest) Reset()         { *m = QueryModuleAcco
 func (m *QueryModuleAccountByNameRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryModuleAccountByNameRequest) ProtoMessage()    {}
 func (*QueryModuleAccountByNameRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c451370b3929a27c, []int{8}
+	return fileDescriptor_c451370b3929a27c, []int{12}
 }
 func (m *QueryModuleAccountByNameRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -445,7 +611,7 @@ func (m *QueryModuleAccountByNameResponse) Reset()         { *m = QueryModuleAcc
 func (m *QueryModuleAccountByNameResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryModuleAccountByNameResponse) ProtoMessage()    {}
 func (*QueryModuleAccountByNameResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c451370b3929a27c, []int{9}
+	return fileDescriptor_c451370b3929a27c, []int{13}
 }
 func (m *QueryModuleAccountByNameResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -491,7 +657,7 @@ func (m *Bech32PrefixRequest) Reset()         { *m = Bech32PrefixRequest{} }
 func (m *Bech32PrefixRequest) String() string { return proto.CompactTextString(m) }
 func (*Bech32PrefixRequest) ProtoMessage()    {}
 func (*Bech32PrefixRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c451370b3929a27c, []int{10}
+	return fileDescriptor_c451370b3929a27c, []int{14}
 }
 func (m *Bech32PrefixRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -531,7 +697,7 @@ func (m *Bech32PrefixResponse) Reset()         { *m = Bech32PrefixResponse{} }
 func (m *Bech32PrefixResponse) String() string { return proto.CompactTextString(m) }
 func (*Bech32PrefixResponse) ProtoMessage()    {}
 func (*Bech32PrefixResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c451370b3929a27c, []int{11}
+	return fileDescriptor_c451370b3929a27c, []int{15}
 }
 func (m *Bech32PrefixResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -578,7 +744,7 @@ func (m *AddressBytesToStringRequest) Reset()         { *m = AddressBytesToStrin
 func (m *AddressBytesToStringRequest) String() string { return proto.CompactTextString(m) }
 func (*AddressBytesToStringRequest) ProtoMessage()    {}
 func (*AddressBytesToStringRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c451370b3929a27c, []int{12}
+	return fileDescriptor_c451370b3929a27c, []int{16}
 }
 func (m *AddressBytesToStringRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -625,7 +791,7 @@ func (m *AddressBytesToStringResponse) Reset()         { *m = AddressBytesToStri
 func (m *AddressBytesToStringResponse) String() string { return proto.CompactTextString(m) }
 func (*AddressBytesToStringResponse) ProtoMessage()    {}
 func (*AddressBytesToStringResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c451370b3929a27c, []int{13}
+	return fileDescriptor_c451370b3929a27c, []int{17}
 }
 func (m *AddressBytesToStringResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -672,7 +838,7 @@ func (m *AddressStringToBytesRequest) Reset()         { *m = AddressStringToByte
 func (m *AddressStringToBytesRequest) String() string { return proto.CompactTextString(m) }
 func (*AddressStringToBytesRequest) ProtoMessage()    {}
 func (*AddressStringToBytesRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c451370b3929a27c, []int{14}
+	return fileDescriptor_c451370b3929a27c, []int{18}
 }
 func (m *AddressStringToBytesRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -719,7 +885,7 @@ func (m *AddressStringToBytesResponse) Reset()         { *m = AddressStringToByt
 func (m *AddressStringToBytesResponse) String() string { return proto.CompactTextString(m) }
 func (*AddressStringToBytesResponse) ProtoMessage()    {}
 func (*AddressStringToBytesResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c451370b3929a27c, []int{15}
+	return fileDescriptor_c451370b3929a27c, []int{19}
 }
 func (m *AddressStringToBytesResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -769,7 +935,7 @@ func (m *QueryAccountAddressByIDRequest) Reset()         { *m = QueryAccountAddr
 func (m *QueryAccountAddressByIDRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryAccountAddressByIDRequest) ProtoMessage()    {}
 func (*QueryAccountAddressByIDRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c451370b3929a27c, []int{16}
+	return fileDescriptor_c451370b3929a27c, []int{20}
 }
 func (m *QueryAccountAddressByIDRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -816,7 +982,7 @@ func (m *QueryAccountAddressByIDResponse) Reset()         { *m = QueryAccountAdd
 func (m *QueryAccountAddressByIDResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryAccountAddressByIDResponse) ProtoMessage()    {}
 func (*QueryAccountAddressByIDResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c451370b3929a27c, []int{17}
+	return fileDescriptor_c451370b3929a27c, []int{21}
 }
 func (m *QueryAccountAddressByIDResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -852,6 +1018,102 @@ func (m *QueryAccountAddressByIDResponse) GetAccountAddress() string {
 	return ""
 }
 
+// QueryPubKeyHistoryRequest is the request type for the Query/PubKeyHistory RPC method.
+type QueryPubKeyHistoryRequest struct {
+	// address defines the address to query the public key history for.
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	// pagination defines an optional pagination for the request.
+	Pagination *query.PageRequest `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryPubKeyHistoryRequest) Reset()         { *m = QueryPubKeyHistoryRequest{} }
+func (m *QueryPubKeyHistoryRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryPubKeyHistoryRequest) ProtoMessage()    {}
+func (*QueryPubKeyHistoryRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c451370b3929a27c, []int{22}
+}
+func (m *QueryPubKeyHistoryRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryPubKeyHistoryRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryPubKeyHistoryRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryPubKeyHistoryRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryPubKeyHistoryRequest.Merge(m, src)
+}
+func (m *QueryPubKeyHistoryRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryPubKeyHistoryRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryPubKeyHistoryRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryPubKeyHistoryRequest proto.InternalMessageInfo
+
+// QueryPubKeyHistoryResponse is the response type for the Query/PubKeyHistory RPC method.
+type QueryPubKeyHistoryResponse struct {
+	// entries are the replaced public keys of the account, oldest first.
+	Entries []PubKeyHistoryEntry `protobuf:"bytes,1,rep,name=entries,proto3" json:"entries"`
+	// pagination defines the pagination in the response.
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryPubKeyHistoryResponse) Reset()         { *m = QueryPubKeyHistoryResponse{} }
+func (m *QueryPubKeyHistoryResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryPubKeyHistoryResponse) ProtoMessage()    {}
+func (*QueryPubKeyHistoryResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c451370b3929a27c, []int{23}
+}
+func (m *QueryPubKeyHistoryResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryPubKeyHistoryResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryPubKeyHistoryResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryPubKeyHistoryResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryPubKeyHistoryResponse.Merge(m, src)
+}
+func (m *QueryPubKeyHistoryResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryPubKeyHistoryResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryPubKeyHistoryResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryPubKeyHistoryResponse proto.InternalMessageInfo
+
+func (m *QueryPubKeyHistoryResponse) GetEntries() []PubKeyHistoryEntry {
+	if m != nil {
+		return m.Entries
+	}
+	return nil
+}
+
+func (m *QueryPubKeyHistoryResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*QueryAccountsRequest)(nil), "cosmos.auth.v1beta1.QueryAccountsRequest")
 	proto.RegisterType((*QueryAccountsResponse)(nil), "cosmos.auth.v1beta1.QueryAccountsResponse")
@@ -859,6 +1121,10 @@ func init() {
 	proto.RegisterType((*QueryAccountResponse)(nil), "cosmos.auth.v1beta1.QueryAccountResponse")
 	proto.RegisterType((*QueryParamsRequest)(nil), "cosmos.auth.v1beta1.QueryParamsRequest")
 	proto.RegisterType((*QueryParamsResponse)(nil), "cosmos.auth.v1beta1.QueryParamsResponse")
+	proto.RegisterType((*QueryPubKeyChangeParamsRequest)(nil), "cosmos.auth.v1beta1.QueryPubKeyChangeParamsRequest")
+	proto.RegisterType((*QueryPubKeyChangeParamsResponse)(nil), "cosmos.auth.v1beta1.QueryPubKeyChangeParamsResponse")
+	proto.RegisterType((*QueryGasRefundParamsRequest)(nil), "cosmos.auth.v1beta1.QueryGasRefundParamsRequest")
+	proto.RegisterType((*QueryGasRefundParamsResponse)(nil), "cosmos.auth.v1beta1.QueryGasRefundParamsResponse")
 	proto.RegisterType((*QueryModuleAccountsRequest)(nil), "cosmos.auth.v1beta1.QueryModuleAccountsRequest")
 	proto.RegisterType((*QueryModuleAccountsResponse)(nil), "cosmos.auth.v1beta1.QueryModuleAccountsResponse")
 	proto.RegisterType((*QueryModuleAccountByNameRequest)(nil), "cosmos.auth.v1beta1.QueryModuleAccountByNameRequest")
@@ -871,72 +1137,88 @@ func init() {
 	proto.RegisterType((*AddressStringToBytesResponse)(nil), "cosmos.auth.v1beta1.AddressStringToBytesResponse")
 	proto.RegisterType((*QueryAccountAddressByIDRequest)(nil), "cosmos.auth.v1beta1.QueryAccountAddressByIDRequest")
 	proto.RegisterType((*QueryAccountAddressByIDResponse)(nil), "cosmos.auth.v1beta1.QueryAccountAddressByIDResponse")
+	proto.RegisterType((*QueryPubKeyHistoryRequest)(nil), "cosmos.auth.v1beta1.QueryPubKeyHistoryRequest")
+	proto.RegisterType((*QueryPubKeyHistoryResponse)(nil), "cosmos.auth.v1beta1.QueryPubKeyHistoryResponse")
 }
 
 func init() { proto.RegisterFile("cosmos/auth/v1beta1/query.proto", fileDescriptor_c451370b3929a27c) }
 
 var fileDescriptor_c451370b3929a27c = []byte{
-	// 956 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x96, 0xcf, 0x4f, 0x1b, 0x47,
-	0x14, 0xc7, 0xbd, 0x24, 0x05, 0xfa, 0x70, 0xa8, 0x34, 0x38, 0x12, 0x5d, 0x88, 0x8d, 0x96, 0x26,
-	0x40, 0x1a, 0xef, 0x06, 0x43, 0x0e, 0xfd, 0xa1, 0x4a, 0x6c, 0x68, 0xab, 0x1c, 0x5a, 0x51, 0x87,
-	0x53, 0x0f, 0xb5, 0xc6, 0xde, 0x65, 0x59, 0x35, 0xde, 0x71, 0x3c, 0xeb, 0x2a, 0x16, 0xb2, 0x54,
-	0xf5, 0x94, 0x5b, 0x2b, 0xf5, 0x1f, 0xa0, 0xd7, 0x9e, 0x5a, 0x09, 0xa9, 0xff, 0x42, 0x94, 0x13,
-	0x6a, 0x2f, 0x3d, 0x55, 0x15, 0xf4, 0xd0, 0x3f, 0x23, 0xda, 0x99, 0x37, 0x8b, 0x17, 0xc6, 0xf6,
-	0x72, 0x62, 0x3d, 0xf3, 0xbe, 0xef, 0x7d, 0xde, 0xbc, 0x79, 0x6f, 0x80, 0x4a, 0x8b, 0xf1, 0x36,
-	0xe3, 0x0e, 0xed, 0xc5, 0x87, 0xce, 0x77, 0x9b, 0x4d, 0x3f, 0xa6, 0x9b, 0xce, 0xf3, 0x9e, 0xdf,
-	0xed, 0xdb, 0x9d, 0x2e, 0x8b, 0x19, 0x59, 0x90, 0x06, 0x76, 0x62, 0x60, 0xa3, 0x81, 0x79, 0x1f,
-	0x55, 0x4d, 0xca, 0x7d, 0x69, 0x9d, 0x6a, 0x3b, 0x34, 0x08, 0x23, 0x1a, 0x87, 0x2c, 0x92, 0x0e,
-	0xcc, 0x52, 0xc0, 0x02, 0x26, 0x3e, 0x9d, 0xe4, 0x0b, 0x57, 0xdf, 0x0d, 0x18, 0x0b, 0x9e, 0xf9,
-	0x8e, 0xf8, 0xd5, 0xec, 0x1d, 0x38, 0x34, 0xc2, 0x88, 0xe6, 0x32, 0x6e, 0xd1, 0x4e, 0xe8, 0xd0,
-	0x28, 0x62, 0xb1, 0xf0, 0xc6, 0x71, 0xb7, 0xac, 0x03, 0x16, 0x70, 0xe8, 0x58, 0xee, 0x37, 0x64,
-	0x44, 0x84, 0x17, 0x3f, 0xac, 0x6f, 0xa0, 0xf4, 0x55, 0xc2, 0xba, 0xd3, 0x6a, 0xb1, 0x5e, 0x14,
-	0xf3, 0xba, 0xff, 0xbc, 0xe7, 0xf3, 0x98, 0x7c, 0x06, 0x70, 0x41, 0xbd, 0x68, 0xac, 0x18, 0xeb,
-	0x73, 0xb5, 0x7b, 0x36, 0x4a, 0x93, 0x14, 0x6d, 0x79, 0x20, 0x18, 0xcd, 0xde, 0xa3, 0x81, 0x8f,
-	0xda, 0xfa, 0x90, 0xd2, 0x3a, 0x36, 0xe0, 0xf6, 0xa5, 0x00, 0xbc, 0xc3, 0x22, 0xee, 0x93, 0x4f,
-	0x60, 0x96, 0xe2, 0xda, 0xa2, 0xb1, 0x72, 0x63, 0x7d, 0xae, 0x56, 0xb2, 0x65, 0x96, 0xb6, 0x3a,
-	0x00, 0x7b, 0x27, 0xea, 0xbb, 0xc5, 0xd7, 0x27, 0xd5, 0x59, 0x54, 0x3f, 0xa9, 0xa7, 0x1a, 0xf2,
-	0x79, 0x86, 0x70, 0x4a, 0x10, 0xae, 0x4d, 0x24, 0x94, 0xc1, 0x33, 0x88, 0x4f, 0x61, 0x61, 0x98,
-	0x50, 0x9d, 0x40, 0x0d, 0x66, 0xa8, 0xe7, 0x75, 0x7d, 0xce, 0x45, 0xfa, 0x6f, 0xbb, 0x8b, 0x7f,
-	0x9e, 0x54, 0x4b, 0xe8, 0x7f, 0x47, 0xee, 0x3c, 0x8d, 0xbb, 0x61, 0x14, 0xd4, 0x95, 0xe1, 0x87,
-	0xb3, 0x2f, 0x8f, 0x2b, 0x85, 0xff, 0x8f, 0x2b, 0x05, 0x6b, 0x3f, 0x7b, 0xae, 0x69, 0xd6, 0x1f,
-	0xc3, 0x0c, 0x66, 0x80, 0x87, 0x9a, 0x27, 0x69, 0x25, 0xb1, 0x4a, 0x40, 0x84, 0xd7, 0x3d, 0xda,
-	0xa5, 0x6d, 0x55, 0x2b, 0x6b, 0x0f, 0x13, 0x50, 0xab, 0x18, 0xea, 0x03, 0x98, 0xee, 0x88, 0x15,
-	0x8c, 0xb4, 0x64, 0x6b, 0xae, 0xad, 0x2d, 0x45, 0xee, 0xcd, 0x57, 0xff, 0x54, 0x0a, 0x75, 0x14,
-	0x58, 0xcb, 0x60, 0x0a, 0x8f, 0x5f, 0x30, 0xaf, 0xf7, 0xcc, 0xbf, 0x74, 0x37, 0xac, 0x16, 0x2c,
-	0x69, 0x77, 0x31, 0xee, 0x6e, 0xce, 0xc2, 0x92, 0xd7, 0x27, 0xd5, 0xf9, 0x8c, 0x8f, 0xa1, 0xf2,
-	0x5a, 0x8f, 0xa0, 0x72, 0x35, 0x88, 0xdb, 0xff, 0x92, 0xb6, 0xd5, 0x3d, 0x23, 0x04, 0x6e, 0x46,
-	0xb4, 0xed, 0xcb, 0xf2, 0xd4, 0xc5, 0xb7, 0x75, 0x00, 0x2b, 0xa3, 0x65, 0x08, 0xe8, 0xe6, 0xab,
-	0x81, 0x8e, 0x2f, 0xad, 0xc4, 0x6d, 0x58, 0x70, 0xfd, 0xd6, 0xe1, 0x56, 0x6d, 0xaf, 0xeb, 0x1f,
-	0x84, 0x2f, 0xd4, 0xd1, 0x7c, 0x04, 0xa5, 0xec, 0x32, 0x86, 0x5c, 0x85, 0x5b, 0x4d, 0xb1, 0xde,
-	0xe8, 0x88, 0x0d, 0x64, 0x2e, 0x36, 0x87, 0x8c, 0x2d, 0x17, 0x96, 0xf0, 0x5e, 0xb9, 0xfd, 0xd8,
-	0xe7, 0xfb, 0x0c, 0xaf, 0x17, 0xa6, 0xbb, 0x0a, 0xb7, 0xf0, 0x9e, 0x35, 0x9a, 0xc9, 0xbe, 0xf0,
-	0x51, 0xac, 0x17, 0xe9, 0x90, 0xc6, 0xfa, 0x14, 0x96, 0xf5, 0x3e, 0x10, 0xe4, 0x2e, 0xcc, 0x2b,
-	0x27, 0x5c, 0xec, 0x20, 0x89, 0x72, 0x2d, 0xcd, 0xad, 0xdd, 0x14, 0x45, 0x2e, 0xec, 0x33, 0xe1,
-	0x4e, 0xa1, 0xe4, 0xf4, 0xf2, 0x38, 0x85, 0xb9, 0xe4, 0xe5, 0xe2, 0x54, 0x26, 0x67, 0xf4, 0x10,
-	0xca, 0xc3, 0x9d, 0x94, 0x66, 0xf7, 0x64, 0x57, 0xd1, 0xcc, 0xc3, 0x54, 0xe8, 0x09, 0xed, 0x8d,
-	0xfa, 0x54, 0xe8, 0x59, 0x1e, 0x5e, 0x1d, 0x9d, 0x02, 0x23, 0xef, 0xc0, 0x3b, 0x58, 0xc9, 0x46,
-	0xde, 0x26, 0x9f, 0xa7, 0x19, 0x77, 0xb5, 0x5f, 0xe7, 0xe0, 0x2d, 0x11, 0x86, 0xbc, 0x34, 0x40,
-	0xf5, 0x2a, 0x27, 0x1b, 0xda, 0x2e, 0xd3, 0xcd, 0x58, 0xf3, 0x7e, 0x1e, 0x53, 0x09, 0x6c, 0xdd,
-	0xfd, 0xe1, 0xaf, 0xff, 0x7e, 0x9e, 0xaa, 0x90, 0x3b, 0x8e, 0x76, 0xd6, 0xab, 0xe8, 0x3f, 0x1a,
-	0x30, 0x83, 0x5a, 0xb2, 0x3e, 0xd1, 0xbd, 0x02, 0xd9, 0xc8, 0x61, 0x89, 0x1c, 0x8e, 0xe0, 0xd8,
-	0x20, 0x6b, 0x63, 0x39, 0x9c, 0x23, 0x3c, 0xd5, 0x01, 0xf9, 0xcd, 0x00, 0x72, 0xb5, 0x10, 0x64,
-	0x6b, 0x62, 0xc8, 0xab, 0x85, 0x36, 0xb7, 0xaf, 0x27, 0xca, 0x87, 0x9c, 0x5e, 0xc0, 0x46, 0xe8,
-	0x39, 0x47, 0xa1, 0x37, 0x20, 0xdf, 0x1b, 0x30, 0x2d, 0xc7, 0x22, 0x59, 0x1b, 0x1d, 0x31, 0x33,
-	0x83, 0xcd, 0xf5, 0xc9, 0x86, 0x88, 0xb3, 0x2a, 0x70, 0xee, 0x90, 0x25, 0x2d, 0x8e, 0x1c, 0xc0,
-	0xe4, 0x17, 0x03, 0xb2, 0xa3, 0x87, 0x13, 0x67, 0x74, 0x04, 0xed, 0x98, 0x36, 0x1f, 0xe6, 0x17,
-	0x20, 0xda, 0x03, 0x81, 0x76, 0x8f, 0xbc, 0xa7, 0x45, 0x6b, 0x0b, 0x51, 0x23, 0xbd, 0x6b, 0x7f,
-	0x18, 0xb0, 0xa0, 0x19, 0xb3, 0x64, 0x3b, 0x67, 0xdc, 0xcc, 0x30, 0x37, 0x1f, 0x5d, 0x53, 0x85,
-	0xc8, 0x5b, 0x02, 0xb9, 0x4a, 0xde, 0xcf, 0x83, 0xec, 0x1c, 0x25, 0x6f, 0xc4, 0x20, 0x69, 0xd8,
-	0xe2, 0xf0, 0x98, 0x1e, 0xd1, 0x2a, 0x9a, 0x01, 0x3f, 0xa2, 0x55, 0x74, 0x33, 0x7f, 0x42, 0xa1,
-	0xe5, 0xe4, 0x4f, 0xda, 0xa3, 0xa4, 0x1b, 0xd8, 0x44, 0x5f, 0xbd, 0x31, 0xef, 0x83, 0xb9, 0x79,
-	0x0d, 0x45, 0xae, 0xd3, 0x93, 0x88, 0x69, 0x2f, 0xcb, 0x19, 0x3d, 0x20, 0xbf, 0x5f, 0x20, 0x67,
-	0xc6, 0xfa, 0x78, 0x64, 0xdd, 0x3b, 0x32, 0x1e, 0x59, 0xfb, 0x66, 0x58, 0xdb, 0x02, 0xd9, 0x26,
-	0x0f, 0x72, 0x21, 0xcb, 0xd7, 0x69, 0xe0, 0x3e, 0x7e, 0x75, 0x56, 0x36, 0x4e, 0xcf, 0xca, 0xc6,
-	0xbf, 0x67, 0x65, 0xe3, 0xa7, 0xf3, 0x72, 0xe1, 0xf4, 0xbc, 0x5c, 0xf8, 0xfb, 0xbc, 0x5c, 0xf8,
-	0x7a, 0x23, 0x08, 0xe3, 0xc3, 0x5e, 0xd3, 0x6e, 0xb1, 0xb6, 0xf2, 0x28, 0xff, 0x54, 0xb9, 0xf7,
-	0xad, 0xf3, 0x42, 0xba, 0x8f, 0xfb, 0x1d, 0x9f, 0x37, 0xa7, 0xc5, 0xbf, 0x07, 0x5b, 0x6f, 0x02,
-	0x00, 0x00, 0xff, 0xff, 0xfd, 0xbf, 0xbf, 0x8e, 0x20, 0x0c, 0x00, 0x00,
+	// 1174 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x96, 0xcf, 0x4f, 0x1b, 0x47,
+	0x14, 0xc7, 0xbd, 0x24, 0x05, 0xfa, 0xc2, 0x0f, 0x69, 0x70, 0x24, 0xb2, 0x80, 0x8d, 0x96, 0x04,
+	0x70, 0x12, 0xef, 0x62, 0x7e, 0x1c, 0xfa, 0x43, 0x95, 0x30, 0x10, 0x1a, 0x55, 0xad, 0xa8, 0xc3,
+	0xa9, 0x87, 0x5a, 0xb3, 0xf6, 0x60, 0xaf, 0x12, 0xef, 0x3a, 0xfb, 0xa3, 0x8a, 0x85, 0x90, 0xaa,
+	0x9e, 0x72, 0x6b, 0xa5, 0xfe, 0x03, 0xe4, 0xd2, 0xde, 0xaa, 0x36, 0x42, 0xea, 0xbf, 0x10, 0xe5,
+	0x14, 0xb5, 0x97, 0x9e, 0xaa, 0x0a, 0x7a, 0xe8, 0x9f, 0x51, 0x79, 0xe6, 0xed, 0xe2, 0x35, 0x63,
+	0x7b, 0xdd, 0xe6, 0x84, 0x99, 0x79, 0xdf, 0xf7, 0x3e, 0xf3, 0x76, 0xe6, 0xbd, 0x07, 0xd9, 0x8a,
+	0xe3, 0x35, 0x1c, 0xcf, 0xa0, 0x81, 0x5f, 0x37, 0xbe, 0x2a, 0x98, 0xcc, 0xa7, 0x05, 0xe3, 0x69,
+	0xc0, 0xdc, 0x96, 0xde, 0x74, 0x1d, 0xdf, 0x21, 0x33, 0xc2, 0x40, 0x6f, 0x1b, 0xe8, 0x68, 0xa0,
+	0xde, 0x45, 0x95, 0x49, 0x3d, 0x26, 0xac, 0x23, 0x6d, 0x93, 0xd6, 0x2c, 0x9b, 0xfa, 0x96, 0x63,
+	0x0b, 0x07, 0x6a, 0xba, 0xe6, 0xd4, 0x1c, 0xfe, 0xd3, 0x68, 0xff, 0xc2, 0xd5, 0x5b, 0x35, 0xc7,
+	0xa9, 0x3d, 0x61, 0x06, 0xff, 0xcf, 0x0c, 0x8e, 0x0c, 0x6a, 0x63, 0x44, 0x75, 0x1e, 0xb7, 0x68,
+	0xd3, 0x32, 0xa8, 0x6d, 0x3b, 0x3e, 0xf7, 0xe6, 0xe1, 0x6e, 0x46, 0x06, 0xcc, 0xe1, 0xd0, 0xb1,
+	0xd8, 0x2f, 0x8b, 0x88, 0x08, 0xcf, 0xff, 0xd1, 0xbe, 0x84, 0xf4, 0xe7, 0x6d, 0xd6, 0xed, 0x4a,
+	0xc5, 0x09, 0x6c, 0xdf, 0x2b, 0xb1, 0xa7, 0x01, 0xf3, 0x7c, 0xf2, 0x00, 0xe0, 0x92, 0x7a, 0x56,
+	0x59, 0x54, 0x56, 0x6f, 0xac, 0x2f, 0xeb, 0x28, 0x6d, 0x1f, 0x51, 0x17, 0x09, 0xc1, 0x68, 0xfa,
+	0x01, 0xad, 0x31, 0xd4, 0x96, 0x3a, 0x94, 0xda, 0xa9, 0x02, 0x37, 0xbb, 0x02, 0x78, 0x4d, 0xc7,
+	0xf6, 0x18, 0xf9, 0x08, 0xc6, 0x29, 0xae, 0xcd, 0x2a, 0x8b, 0xd7, 0x56, 0x6f, 0xac, 0xa7, 0x75,
+	0x71, 0x4a, 0x3d, 0x4c, 0x80, 0xbe, 0x6d, 0xb7, 0x8a, 0x13, 0xaf, 0xcf, 0xf2, 0xe3, 0xa8, 0x7e,
+	0x58, 0x8a, 0x34, 0x64, 0x3f, 0x46, 0x38, 0xc2, 0x09, 0x57, 0x06, 0x12, 0x8a, 0xe0, 0x31, 0xc4,
+	0x47, 0x30, 0xd3, 0x49, 0x18, 0x66, 0x60, 0x1d, 0xc6, 0x68, 0xb5, 0xea, 0x32, 0xcf, 0xe3, 0xc7,
+	0x7f, 0xb7, 0x38, 0xfb, 0xdb, 0x59, 0x3e, 0x8d, 0xfe, 0xb7, 0xc5, 0xce, 0x23, 0xdf, 0xb5, 0xec,
+	0x5a, 0x29, 0x34, 0x7c, 0x7f, 0xfc, 0xf9, 0x69, 0x36, 0xf5, 0xcf, 0x69, 0x36, 0xa5, 0x1d, 0xc6,
+	0xf3, 0x1a, 0x9d, 0xfa, 0x43, 0x18, 0xc3, 0x13, 0x60, 0x52, 0x93, 0x1c, 0x3a, 0x94, 0x68, 0x69,
+	0x20, 0xdc, 0xeb, 0x01, 0x75, 0x69, 0x23, 0xfc, 0x56, 0xda, 0x01, 0xcc, 0xc4, 0x56, 0x31, 0xd4,
+	0x7b, 0x30, 0xda, 0xe4, 0x2b, 0x18, 0x69, 0x4e, 0x97, 0x5c, 0x5b, 0x5d, 0x88, 0x8a, 0xd7, 0x5f,
+	0xfd, 0x99, 0x4d, 0x95, 0x50, 0xa0, 0x2d, 0x42, 0x46, 0x78, 0x0c, 0xcc, 0x4f, 0x58, 0x6b, 0xa7,
+	0x4e, 0xed, 0x1a, 0x8b, 0xc7, 0xac, 0x43, 0xb6, 0xa7, 0x05, 0xc6, 0xdf, 0xeb, 0x8a, 0xbf, 0x22,
+	0x8f, 0x7f, 0xc5, 0x41, 0x17, 0xcb, 0x02, 0xcc, 0xf1, 0x48, 0xfb, 0xd4, 0x2b, 0xb1, 0xa3, 0xc0,
+	0xae, 0xc6, 0x41, 0x4c, 0x98, 0x97, 0x6f, 0x23, 0x45, 0xb1, 0x8b, 0xe2, 0xb6, 0x94, 0xa2, 0x4b,
+	0xdd, 0x85, 0x30, 0x0f, 0x2a, 0x8f, 0xf1, 0xa9, 0x53, 0x0d, 0x9e, 0xb0, 0xae, 0xa7, 0xa2, 0x55,
+	0x60, 0x4e, 0xba, 0x8b, 0x00, 0xbb, 0x09, 0xef, 0x39, 0x79, 0x7d, 0x96, 0x9f, 0x8a, 0xf9, 0xe8,
+	0xb8, 0xed, 0xda, 0x16, 0xe6, 0x3b, 0x66, 0x50, 0x6c, 0x7d, 0x46, 0x1b, 0xe1, 0xb3, 0x23, 0x04,
+	0xae, 0xdb, 0xb4, 0xc1, 0xc4, 0x6d, 0x2d, 0xf1, 0xdf, 0xda, 0x11, 0x2c, 0xf6, 0x96, 0x45, 0x19,
+	0x4a, 0x74, 0x25, 0x65, 0x7c, 0xd1, 0xc5, 0xbc, 0x09, 0x33, 0x45, 0x56, 0xa9, 0x6f, 0xac, 0x1f,
+	0xb8, 0xec, 0xc8, 0x7a, 0x16, 0xa6, 0xe6, 0x03, 0x48, 0xc7, 0x97, 0x31, 0xe4, 0x12, 0x4c, 0x9a,
+	0x7c, 0xbd, 0xdc, 0xe4, 0x1b, 0xc8, 0x3c, 0x61, 0x76, 0x18, 0x6b, 0x45, 0x98, 0xc3, 0x67, 0x56,
+	0x6c, 0xf9, 0xcc, 0x3b, 0x74, 0xf0, 0xb5, 0xe1, 0x71, 0x97, 0x60, 0x12, 0x9f, 0x5d, 0xd9, 0x6c,
+	0xef, 0x73, 0x1f, 0x13, 0xa5, 0x09, 0xda, 0xa1, 0xd1, 0xf6, 0x60, 0x5e, 0xee, 0x03, 0x41, 0xee,
+	0xc0, 0x54, 0xe8, 0xc4, 0xe3, 0x3b, 0x48, 0x12, 0xba, 0x16, 0xe6, 0xda, 0x6e, 0x84, 0x22, 0x16,
+	0x0e, 0x1d, 0xee, 0x2e, 0x44, 0x49, 0xe8, 0x65, 0x27, 0x82, 0xe9, 0xf2, 0x72, 0x99, 0x95, 0xc1,
+	0x27, 0x5a, 0xc3, 0xa7, 0x89, 0xdf, 0x20, 0x3a, 0xdd, 0xc3, 0xdd, 0x90, 0x66, 0x0a, 0x46, 0xac,
+	0x2a, 0xd7, 0x5e, 0x2b, 0x8d, 0x58, 0x55, 0xad, 0x0a, 0xd9, 0x9e, 0x0a, 0x8c, 0xbc, 0x0d, 0xd3,
+	0xf8, 0x25, 0xcb, 0x49, 0x6b, 0xde, 0x14, 0x8d, 0xb9, 0xd3, 0x5e, 0x28, 0x70, 0xab, 0xa3, 0x22,
+	0x7c, 0x6c, 0x79, 0xbe, 0xe3, 0xb6, 0xfe, 0x47, 0x31, 0x25, 0x0f, 0x24, 0x05, 0xfe, 0x3f, 0xb4,
+	0xa0, 0x8e, 0xa2, 0xfc, 0x93, 0x02, 0xaa, 0x8c, 0x11, 0xb3, 0xb0, 0x0f, 0x63, 0xcc, 0xf6, 0x5d,
+	0x8b, 0x85, 0x0f, 0xb5, 0x5f, 0xc5, 0x42, 0xf1, 0x9e, 0xed, 0xbb, 0x2d, 0x2c, 0x17, 0xa1, 0xfa,
+	0xad, 0xb5, 0xa6, 0xf5, 0x97, 0xd3, 0xf0, 0x0e, 0x07, 0x26, 0xcf, 0x15, 0x08, 0xfb, 0x81, 0x47,
+	0x72, 0x52, 0x2e, 0x59, 0x1f, 0x57, 0xef, 0x26, 0x31, 0x15, 0x91, 0xb5, 0x3b, 0xdf, 0xfc, 0xfe,
+	0xf7, 0xf7, 0x23, 0x59, 0xb2, 0x60, 0x48, 0xe7, 0x89, 0x30, 0xfa, 0xb7, 0x0a, 0x8c, 0xa1, 0x96,
+	0xac, 0x0e, 0x74, 0x1f, 0x82, 0xe4, 0x12, 0x58, 0x22, 0x87, 0xc1, 0x39, 0x72, 0x64, 0xa5, 0x2f,
+	0x87, 0x71, 0x8c, 0x17, 0xe5, 0x84, 0xfc, 0xac, 0x00, 0xb9, 0x7a, 0xbb, 0xc9, 0xc6, 0xc0, 0x90,
+	0x57, 0x5f, 0x8f, 0xba, 0x39, 0x9c, 0x28, 0x19, 0x72, 0xf4, 0xaa, 0xcb, 0x56, 0xd5, 0x38, 0xb6,
+	0xaa, 0x27, 0xe4, 0x6b, 0x05, 0x46, 0x45, 0xaf, 0x21, 0x2b, 0xbd, 0x23, 0xc6, 0x5a, 0x9d, 0xba,
+	0x3a, 0xd8, 0x10, 0x71, 0x96, 0x38, 0xce, 0x02, 0x99, 0x93, 0xe2, 0x88, 0xae, 0x46, 0x5e, 0x2a,
+	0x40, 0xae, 0x76, 0xdf, 0x7e, 0x59, 0xeb, 0x39, 0x0e, 0xa8, 0x9b, 0xc3, 0x89, 0x10, 0xb3, 0xc0,
+	0x31, 0xef, 0x91, 0x9c, 0x1c, 0x33, 0x30, 0x1f, 0xb3, 0x56, 0xb9, 0xc2, 0x95, 0x65, 0x84, 0xfe,
+	0x41, 0x81, 0xe9, 0xae, 0x66, 0x4d, 0xd6, 0x7a, 0x07, 0x97, 0x0f, 0x0d, 0x6a, 0x61, 0x08, 0x05,
+	0xb2, 0xea, 0x9c, 0x75, 0x95, 0x2c, 0x4b, 0x59, 0x6b, 0xd4, 0x2b, 0xbb, 0x5c, 0x16, 0x82, 0xbe,
+	0x50, 0x20, 0xde, 0x2d, 0x3d, 0x62, 0xf4, 0x8e, 0x2a, 0x9d, 0x2c, 0xd4, 0xb5, 0xe4, 0x02, 0xa4,
+	0xbc, 0xcf, 0x29, 0x97, 0xc9, 0x6d, 0x29, 0x65, 0x83, 0x8b, 0xca, 0xd1, 0x4b, 0xfe, 0x55, 0x81,
+	0x19, 0xc9, 0x64, 0x40, 0x36, 0x13, 0xc6, 0x8d, 0xcd, 0x1f, 0xea, 0xd6, 0x90, 0x2a, 0x44, 0xde,
+	0xe0, 0xc8, 0x79, 0x72, 0x2f, 0x09, 0xb2, 0x71, 0xdc, 0x1e, 0x6b, 0x4e, 0xda, 0xe5, 0x70, 0xa2,
+	0x73, 0xb2, 0xe8, 0x51, 0x88, 0x24, 0x33, 0x89, 0x9a, 0x4b, 0x60, 0x99, 0xe8, 0x19, 0x89, 0x61,
+	0xa5, 0x5d, 0x7c, 0xd2, 0xb2, 0x19, 0xa3, 0xc7, 0xb5, 0xec, 0x33, 0xd2, 0xa8, 0x85, 0x21, 0x14,
+	0x89, 0xb2, 0x27, 0x10, 0x8d, 0xe3, 0xd8, 0x58, 0x71, 0x42, 0x7e, 0xb9, 0x44, 0x8e, 0x4d, 0x22,
+	0xfd, 0x91, 0x65, 0xa3, 0x8f, 0x5a, 0x18, 0x42, 0x81, 0xc8, 0x9b, 0x1c, 0x59, 0x27, 0xf7, 0x13,
+	0x21, 0x8b, 0x81, 0xea, 0x84, 0xfc, 0xa8, 0xc0, 0x64, 0xac, 0xf3, 0x12, 0x7d, 0x50, 0xcd, 0x89,
+	0xcf, 0x20, 0xaa, 0x91, 0xd8, 0x1e, 0x41, 0xb7, 0x38, 0xa8, 0x41, 0xf2, 0xfd, 0xca, 0x53, 0x5d,
+	0x88, 0x2e, 0xbb, 0x51, 0x71, 0xe7, 0xd5, 0x79, 0x46, 0x79, 0x73, 0x9e, 0x51, 0xfe, 0x3a, 0xcf,
+	0x28, 0xdf, 0x5d, 0x64, 0x52, 0x6f, 0x2e, 0x32, 0xa9, 0x3f, 0x2e, 0x32, 0xa9, 0x2f, 0x72, 0x35,
+	0xcb, 0xaf, 0x07, 0xa6, 0x5e, 0x71, 0x1a, 0xa1, 0x4b, 0xf1, 0x27, 0xef, 0x55, 0x1f, 0x1b, 0xcf,
+	0x84, 0x7f, 0xbf, 0xd5, 0x64, 0x9e, 0x39, 0xca, 0x67, 0xef, 0x8d, 0x7f, 0x07, 0x00, 0xac, 0x90,
+	0x49, 0x90, 0x8c, 0x10, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -963,6 +1245,10 @@ type QueryClient interface {
 	AccountAddressByID(ctx context.Context, in *QueryAccountAddressByIDRequest, opts ...grpc.CallOption) (*QueryAccountAddressByIDResponse, error)
 	// Params queries all parameters.
 	Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error)
+	// PubKeyChangeParams queries the parameters of MsgChangePubKey.
+	PubKeyChangeParams(ctx context.Context, in *QueryPubKeyChangeParamsRequest, opts ...grpc.CallOption) (*QueryPubKeyChangeParamsResponse, error)
+	// GasRefundParams queries the parameters of the gas refunds.
+	GasRefundParams(ctx context.Context, in *QueryGasRefundParamsRequest, opts ...grpc.CallOption) (*QueryGasRefundParamsResponse, error)
 	// ModuleAccounts returns all the existing module accounts.
 	//
 	// Since: cosmos-sdk 0.46
@@ -981,6 +1267,8 @@ type QueryClient interface {
 	//
 	// Since: cosmos-sdk 0.46
 	AddressStringToBytes(ctx context.Context, in *AddressStringToBytesRequest, opts ...grpc.CallOption) (*AddressStringToBytesResponse, error)
+	// PubKeyHistory returns the public keys previously used by an account.
+	PubKeyHistory(ctx context.Context, in *QueryPubKeyHistoryRequest, opts ...grpc.CallOption) (*QueryPubKeyHistoryResponse, error)
 }
 
 type queryClient struct {
@@ -1027,6 +1315,24 @@ func (c *queryClient) Params(ctx context.Context, in *QueryParamsRequest, opts .
 	return out, nil
 }
 
+func (c *queryClient) PubKeyChangeParams(ctx context.Context, in *QueryPubKeyChangeParamsRequest, opts ...grpc.CallOption) (*QueryPubKeyChangeParamsResponse, error) {
+	out := new(QueryPubKeyChangeParamsResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.auth.v1beta1.Query/PubKeyChangeParams", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) GasRefundParams(ctx context.Context, in *QueryGasRefundParamsRequest, opts ...grpc.CallOption) (*QueryGasRefundParamsResponse, error) {
+	out := new(QueryGasRefundParamsResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.auth.v1beta1.Query/GasRefundParams", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *queryClient) ModuleAccounts(ctx context.Context, in *QueryModuleAccountsRequest, opts ...grpc.CallOption) (*QueryModuleAccountsResponse, error) {
 	out := new(QueryModuleAccountsResponse)
 	err := c.cc.Invoke(ctx, "/cosmos.auth.v1beta1.Query/ModuleAccounts", in, out, opts...)
@@ -1072,6 +1378,15 @@ func (c *queryClient) AddressStringToBytes(ctx context.Context, in *AddressStrin
 	return out, nil
 }
 
+func (c *queryClient) PubKeyHistory(ctx context.Context, in *QueryPubKeyHistoryRequest, opts ...grpc.CallOption) (*QueryPubKeyHistoryResponse, error) {
+	out := new(QueryPubKeyHistoryResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.auth.v1beta1.Query/PubKeyHistory", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // QueryServer is the server API for Query service.
 type QueryServer interface {
 	// Accounts returns all the existing accounts
@@ -1086,6 +1401,10 @@ type QueryServer interface {
 	AccountAddressByID(context.Context, *QueryAccountAddressByIDRequest) (*QueryAccountAddressByIDResponse, error)
 	// Params queries all parameters.
 	Params(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error)
+	// PubKeyChangeParams queries the parameters of MsgChangePubKey.
+	PubKeyChangeParams(context.Context, *QueryPubKeyChangeParamsRequest) (*QueryPubKeyChangeParamsResponse, error)
+	// GasRefundParams queries the parameters of the gas refunds.
+	GasRefundParams(context.Context, *QueryGasRefundParamsRequest) (*QueryGasRefundParamsResponse, error)
 	// ModuleAccounts returns all the existing module accounts.
 	//
 	// Since: cosmos-sdk 0.46
@@ -1104,6 +1423,8 @@ type QueryServer interface {
 	//
 	// Since: cosmos-sdk 0.46
 	AddressStringToBytes(context.Context, *AddressStringToBytesRequest) (*AddressStringToBytesResponse, error)
+	// PubKeyHistory returns the public keys previously used by an account.
+	PubKeyHistory(context.Context, *QueryPubKeyHistoryRequest) (*QueryPubKeyHistoryResponse, error)
 }
 
 // UnimplementedQueryServer can be embedded to have forward compatible implementations.
@@ -1122,6 +1443,12 @@ func (*UnimplementedQueryServer) AccountAddressByID(ctx context.Context, req *Qu
 func (*UnimplementedQueryServer) Params(ctx context.Context, req *QueryParamsRequest) (*QueryParamsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Params not implemented")
 }
+func (*UnimplementedQueryServer) PubKeyChangeParams(ctx context.Context, req *QueryPubKeyChangeParamsRequest) (*QueryPubKeyChangeParamsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PubKeyChangeParams not implemented")
+}
+func (*UnimplementedQueryServer) GasRefundParams(ctx context.Context, req *QueryGasRefundParamsRequest) (*QueryGasRefundParamsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GasRefundParams not implemented")
+}
 func (*UnimplementedQueryServer) ModuleAccounts(ctx context.Context, req *QueryModuleAccountsRequest) (*QueryModuleAccountsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ModuleAccounts not implemented")
 }
@@ -1137,6 +1464,9 @@ func (*UnimplementedQueryServer) AddressBytesToString(ctx context.Context, req *
 func (*UnimplementedQueryServer) AddressStringToBytes(ctx context.Context, req *AddressStringToBytesRequest) (*AddressStringToBytesResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method AddressStringToBytes not implemented")
 }
+func (*UnimplementedQueryServer) PubKeyHistory(ctx context.Context, req *QueryPubKeyHistoryRequest) (*QueryPubKeyHistoryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PubKeyHistory not implemented")
+}
 
 func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
 	s.RegisterService(&_Query_serviceDesc, srv)
@@ -1214,6 +1544,42 @@ func _Query_Params_Handler(srv interface{}, ctx context.Context, dec func(interf
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_PubKeyChangeParams_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryPubKeyChangeParamsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).PubKeyChangeParams(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.auth.v1beta1.Query/PubKeyChangeParams",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).PubKeyChangeParams(ctx, req.(*QueryPubKeyChangeParamsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_GasRefundParams_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryGasRefundParamsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).GasRefundParams(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.auth.v1beta1.Query/GasRefundParams",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).GasRefundParams(ctx, req.(*QueryGasRefundParamsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Query_ModuleAccounts_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(QueryModuleAccountsRequest)
 	if err := dec(in); err != nil {
@@ -1304,6 +1670,24 @@ func _Query_AddressStringToBytes_Handler(srv interface{}, ctx context.Context, d
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_PubKeyHistory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryPubKeyHistoryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).PubKeyHistory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.auth.v1beta1.Query/PubKeyHistory",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).PubKeyHistory(ctx, req.(*QueryPubKeyHistoryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Query_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "cosmos.auth.v1beta1.Query",
 	HandlerType: (*QueryServer)(nil),
@@ -1324,6 +1708,14 @@ var _Query_serviceDesc = grpc.ServiceDesc{
 			MethodName: "Params",
 			Handler:    _Query_Params_Handler,
 		},
+		{
+			MethodName: "PubKeyChangeParams",
+			Handler:    _Query_PubKeyChangeParams_Handler,
+		},
+		{
+			MethodName: "GasRefundParams",
+			Handler:    _Query_GasRefundParams_Handler,
+		},
 		{
 			MethodName: "ModuleAccounts",
 			Handler:    _Query_ModuleAccounts_Handler,
@@ -1344,6 +1736,10 @@ var _Query_serviceDesc = grpc.ServiceDesc{
 			MethodName: "AddressStringToBytes",
 			Handler:    _Query_AddressStringToBytes_Handler,
 		},
+		{
+			MethodName: "PubKeyHistory",
+			Handler:    _Query_PubKeyHistory_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "cosmos/auth/v1beta1/query.proto",
@@ -1554,7 +1950,7 @@ func (m *QueryParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func (m *QueryModuleAccountsRequest) Marshal() (dAtA []byte, err error) {
+func (m *QueryPubKeyChangeParamsRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -1564,12 +1960,12 @@ func (m *QueryModuleAccountsRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *QueryModuleAccountsRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryPubKeyChangeParamsRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryModuleAccountsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryPubKeyChangeParamsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -1577,7 +1973,7 @@ func (m *QueryModuleAccountsRequest) MarshalToSizedBuffer(dAtA []byte) (int, err
 	return len(dAtA) - i, nil
 }
 
-func (m *QueryModuleAccountsResponse) Marshal() (dAtA []byte, err error) {
+func (m *QueryPubKeyChangeParamsResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -1587,25 +1983,137 @@ func (m *QueryModuleAccountsResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *QueryModuleAccountsResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryPubKeyChangeParamsResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryModuleAccountsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryPubKeyChangeParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.Accounts) > 0 {
-		for iNdEx := len(m.Accounts) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Accounts[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
+	{
+		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryGasRefundParamsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGasRefundParamsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGasRefundParamsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryGasRefundParamsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGasRefundParamsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGasRefundParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryModuleAccountsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryModuleAccountsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryModuleAccountsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryModuleAccountsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryModuleAccountsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryModuleAccountsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Accounts) > 0 {
+		for iNdEx := len(m.Accounts) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Accounts[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
 			}
 			i--
 			dAtA[i] = 0xa
@@ -1910,6 +2418,97 @@ func (m *QueryAccountAddressByIDResponse) MarshalToSizedBuffer(dAtA []byte) (int
 	return len(dAtA) - i, nil
 }
 
+func (m *QueryPubKeyHistoryRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryPubKeyHistoryRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryPubKeyHistoryRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryPubKeyHistoryResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryPubKeyHistoryResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryPubKeyHistoryResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Entries) > 0 {
+		for iNdEx := len(m.Entries) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Entries[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
 	offset -= sovQuery(v)
 	base := offset
@@ -1999,6 +2598,46 @@ func (m *QueryParamsResponse) Size() (n int) {
 	return n
 }
 
+func (m *QueryPubKeyChangeParamsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryPubKeyChangeParamsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Params.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryGasRefundParamsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryGasRefundParamsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Params.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
 func (m *QueryModuleAccountsRequest) Size() (n int) {
 	if m == nil {
 		return 0
@@ -2148,16 +2787,52 @@ func (m *QueryAccountAddressByIDResponse) Size() (n int) {
 	return n
 }
 
-func sovQuery(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozQuery(x uint64) (n int) {
-	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+func (m *QueryPubKeyHistoryRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
 }
-func (m *QueryAccountsRequest) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
+
+func (m *QueryPubKeyHistoryResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Entries) > 0 {
+		for _, e := range m.Entries {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func sovQuery(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozQuery(x uint64) (n int) {
+	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *QueryAccountsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
 		preIndex := iNdEx
 		var wire uint64
 		for shift := uint(0); ; shift += 7 {
@@ -2419,8 +3094,277 @@ func (m *QueryAccountRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Address = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryAccountResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAccountResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAccountResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Account", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Account == nil {
+				m.Account = &types.Any{}
+			}
+			if err := m.Account.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryParamsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryParamsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryParamsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryPubKeyChangeParamsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryPubKeyChangeParamsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryPubKeyChangeParamsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -2442,7 +3386,7 @@ func (m *QueryAccountRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryAccountResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryPubKeyChangeParamsResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2465,15 +3409,15 @@ func (m *QueryAccountResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryAccountResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryPubKeyChangeParamsResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryAccountResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryPubKeyChangeParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Account", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -2500,10 +3444,7 @@ func (m *QueryAccountResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Account == nil {
-				m.Account = &types.Any{}
-			}
-			if err := m.Account.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -2528,7 +3469,7 @@ func (m *QueryAccountResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryGasRefundParamsRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2551,10 +3492,10 @@ func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryParamsRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryGasRefundParamsRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryParamsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryGasRefundParamsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -2578,7 +3519,7 @@ func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryGasRefundParamsResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2601,10 +3542,10 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryParamsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryGasRefundParamsResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryGasRefundParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -3578,6 +4519,244 @@ func (m *QueryAccountAddressByIDResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *QueryPubKeyHistoryRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryPubKeyHistoryRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryPubKeyHistoryRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryPubKeyHistoryResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryPubKeyHistoryResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryPubKeyHistoryResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Entries", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Entries = append(m.Entries, PubKeyHistoryEntry{})
+			if err := m.Entries[len(m.Entries)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipQuery(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
@@ -2,15 +2,21 @@ package testutil
 
 import (
 	"fmt"
+	"os"
+	"path/filepath"
 
 	"github.com/gogo/protobuf/proto"
 	"github.com/stretchr/testify/suite"
+	tmcli "github.com/tendermint/tendermint/libs/cli"
 
 	"github.com/cosmos/cosmos-sdk/client/flags"
 	clitestutil "github.com/cosmos/cosmos-sdk/testutil/cli"
 	"github.com/cosmos/cosmos-sdk/testutil/network"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtx "github.com/cosmos/cosmos-sdk/x/auth/tx"
 	"github.com/cosmos/cosmos-sdk/x/auth/vesting/client/cli"
+	"github.com/cosmos/cosmos-sdk/x/auth/vesting/types"
+	banktestutil "github.com/cosmos/cosmos-sdk/x/bank/client/testutil"
 )
 
 type IntegrationTestSuite struct {
@@ -221,3 +227,112 @@ func (s *IntegrationTestSuite) TestNewMsgCreatePermanentLockedAccountCmd() {
 		s.T().Logf("Height now: %d", height)
 	}
 }
+
+func (s *IntegrationTestSuite) TestClawbackVestingAccountCmds() {
+	val := s.network.Validators[0]
+	clientCtx := val.ClientCtx
+	addr := sdk.AccAddress("addr6_______________")
+	amount := sdk.NewCoins(sdk.NewCoin(s.cfg.BondDenom, sdk.NewInt(100)))
+	txFlags := []string{
+		fmt.Sprintf("--%s=%s", flags.FlagFrom, val.Address),
+		fmt.Sprintf("--%s=true", flags.FlagSkipConfirmation),
+		fmt.Sprintf("--%s=%s", flags.FlagBroadcastMode, flags.BroadcastSync),
+		fmt.Sprintf("--%s=%s", flags.FlagFees, sdk.NewCoins(sdk.NewCoin(s.cfg.BondDenom, sdk.NewInt(10))).String()),
+		fmt.Sprintf("--%s=%d", flags.FlagGas, 300000),
+	}
+
+	vestingFile := filepath.Join(s.T().TempDir(), "vesting.json")
+	s.Require().NoError(os.WriteFile(vestingFile, []byte(fmt.Sprintf(
+		`{"start_time": 4070908800, "periods": [{"coins": "%s", "length_seconds": 3600}]}`, amount,
+	)), 0o600))
+
+	// create the clawback vesting account
+	_, err := clitestutil.ExecTestCLICmd(clientCtx, cli.NewMsgCreateClawbackVestingAccountCmd(), []string{
+		addr.String(),
+		fmt.Sprintf("--%s=%s", cli.FlagLockup, filepath.Join(s.T().TempDir(), "missing.json")),
+	})
+	s.Require().Error(err)
+
+	bw, err := clitestutil.ExecTestCLICmd(clientCtx, cli.NewMsgCreateClawbackVestingAccountCmd(), append([]string{
+		addr.String(),
+		fmt.Sprintf("--%s=%s", cli.FlagVesting, vestingFile),
+	}, txFlags...))
+	s.Require().NoError(err)
+	var txResp sdk.TxResponse
+	s.Require().NoError(clientCtx.Codec.UnmarshalJSON(bw.Bytes(), &txResp), bw.String())
+	s.Require().Equal(uint32(0), txResp.Code, txResp.RawLog)
+	s.Require().NoError(s.network.WaitForNextBlock())
+
+	var balances types.QueryBalancesResponse
+	bw, err = clitestutil.ExecTestCLICmd(clientCtx, cli.GetCmdQueryBalances(), []string{addr.String(), fmt.Sprintf("--%s=json", tmcli.OutputFlag)})
+	s.Require().NoError(err)
+	s.Require().NoError(clientCtx.Codec.UnmarshalJSON(bw.Bytes(), &balances), bw.String())
+	s.Require().Equal(amount, balances.Unvested)
+	s.Require().Equal(amount, balances.Locked)
+	s.Require().True(balances.Vested.IsZero())
+
+	// claw back the unvested coins
+	bw, err = clitestutil.ExecTestCLICmd(clientCtx, cli.NewMsgClawbackCmd(), append([]string{addr.String()}, txFlags...))
+	s.Require().NoError(err)
+	s.Require().NoError(clientCtx.Codec.UnmarshalJSON(bw.Bytes(), &txResp), bw.String())
+	s.Require().Equal(uint32(0), txResp.Code, txResp.RawLog)
+	s.Require().NoError(s.network.WaitForNextBlock())
+
+	bw, err = clitestutil.ExecTestCLICmd(clientCtx, cli.GetCmdQueryBalances(), []string{addr.String(), fmt.Sprintf("--%s=json", tmcli.OutputFlag)})
+	s.Require().NoError(err)
+	s.Require().NoError(clientCtx.Codec.UnmarshalJSON(bw.Bytes(), &balances), bw.String())
+	s.Require().True(balances.Unvested.IsZero())
+}
+
+func (s *IntegrationTestSuite) TestNewMsgCreatePeriodicVestingAccountCmdMerge() {
+	val := s.network.Validators[0]
+	clientCtx := val.ClientCtx
+	addr := sdk.AccAddress("addr7_______________")
+	amount := sdk.NewCoins(sdk.NewCoin(s.cfg.BondDenom, sdk.NewInt(100)))
+	txFlags := []string{
+		fmt.Sprintf("--%s=%s", flags.FlagFrom, val.Address),
+		fmt.Sprintf("--%s=true", flags.FlagSkipConfirmation),
+		fmt.Sprintf("--%s=%s", flags.FlagBroadcastMode, flags.BroadcastSync),
+		fmt.Sprintf("--%s=%s", flags.FlagFees, sdk.NewCoins(sdk.NewCoin(s.cfg.BondDenom, sdk.NewInt(10))).String()),
+		fmt.Sprintf("--%s=%d", flags.FlagGas, 300000),
+	}
+
+	periodsFile := filepath.Join(s.T().TempDir(), "periods.json")
+	s.Require().NoError(os.WriteFile(periodsFile, []byte(fmt.Sprintf(
+		`{"start_time": 4070908800, "periods": [{"coins": "%s", "length_seconds": 3600}]}`, amount,
+	)), 0o600))
+
+	execTx := func(args ...string) sdk.TxResponse {
+		bw, err := clitestutil.ExecTestCLICmd(clientCtx, cli.NewMsgCreatePeriodicVestingAccountCmd(), append(args, txFlags...))
+		s.Require().NoError(err)
+		var txResp sdk.TxResponse
+		s.Require().NoError(clientCtx.Codec.UnmarshalJSON(bw.Bytes(), &txResp), bw.String())
+		s.Require().Equal(uint32(0), txResp.Code, txResp.RawLog)
+		s.Require().NoError(s.network.WaitForNextBlock())
+
+		res, err := authtx.QueryTx(clientCtx, txResp.TxHash)
+		s.Require().NoError(err)
+		return *res
+	}
+
+	// create a base account
+	_, err := banktestutil.MsgSendExec(clientCtx, val.Address, addr, amount, txFlags[1:]...)
+	s.Require().NoError(err)
+	s.Require().NoError(s.network.WaitForNextBlock())
+
+	// require the creation to fail for an existing account without merge
+	res := execTx(addr.String(), periodsFile)
+	s.Require().NotEqual(uint32(0), res.Code)
+
+	// convert the base account, and merge a new grant into it
+	res = execTx(addr.String(), periodsFile, fmt.Sprintf("--%s=true", cli.FlagMerge))
+	s.Require().Equal(uint32(0), res.Code, res.RawLog)
+	res = execTx(addr.String(), periodsFile, fmt.Sprintf("--%s=true", cli.FlagMerge))
+	s.Require().Equal(uint32(0), res.Code, res.RawLog)
+
+	var balances types.QueryBalancesResponse
+	bw, err := clitestutil.ExecTestCLICmd(clientCtx, cli.GetCmdQueryBalances(), []string{addr.String(), fmt.Sprintf("--%s=json", tmcli.OutputFlag)})
+	s.Require().NoError(err)
+	s.Require().NoError(clientCtx.Codec.UnmarshalJSON(bw.Bytes(), &balances), bw.String())
+	s.Require().Equal(amount.Add(amount...), balances.Unvested)
+}
@@ -0,0 +1,62 @@
+package vesting
+
+import (
+	"context"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/auth/keeper"
+	"github.com/cosmos/cosmos-sdk/x/auth/vesting/exported"
+	"github.com/cosmos/cosmos-sdk/x/auth/vesting/types"
+)
+
+type queryServer struct {
+	keeper.AccountKeeper
+}
+
+// NewQueryServerImpl returns an implementation of the vesting QueryServer
+// interface, wrapping the corresponding AccountKeeper.
+func NewQueryServerImpl(k keeper.AccountKeeper) types.QueryServer {
+	return &queryServer{AccountKeeper: k}
+}
+
+var _ types.QueryServer = queryServer{}
+
+// Balances returns the locked, unvested and vested coins of a vesting account.
+func (s queryServer) Balances(goCtx context.Context, req *types.QueryBalancesRequest) (*types.QueryBalancesResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	addr, err := sdk.AccAddressFromBech32(req.Address)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid address: %s", err)
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	acc := s.GetAccount(ctx, addr)
+	if acc == nil {
+		return nil, status.Errorf(codes.NotFound, "account %s not found", req.Address)
+	}
+
+	blockTime := ctx.BlockTime()
+	switch va := acc.(type) {
+	case *types.ClawbackVestingAccount:
+		return &types.QueryBalancesResponse{
+			Locked:   va.GetLockedOnly(blockTime),
+			Unvested: va.GetUnvestedOnly(blockTime),
+			Vested:   va.GetVestedOnly(blockTime),
+		}, nil
+	case exported.VestingAccount:
+		vesting := va.GetVestingCoins(blockTime)
+		return &types.QueryBalancesResponse{
+			Locked:   vesting,
+			Unvested: vesting,
+			Vested:   va.GetVestedCoins(blockTime),
+		}, nil
+	default:
+		return nil, status.Errorf(codes.InvalidArgument, "account %s is not a vesting account", req.Address)
+	}
+}
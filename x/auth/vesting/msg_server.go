@@ -2,6 +2,8 @@ package vesting
 
 import (
 	"context"
+	"errors"
+	"math"
 
 	"github.com/armon/go-metrics"
 
@@ -11,17 +13,22 @@ import (
 	"github.com/cosmos/cosmos-sdk/x/auth/keeper"
 	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
 	"github.com/cosmos/cosmos-sdk/x/auth/vesting/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
 )
 
 type msgServer struct {
 	keeper.AccountKeeper
 	types.BankKeeper
+	types.StakingKeeper
+	types.EpochingKeeper
 }
 
 // NewMsgServerImpl returns an implementation of the vesting MsgServer interface,
-// wrapping the corresponding AccountKeeper and BankKeeper.
-func NewMsgServerImpl(k keeper.AccountKeeper, bk types.BankKeeper) types.MsgServer {
-	return &msgServer{AccountKeeper: k, BankKeeper: bk}
+// wrapping the corresponding AccountKeeper, BankKeeper, StakingKeeper and
+// EpochingKeeper. The EpochingKeeper may be nil if the app does not queue the
+// staking msgs.
+func NewMsgServerImpl(k keeper.AccountKeeper, bk types.BankKeeper, sk types.StakingKeeper, ek types.EpochingKeeper) types.MsgServer {
+	return &msgServer{AccountKeeper: k, BankKeeper: bk, StakingKeeper: sk, EpochingKeeper: ek}
 }
 
 var _ types.MsgServer = msgServer{}
@@ -168,10 +175,6 @@ func (s msgServer) CreatePeriodicVestingAccount(goCtx context.Context, msg *type
 		return nil, err
 	}
 
-	if acc := ak.GetAccount(ctx, to); acc != nil {
-		return nil, sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "account %s already exists", msg.ToAddress)
-	}
-
 	var totalCoins sdk.Coins
 	for _, period := range msg.VestingPeriods {
 		totalCoins = totalCoins.Add(period.Amount...)
@@ -181,14 +184,40 @@ func (s msgServer) CreatePeriodicVestingAccount(goCtx context.Context, msg *type
 		return nil, err
 	}
 
-	baseAccount := authtypes.NewBaseAccountWithAddress(to)
-	baseAccount = ak.NewAccount(ctx, baseAccount).(*authtypes.BaseAccount)
-	vestingAccount := types.NewPeriodicVestingAccount(baseAccount, totalCoins.Sort(), msg.StartTime, msg.VestingPeriods)
+	var vestingAccount *types.PeriodicVestingAccount
+	madeNewAcc := false
+	if acc := ak.GetAccount(ctx, to); acc != nil {
+		if !msg.Merge {
+			return nil, sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "account %s already exists", msg.ToAddress)
+		}
+
+		switch acc := acc.(type) {
+		case *types.PeriodicVestingAccount:
+			vestingAccount = acc
+		case *authtypes.BaseAccount:
+			vestingAccount = types.NewPeriodicVestingAccount(acc, sdk.NewCoins(), msg.StartTime, nil)
+		default:
+			return nil, sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "account %s must be a periodic vesting account or a base account to merge into", msg.ToAddress)
+		}
+
+		bondDenom := s.BondDenom(ctx)
+		delegatedAmt := s.GetDelegatorBonded(ctx, to).Add(s.GetDelegatorUnbonding(ctx, to))
+		delegatedAmt = delegatedAmt.Add(s.escrowedTokens(ctx, to).AmountOf(bondDenom))
+		delegated := sdk.NewCoins(sdk.NewCoin(bondDenom, delegatedAmt))
+		vestingAccount.AddGrant(ctx.BlockTime(), msg.StartTime, msg.VestingPeriods, totalCoins, delegated)
+	} else {
+		baseAccount := authtypes.NewBaseAccountWithAddress(to)
+		baseAccount = ak.NewAccount(ctx, baseAccount).(*authtypes.BaseAccount)
+		vestingAccount = types.NewPeriodicVestingAccount(baseAccount, totalCoins.Sort(), msg.StartTime, msg.VestingPeriods)
+		madeNewAcc = true
+	}
 
 	ak.SetAccount(ctx, vestingAccount)
 
 	defer func() {
-		telemetry.IncrCounter(1, "new", "account")
+		if madeNewAcc {
+			telemetry.IncrCounter(1, "new", "account")
+		}
 
 		for _, a := range totalCoins {
 			if a.Amount.IsInt64() {
@@ -213,3 +242,244 @@ func (s msgServer) CreatePeriodicVestingAccount(goCtx context.Context, msg *type
 	)
 	return &types.MsgCreatePeriodicVestingAccountResponse{}, nil
 }
+
+func (s msgServer) CreateClawbackVestingAccount(goCtx context.Context, msg *types.MsgCreateClawbackVestingAccount) (*types.MsgCreateClawbackVestingAccountResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	ak := s.AccountKeeper
+	bk := s.BankKeeper
+
+	from, err := sdk.AccAddressFromBech32(msg.FromAddress)
+	if err != nil {
+		return nil, err
+	}
+	to, err := sdk.AccAddressFromBech32(msg.ToAddress)
+	if err != nil {
+		return nil, err
+	}
+
+	if bk.BlockedAddr(to) {
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrUnauthorized, "%s is not allowed to receive funds", msg.ToAddress)
+	}
+
+	if acc := ak.GetAccount(ctx, to); acc != nil {
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "account %s already exists", msg.ToAddress)
+	}
+
+	lockupPeriods := msg.LockupPeriods
+	vestingPeriods := msg.VestingPeriods
+	vestingCoins := types.Periods(vestingPeriods).TotalAmount()
+	lockupCoins := types.Periods(lockupPeriods).TotalAmount()
+
+	// an empty schedule means that the coins are unlocked or vested immediately
+	if len(lockupPeriods) == 0 {
+		lockupPeriods = []types.Period{{Length: 0, Amount: vestingCoins}}
+		lockupCoins = vestingCoins
+	}
+	if len(vestingPeriods) == 0 {
+		vestingPeriods = []types.Period{{Length: 0, Amount: lockupCoins}}
+		vestingCoins = lockupCoins
+	}
+
+	if err := bk.IsSendEnabledCoins(ctx, vestingCoins...); err != nil {
+		return nil, err
+	}
+
+	baseAccount := authtypes.NewBaseAccountWithAddress(to)
+	baseAccount = ak.NewAccount(ctx, baseAccount).(*authtypes.BaseAccount)
+	vestingAccount := types.NewClawbackVestingAccount(baseAccount, from, vestingCoins.Sort(), msg.StartTime, lockupPeriods, vestingPeriods)
+
+	ak.SetAccount(ctx, vestingAccount)
+
+	defer func() {
+		telemetry.IncrCounter(1, "new", "account")
+
+		for _, a := range vestingCoins {
+			if a.Amount.IsInt64() {
+				telemetry.SetGaugeWithLabels(
+					[]string{"tx", "msg", "create_clawback_vesting_account"},
+					float32(a.Amount.Int64()),
+					[]metrics.Label{telemetry.NewLabel("denom", a.Denom)},
+				)
+			}
+		}
+	}()
+
+	if err = bk.SendCoins(ctx, from, to, vestingCoins); err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+		),
+	)
+
+	return &types.MsgCreateClawbackVestingAccountResponse{}, nil
+}
+
+func (s msgServer) Clawback(goCtx context.Context, msg *types.MsgClawback) (*types.MsgClawbackResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	ak := s.AccountKeeper
+	bk := s.BankKeeper
+
+	addr, err := sdk.AccAddressFromBech32(msg.Address)
+	if err != nil {
+		return nil, err
+	}
+
+	// the clawed-back coins are returned to the funder by default
+	dest, err := sdk.AccAddressFromBech32(msg.FunderAddress)
+	if err != nil {
+		return nil, err
+	}
+	if msg.DestAddress != "" {
+		dest, err = sdk.AccAddressFromBech32(msg.DestAddress)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	if bk.BlockedAddr(dest) {
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrUnauthorized, "%s is not allowed to receive funds", dest)
+	}
+
+	acc := ak.GetAccount(ctx, addr)
+	if acc == nil {
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrNotFound, "account %s does not exist", msg.Address)
+	}
+	va, ok := acc.(*types.ClawbackVestingAccount)
+	if !ok {
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "account %s is not subject to clawback", msg.Address)
+	}
+	if va.FunderAddress != msg.FunderAddress {
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrUnauthorized, "clawback can only be requested by the original funder %s", va.FunderAddress)
+	}
+
+	clawedBack, err := s.clawback(ctx, va, dest)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+		),
+		sdk.NewEvent(
+			types.EventTypeClawback,
+			sdk.NewAttribute(types.AttributeKeyFunder, msg.FunderAddress),
+			sdk.NewAttribute(types.AttributeKeyAccount, msg.Address),
+			sdk.NewAttribute(types.AttributeKeyDestination, dest.String()),
+			sdk.NewAttribute(sdk.AttributeKeyAmount, clawedBack.String()),
+		),
+	})
+
+	return &types.MsgClawbackResponse{}, nil
+}
+
+// clawback removes the unvested coins from the vesting schedule of the
+// account and transfers them to dest. The coins are taken from the bank
+// balance first, then from the unbonding delegations and finally from the
+// delegations, which are transferred to dest. It returns the coins clawed back
+// from the vesting schedule, which may exceed the coins transferred if some
+// were slashed.
+func (s msgServer) clawback(ctx sdk.Context, va *types.ClawbackVestingAccount, dest sdk.AccAddress) (sdk.Coins, error) {
+	ak := s.AccountKeeper
+	bk := s.BankKeeper
+	sk := s.StakingKeeper
+
+	// the escrowed tokens of the queued delegations can neither be transferred
+	// nor be told apart from slashed tokens until the delegations are executed
+	if escrowed := s.escrowedTokens(ctx, va.GetAddress()); !escrowed.IsZero() {
+		return nil, sdkerrors.Wrapf(
+			sdkerrors.ErrInvalidRequest, "account %s has %s escrowed for queued delegations until the end of the epoch", va.Address, escrowed,
+		)
+	}
+
+	// compute the clawback based on the account state only, and update the account
+	updatedAcc, toClawBack := va.ComputeClawback(ctx.BlockTime().Unix())
+	if toClawBack.IsZero() {
+		return toClawBack, nil
+	}
+	*va = updatedAcc
+	addr := va.GetAddress()
+	bondDenom := sk.BondDenom(ctx)
+
+	// update the delegation bookkeeping based on the bank and staking state
+	encumbered := va.GetVestingCoins(ctx.BlockTime())
+	bonded := sdk.NewCoins(sdk.NewCoin(bondDenom, sk.GetDelegatorBonded(ctx, addr)))
+	unbonding := sdk.NewCoins(sdk.NewCoin(bondDenom, sk.GetDelegatorUnbonding(ctx, addr)))
+	unbonded := bk.GetAllBalances(ctx, addr)
+	clawedBack := va.UpdateDelegation(encumbered, toClawBack, bonded, unbonding, unbonded)
+	toClawBack = clawedBack
+
+	// write the account now, so that the bank module sees that the unvested
+	// coins are no longer locked
+	ak.SetAccount(ctx, va)
+
+	// the balance of the account is now unlocked and can be transferred
+	toXfer := toClawBack.Min(bk.SpendableCoins(ctx, addr))
+	if err := bk.SendCoins(ctx, addr, dest, toXfer); err != nil {
+		return nil, err
+	}
+	toClawBack = toClawBack.Sub(toXfer...)
+
+	// Staking is the only way for the unvested coins to be missing from the
+	// bank balance, so transfer the unbonding delegations, then the
+	// delegations, until the remaining coins are clawed back.
+	want := toClawBack.AmountOf(bondDenom)
+	if !want.IsPositive() {
+		return clawedBack, nil
+	}
+
+	for _, ubd := range sk.GetUnbondingDelegations(ctx, addr, math.MaxUint16) {
+		valAddr, err := sdk.ValAddressFromBech32(ubd.ValidatorAddress)
+		if err != nil {
+			return nil, err
+		}
+		want = want.Sub(sk.TransferUnbonding(ctx, addr, dest, valAddr, want))
+		if !want.IsPositive() {
+			return clawedBack, nil
+		}
+	}
+
+	for _, delegation := range sk.GetDelegatorDelegations(ctx, addr, math.MaxUint16) {
+		valAddr := delegation.GetValidatorAddr()
+		validator, found := sk.GetValidator(ctx, valAddr)
+		if !found {
+			continue
+		}
+		wantShares, err := validator.SharesFromTokensTruncated(want)
+		if err != nil {
+			// the validator has no tokens
+			continue
+		}
+		transferredShares, err := sk.TransferDelegation(ctx, addr, dest, valAddr, wantShares)
+		if errors.Is(err, stakingtypes.ErrMaxRedelegationEntries) {
+			// the redelegations cannot be transferred, so try other delegations
+			continue
+		}
+		if err != nil {
+			return nil, err
+		}
+		// round the transferred tokens up, to be conservative in what is clawed back
+		want = want.Sub(validator.TokensFromSharesRoundUp(transferredShares).RoundInt())
+		if !want.IsPositive() {
+			break
+		}
+	}
+
+	return clawedBack, nil
+}
+
+// escrowedTokens returns the tokens of addr escrowed for the queued
+// delegations, if the staking msgs are queued.
+func (s msgServer) escrowedTokens(ctx sdk.Context, addr sdk.AccAddress) sdk.Coins {
+	if s.EpochingKeeper == nil {
+		return sdk.NewCoins()
+	}
+	return s.GetEscrowedTokens(ctx, addr)
+}
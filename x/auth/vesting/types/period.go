@@ -58,3 +58,140 @@ func (p Periods) String() string {
 	return strings.TrimSpace(fmt.Sprintf(`Vesting Periods:
 		%s`, strings.Join(periodsListString, ", ")))
 }
+
+// ReadSchedule returns the amount of coins of the schedule which have vested
+// at readTime. The schedule starts at startTime and is complete at endTime,
+// where totalCoins have vested.
+func ReadSchedule(startTime, endTime int64, periods Periods, totalCoins sdk.Coins, readTime int64) sdk.Coins {
+	if readTime <= startTime {
+		return sdk.NewCoins()
+	}
+	if readTime >= endTime {
+		return totalCoins
+	}
+
+	coins := sdk.NewCoins()
+	time := startTime
+	for _, period := range periods {
+		if readTime < time+period.Length {
+			break
+		}
+		coins = coins.Add(period.Amount...)
+		time += period.Length
+	}
+
+	return coins
+}
+
+// ConjunctPeriods returns the combination of the schedules p and q, starting at
+// startP and startQ, where the amount vested at any time is the minimum of the
+// amounts vested by p and q. It returns the start and end times of the
+// combined schedule, along with its periods.
+func ConjunctPeriods(startP, startQ int64, p, q Periods) (startTime, endTime int64, result Periods) {
+	timeP, timeQ := startP, startQ
+	iP, iQ := 0, 0
+	amountP, amountQ := sdk.NewCoins(), sdk.NewCoins()
+	min := sdk.NewCoins()
+
+	startTime = startP
+	if startQ < startTime {
+		startTime = startQ
+	}
+	time := startTime
+
+	// emit adds an output period when the minimum of p and q increases, and
+	// updates the time of the last event
+	emit := func(nextTime int64) {
+		newMin := amountP.Min(amountQ)
+		if min.IsAllGTE(newMin) {
+			return
+		}
+		result = append(result, Period{Length: nextTime - time, Amount: newMin.Sub(min...)})
+		time = nextTime
+		min = newMin
+	}
+
+	consumeP := func(nextP int64) {
+		amountP = amountP.Add(p[iP].Amount...)
+		timeP = nextP
+		iP++
+	}
+
+	consumeQ := func(nextQ int64) {
+		amountQ = amountQ.Add(q[iQ].Amount...)
+		timeQ = nextQ
+		iQ++
+	}
+
+	// merge the events of both schedules in time order
+	for iP < len(p) || iQ < len(q) {
+		switch {
+		case iQ >= len(q):
+			consumeP(timeP + p[iP].Length)
+			emit(timeP)
+		case iP >= len(p):
+			consumeQ(timeQ + q[iQ].Length)
+			emit(timeQ)
+		default:
+			nextP, nextQ := timeP+p[iP].Length, timeQ+q[iQ].Length
+			switch {
+			case nextP < nextQ:
+				consumeP(nextP)
+				emit(nextP)
+			case nextP > nextQ:
+				consumeQ(nextQ)
+				emit(nextQ)
+			default:
+				consumeP(nextP)
+				consumeQ(nextQ)
+				emit(nextP)
+			}
+		}
+	}
+
+	endTime = time
+	return startTime, endTime, result
+}
+
+// DisjunctPeriods returns the union of the schedules p and q, starting at
+// startP and startQ, where the amount vested at any time is the sum of the
+// amounts vested by p and q. It returns the start and end times of the
+// combined schedule, along with its periods.
+func DisjunctPeriods(startP, startQ int64, p, q Periods) (startTime, endTime int64, result Periods) {
+	timeP, timeQ := startP, startQ
+	iP, iQ := 0, 0
+
+	startTime = startP
+	if startQ < startTime {
+		startTime = startQ
+	}
+	time := startTime
+
+	// emit adds an output period for the event, or merges it into the last
+	// output period if both happen at the same time
+	emit := func(nextTime int64, amount sdk.Coins) {
+		if len(result) > 0 && nextTime == time {
+			last := &result[len(result)-1]
+			last.Amount = last.Amount.Add(amount...)
+			return
+		}
+		result = append(result, Period{Length: nextTime - time, Amount: amount})
+		time = nextTime
+	}
+
+	// merge the events of both schedules in time order
+	for iP < len(p) || iQ < len(q) {
+		if iQ >= len(q) || (iP < len(p) && timeP+p[iP].Length <= timeQ+q[iQ].Length) {
+			timeP += p[iP].Length
+			emit(timeP, p[iP].Amount)
+			iP++
+			continue
+		}
+		timeQ += q[iQ].Length
+		emit(timeQ, q[iQ].Amount)
+		iQ++
+	}
+
+	endTime = time
+	return startTime, endTime, result
+}
@@ -0,0 +1,142 @@
+package types_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/auth/vesting/types"
+)
+
+func TestReadSchedule(t *testing.T) {
+	periods := types.Periods{
+		{Length: 10, Amount: sdk.Coins{sdk.NewInt64Coin(stakeDenom, 25)}},
+		{Length: 10, Amount: sdk.Coins{sdk.NewInt64Coin(stakeDenom, 75)}},
+	}
+	total := periods.TotalAmount()
+
+	require.Equal(t, sdk.NewCoins(), types.ReadSchedule(100, 120, periods, total, 50))
+	require.Equal(t, sdk.NewCoins(), types.ReadSchedule(100, 120, periods, total, 109))
+	require.Equal(t, sdk.Coins{sdk.NewInt64Coin(stakeDenom, 25)}, types.ReadSchedule(100, 120, periods, total, 110))
+	require.Equal(t, sdk.Coins{sdk.NewInt64Coin(stakeDenom, 25)}, types.ReadSchedule(100, 120, periods, total, 119))
+	require.Equal(t, total, types.ReadSchedule(100, 120, periods, total, 120))
+	require.Equal(t, total, types.ReadSchedule(100, 120, periods, total, 200))
+}
+
+func TestConjunctPeriods(t *testing.T) {
+	c := func(amt int64) sdk.Coins { return sdk.Coins{sdk.NewInt64Coin(stakeDenom, amt)} }
+
+	testCases := []struct {
+		name      string
+		startP    int64
+		p         types.Periods
+		startQ    int64
+		q         types.Periods
+		expStart  int64
+		expEnd    int64
+		expResult types.Periods
+	}{
+		{
+			"empty schedules",
+			0, nil, 0, nil,
+			0, 0, nil,
+		},
+		{
+			"identical schedules",
+			100, types.Periods{{Length: 10, Amount: c(50)}, {Length: 10, Amount: c(50)}},
+			100, types.Periods{{Length: 10, Amount: c(50)}, {Length: 10, Amount: c(50)}},
+			100, 120, types.Periods{{Length: 10, Amount: c(50)}, {Length: 10, Amount: c(50)}},
+		},
+		{
+			"later schedule dominates",
+			100, types.Periods{{Length: 10, Amount: c(100)}},
+			100, types.Periods{{Length: 5, Amount: c(50)}, {Length: 10, Amount: c(50)}},
+			100, 115, types.Periods{{Length: 10, Amount: c(50)}, {Length: 5, Amount: c(50)}},
+		},
+		{
+			"interleaved schedules with different starts",
+			100, types.Periods{{Length: 0, Amount: c(20)}, {Length: 20, Amount: c(80)}},
+			110, types.Periods{{Length: 5, Amount: c(60)}, {Length: 10, Amount: c(40)}},
+			100, 125, types.Periods{{Length: 15, Amount: c(20)}, {Length: 5, Amount: c(40)}, {Length: 5, Amount: c(40)}},
+		},
+		{
+			"cap to a smaller total",
+			100, types.Periods{{Length: 10, Amount: c(50)}, {Length: 10, Amount: c(50)}},
+			100, types.Periods{{Length: 0, Amount: c(30)}},
+			100, 110, types.Periods{{Length: 10, Amount: c(30)}},
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			start, end, result := types.ConjunctPeriods(tc.startP, tc.startQ, tc.p, tc.q)
+			require.Equal(t, tc.expStart, start)
+			require.Equal(t, tc.expEnd, end)
+			require.Equal(t, tc.expResult, result)
+
+			// the combination is commutative
+			start, end, result = types.ConjunctPeriods(tc.startQ, tc.startP, tc.q, tc.p)
+			require.Equal(t, tc.expStart, start)
+			require.Equal(t, tc.expEnd, end)
+			require.Equal(t, tc.expResult, result)
+		})
+	}
+}
+
+func TestDisjunctPeriods(t *testing.T) {
+	c := func(amt int64) sdk.Coins { return sdk.Coins{sdk.NewInt64Coin(stakeDenom, amt)} }
+
+	testCases := []struct {
+		name      string
+		startP    int64
+		p         types.Periods
+		startQ    int64
+		q         types.Periods
+		expStart  int64
+		expEnd    int64
+		expResult types.Periods
+	}{
+		{
+			"empty schedules",
+			100, nil, 100, nil,
+			100, 100, nil,
+		},
+		{
+			"one empty schedule",
+			100, types.Periods{{Length: 10, Amount: c(50)}, {Length: 10, Amount: c(50)}},
+			50, nil,
+			50, 120, types.Periods{{Length: 60, Amount: c(50)}, {Length: 10, Amount: c(50)}},
+		},
+		{
+			"simultaneous events are merged",
+			100, types.Periods{{Length: 10, Amount: c(50)}, {Length: 10, Amount: c(50)}},
+			100, types.Periods{{Length: 20, Amount: c(30)}},
+			100, 120, types.Periods{{Length: 10, Amount: c(50)}, {Length: 10, Amount: c(80)}},
+		},
+		{
+			"interleaved schedules with different starts",
+			100, types.Periods{{Length: 0, Amount: c(20)}, {Length: 20, Amount: c(80)}},
+			110, types.Periods{{Length: 5, Amount: c(60)}, {Length: 10, Amount: c(40)}},
+			100, 125, types.Periods{{Length: 0, Amount: c(20)}, {Length: 15, Amount: c(60)}, {Length: 5, Amount: c(80)}, {Length: 5, Amount: c(40)}},
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			start, end, result := types.DisjunctPeriods(tc.startP, tc.startQ, tc.p, tc.q)
+			require.Equal(t, tc.expStart, start)
+			require.Equal(t, tc.expEnd, end)
+			require.Equal(t, tc.expResult, result)
+			require.True(t, tc.p.TotalAmount().Add(tc.q.TotalAmount()...).IsEqual(result.TotalAmount()))
+
+			// the union is commutative
+			start, end, result = types.DisjunctPeriods(tc.startQ, tc.startP, tc.q, tc.p)
+			require.Equal(t, tc.expStart, start)
+			require.Equal(t, tc.expEnd, end)
+			require.Equal(t, tc.expResult, result)
+		})
+	}
+}
@@ -2,6 +2,7 @@ package types
 
 import (
 	"errors"
+	"fmt"
 	"time"
 
 	"sigs.k8s.io/yaml"
@@ -18,6 +19,7 @@ var (
 	_ vestexported.VestingAccount = (*ContinuousVestingAccount)(nil)
 	_ vestexported.VestingAccount = (*PeriodicVestingAccount)(nil)
 	_ vestexported.VestingAccount = (*DelayedVestingAccount)(nil)
+	_ vestexported.VestingAccount = (*ClawbackVestingAccount)(nil)
 )
 
 // Base Vesting Account
@@ -164,6 +166,8 @@ type vestingAccountYAML struct {
 	// custom fields based on concrete vesting type which can be omitted
 	StartTime      int64   `json:"start_time,omitempty"`
 	VestingPeriods Periods `json:"vesting_periods,omitempty"`
+	FunderAddress  string  `json:"funder_address,omitempty"`
+	LockupPeriods  Periods `json:"lockup_periods,omitempty"`
 }
 
 func (bva BaseVestingAccount) String() string {
@@ -394,6 +398,29 @@ func (pva *PeriodicVestingAccount) TrackDelegation(blockTime time.Time, balance,
 	pva.BaseVestingAccount.TrackDelegation(balance, pva.GetVestingCoins(blockTime), amount)
 }
 
+// AddGrant merges a new grant of grantCoins, vesting on grantVestingPeriods from
+// grantStartTime, into the vesting schedule of the account. The delegation
+// bookkeeping is rebased on the delegated (i.e. bonded and unbonding) coins of
+// the account, since they might have been slashed, or not tracked at all for an
+// account converted from a BaseAccount.
+func (pva *PeriodicVestingAccount) AddGrant(blockTime time.Time, grantStartTime int64, grantVestingPeriods Periods, grantCoins, delegated sdk.Coins) {
+	// discover what has been slashed, and keep it accounted for up to the
+	// current vesting amount
+	oldDelegated := pva.DelegatedVesting.Add(pva.DelegatedFree...)
+	slashed := oldDelegated.Sub(oldDelegated.Min(delegated)...)
+	newTotalDelegated := delegated.Add(pva.GetVestingCoins(blockTime).Min(slashed)...)
+
+	newStart, newEnd, newPeriods := DisjunctPeriods(pva.StartTime, grantStartTime, pva.VestingPeriods, grantVestingPeriods)
+	pva.StartTime = newStart
+	pva.EndTime = newEnd
+	pva.VestingPeriods = newPeriods
+	pva.OriginalVesting = pva.OriginalVesting.Add(grantCoins...)
+
+	// the delegations cover the vesting coins first, as in TrackDelegation
+	pva.DelegatedVesting = newTotalDelegated.Min(pva.GetVestingCoins(blockTime))
+	pva.DelegatedFree = newTotalDelegated.Sub(pva.DelegatedVesting...)
+}
+
 // GetStartTime returns the time when vesting starts for a periodic vesting
 // account.
 func (pva PeriodicVestingAccount) GetStartTime() int64 {
@@ -591,6 +618,240 @@ func (plva PermanentLockedAccount) String() string {
 	return out.(string)
 }
 
+//-----------------------------------------------------------------------------
+// Clawback Vesting Account
+
+var (
+	_ vestexported.VestingAccount = (*ClawbackVestingAccount)(nil)
+	_ authtypes.GenesisAccount    = (*ClawbackVestingAccount)(nil)
+)
+
+// NewClawbackVestingAccount returns a new ClawbackVestingAccount
+func NewClawbackVestingAccount(baseAcc *authtypes.BaseAccount, funder sdk.AccAddress, originalVesting sdk.Coins, startTime int64, lockupPeriods, vestingPeriods Periods) *ClawbackVestingAccount {
+	// the account vests when both schedules are complete
+	endTime := startTime + lockupPeriods.TotalLength()
+	if vestingEnd := startTime + vestingPeriods.TotalLength(); vestingEnd > endTime {
+		endTime = vestingEnd
+	}
+	baseVestingAcc := &BaseVestingAccount{
+		BaseAccount:     baseAcc,
+		OriginalVesting: originalVesting,
+		EndTime:         endTime,
+	}
+
+	return &ClawbackVestingAccount{
+		BaseVestingAccount: baseVestingAcc,
+		FunderAddress:      funder.String(),
+		StartTime:          startTime,
+		LockupPeriods:      lockupPeriods,
+		VestingPeriods:     vestingPeriods,
+	}
+}
+
+// GetVestedCoins returns the total number of vested coins that are no longer
+// subject to the lockup. If no coins are vested, nil is returned.
+func (va ClawbackVestingAccount) GetVestedCoins(blockTime time.Time) sdk.Coins {
+	// It's likely that one or the other schedule will be nearly trivial,
+	// so there should be little overhead in recomputing the conjunction each time.
+	coins := va.GetUnlockedOnly(blockTime).Min(va.GetVestedOnly(blockTime))
+	if coins.IsZero() {
+		return nil
+	}
+	return coins
+}
+
+// GetVestingCoins returns the total number of vesting coins, i.e. the coins
+// which are either unvested or still subject to the lockup. If no coins are
+// vesting, nil is returned.
+func (va ClawbackVestingAccount) GetVestingCoins(blockTime time.Time) sdk.Coins {
+	return va.OriginalVesting.Sub(va.GetVestedCoins(blockTime)...)
+}
+
+// LockedCoins returns the set of coins that are not spendable (i.e. locked),
+// defined as the vesting coins that are not delegated.
+func (va ClawbackVestingAccount) LockedCoins(blockTime time.Time) sdk.Coins {
+	return va.BaseVestingAccount.LockedCoinsFromVesting(va.GetVestingCoins(blockTime))
+}
+
+// TrackDelegation tracks a desired delegation amount by setting the appropriate
+// values for the amount of delegated vesting, delegated free, and reducing the
+// overall amount of base coins.
+//
+// NOTE: the unvested coins can be delegated, and the delegations are tracked
+// as delegated vesting, so that they can later be clawed back.
+func (va *ClawbackVestingAccount) TrackDelegation(blockTime time.Time, balance, amount sdk.Coins) {
+	va.BaseVestingAccount.TrackDelegation(balance, va.GetVestingCoins(blockTime), amount)
+}
+
+// GetStartTime returns the time when vesting starts for a clawback vesting
+// account.
+func (va ClawbackVestingAccount) GetStartTime() int64 {
+	return va.StartTime
+}
+
+// GetUnlockedOnly returns the coins unlocked by the lockup schedule at
+// blockTime, regardless of the vesting schedule.
+func (va ClawbackVestingAccount) GetUnlockedOnly(blockTime time.Time) sdk.Coins {
+	return ReadSchedule(va.StartTime, va.EndTime, va.LockupPeriods, va.OriginalVesting, blockTime.Unix())
+}
+
+// GetVestedOnly returns the coins vested by the vesting schedule at
+// blockTime, regardless of the lockup schedule.
+func (va ClawbackVestingAccount) GetVestedOnly(blockTime time.Time) sdk.Coins {
+	return ReadSchedule(va.StartTime, va.EndTime, va.VestingPeriods, va.OriginalVesting, blockTime.Unix())
+}
+
+// GetUnvestedOnly returns the coins which have not been vested by the vesting
+// schedule at blockTime, and are subject to clawback.
+func (va ClawbackVestingAccount) GetUnvestedOnly(blockTime time.Time) sdk.Coins {
+	return va.OriginalVesting.Sub(va.GetVestedOnly(blockTime)...)
+}
+
+// GetLockedOnly returns the coins which are still locked by the lockup
+// schedule at blockTime, regardless of the vesting schedule.
+func (va ClawbackVestingAccount) GetLockedOnly(blockTime time.Time) sdk.Coins {
+	return va.OriginalVesting.Sub(va.GetUnlockedOnly(blockTime)...)
+}
+
+// Validate checks for errors on the account fields
+func (va ClawbackVestingAccount) Validate() error {
+	if _, err := sdk.AccAddressFromBech32(va.FunderAddress); err != nil {
+		return fmt.Errorf("invalid funder address: %w", err)
+	}
+	if va.GetStartTime() > va.GetEndTime() {
+		return errors.New("vesting start-time cannot be after end-time")
+	}
+
+	lockupEnd := va.StartTime
+	lockupCoins := sdk.NewCoins()
+	for _, p := range va.LockupPeriods {
+		if p.Length < 0 {
+			return errors.New("lockup period length cannot be negative")
+		}
+		lockupEnd += p.Length
+		lockupCoins = lockupCoins.Add(p.Amount...)
+	}
+	if lockupEnd > va.EndTime {
+		return errors.New("lockup schedule extends beyond account end time")
+	}
+	if !coinsEq(lockupCoins, va.OriginalVesting) {
+		return errors.New("original vesting coins does not match the sum of all coins in lockup periods")
+	}
+
+	vestingEnd := va.StartTime
+	vestingCoins := sdk.NewCoins()
+	for _, p := range va.VestingPeriods {
+		if p.Length < 0 {
+			return errors.New("vesting period length cannot be negative")
+		}
+		vestingEnd += p.Length
+		vestingCoins = vestingCoins.Add(p.Amount...)
+	}
+	if vestingEnd > va.EndTime {
+		return errors.New("vesting schedule extends beyond account end time")
+	}
+	if !coinsEq(vestingCoins, va.OriginalVesting) {
+		return errors.New("original vesting coins does not match the sum of all coins in vesting periods")
+	}
+
+	return va.BaseVestingAccount.Validate()
+}
+
+// ComputeClawback returns the account truncated to the coins vested at
+// clawbackTime, along with the unvested coins which are removed from its
+// vesting schedule. The lockup schedule is capped to the remaining vested
+// coins. The delegation bookkeeping must be updated separately, with
+// UpdateDelegation.
+func (va ClawbackVestingAccount) ComputeClawback(clawbackTime int64) (ClawbackVestingAccount, sdk.Coins) {
+	vestTime := va.StartTime
+	totalVested := sdk.NewCoins()
+	totalUnvested := sdk.NewCoins()
+	unvestedIdx := 0
+	for i, period := range va.VestingPeriods {
+		vestTime += period.Length
+		if vestTime <= clawbackTime {
+			totalVested = totalVested.Add(period.Amount...)
+			unvestedIdx = i + 1
+			continue
+		}
+		totalUnvested = totalUnvested.Add(period.Amount...)
+	}
+	newVestingPeriods := make(Periods, unvestedIdx)
+	copy(newVestingPeriods, va.VestingPeriods[:unvestedIdx])
+	lastVestTime := va.StartTime + newVestingPeriods.TotalLength()
+
+	// cap the lockup schedule to the new total vested, by conjunction with a
+	// schedule unlocking it all at the start time
+	capPeriods := Periods{{Length: 0, Amount: totalVested}}
+	_, lastLockTime, newLockupPeriods := ConjunctPeriods(va.StartTime, va.StartTime, va.LockupPeriods, capPeriods)
+
+	// copy the embedded base vesting account so that the receiver is unchanged
+	bva := *va.BaseVestingAccount
+	va.BaseVestingAccount = &bva
+	va.OriginalVesting = totalVested
+	va.EndTime = lastVestTime
+	if lastLockTime > va.EndTime {
+		va.EndTime = lastLockTime
+	}
+	va.LockupPeriods = newLockupPeriods
+	va.VestingPeriods = newVestingPeriods
+
+	return va, totalUnvested
+}
+
+// UpdateDelegation updates the delegation bookkeeping of the account for a
+// clawback of toClawBack, given its current encumbered (i.e. vesting) coins,
+// its bonded and unbonding delegations and its bank balance. The clawback is
+// taken from the bank balance first, then from the delegations, and is
+// reduced if some coins were slashed. It returns the amount which can
+// actually be clawed back.
+func (va *ClawbackVestingAccount) UpdateDelegation(encumbered, toClawBack, bonded, unbonding, unbonded sdk.Coins) sdk.Coins {
+	delegated := bonded.Add(unbonding...)
+	oldDelegated := va.DelegatedVesting.Add(va.DelegatedFree...)
+	slashed := oldDelegated.Sub(delegated.Min(oldDelegated)...)
+	total := delegated.Add(unbonded...)
+	toClawBack = toClawBack.Min(total) // might have been slashed
+	newDelegated := delegated.Min(total.Sub(toClawBack...)).Add(slashed...)
+	va.DelegatedVesting = encumbered.Min(newDelegated)
+	va.DelegatedFree = newDelegated.Sub(va.DelegatedVesting...)
+	return toClawBack
+}
+
+func (va ClawbackVestingAccount) String() string {
+	out, _ := va.MarshalYAML()
+	return out.(string)
+}
+
+// MarshalYAML returns the YAML representation of a ClawbackVestingAccount.
+func (va ClawbackVestingAccount) MarshalYAML() (interface{}, error) {
+	accAddr, err := sdk.AccAddressFromBech32(va.Address)
+	if err != nil {
+		return nil, err
+	}
+
+	out := vestingAccountYAML{
+		Address:          accAddr,
+		AccountNumber:    va.AccountNumber,
+		PubKey:           getPKString(va),
+		Sequence:         va.Sequence,
+		OriginalVesting:  va.OriginalVesting,
+		DelegatedFree:    va.DelegatedFree,
+		DelegatedVesting: va.DelegatedVesting,
+		EndTime:          va.EndTime,
+		StartTime:        va.StartTime,
+		VestingPeriods:   va.VestingPeriods,
+		FunderAddress:    va.FunderAddress,
+		LockupPeriods:    va.LockupPeriods,
+	}
+	return marshalYaml(out)
+}
+
+// coinsEq returns whether two sets of coins are equal, regardless of their
+// denoms, unlike sdk.Coins.IsEqual.
+func coinsEq(a, b sdk.Coins) bool {
+	return a.IsAllLTE(b) && b.IsAllLTE(a)
+}
+
 type getPK interface {
 	GetPubKey() cryptotypes.PubKey
 }
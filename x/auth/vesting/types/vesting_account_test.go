@@ -677,6 +677,268 @@ func TestTrackUndelegationPermLockedVestingAcc(t *testing.T) {
 	require.Equal(t, sdk.Coins{sdk.NewInt64Coin(stakeDenom, 25)}, plva.DelegatedVesting)
 }
 
+func TestAddGrantPeriodicVestingAcc(t *testing.T) {
+	c := sdk.NewInt64Coin
+	now := tmtime.Now()
+	periods := types.Periods{
+		types.Period{Length: int64(12 * 60 * 60), Amount: sdk.Coins{c(feeDenom, 500), c(stakeDenom, 50)}},
+		types.Period{Length: int64(12 * 60 * 60), Amount: sdk.Coins{c(feeDenom, 500), c(stakeDenom, 50)}},
+	}
+	grantPeriods := types.Periods{
+		types.Period{Length: int64(6 * 60 * 60), Amount: sdk.Coins{c(stakeDenom, 25)}},
+		types.Period{Length: int64(6 * 60 * 60), Amount: sdk.Coins{c(stakeDenom, 25)}},
+	}
+
+	bacc, origCoins := initBaseAccount()
+	pva := types.NewPeriodicVestingAccount(bacc, origCoins, now.Unix(), periods)
+	pva.TrackDelegation(now, origCoins, sdk.Coins{c(stakeDenom, 60)})
+	require.Equal(t, sdk.Coins{c(stakeDenom, 60)}, pva.DelegatedVesting)
+
+	// require the grant to be merged into the schedule, 20 of the delegated
+	// tokens having been slashed
+	blockTime := now.Add(12 * time.Hour)
+	pva.AddGrant(blockTime, blockTime.Unix(), grantPeriods, sdk.Coins{c(stakeDenom, 50)}, sdk.Coins{c(stakeDenom, 40)})
+	require.NoError(t, pva.Validate())
+	require.Equal(t, now.Unix(), pva.StartTime)
+	require.Equal(t, now.Add(24*time.Hour).Unix(), pva.EndTime)
+	require.Equal(t, sdk.Coins{c(feeDenom, 1000), c(stakeDenom, 150)}, pva.OriginalVesting)
+	require.Equal(t, []types.Period{
+		{Length: int64(12 * 60 * 60), Amount: sdk.Coins{c(feeDenom, 500), c(stakeDenom, 50)}},
+		{Length: int64(6 * 60 * 60), Amount: sdk.Coins{c(stakeDenom, 25)}},
+		{Length: int64(6 * 60 * 60), Amount: sdk.Coins{c(feeDenom, 500), c(stakeDenom, 75)}},
+	}, pva.VestingPeriods)
+	require.Equal(t, sdk.Coins{c(feeDenom, 500), c(stakeDenom, 100)}, pva.GetVestingCoins(blockTime))
+
+	// the slashed tokens stay accounted for as delegated vesting
+	require.Equal(t, sdk.Coins{c(stakeDenom, 60)}, pva.DelegatedVesting)
+	require.True(t, pva.DelegatedFree.IsZero())
+	require.Equal(t, sdk.Coins{c(feeDenom, 500), c(stakeDenom, 40)}, pva.LockedCoins(blockTime))
+}
+
+func TestAddGrantConvertedBaseAcc(t *testing.T) {
+	c := sdk.NewInt64Coin
+	now := tmtime.Now()
+	grantPeriods := types.Periods{
+		types.Period{Length: int64(12 * 60 * 60), Amount: sdk.Coins{c(stakeDenom, 100)}},
+	}
+
+	// convert a base account with 30 tokens delegated
+	bacc, _ := initBaseAccount()
+	pva := types.NewPeriodicVestingAccount(bacc, sdk.NewCoins(), now.Unix(), nil)
+	pva.AddGrant(now, now.Unix(), grantPeriods, sdk.Coins{c(stakeDenom, 100)}, sdk.Coins{c(stakeDenom, 30)})
+	require.NoError(t, pva.Validate())
+	require.Equal(t, now.Add(12*time.Hour).Unix(), pva.EndTime)
+	require.Equal(t, sdk.Coins{c(stakeDenom, 100)}, pva.OriginalVesting)
+
+	// require the existing delegations to cover the vesting coins first
+	require.Equal(t, sdk.Coins{c(stakeDenom, 30)}, pva.DelegatedVesting)
+	require.True(t, pva.DelegatedFree.IsZero())
+	require.Equal(t, sdk.Coins{c(stakeDenom, 70)}, pva.LockedCoins(now))
+	require.True(t, pva.LockedCoins(now.Add(12*time.Hour)).IsZero())
+
+	// require the delegations in excess of the vesting coins to be free
+	pva = types.NewPeriodicVestingAccount(bacc, sdk.NewCoins(), now.Unix(), nil)
+	pva.AddGrant(now, now.Unix(), grantPeriods, sdk.Coins{c(stakeDenom, 100)}, sdk.Coins{c(stakeDenom, 130)})
+	require.Equal(t, sdk.Coins{c(stakeDenom, 100)}, pva.DelegatedVesting)
+	require.Equal(t, sdk.Coins{c(stakeDenom, 30)}, pva.DelegatedFree)
+	require.True(t, pva.LockedCoins(now).IsZero())
+}
+
+func TestGetVestedCoinsClawbackVestingAcc(t *testing.T) {
+	now := tmtime.Now()
+	lockupPeriods := types.Periods{
+		types.Period{Length: int64(16 * 60 * 60), Amount: sdk.Coins{sdk.NewInt64Coin(feeDenom, 1000), sdk.NewInt64Coin(stakeDenom, 100)}},
+	}
+	vestingPeriods := types.Periods{
+		types.Period{Length: int64(12 * 60 * 60), Amount: sdk.Coins{sdk.NewInt64Coin(feeDenom, 500), sdk.NewInt64Coin(stakeDenom, 50)}},
+		types.Period{Length: int64(6 * 60 * 60), Amount: sdk.Coins{sdk.NewInt64Coin(feeDenom, 250), sdk.NewInt64Coin(stakeDenom, 25)}},
+		types.Period{Length: int64(6 * 60 * 60), Amount: sdk.Coins{sdk.NewInt64Coin(feeDenom, 250), sdk.NewInt64Coin(stakeDenom, 25)}},
+	}
+
+	bacc, origCoins := initBaseAccount()
+	_, _, funder := testdata.KeyTestPubAddr()
+	va := types.NewClawbackVestingAccount(bacc, funder, origCoins, now.Unix(), lockupPeriods, vestingPeriods)
+	require.Equal(t, now.Add(24*time.Hour).Unix(), va.GetEndTime())
+
+	// require no coins vested at the beginning of the vesting schedule
+	require.Nil(t, va.GetVestedCoins(now))
+	require.Equal(t, origCoins, va.GetVestingCoins(now))
+
+	// require no coins vested while locked, even if the vesting schedule has vested
+	require.Nil(t, va.GetVestedCoins(now.Add(12*time.Hour)))
+	require.Equal(t, sdk.Coins{sdk.NewInt64Coin(feeDenom, 500), sdk.NewInt64Coin(stakeDenom, 50)}, va.GetVestedOnly(now.Add(12*time.Hour)))
+
+	// require the coins vested by the vesting schedule once unlocked
+	require.Equal(t, sdk.Coins{sdk.NewInt64Coin(feeDenom, 500), sdk.NewInt64Coin(stakeDenom, 50)}, va.GetVestedCoins(now.Add(16*time.Hour)))
+	require.Equal(t, sdk.Coins{sdk.NewInt64Coin(feeDenom, 750), sdk.NewInt64Coin(stakeDenom, 75)}, va.GetVestedCoins(now.Add(18*time.Hour)))
+	require.Equal(t, sdk.Coins{sdk.NewInt64Coin(feeDenom, 250), sdk.NewInt64Coin(stakeDenom, 25)}, va.GetVestingCoins(now.Add(18*time.Hour)))
+
+	// require all coins vested at the end of both schedules
+	require.Equal(t, origCoins, va.GetVestedCoins(now.Add(24*time.Hour)))
+	require.Nil(t, va.GetVestingCoins(now.Add(48*time.Hour)))
+}
+
+func TestBalancesClawbackVestingAcc(t *testing.T) {
+	now := tmtime.Now()
+	lockupPeriods := types.Periods{
+		types.Period{Length: int64(6 * 60 * 60), Amount: sdk.Coins{sdk.NewInt64Coin(stakeDenom, 50)}},
+		types.Period{Length: int64(6 * 60 * 60), Amount: sdk.Coins{sdk.NewInt64Coin(stakeDenom, 50)}},
+	}
+	vestingPeriods := types.Periods{
+		types.Period{Length: int64(12 * 60 * 60), Amount: sdk.Coins{sdk.NewInt64Coin(stakeDenom, 100)}},
+	}
+
+	bacc, _ := initBaseAccount()
+	origCoins := sdk.Coins{sdk.NewInt64Coin(stakeDenom, 100)}
+	_, _, funder := testdata.KeyTestPubAddr()
+	va := types.NewClawbackVestingAccount(bacc, funder, origCoins, now.Unix(), lockupPeriods, vestingPeriods)
+
+	at := now.Add(6 * time.Hour)
+	require.Equal(t, sdk.Coins{sdk.NewInt64Coin(stakeDenom, 50)}, va.GetUnlockedOnly(at))
+	require.Equal(t, sdk.Coins{sdk.NewInt64Coin(stakeDenom, 50)}, va.GetLockedOnly(at))
+	require.Equal(t, sdk.NewCoins(), va.GetVestedOnly(at))
+	require.Equal(t, origCoins, va.GetUnvestedOnly(at))
+	require.Equal(t, origCoins, va.LockedCoins(at))
+}
+
+func TestTrackDelegationClawbackVestingAcc(t *testing.T) {
+	now := tmtime.Now()
+	periods := types.Periods{
+		types.Period{Length: int64(12 * 60 * 60), Amount: sdk.Coins{sdk.NewInt64Coin(feeDenom, 500), sdk.NewInt64Coin(stakeDenom, 50)}},
+		types.Period{Length: int64(12 * 60 * 60), Amount: sdk.Coins{sdk.NewInt64Coin(feeDenom, 500), sdk.NewInt64Coin(stakeDenom, 50)}},
+	}
+
+	bacc, origCoins := initBaseAccount()
+	_, _, funder := testdata.KeyTestPubAddr()
+
+	// require the ability to delegate all unvested coins
+	va := types.NewClawbackVestingAccount(bacc, funder, origCoins, now.Unix(), periods, periods)
+	va.TrackDelegation(now, origCoins, origCoins)
+	require.Equal(t, origCoins, va.DelegatedVesting)
+	require.Nil(t, va.DelegatedFree)
+
+	// delegate 75% of coins, split between vested and vesting
+	va = types.NewClawbackVestingAccount(bacc, funder, origCoins, now.Unix(), periods, periods)
+	va.TrackDelegation(now.Add(12*time.Hour), origCoins, sdk.Coins{sdk.NewInt64Coin(stakeDenom, 75)})
+	require.Equal(t, sdk.Coins{sdk.NewInt64Coin(stakeDenom, 50)}, va.DelegatedVesting)
+	require.Equal(t, sdk.Coins{sdk.NewInt64Coin(stakeDenom, 25)}, va.DelegatedFree)
+
+	// require the undelegations to release the delegated free coins first
+	va.TrackUndelegation(sdk.Coins{sdk.NewInt64Coin(stakeDenom, 50)})
+	require.Nil(t, va.DelegatedFree)
+	require.Equal(t, sdk.Coins{sdk.NewInt64Coin(stakeDenom, 25)}, va.DelegatedVesting)
+}
+
+func TestComputeClawbackClawbackVestingAcc(t *testing.T) {
+	now := tmtime.Now()
+	c := sdk.NewInt64Coin
+	lockupPeriods := types.Periods{
+		types.Period{Length: int64(12 * 60 * 60), Amount: sdk.Coins{c(stakeDenom, 100)}},
+	}
+	vestingPeriods := types.Periods{
+		types.Period{Length: int64(6 * 60 * 60), Amount: sdk.Coins{c(stakeDenom, 25)}},
+		types.Period{Length: int64(6 * 60 * 60), Amount: sdk.Coins{c(stakeDenom, 25)}},
+		types.Period{Length: int64(6 * 60 * 60), Amount: sdk.Coins{c(stakeDenom, 50)}},
+	}
+
+	bacc, _ := initBaseAccount()
+	origCoins := sdk.Coins{c(stakeDenom, 100)}
+	_, _, funder := testdata.KeyTestPubAddr()
+	va := types.NewClawbackVestingAccount(bacc, funder, origCoins, now.Unix(), lockupPeriods, vestingPeriods)
+
+	// require nothing clawed back at the end of the vesting schedule
+	va2, clawedBack := va.ComputeClawback(now.Add(18 * time.Hour).Unix())
+	require.True(t, clawedBack.IsZero())
+	require.Equal(t, origCoins, va2.OriginalVesting)
+	require.NoError(t, va2.Validate())
+
+	// require the coins vesting at the clawback time to be vested
+	va2, clawedBack = va.ComputeClawback(now.Add(6 * time.Hour).Unix())
+	require.Equal(t, sdk.Coins{c(stakeDenom, 75)}, clawedBack)
+	require.Equal(t, sdk.Coins{c(stakeDenom, 25)}, va2.OriginalVesting)
+	require.Equal(t, []types.Period{vestingPeriods[0]}, va2.VestingPeriods)
+	// require the lockup to be capped to the vested coins
+	require.Equal(t, []types.Period{{Length: int64(12 * 60 * 60), Amount: sdk.Coins{c(stakeDenom, 25)}}}, va2.LockupPeriods)
+	require.Equal(t, now.Add(12*time.Hour).Unix(), va2.EndTime)
+	require.NoError(t, va2.Validate())
+	// require the original account to be unchanged
+	require.Equal(t, origCoins, va.OriginalVesting)
+	require.Len(t, va.VestingPeriods, 3)
+
+	// require everything clawed back before the first vesting event
+	va2, clawedBack = va.ComputeClawback(now.Add(time.Hour).Unix())
+	require.Equal(t, origCoins, clawedBack)
+	require.True(t, va2.OriginalVesting.IsZero())
+	require.Empty(t, va2.VestingPeriods)
+	require.Empty(t, va2.LockupPeriods)
+	require.NoError(t, va2.Validate())
+}
+
+func TestUpdateDelegationClawbackVestingAcc(t *testing.T) {
+	c := sdk.NewInt64Coin
+	now := tmtime.Now()
+	bacc, _ := initBaseAccount()
+	_, _, funder := testdata.KeyTestPubAddr()
+	periods := types.Periods{types.Period{Length: 100, Amount: sdk.Coins{c(stakeDenom, 100)}}}
+
+	testCases := []struct {
+		name                     string
+		delegatedVesting         sdk.Coins
+		delegatedFree            sdk.Coins
+		encumbered               sdk.Coins
+		toClawBack               sdk.Coins
+		bonded                   sdk.Coins
+		unbonded                 sdk.Coins
+		expClawBack              sdk.Coins
+		expDelegatedVesting      sdk.Coins
+		expDelegatedFree         sdk.Coins
+		expDelegatedVestingEmpty bool
+	}{
+		{
+			"clawback from the bank balance only",
+			sdk.Coins{c(stakeDenom, 30)}, nil, sdk.Coins{c(stakeDenom, 50)}, sdk.Coins{c(stakeDenom, 50)},
+			sdk.Coins{c(stakeDenom, 30)}, sdk.Coins{c(stakeDenom, 70)},
+			sdk.Coins{c(stakeDenom, 50)}, sdk.Coins{c(stakeDenom, 30)}, sdk.NewCoins(), false,
+		},
+		{
+			"clawback from the delegations",
+			sdk.Coins{c(stakeDenom, 80)}, nil, sdk.Coins{c(stakeDenom, 50)}, sdk.Coins{c(stakeDenom, 50)},
+			sdk.Coins{c(stakeDenom, 80)}, sdk.Coins{c(stakeDenom, 20)},
+			sdk.Coins{c(stakeDenom, 50)}, sdk.Coins{c(stakeDenom, 50)}, sdk.NewCoins(), false,
+		},
+		{
+			"clawback from vested delegations",
+			sdk.Coins{c(stakeDenom, 50)}, sdk.Coins{c(stakeDenom, 50)}, sdk.Coins{c(stakeDenom, 20)}, sdk.Coins{c(stakeDenom, 30)},
+			sdk.Coins{c(stakeDenom, 100)}, nil,
+			sdk.Coins{c(stakeDenom, 30)}, sdk.Coins{c(stakeDenom, 20)}, sdk.Coins{c(stakeDenom, 50)}, false,
+		},
+		{
+			"clawback reduced by slashing",
+			sdk.Coins{c(stakeDenom, 100)}, nil, sdk.NewCoins(), sdk.Coins{c(stakeDenom, 100)},
+			sdk.Coins{c(stakeDenom, 60)}, nil,
+			sdk.Coins{c(stakeDenom, 60)}, nil, sdk.Coins{c(stakeDenom, 40)}, true,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			va := types.NewClawbackVestingAccount(bacc, funder, sdk.Coins{c(stakeDenom, 100)}, now.Unix(), periods, periods)
+			va.DelegatedVesting = tc.delegatedVesting
+			va.DelegatedFree = tc.delegatedFree
+
+			clawBack := va.UpdateDelegation(tc.encumbered, tc.toClawBack, tc.bonded, sdk.NewCoins(), tc.unbonded)
+			require.Equal(t, tc.expClawBack, clawBack)
+			if tc.expDelegatedVestingEmpty {
+				require.True(t, va.DelegatedVesting.IsZero())
+			} else {
+				require.Equal(t, tc.expDelegatedVesting, va.DelegatedVesting)
+			}
+			require.True(t, tc.expDelegatedFree.IsEqual(va.DelegatedFree), "%s != %s", tc.expDelegatedFree, va.DelegatedFree)
+		})
+	}
+}
+
 func TestGenesisAccountValidate(t *testing.T) {
 	pubkey := secp256k1.GenPrivKey().PubKey()
 	addr := sdk.AccAddress(pubkey.Address())
@@ -742,6 +1004,39 @@ func TestGenesisAccountValidate(t *testing.T) {
 			&types.PermanentLockedAccount{BaseVestingAccount: baseVestingWithCoins},
 			true,
 		},
+		{
+			"valid clawback vesting account",
+			types.NewClawbackVestingAccount(baseAcc, addr, initialVesting, 0,
+				types.Periods{types.Period{Length: int64(100), Amount: initialVesting}},
+				types.Periods{types.Period{Length: int64(50), Amount: initialVesting}}),
+			false,
+		},
+		{
+			"invalid clawback vesting account funder",
+			&types.ClawbackVestingAccount{
+				BaseVestingAccount: baseVestingWithCoins,
+				LockupPeriods:      types.Periods{types.Period{Length: int64(100), Amount: initialVesting}},
+				VestingPeriods:     types.Periods{types.Period{Length: int64(100), Amount: initialVesting}},
+			},
+			true,
+		},
+		{
+			"invalid clawback vesting period amounts",
+			types.NewClawbackVestingAccount(baseAcc, addr, initialVesting, 0,
+				types.Periods{types.Period{Length: int64(100), Amount: initialVesting}},
+				types.Periods{types.Period{Length: int64(100), Amount: sdk.Coins{sdk.NewInt64Coin(sdk.DefaultBondDenom, 25)}}}),
+			true,
+		},
+		{
+			"invalid clawback lockup period lengths",
+			&types.ClawbackVestingAccount{
+				BaseVestingAccount: baseVestingWithCoins,
+				FunderAddress:      addr.String(),
+				LockupPeriods:      types.Periods{types.Period{Length: int64(150), Amount: initialVesting}},
+				VestingPeriods:     types.Periods{types.Period{Length: int64(100), Amount: initialVesting}},
+			},
+			true,
+		},
 	}
 
 	for _, tt := range tests {
@@ -830,6 +1125,26 @@ func (s *VestingAccountTestSuite) TestPermanentLockedAccountMarshal() {
 	require.NotNil(err)
 }
 
+func (s *VestingAccountTestSuite) TestClawbackVestingAccountMarshal() {
+	app := s.app
+	require := s.Require()
+	baseAcc, coins := initBaseAccount()
+	_, _, funder := testdata.KeyTestPubAddr()
+	acc := types.NewClawbackVestingAccount(baseAcc, funder, coins, time.Now().Unix(), types.Periods{types.Period{Length: 3600, Amount: coins}}, types.Periods{types.Period{Length: 7200, Amount: coins}})
+
+	bz, err := app.AccountKeeper.MarshalAccount(acc)
+	require.Nil(err)
+
+	acc2, err := app.AccountKeeper.UnmarshalAccount(bz)
+	require.Nil(err)
+	require.IsType(&types.ClawbackVestingAccount{}, acc2)
+	require.Equal(acc.String(), acc2.String())
+
+	// error on bad bytes
+	_, err = app.AccountKeeper.UnmarshalAccount(bz[:len(bz)/2])
+	require.NotNil(err)
+}
+
 func initBaseAccount() (*authtypes.BaseAccount, sdk.Coins) {
 	_, _, addr := testdata.KeyTestPubAddr()
 	origCoins := sdk.Coins{sdk.NewInt64Coin(feeDenom, 1000), sdk.NewInt64Coin(stakeDenom, 100)}
@@ -6,13 +6,22 @@ package testutil
 import (
 	"testing"
 
+	"github.com/stretchr/testify/require"
 	"github.com/stretchr/testify/suite"
 
 	"github.com/cosmos/cosmos-sdk/testutil/network"
+	epochingtypes "github.com/cosmos/cosmos-sdk/x/epoching/types"
 )
 
 func TestIntegrationTestSuite(t *testing.T) {
 	cfg := network.DefaultConfig()
 	cfg.NumValidators = 1
+	// the staking msgs are queued until the end of the epoch, which is every
+	// block here, so that the suite can query their result in the next block
+	epochingGenesis := epochingtypes.DefaultGenesisState()
+	epochingGenesis.Params.EpochLength = 1
+	bz, err := cfg.Codec.MarshalJSON(epochingGenesis)
+	require.NoError(t, err)
+	cfg.GenesisState[epochingtypes.ModuleName] = bz
 	suite.Run(t, NewIntegrationTestSuite(cfg))
 }
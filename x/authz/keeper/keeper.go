@@ -16,6 +16,7 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	authkeeper "github.com/cosmos/cosmos-sdk/x/auth/keeper"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
 	"github.com/cosmos/cosmos-sdk/x/authz"
 )
 
@@ -88,6 +89,12 @@ func (k Keeper) DispatchActions(ctx sdk.Context, grantee sdk.AccAddress, msgs []
 	now := ctx.BlockTime()
 
 	for i, msg := range msgs {
+		// a grantee allowed to change the pubkey of the granter account could
+		// hand it over to a key of its own
+		if _, ok := msg.(*authtypes.MsgChangePubKey); ok {
+			return nil, sdkerrors.ErrUnauthorized.Wrapf("%s cannot be executed with authz", sdk.MsgTypeURL(msg))
+		}
+
 		signers := msg.GetSigners()
 		if len(signers) != 1 {
 			return nil, authz.ErrAuthorizationNumOfSigners
@@ -9,8 +9,11 @@ import (
 	tmtime "github.com/tendermint/tendermint/types/time"
 
 	"github.com/cosmos/cosmos-sdk/baseapp"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
 	"github.com/cosmos/cosmos-sdk/simapp"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
 	"github.com/cosmos/cosmos-sdk/x/authz"
 	"github.com/cosmos/cosmos-sdk/x/bank/testutil"
 	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
@@ -320,6 +323,29 @@ func (s *TestSuite) TestDispatchedEvents() {
 	}
 }
 
+func (s *TestSuite) TestDispatchChangePubKey() {
+	require := s.Require()
+	app, addrs := s.app, s.addrs
+	granterAddr := addrs[0]
+	granteeAddr := addrs[1]
+
+	msgType := sdk.MsgTypeURL(&authtypes.MsgChangePubKey{})
+	err := app.AuthzKeeper.SaveGrant(s.ctx, granteeAddr, granterAddr, authz.NewGenericAuthorization(msgType), nil)
+	require.NoError(err)
+
+	// the grantee signs with a key of its own
+	priv := secp256k1.GenPrivKey()
+	acc := app.AccountKeeper.GetAccount(s.ctx, granterAddr)
+	sig, err := priv.Sign(authtypes.ChangePubKeySignBytes(granterAddr, acc.GetAccountNumber()))
+	require.NoError(err)
+	msg, err := authtypes.NewMsgChangePubKey(granterAddr, priv.PubKey(), sig)
+	require.NoError(err)
+
+	_, err = app.AuthzKeeper.DispatchActions(s.ctx, granteeAddr, []sdk.Msg{msg})
+	require.ErrorIs(err, sdkerrors.ErrUnauthorized)
+	require.Nil(app.AccountKeeper.GetAccount(s.ctx, granterAddr).GetPubKey())
+}
+
 func (s *TestSuite) TestDequeueAllGrantsQueue() {
 	require := s.Require()
 	app, addrs := s.app, s.addrs
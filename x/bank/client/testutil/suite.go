@@ -78,6 +78,11 @@ func (s *IntegrationTestSuite) SetupSuite() {
 		},
 	}
 
+	bankGenesis.SendEnabled = []types.SendEnabled{
+		{Denom: "uatom", Enabled: true},
+		{Denom: "wei", Enabled: false},
+	}
+
 	bankGenesisBz, err := s.cfg.Codec.MarshalJSON(&bankGenesis)
 	s.Require().NoError(err)
 	genesisState[types.ModuleName] = bankGenesisBz
@@ -238,6 +243,59 @@ func (s *IntegrationTestSuite) TestGetCmdQueryTotalSupply() {
 	}
 }
 
+func (s *IntegrationTestSuite) TestGetCmdQuerySendEnabled() {
+	val := s.network.Validators[0]
+
+	testCases := []struct {
+		name     string
+		args     []string
+		expected *types.QuerySendEnabledResponse
+	}{
+		{
+			"all send enabled entries",
+			[]string{
+				fmt.Sprintf("--%s=json", tmcli.OutputFlag),
+			},
+			&types.QuerySendEnabledResponse{
+				SendEnabled: []*types.SendEnabled{
+					types.NewSendEnabled("uatom", true),
+					types.NewSendEnabled("wei", false),
+				},
+				Pagination: &query.PageResponse{Total: 0},
+			},
+		},
+		{
+			"send enabled entries of specific denoms",
+			[]string{
+				"wei",
+				s.cfg.BondDenom,
+				fmt.Sprintf("--%s=json", tmcli.OutputFlag),
+			},
+			&types.QuerySendEnabledResponse{
+				SendEnabled: []*types.SendEnabled{
+					types.NewSendEnabled("wei", false),
+				},
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		s.Run(tc.name, func() {
+			cmd := cli.GetCmdQuerySendEnabled()
+			clientCtx := val.ClientCtx
+
+			out, err := clitestutil.ExecTestCLICmd(clientCtx, cmd, tc.args)
+			s.Require().NoError(err)
+
+			var res types.QuerySendEnabledResponse
+			s.Require().NoError(clientCtx.Codec.UnmarshalJSON(out.Bytes(), &res))
+			s.Require().Equal(tc.expected, &res)
+		})
+	}
+}
+
 func (s *IntegrationTestSuite) TestGetCmdQueryDenomsMetadata() {
 	val := s.network.Validators[0]
 
@@ -70,6 +70,22 @@ func (suite *IntegrationTestSuite) TestInitGenesis() {
 	suite.Require().Equal(m, m2)
 }
 
+func (suite *IntegrationTestSuite) TestInitGenesisSendEnabled() {
+	g := types.DefaultGenesisState()
+	g.Params.SendEnabled = []*types.SendEnabled{types.NewSendEnabled("foocoin", false)}
+	g.SendEnabled = []types.SendEnabled{{Denom: "barcoin", Enabled: true}}
+	bk := suite.app.BankKeeper
+	bk.InitGenesis(suite.ctx, g)
+
+	// the entries of the params are merged with the genesis ones
+	expected := []types.SendEnabled{{Denom: "barcoin", Enabled: true}, {Denom: "foocoin", Enabled: false}}
+	suite.Require().Equal(expected, bk.GetAllSendEnabledEntries(suite.ctx))
+
+	exportGenesis := bk.ExportGenesis(suite.ctx)
+	suite.Require().Len(exportGenesis.Params.SendEnabled, 0)
+	suite.Require().Equal(expected, exportGenesis.SendEnabled)
+}
+
 func (suite *IntegrationTestSuite) TestTotalSupply() {
 	// Prepare some test data.
 	defaultGenesis := types.DefaultGenesisState()
@@ -92,17 +108,17 @@ func (suite *IntegrationTestSuite) TestTotalSupply() {
 	}{
 		{
 			"calculation NOT matching genesis Supply field",
-			types.NewGenesisState(defaultGenesis.Params, balances, sdk.NewCoins(sdk.NewCoin("wrongcoin", sdk.NewInt(1))), defaultGenesis.DenomMetadata),
+			types.NewGenesisState(defaultGenesis.Params, balances, sdk.NewCoins(sdk.NewCoin("wrongcoin", sdk.NewInt(1))), defaultGenesis.DenomMetadata, defaultGenesis.SendEnabled),
 			nil, true, "genesis supply is incorrect, expected 1wrongcoin, got 21barcoin,11foocoin",
 		},
 		{
 			"calculation matches genesis Supply field",
-			types.NewGenesisState(defaultGenesis.Params, balances, totalSupply, defaultGenesis.DenomMetadata),
+			types.NewGenesisState(defaultGenesis.Params, balances, totalSupply, defaultGenesis.DenomMetadata, defaultGenesis.SendEnabled),
 			totalSupply, false, "",
 		},
 		{
 			"calculation is correct, empty genesis Supply field",
-			types.NewGenesisState(defaultGenesis.Params, balances, nil, defaultGenesis.DenomMetadata),
+			types.NewGenesisState(defaultGenesis.Params, balances, nil, defaultGenesis.DenomMetadata, defaultGenesis.SendEnabled),
 			totalSupply, false, "",
 		},
 	}
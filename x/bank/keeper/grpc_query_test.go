@@ -365,6 +365,59 @@ func (suite *IntegrationTestSuite) QueryDenomMetadataRequest() {
 	}
 }
 
+func (suite *IntegrationTestSuite) TestQuerySendEnabled() {
+	app, ctx, queryClient := suite.app, suite.ctx, suite.queryClient
+
+	app.BankKeeper.SetSendEnabled(ctx, "falsestcoin", false)
+	app.BankKeeper.SetSendEnabled(ctx, "truestcoin", true)
+
+	testCases := []struct {
+		name string
+		req  *types.QuerySendEnabledRequest
+		exp  *types.QuerySendEnabledResponse
+	}{
+		{
+			"nil denoms, nil pagination",
+			&types.QuerySendEnabledRequest{},
+			&types.QuerySendEnabledResponse{
+				SendEnabled: []*types.SendEnabled{
+					types.NewSendEnabled("falsestcoin", false),
+					types.NewSendEnabled("truestcoin", true),
+				},
+				Pagination: &query.PageResponse{Total: 2},
+			},
+		},
+		{
+			"nil denoms, paginated",
+			&types.QuerySendEnabledRequest{Pagination: &query.PageRequest{Limit: 1, CountTotal: true}},
+			&types.QuerySendEnabledResponse{
+				SendEnabled: []*types.SendEnabled{
+					types.NewSendEnabled("falsestcoin", false),
+				},
+				Pagination: &query.PageResponse{NextKey: []byte("truestcoin"), Total: 2},
+			},
+		},
+		{
+			"specific denoms, the ones without an entry are omitted",
+			&types.QuerySendEnabledRequest{Denoms: []string{"truestcoin", "defaultcoin"}},
+			&types.QuerySendEnabledResponse{
+				SendEnabled: []*types.SendEnabled{
+					types.NewSendEnabled("truestcoin", true),
+				},
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		suite.Run(tc.name, func() {
+			res, err := queryClient.SendEnabled(gocontext.Background(), tc.req)
+			suite.Require().NoError(err)
+			suite.Require().Equal(tc.exp, res)
+		})
+	}
+}
+
 func (suite *IntegrationTestSuite) TestGRPCDenomOwners() {
 	ctx := suite.ctx
 
@@ -13,6 +13,7 @@ import (
 	"github.com/cosmos/cosmos-sdk/baseapp"
 	"github.com/cosmos/cosmos-sdk/simapp"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	"github.com/cosmos/cosmos-sdk/types/query"
 	authkeeper "github.com/cosmos/cosmos-sdk/x/auth/keeper"
 	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
@@ -20,6 +21,7 @@ import (
 	"github.com/cosmos/cosmos-sdk/x/bank/keeper"
 	"github.com/cosmos/cosmos-sdk/x/bank/testutil"
 	"github.com/cosmos/cosmos-sdk/x/bank/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
 	minttypes "github.com/cosmos/cosmos-sdk/x/mint/types"
 )
 
@@ -88,6 +90,7 @@ func (suite *IntegrationTestSuite) initKeepersWithmAccPerms(blockedAddrs map[str
 	keeper := keeper.NewBaseKeeper(
 		appCodec, app.GetKey(types.StoreKey), authKeeper,
 		app.GetSubspace(types.ModuleName), blockedAddrs,
+		authtypes.NewModuleAddress(govtypes.ModuleName).String(),
 	)
 
 	return authKeeper, keeper
@@ -458,6 +461,166 @@ func (suite *IntegrationTestSuite) TestSendCoins() {
 	suite.Require().Equal(newBarCoin(25), coins[0], "expected only bar coins in the account balance, got: %v", coins)
 }
 
+func (suite *IntegrationTestSuite) TestSendCoinsWithRestrictions() {
+	app, ctx := suite.app, suite.ctx
+	balances := sdk.NewCoins(newFooCoin(100), newBarCoin(50))
+
+	addr1 := sdk.AccAddress("addr1_______________")
+	addr2 := sdk.AccAddress("addr2_______________")
+	frozenAddr := sdk.AccAddress("frozen______________")
+	suite.Require().NoError(testutil.FundAccount(app.BankKeeper, ctx, addr1, balances))
+	suite.Require().NoError(testutil.FundAccount(app.BankKeeper, ctx, frozenAddr, balances))
+	holderAddr := sdk.AccAddress("holder______________")
+
+	// reject the transfers from the frozen address, and route the transfers to
+	// addr2 to the holder address
+	var calls []string
+	app.BankKeeper.AppendSendRestriction(func(_ sdk.Context, fromAddr, toAddr sdk.AccAddress, _ sdk.Coins) (sdk.AccAddress, error) {
+		calls = append(calls, "route")
+		if toAddr.Equals(addr2) {
+			return holderAddr, nil
+		}
+		return toAddr, nil
+	})
+	app.BankKeeper.PrependSendRestriction(func(_ sdk.Context, fromAddr, toAddr sdk.AccAddress, _ sdk.Coins) (sdk.AccAddress, error) {
+		calls = append(calls, "freeze")
+		if fromAddr.Equals(frozenAddr) {
+			return nil, fmt.Errorf("%s is frozen", fromAddr)
+		}
+		return toAddr, nil
+	})
+
+	sendAmt := sdk.NewCoins(newFooCoin(10))
+	suite.Require().NoError(app.BankKeeper.SendCoins(ctx, addr1, addr2, sendAmt))
+	suite.Require().Equal([]string{"freeze", "route"}, calls)
+	suite.Require().True(app.BankKeeper.GetAllBalances(ctx, addr2).IsZero())
+	suite.Require().Equal(sendAmt, app.BankKeeper.GetAllBalances(ctx, holderAddr))
+
+	// require the event to have the actual recipient
+	events := ctx.EventManager().ABCIEvents()
+	transferEvent := events[len(events)-2]
+	suite.Require().Equal(types.EventTypeTransfer, transferEvent.Type)
+	suite.Require().Equal(holderAddr.String(), string(transferEvent.Attributes[0].Value))
+
+	calls = nil
+	suite.Require().ErrorContains(app.BankKeeper.SendCoins(ctx, frozenAddr, addr1, sendAmt), "frozen")
+	suite.Require().Equal([]string{"freeze"}, calls)
+	suite.Require().Equal(balances, app.BankKeeper.GetAllBalances(ctx, frozenAddr))
+
+	// require the restrictions to be bypassed in the context
+	bypassCtx := types.WithSendRestrictionsBypass(ctx)
+	suite.Require().NoError(app.BankKeeper.SendCoins(bypassCtx, frozenAddr, addr2, sendAmt))
+	suite.Require().Equal(sendAmt, app.BankKeeper.GetAllBalances(ctx, addr2))
+	suite.Require().Error(app.BankKeeper.SendCoins(types.WithoutSendRestrictionsBypass(bypassCtx), frozenAddr, addr2, sendAmt))
+
+	// require the transfers between module accounts to bypass the restrictions
+	app.BankKeeper.AppendSendRestriction(func(_ sdk.Context, _, _ sdk.AccAddress, _ sdk.Coins) (sdk.AccAddress, error) {
+		return nil, fmt.Errorf("all transfers are rejected")
+	})
+	suite.Require().NoError(app.BankKeeper.MintCoins(ctx, minttypes.ModuleName, sendAmt))
+	suite.Require().NoError(app.BankKeeper.SendCoinsFromModuleToModule(ctx, minttypes.ModuleName, authtypes.FeeCollectorName, sendAmt))
+	suite.Require().Error(app.BankKeeper.SendCoinsFromAccountToModule(ctx, addr1, authtypes.FeeCollectorName, sendAmt))
+
+	// require no restriction once cleared
+	app.BankKeeper.ClearSendRestriction()
+	calls = nil
+	suite.Require().NoError(app.BankKeeper.SendCoins(ctx, frozenAddr, addr2, sendAmt))
+	suite.Require().Empty(calls)
+	suite.Require().Equal(sendAmt.Add(sendAmt...), app.BankKeeper.GetAllBalances(ctx, addr2))
+}
+
+func (suite *IntegrationTestSuite) TestInputOutputCoinsWithRestrictions() {
+	app, ctx := suite.app, suite.ctx
+	balances := sdk.NewCoins(newFooCoin(100), newBarCoin(50))
+
+	addr1 := sdk.AccAddress("addr1_______________")
+	addr2 := sdk.AccAddress("addr2_______________")
+	addr3 := sdk.AccAddress("addr3_______________")
+	frozenAddr := sdk.AccAddress("frozen______________")
+	suite.Require().NoError(testutil.FundAccount(app.BankKeeper, ctx, addr1, balances))
+	suite.Require().NoError(testutil.FundAccount(app.BankKeeper, ctx, addr2, balances))
+	holderAddr := sdk.AccAddress("holder______________")
+
+	// reject the transfers to the frozen address, and route the transfers to
+	// addr3 to the holder address
+	app.BankKeeper.AppendSendRestriction(func(_ sdk.Context, _, toAddr sdk.AccAddress, _ sdk.Coins) (sdk.AccAddress, error) {
+		if toAddr.Equals(frozenAddr) {
+			return nil, fmt.Errorf("%s is frozen", toAddr)
+		}
+		return toAddr, nil
+	})
+	var senders []string
+	app.BankKeeper.AppendSendRestriction(func(_ sdk.Context, fromAddr, toAddr sdk.AccAddress, _ sdk.Coins) (sdk.AccAddress, error) {
+		senders = append(senders, string(fromAddr))
+		if toAddr.Equals(addr3) {
+			return holderAddr, nil
+		}
+		return toAddr, nil
+	})
+
+	inputs := []types.Input{
+		{Address: addr1.String(), Coins: sdk.NewCoins(newFooCoin(30))},
+		{Address: addr2.String(), Coins: sdk.NewCoins(newFooCoin(30))},
+	}
+	frozenOutputs := []types.Output{
+		{Address: addr3.String(), Coins: sdk.NewCoins(newFooCoin(30))},
+		{Address: frozenAddr.String(), Coins: sdk.NewCoins(newFooCoin(30))},
+	}
+	suite.Require().ErrorContains(app.BankKeeper.InputOutputCoins(ctx, inputs, frozenOutputs), "frozen")
+	suite.Require().Equal(balances, app.BankKeeper.GetAllBalances(ctx, addr1))
+	suite.Require().Equal(balances, app.BankKeeper.GetAllBalances(ctx, addr2))
+
+	// require each output to be checked for each input
+	senders = nil
+	outputs := []types.Output{
+		{Address: addr3.String(), Coins: sdk.NewCoins(newFooCoin(40))},
+		{Address: addr1.String(), Coins: sdk.NewCoins(newFooCoin(20))},
+	}
+	suite.Require().NoError(app.BankKeeper.InputOutputCoins(ctx, inputs, outputs))
+	suite.Require().Equal([]string{string(addr1), string(addr2), string(addr1), string(addr2)}, senders)
+	suite.Require().True(app.BankKeeper.GetAllBalances(ctx, addr3).IsZero())
+	suite.Require().Equal(sdk.NewCoins(newFooCoin(40)), app.BankKeeper.GetAllBalances(ctx, holderAddr))
+	suite.Require().Equal(sdk.NewCoins(newFooCoin(90), newBarCoin(50)), app.BankKeeper.GetAllBalances(ctx, addr1))
+	suite.Require().Equal(sdk.NewCoins(newFooCoin(70), newBarCoin(50)), app.BankKeeper.GetAllBalances(ctx, addr2))
+}
+
+func (suite *IntegrationTestSuite) TestSendRestrictionToBlockedAddr() {
+	app, ctx := suite.app, suite.ctx
+	balances := sdk.NewCoins(newFooCoin(100))
+
+	addr1 := sdk.AccAddress("addr1_______________")
+	addr2 := sdk.AccAddress("addr2_______________")
+	suite.Require().NoError(testutil.FundAccount(app.BankKeeper, ctx, addr1, balances))
+	blockedAddr := app.AccountKeeper.GetModuleAddress(authtypes.FeeCollectorName)
+	suite.Require().True(app.BankKeeper.BlockedAddr(blockedAddr))
+
+	// route the transfers to addr2 to a blocked address
+	app.BankKeeper.AppendSendRestriction(func(_ sdk.Context, _, toAddr sdk.AccAddress, _ sdk.Coins) (sdk.AccAddress, error) {
+		if toAddr.Equals(addr2) {
+			return blockedAddr, nil
+		}
+		return toAddr, nil
+	})
+	defer app.BankKeeper.ClearSendRestriction()
+
+	sendAmt := sdk.NewCoins(newFooCoin(10))
+	suite.Require().ErrorIs(app.BankKeeper.SendCoins(ctx, addr1, addr2, sendAmt), sdkerrors.ErrUnauthorized)
+
+	outputs := []types.Output{{Address: addr2.String(), Coins: sendAmt}}
+	inputs := []types.Input{{Address: addr1.String(), Coins: sendAmt}}
+	suite.Require().ErrorIs(app.BankKeeper.InputOutputCoins(ctx, inputs, outputs), sdkerrors.ErrUnauthorized)
+
+	suite.Require().NoError(app.BankKeeper.MintCoins(ctx, minttypes.ModuleName, sendAmt))
+	suite.Require().ErrorIs(app.BankKeeper.SendCoinsFromModuleToAccount(ctx, minttypes.ModuleName, addr2, sendAmt), sdkerrors.ErrUnauthorized)
+
+	suite.Require().Equal(balances, app.BankKeeper.GetAllBalances(ctx, addr1))
+	suite.Require().True(app.BankKeeper.GetAllBalances(ctx, blockedAddr).IsZero())
+
+	// the transfers to a blocked module account which are not routed are
+	// not affected
+	suite.Require().NoError(app.BankKeeper.SendCoinsFromModuleToModule(ctx, minttypes.ModuleName, authtypes.FeeCollectorName, sendAmt))
+}
+
 func (suite *IntegrationTestSuite) TestValidateBalance() {
 	app, ctx := suite.app, suite.ctx
 	now := tmtime.Now()
@@ -527,6 +690,99 @@ func (suite *IntegrationTestSuite) TestSendEnabled() {
 	suite.Require().Error(err)
 }
 
+func (suite *IntegrationTestSuite) TestSendEnabledEntries() {
+	app, ctx := suite.app, suite.ctx
+
+	// the entries of the params are moved to their own store
+	params := types.NewParams(true, types.SendEnabledParams{
+		types.NewSendEnabled("foocoin", false),
+		types.NewSendEnabled("barcoin", true),
+	})
+	app.BankKeeper.SetParams(ctx, params)
+	suite.Require().Empty(app.BankKeeper.GetParams(ctx).SendEnabled)
+	suite.Require().True(app.BankKeeper.GetDefaultSendEnabled(ctx))
+	suite.Require().Equal([]types.SendEnabled{
+		{Denom: "barcoin", Enabled: true},
+		{Denom: "foocoin", Enabled: false},
+	}, app.BankKeeper.GetAllSendEnabledEntries(ctx))
+
+	se, found := app.BankKeeper.GetSendEnabledEntry(ctx, "foocoin")
+	suite.Require().True(found)
+	suite.Require().Equal(types.SendEnabled{Denom: "foocoin", Enabled: false}, se)
+	suite.Require().False(app.BankKeeper.IsSendEnabledDenom(ctx, "foocoin"))
+
+	_, found = app.BankKeeper.GetSendEnabledEntry(ctx, "bazcoin")
+	suite.Require().False(found)
+	suite.Require().True(app.BankKeeper.IsSendEnabledDenom(ctx, "bazcoin"))
+
+	// an entry can be updated, and deleted to use the default again
+	app.BankKeeper.SetSendEnabled(ctx, "foocoin", true)
+	suite.Require().True(app.BankKeeper.IsSendEnabledDenom(ctx, "foocoin"))
+	app.BankKeeper.SetDefaultSendEnabled(ctx, false)
+	suite.Require().False(app.BankKeeper.IsSendEnabledDenom(ctx, "bazcoin"))
+	app.BankKeeper.DeleteSendEnabled(ctx, "foocoin", "bazcoin")
+	suite.Require().False(app.BankKeeper.IsSendEnabledDenom(ctx, "foocoin"))
+
+	var denoms []string
+	app.BankKeeper.IterateSendEnabledEntries(ctx, func(denom string, _ bool) bool {
+		denoms = append(denoms, denom)
+		return true
+	})
+	suite.Require().Equal([]string{"barcoin"}, denoms)
+}
+
+func (suite *IntegrationTestSuite) TestMsgSetSendEnabled() {
+	app, ctx := suite.app, suite.ctx
+	msgServer := keeper.NewMsgServerImpl(app.BankKeeper)
+	authority := app.BankKeeper.GetAuthority()
+
+	app.BankKeeper.SetSendEnabled(ctx, "barcoin", false)
+
+	testCases := []struct {
+		name       string
+		msg        *types.MsgSetSendEnabled
+		expErr     bool
+		expEntries []types.SendEnabled
+		expDefault bool
+	}{
+		{
+			"invalid authority",
+			types.NewMsgSetSendEnabled(sdk.AccAddress("addr1_______________").String(), []*types.SendEnabled{types.NewSendEnabled("foocoin", false)}, nil, false, false),
+			true,
+			[]types.SendEnabled{{Denom: "barcoin", Enabled: false}},
+			true,
+		},
+		{
+			"add and update entries",
+			types.NewMsgSetSendEnabled(authority, []*types.SendEnabled{types.NewSendEnabled("foocoin", false), types.NewSendEnabled("barcoin", true)}, nil, false, false),
+			false,
+			[]types.SendEnabled{{Denom: "barcoin", Enabled: true}, {Denom: "foocoin", Enabled: false}},
+			true,
+		},
+		{
+			"remove an entry and set the default",
+			types.NewMsgSetSendEnabled(authority, nil, []string{"barcoin", "bazcoin"}, true, false),
+			false,
+			[]types.SendEnabled{{Denom: "foocoin", Enabled: false}},
+			false,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		suite.Run(tc.name, func() {
+			_, err := msgServer.SetSendEnabled(sdk.WrapSDKContext(ctx), tc.msg)
+			if tc.expErr {
+				suite.Require().Error(err)
+			} else {
+				suite.Require().NoError(err)
+			}
+			suite.Require().Equal(tc.expEntries, app.BankKeeper.GetAllSendEnabledEntries(ctx))
+			suite.Require().Equal(tc.expDefault, app.BankKeeper.GetDefaultSendEnabled(ctx))
+		})
+	}
+}
+
 func (suite *IntegrationTestSuite) TestHasBalance() {
 	app, ctx := suite.app, suite.ctx
 	addr := sdk.AccAddress([]byte("addr1_______________"))
@@ -716,6 +972,42 @@ func (suite *IntegrationTestSuite) TestSpendableCoins() {
 	suite.Require().Equal(origCoins.Sub(delCoins...), app.BankKeeper.SpendableCoins(ctx, addr1))
 }
 
+func (suite *IntegrationTestSuite) TestSpendableCoinsWithFrozenCoins() {
+	app, ctx := suite.app, suite.ctx
+	now := tmtime.Now()
+	ctx = ctx.WithBlockHeader(tmproto.Header{Time: now})
+	endTime := now.Add(24 * time.Hour)
+
+	origCoins := sdk.NewCoins(sdk.NewInt64Coin("stake", 100), sdk.NewInt64Coin("usd", 100))
+	vestingCoins := sdk.NewCoins(sdk.NewInt64Coin("usd", 60))
+
+	addr1 := sdk.AccAddress([]byte("addr1_______________"))
+	addr2 := sdk.AccAddress([]byte("addr2_______________"))
+
+	bacc := authtypes.NewBaseAccountWithAddress(addr1)
+	vacc := vesting.NewDelayedVestingAccount(bacc, vestingCoins, endTime.Unix())
+	app.AccountKeeper.SetAccount(ctx, vacc)
+	app.AccountKeeper.SetAccount(ctx, app.AccountKeeper.NewAccountWithAddress(ctx, addr2))
+	suite.Require().NoError(testutil.FundAccount(app.BankKeeper, ctx, addr1, origCoins))
+	suite.Require().NoError(testutil.FundAccount(app.BankKeeper, ctx, addr2, origCoins))
+
+	// freeze 70usd of addr1 and all the usd of addr2
+	app.BankKeeper.SetFrozenCoinsFn(func(_ sdk.Context, addr sdk.AccAddress, balances sdk.Coins) sdk.Coins {
+		if addr.Equals(addr1) {
+			return sdk.NewCoins(sdk.NewInt64Coin("usd", 70))
+		}
+		return sdk.NewCoins(sdk.NewCoin("usd", balances.AmountOf("usd")))
+	})
+
+	// the frozen coins are not spendable, on top of the locked coins
+	suite.Require().Equal(sdk.NewCoins(sdk.NewInt64Coin("stake", 100)), app.BankKeeper.SpendableCoins(ctx, addr1))
+	suite.Require().Equal(sdk.NewCoins(sdk.NewInt64Coin("stake", 100)), app.BankKeeper.SpendableCoins(ctx, addr2))
+
+	app.BankKeeper.SetFrozenCoinsFn(nil)
+	suite.Require().Equal(origCoins.Sub(vestingCoins...), app.BankKeeper.SpendableCoins(ctx, addr1))
+	suite.Require().Equal(origCoins, app.BankKeeper.SpendableCoins(ctx, addr2))
+}
+
 func (suite *IntegrationTestSuite) TestVestingAccountSend() {
 	app, ctx := suite.app, suite.ctx
 	now := tmtime.Now()
@@ -893,6 +1185,33 @@ func (suite *IntegrationTestSuite) TestDelegateCoins() {
 	suite.Require().Equal(delCoins, vestingAcc.GetDelegatedVesting())
 }
 
+func (suite *IntegrationTestSuite) TestDelegateFrozenCoins() {
+	app, ctx := suite.app, suite.ctx
+
+	origCoins := sdk.NewCoins(sdk.NewInt64Coin("stake", 100))
+	addr1 := sdk.AccAddress([]byte("addr1_______________"))
+	addrModule := sdk.AccAddress([]byte("moduleAcc___________"))
+
+	macc := app.AccountKeeper.NewAccountWithAddress(ctx, addrModule) // we don't need to define an actual module account bc we just need the address for testing
+	app.AccountKeeper.SetAccount(ctx, macc)
+	app.AccountKeeper.SetAccount(ctx, app.AccountKeeper.NewAccountWithAddress(ctx, addr1))
+	suite.Require().NoError(testutil.FundAccount(app.BankKeeper, ctx, addr1, origCoins))
+
+	app.BankKeeper.SetFrozenCoinsFn(func(_ sdk.Context, _ sdk.AccAddress, _ sdk.Coins) sdk.Coins {
+		return sdk.NewCoins(sdk.NewInt64Coin("stake", 70))
+	})
+	defer app.BankKeeper.SetFrozenCoinsFn(nil)
+
+	// the frozen coins cannot be delegated
+	suite.Require().ErrorIs(app.BankKeeper.DelegateCoins(ctx, addr1, addrModule, sdk.NewCoins(sdk.NewInt64Coin("stake", 31))), sdkerrors.ErrInsufficientFunds)
+	suite.Require().Equal(origCoins, app.BankKeeper.GetAllBalances(ctx, addr1))
+
+	delCoins := sdk.NewCoins(sdk.NewInt64Coin("stake", 30))
+	suite.Require().NoError(app.BankKeeper.DelegateCoins(ctx, addr1, addrModule, delCoins))
+	suite.Require().Equal(origCoins.Sub(delCoins...), app.BankKeeper.GetAllBalances(ctx, addr1))
+	suite.Require().Equal(delCoins, app.BankKeeper.GetAllBalances(ctx, addrModule))
+}
+
 func (suite *IntegrationTestSuite) TestDelegateCoins_Invalid() {
 	app, ctx := suite.app, suite.ctx
 
@@ -1054,7 +1373,7 @@ func (suite *IntegrationTestSuite) TestBalanceTrackingEvents() {
 	)
 
 	suite.app.BankKeeper = keeper.NewBaseKeeper(suite.app.AppCodec(), suite.app.GetKey(types.StoreKey),
-		suite.app.AccountKeeper, suite.app.GetSubspace(types.ModuleName), nil)
+		suite.app.AccountKeeper, suite.app.GetSubspace(types.ModuleName), nil, authtypes.NewModuleAddress(govtypes.ModuleName).String())
 
 	// set account with multiple permissions
 	suite.app.AccountKeeper.SetModuleAccount(suite.ctx, multiPermAcc)
@@ -1215,7 +1534,7 @@ func (suite *IntegrationTestSuite) TestMintCoinRestrictions() {
 
 	for _, test := range tests {
 		suite.app.BankKeeper = keeper.NewBaseKeeper(suite.app.AppCodec(), suite.app.GetKey(types.StoreKey),
-			suite.app.AccountKeeper, suite.app.GetSubspace(types.ModuleName), nil).WithMintCoinsRestriction(keeper.MintingRestrictionFn(test.restrictionFn))
+			suite.app.AccountKeeper, suite.app.GetSubspace(types.ModuleName), nil, authtypes.NewModuleAddress(govtypes.ModuleName).String()).WithMintCoinsRestriction(keeper.MintingRestrictionFn(test.restrictionFn))
 		for _, testCase := range test.testCases {
 			if testCase.expectPass {
 				suite.Require().NoError(
@@ -4,6 +4,7 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	v043 "github.com/cosmos/cosmos-sdk/x/bank/migrations/v043"
 	v046 "github.com/cosmos/cosmos-sdk/x/bank/migrations/v046"
+	v4 "github.com/cosmos/cosmos-sdk/x/bank/migrations/v4"
 )
 
 // Migrator is a struct for handling in-place store migrations.
@@ -31,3 +32,8 @@ func (m Migrator) Migrate2to3(ctx sdk.Context) error {
 func (m Migrator) Migrate3_V046_4_To_V046_5(ctx sdk.Context) error {
 	return v046.Migrate_V046_4_To_V046_5(ctx.KVStore(m.keeper.storeKey))
 }
+
+// Migrate3to4 migrates x/bank storage from version 3 to 4.
+func (m Migrator) Migrate3to4(ctx sdk.Context) error {
+	return v4.MigrateStore(ctx, m.keeper.storeKey, m.keeper.paramSpace)
+}
@@ -1,6 +1,8 @@
 package keeper
 
 import (
+	"fmt"
+
 	"github.com/cosmos/cosmos-sdk/codec"
 	"github.com/cosmos/cosmos-sdk/store/prefix"
 	storetypes "github.com/cosmos/cosmos-sdk/store/types"
@@ -23,10 +25,27 @@ type SendKeeper interface {
 	GetParams(ctx sdk.Context) types.Params
 	SetParams(ctx sdk.Context, params types.Params)
 
+	IsSendEnabledDenom(ctx sdk.Context, denom string) bool
+	GetSendEnabledEntry(ctx sdk.Context, denom string) (types.SendEnabled, bool)
+	SetSendEnabled(ctx sdk.Context, denom string, value bool)
+	SetAllSendEnabled(ctx sdk.Context, sendEnableds []*types.SendEnabled)
+	DeleteSendEnabled(ctx sdk.Context, denoms ...string)
+	IterateSendEnabledEntries(ctx sdk.Context, cb func(denom string, sendEnabled bool) (stop bool))
+	GetAllSendEnabledEntries(ctx sdk.Context) []types.SendEnabled
+	GetDefaultSendEnabled(ctx sdk.Context) bool
+	SetDefaultSendEnabled(ctx sdk.Context, value bool)
+
 	IsSendEnabledCoin(ctx sdk.Context, coin sdk.Coin) bool
 	IsSendEnabledCoins(ctx sdk.Context, coins ...sdk.Coin) error
 
 	BlockedAddr(addr sdk.AccAddress) bool
+	GetAuthority() string
+
+	AppendSendRestriction(restriction types.SendRestrictionFn)
+	PrependSendRestriction(restriction types.SendRestrictionFn)
+	ClearSendRestriction()
+
+	SetFrozenCoinsFn(fn types.FrozenCoinsFn)
 }
 
 var _ SendKeeper = (*BaseSendKeeper)(nil)
@@ -43,29 +62,71 @@ type BaseSendKeeper struct {
 
 	// list of addresses that are restricted from receiving transactions
 	blockedAddrs map[string]bool
+
+	// the address capable of executing a MsgSetSendEnabled message. Typically,
+	// this should be the x/gov module account.
+	authority string
+
+	sendRestriction *sendRestriction
 }
 
 func NewBaseSendKeeper(
-	cdc codec.BinaryCodec, storeKey storetypes.StoreKey, ak types.AccountKeeper, paramSpace paramtypes.Subspace, blockedAddrs map[string]bool,
+	cdc codec.BinaryCodec, storeKey storetypes.StoreKey, ak types.AccountKeeper, paramSpace paramtypes.Subspace, blockedAddrs map[string]bool, authority string,
 ) BaseSendKeeper {
+	if _, err := sdk.AccAddressFromBech32(authority); err != nil {
+		panic(fmt.Errorf("invalid bank authority address: %w", err))
+	}
+
 	return BaseSendKeeper{
-		BaseViewKeeper: NewBaseViewKeeper(cdc, storeKey, ak),
-		cdc:            cdc,
-		ak:             ak,
-		storeKey:       storeKey,
-		paramSpace:     paramSpace,
-		blockedAddrs:   blockedAddrs,
+		BaseViewKeeper:  NewBaseViewKeeper(cdc, storeKey, ak),
+		cdc:             cdc,
+		ak:              ak,
+		storeKey:        storeKey,
+		paramSpace:      paramSpace,
+		blockedAddrs:    blockedAddrs,
+		authority:       authority,
+		sendRestriction: newSendRestriction(),
 	}
 }
 
-// GetParams returns the total set of bank parameters.
+// AppendSendRestriction adds the provided SendRestrictionFn to run after the
+// previously registered restrictions.
+func (k BaseSendKeeper) AppendSendRestriction(restriction types.SendRestrictionFn) {
+	k.sendRestriction.append(restriction)
+}
+
+// PrependSendRestriction adds the provided SendRestrictionFn to run before the
+// previously registered restrictions.
+func (k BaseSendKeeper) PrependSendRestriction(restriction types.SendRestrictionFn) {
+	k.sendRestriction.prepend(restriction)
+}
+
+// ClearSendRestriction removes all the registered send restrictions.
+func (k BaseSendKeeper) ClearSendRestriction() {
+	k.sendRestriction.clear()
+}
+
+// GetAuthority returns the x/bank module's authority.
+func (k BaseSendKeeper) GetAuthority() string {
+	return k.authority
+}
+
+// GetParams returns the total set of bank parameters. The SendEnabled entries
+// are kept in their own store, and are never part of the returned params.
 func (k BaseSendKeeper) GetParams(ctx sdk.Context) (params types.Params) {
 	k.paramSpace.GetParamSet(ctx, &params)
 	return params
 }
 
 // SetParams sets the total set of bank parameters.
+//
+// Note: params.SendEnabled is deprecated but it should be here regardless, as
+// the entries it holds are moved to their own store, and cleared from the params.
 func (k BaseSendKeeper) SetParams(ctx sdk.Context, params types.Params) {
+	if len(params.SendEnabled) > 0 { //nolint:staticcheck // SA1019: params.SendEnabled is deprecated
+		k.SetAllSendEnabled(ctx, params.SendEnabled) //nolint:staticcheck // SA1019: params.SendEnabled is deprecated
+	}
+	params = types.NewParams(params.DefaultSendEnabled, nil)
 	k.paramSpace.SetParamSet(ctx, &params)
 }
 
@@ -79,13 +140,35 @@ func (k BaseSendKeeper) InputOutputCoins(ctx sdk.Context, inputs []types.Input,
 		return err
 	}
 
-	for _, in := range inputs {
+	inAddresses := make([]sdk.AccAddress, len(inputs))
+	for i, in := range inputs {
 		inAddress, err := sdk.AccAddressFromBech32(in.Address)
 		if err != nil {
 			return err
 		}
+		inAddresses[i] = inAddress
+	}
 
-		err = k.subUnlockedCoins(ctx, inAddress, in.Coins)
+	// Apply the send restrictions to each output before moving any coins. As
+	// the coins of the inputs are pooled, each output must be allowed from
+	// every input.
+	outAddresses := make([]sdk.AccAddress, len(outputs))
+	for i, out := range outputs {
+		outAddress, err := sdk.AccAddressFromBech32(out.Address)
+		if err != nil {
+			return err
+		}
+		for _, inAddress := range inAddresses {
+			outAddress, err = k.applySendRestriction(ctx, inAddress, outAddress, out.Coins)
+			if err != nil {
+				return err
+			}
+		}
+		outAddresses[i] = outAddress
+	}
+
+	for i, in := range inputs {
+		err := k.subUnlockedCoins(ctx, inAddresses[i], in.Coins)
 		if err != nil {
 			return err
 		}
@@ -98,12 +181,9 @@ func (k BaseSendKeeper) InputOutputCoins(ctx sdk.Context, inputs []types.Input,
 		)
 	}
 
-	for _, out := range outputs {
-		outAddress, err := sdk.AccAddressFromBech32(out.Address)
-		if err != nil {
-			return err
-		}
-		err = k.addCoins(ctx, outAddress, out.Coins)
+	for i, out := range outputs {
+		outAddress := outAddresses[i]
+		err := k.addCoins(ctx, outAddress, out.Coins)
 		if err != nil {
 			return err
 		}
@@ -111,7 +191,7 @@ func (k BaseSendKeeper) InputOutputCoins(ctx sdk.Context, inputs []types.Input,
 		ctx.EventManager().EmitEvent(
 			sdk.NewEvent(
 				types.EventTypeTransfer,
-				sdk.NewAttribute(types.AttributeKeyRecipient, out.Address),
+				sdk.NewAttribute(types.AttributeKeyRecipient, outAddress.String()),
 				sdk.NewAttribute(sdk.AttributeKeyAmount, out.Coins.String()),
 			),
 		)
@@ -130,10 +210,16 @@ func (k BaseSendKeeper) InputOutputCoins(ctx sdk.Context, inputs []types.Input,
 	return nil
 }
 
-// SendCoins transfers amt coins from a sending account to a receiving account.
-// An error is returned upon failure.
+// SendCoins transfers amt coins from a sending account to a receiving account,
+// or to the recipient returned by the send restrictions. An error is returned
+// upon failure.
 func (k BaseSendKeeper) SendCoins(ctx sdk.Context, fromAddr sdk.AccAddress, toAddr sdk.AccAddress, amt sdk.Coins) error {
-	err := k.subUnlockedCoins(ctx, fromAddr, amt)
+	toAddr, err := k.applySendRestriction(ctx, fromAddr, toAddr, amt)
+	if err != nil {
+		return err
+	}
+
+	err = k.subUnlockedCoins(ctx, fromAddr, amt)
 	if err != nil {
 		return err
 	}
@@ -304,8 +390,24 @@ func (k BaseSendKeeper) setBalance(ctx sdk.Context, addr sdk.AccAddress, balance
 // any of the coins are not configured for sending.  Returns nil if sending is enabled
 // for all provided coin
 func (k BaseSendKeeper) IsSendEnabledCoins(ctx sdk.Context, coins ...sdk.Coin) error {
+	if len(coins) == 0 {
+		return nil
+	}
+
+	store := ctx.KVStore(k.storeKey)
+
+	// the default is only read once, and only if a denom has no entry
+	var defaultVal *bool
 	for _, coin := range coins {
-		if !k.IsSendEnabledCoin(ctx, coin) {
+		sendEnabled, found := k.getSendEnabled(store, coin.Denom)
+		if !found {
+			if defaultVal == nil {
+				d := k.GetDefaultSendEnabled(ctx)
+				defaultVal = &d
+			}
+			sendEnabled = *defaultVal
+		}
+		if !sendEnabled {
 			return sdkerrors.Wrapf(types.ErrSendDisabled, "%s transfers are currently disabled", coin.Denom)
 		}
 	}
@@ -314,7 +416,106 @@ func (k BaseSendKeeper) IsSendEnabledCoins(ctx sdk.Context, coins ...sdk.Coin) e
 
 // IsSendEnabledCoin returns the current SendEnabled status of the provided coin's denom
 func (k BaseSendKeeper) IsSendEnabledCoin(ctx sdk.Context, coin sdk.Coin) bool {
-	return k.GetParams(ctx).SendEnabledDenom(coin.Denom)
+	return k.IsSendEnabledDenom(ctx, coin.Denom)
+}
+
+// IsSendEnabledDenom returns the current SendEnabled status of the provided denom.
+func (k BaseSendKeeper) IsSendEnabledDenom(ctx sdk.Context, denom string) bool {
+	if sendEnabled, found := k.getSendEnabled(ctx.KVStore(k.storeKey), denom); found {
+		return sendEnabled
+	}
+	return k.GetDefaultSendEnabled(ctx)
+}
+
+// GetSendEnabledEntry gets a SendEnabled entry for the given denom.
+// The second return argument is true iff a specific entry exists for the given denom.
+func (k BaseSendKeeper) GetSendEnabledEntry(ctx sdk.Context, denom string) (types.SendEnabled, bool) {
+	sendEnabled, found := k.getSendEnabled(ctx.KVStore(k.storeKey), denom)
+	if !found {
+		return types.SendEnabled{}, false
+	}
+
+	return types.SendEnabled{Denom: denom, Enabled: sendEnabled}, true
+}
+
+// SetSendEnabled sets the SendEnabled flag for a denom to the provided value.
+func (k BaseSendKeeper) SetSendEnabled(ctx sdk.Context, denom string, value bool) {
+	store := ctx.KVStore(k.storeKey)
+	k.setSendEnabledEntry(store, denom, value)
+}
+
+// SetAllSendEnabled sets all the provided SendEnabled entries in the bank store.
+func (k BaseSendKeeper) SetAllSendEnabled(ctx sdk.Context, sendEnableds []*types.SendEnabled) {
+	store := ctx.KVStore(k.storeKey)
+	for _, se := range sendEnableds {
+		k.setSendEnabledEntry(store, se.Denom, se.Enabled)
+	}
+}
+
+// DeleteSendEnabled deletes the SendEnabled flags for one or more denoms.
+// If a denom is provided that doesn't have a SendEnabled entry, it is ignored.
+func (k BaseSendKeeper) DeleteSendEnabled(ctx sdk.Context, denoms ...string) {
+	store := ctx.KVStore(k.storeKey)
+	for _, denom := range denoms {
+		store.Delete(types.CreateSendEnabledKey(denom))
+	}
+}
+
+// IterateSendEnabledEntries iterates over all the SendEnabled entries, in
+// order of denom, until the callback returns true.
+func (k BaseSendKeeper) IterateSendEnabledEntries(ctx sdk.Context, cb func(denom string, sendEnabled bool) (stop bool)) {
+	seStore := prefix.NewStore(ctx.KVStore(k.storeKey), types.SendEnabledPrefix)
+
+	iterator := seStore.Iterator(nil, nil)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		if cb(string(iterator.Key()), types.IsTrueB(iterator.Value())) {
+			break
+		}
+	}
+}
+
+// GetAllSendEnabledEntries gets all the SendEnabled entries that are stored.
+// Any denominations not returned use the default value (set in Params).
+func (k BaseSendKeeper) GetAllSendEnabledEntries(ctx sdk.Context) []types.SendEnabled {
+	var rv []types.SendEnabled
+	k.IterateSendEnabledEntries(ctx, func(denom string, sendEnabled bool) bool {
+		rv = append(rv, types.SendEnabled{Denom: denom, Enabled: sendEnabled})
+		return false
+	})
+
+	return rv
+}
+
+// GetDefaultSendEnabled returns the default SendEnabled flag of the denoms
+// without a specific entry. Only this param is read, not the whole param set.
+func (k BaseSendKeeper) GetDefaultSendEnabled(ctx sdk.Context) bool {
+	var defaultSendEnabled bool
+	k.paramSpace.Get(ctx, types.KeyDefaultSendEnabled, &defaultSendEnabled)
+	return defaultSendEnabled
+}
+
+// SetDefaultSendEnabled sets the default SendEnabled flag of the denoms without
+// a specific entry.
+func (k BaseSendKeeper) SetDefaultSendEnabled(ctx sdk.Context, value bool) {
+	k.paramSpace.Set(ctx, types.KeyDefaultSendEnabled, value)
+}
+
+// getSendEnabled returns the SendEnabled flag of a denom, and whether a
+// specific entry exists for it.
+func (k BaseSendKeeper) getSendEnabled(store sdk.KVStore, denom string) (bool, bool) {
+	bz := store.Get(types.CreateSendEnabledKey(denom))
+	if bz == nil {
+		return false, false
+	}
+
+	return types.IsTrueB(bz), true
+}
+
+// setSendEnabledEntry sets the SendEnabled flag of a denom.
+func (k BaseSendKeeper) setSendEnabledEntry(store sdk.KVStore, denom string, value bool) {
+	store.Set(types.CreateSendEnabledKey(denom), types.ToBoolB(value))
 }
 
 // BlockedAddr checks if a given address is restricted from
@@ -322,3 +523,50 @@ func (k BaseSendKeeper) IsSendEnabledCoin(ctx sdk.Context, coin sdk.Coin) bool {
 func (k BaseSendKeeper) BlockedAddr(addr sdk.AccAddress) bool {
 	return k.blockedAddrs[addr.String()]
 }
+
+// applySendRestriction runs the send restrictions and returns the recipient of
+// the transfer. The recipient chosen by the restrictions must not be blocked,
+// as checked for the requested one by the callers.
+func (k BaseSendKeeper) applySendRestriction(ctx sdk.Context, fromAddr, toAddr sdk.AccAddress, amt sdk.Coins) (sdk.AccAddress, error) {
+	newToAddr, err := k.sendRestriction.apply(ctx, fromAddr, toAddr, amt)
+	if err != nil {
+		return nil, err
+	}
+
+	if !newToAddr.Equals(toAddr) && k.BlockedAddr(newToAddr) {
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrUnauthorized, "%s is not allowed to receive funds", newToAddr)
+	}
+
+	return newToAddr, nil
+}
+
+// sendRestriction holds the SendRestrictionFn of the keeper, so that it can be
+// updated through the keeper values.
+type sendRestriction struct {
+	fn types.SendRestrictionFn
+}
+
+func newSendRestriction() *sendRestriction {
+	return &sendRestriction{}
+}
+
+func (r *sendRestriction) append(restriction types.SendRestrictionFn) {
+	r.fn = types.ComposeSendRestrictions(r.fn, restriction)
+}
+
+func (r *sendRestriction) prepend(restriction types.SendRestrictionFn) {
+	r.fn = types.ComposeSendRestrictions(restriction, r.fn)
+}
+
+func (r *sendRestriction) clear() {
+	r.fn = nil
+}
+
+// apply runs the send restrictions, unless they are bypassed in the context,
+// and returns the recipient of the transfer.
+func (r *sendRestriction) apply(ctx sdk.Context, fromAddr, toAddr sdk.AccAddress, amt sdk.Coins) (sdk.AccAddress, error) {
+	if r == nil || r.fn == nil || types.HasSendRestrictionsBypass(ctx) {
+		return toAddr, nil
+	}
+	return r.fn(ctx, fromAddr, toAddr, amt)
+}
@@ -37,17 +37,27 @@ type BaseViewKeeper struct {
 	cdc      codec.BinaryCodec
 	storeKey storetypes.StoreKey
 	ak       types.AccountKeeper
+
+	frozenCoins *frozenCoins
 }
 
 // NewBaseViewKeeper returns a new BaseViewKeeper.
 func NewBaseViewKeeper(cdc codec.BinaryCodec, storeKey storetypes.StoreKey, ak types.AccountKeeper) BaseViewKeeper {
 	return BaseViewKeeper{
-		cdc:      cdc,
-		storeKey: storeKey,
-		ak:       ak,
+		cdc:         cdc,
+		storeKey:    storeKey,
+		ak:          ak,
+		frozenCoins: &frozenCoins{},
 	}
 }
 
+// SetFrozenCoinsFn sets the hook returning the coins of an account which are
+// frozen by a module, and thus excluded from its spendable coins. It replaces
+// the previously set hook, a nil hook freezes no coins.
+func (k BaseViewKeeper) SetFrozenCoinsFn(fn types.FrozenCoinsFn) {
+	k.frozenCoins.fn = fn
+}
+
 // Logger returns a module-specific logger.
 func (k BaseViewKeeper) Logger(ctx sdk.Context) log.Logger {
 	return ctx.Logger().With("module", "x/"+types.ModuleName)
@@ -196,6 +206,10 @@ func (k BaseViewKeeper) spendableCoins(ctx sdk.Context, addr sdk.AccAddress) (sp
 		return
 	}
 
+	if frozen := k.frozenCoins.get(ctx, addr, total); !frozen.IsZero() {
+		spendable = spendable.Sub(frozen.Min(spendable)...)
+	}
+
 	return
 }
 
@@ -259,3 +273,17 @@ func UnmarshalBalanceCompat(cdc codec.BinaryCodec, bz []byte, denom string) (sdk
 
 	return sdk.NewCoin(denom, amount), nil
 }
+
+// frozenCoins holds the FrozenCoinsFn of the keeper, so that it can be updated
+// through the keeper values.
+type frozenCoins struct {
+	fn types.FrozenCoinsFn
+}
+
+// get returns the frozen coins of an account, among its balances.
+func (f *frozenCoins) get(ctx sdk.Context, addr sdk.AccAddress, balances sdk.Coins) sdk.Coins {
+	if f == nil || f.fn == nil || balances.IsZero() {
+		return nil
+	}
+	return f.fn(ctx, addr, balances)
+}
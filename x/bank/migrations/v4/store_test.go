@@ -0,0 +1,46 @@
+package v4_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/cosmos-sdk/simapp"
+	"github.com/cosmos/cosmos-sdk/testutil"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	v4bank "github.com/cosmos/cosmos-sdk/x/bank/migrations/v4"
+	"github.com/cosmos/cosmos-sdk/x/bank/types"
+	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
+)
+
+func TestStoreMigration(t *testing.T) {
+	encCfg := simapp.MakeTestEncodingConfig()
+	bankKey := sdk.NewKVStoreKey("bank")
+	tBankKey := sdk.NewTransientStoreKey("transient_test")
+	ctx := testutil.DefaultContext(bankKey, tBankKey)
+	paramstore := paramtypes.NewSubspace(encCfg.Codec, encCfg.Amino, bankKey, tBankKey, "bank").
+		WithKeyTable(types.ParamKeyTable())
+
+	sendEnabled := []*types.SendEnabled{
+		types.NewSendEnabled("foocoin", false),
+		types.NewSendEnabled("barcoin", true),
+	}
+	paramstore.Set(ctx, types.KeySendEnabled, sendEnabled)
+	paramstore.Set(ctx, types.KeyDefaultSendEnabled, true)
+
+	require.NoError(t, v4bank.MigrateStore(ctx, bankKey, paramstore))
+
+	// the entries are moved to their own store
+	store := ctx.KVStore(bankKey)
+	for _, se := range sendEnabled {
+		bz := store.Get(types.CreateSendEnabledKey(se.Denom))
+		require.NotNil(t, bz, se.Denom)
+		require.Equal(t, se.Enabled, types.IsTrueB(bz), se.Denom)
+	}
+
+	// and cleared from the params, the default being unchanged
+	var params types.Params
+	paramstore.GetParamSet(ctx, &params)
+	require.Empty(t, params.SendEnabled)
+	require.True(t, params.DefaultSendEnabled)
+}
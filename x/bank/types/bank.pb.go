@@ -29,7 +29,13 @@ const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
 // Params defines the parameters for the bank module.
 type Params struct {
-	SendEnabled        []*SendEnabled `protobuf:"bytes,1,rep,name=send_enabled,json=sendEnabled,proto3" json:"send_enabled,omitempty"`
+	// Deprecated: Use of SendEnabled in params is deprecated.
+	// For genesis, use the newly added send_enabled field in the genesis object.
+	// Storage, lookup, and manipulation of this information is now in the keeper.
+	//
+	// As of the store migration to consensus version 4, the send_enabled entries
+	// are moved out of the params, and this field is always empty.
+	SendEnabled        []*SendEnabled `protobuf:"bytes,1,rep,name=send_enabled,json=sendEnabled,proto3" json:"send_enabled,omitempty"` // Deprecated: Do not use.
 	DefaultSendEnabled bool           `protobuf:"varint,2,opt,name=default_send_enabled,json=defaultSendEnabled,proto3" json:"default_send_enabled,omitempty"`
 }
 
@@ -65,6 +71,7 @@ func (m *Params) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_Params proto.InternalMessageInfo
 
+// Deprecated: Do not use.
 func (m *Params) GetSendEnabled() []*SendEnabled {
 	if m != nil {
 		return m.SendEnabled
@@ -454,48 +461,48 @@ func init() {
 func init() { proto.RegisterFile("cosmos/bank/v1beta1/bank.proto", fileDescriptor_dd052eee12edf988) }
 
 var fileDescriptor_dd052eee12edf988 = []byte{
-	// 649 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x54, 0x41, 0x6b, 0x13, 0x41,
-	0x14, 0xce, 0x34, 0x4d, 0xb2, 0x9d, 0xe8, 0x65, 0x0c, 0x3a, 0xed, 0x61, 0x13, 0xf6, 0x20, 0xb1,
-	0xd0, 0x24, 0xad, 0x9e, 0x82, 0x20, 0xb6, 0x8a, 0x46, 0x10, 0x65, 0x4b, 0x11, 0xbc, 0x84, 0x49,
-	0x76, 0x4c, 0x86, 0xee, 0xce, 0x2c, 0x3b, 0xb3, 0xa5, 0xb9, 0x0a, 0x82, 0x78, 0xf2, 0xe8, 0xb1,
-	0x57, 0x3d, 0x79, 0x28, 0xf8, 0x17, 0x8a, 0xa7, 0xe2, 0xc9, 0x53, 0x95, 0xf4, 0xa0, 0x3f, 0x43,
-	0x66, 0x66, 0x37, 0x4d, 0xa1, 0x8a, 0x07, 0x0f, 0x9e, 0xf6, 0x7d, 0xef, 0x7b, 0xf3, 0xbd, 0xef,
-	0xed, 0xbc, 0x5d, 0xe8, 0x0e, 0x85, 0x8c, 0x84, 0x6c, 0x0f, 0x08, 0xdf, 0x6d, 0xef, 0xad, 0x0f,
-	0xa8, 0x22, 0xeb, 0x06, 0xb4, 0xe2, 0x44, 0x28, 0x81, 0xae, 0x58, 0xbe, 0x65, 0x52, 0x19, 0xbf,
-	0x52, 0x1b, 0x89, 0x91, 0x30, 0x7c, 0x5b, 0x47, 0xb6, 0x74, 0x65, 0xd9, 0x96, 0xf6, 0x2d, 0x91,
-	0x9d, 0xb3, 0xd4, 0x59, 0x17, 0x49, 0x67, 0x5d, 0x86, 0x82, 0xf1, 0x8c, 0xbf, 0x96, 0xf1, 0x91,
-	0x1c, 0xb5, 0xf7, 0xd6, 0xf5, 0xc3, 0x12, 0xde, 0x2b, 0x00, 0xcb, 0x4f, 0x49, 0x42, 0x22, 0x89,
-	0xb6, 0xe0, 0x25, 0x49, 0x79, 0xd0, 0xa7, 0x9c, 0x0c, 0x42, 0x1a, 0x60, 0xd0, 0x28, 0x36, 0xab,
-	0x1b, 0x8d, 0xd6, 0x05, 0x06, 0x5b, 0xdb, 0x94, 0x07, 0xf7, 0x6d, 0x9d, 0x5f, 0x95, 0x67, 0x00,
-	0x75, 0x60, 0x2d, 0xa0, 0x2f, 0x48, 0x1a, 0xaa, 0xfe, 0x39, 0xb1, 0x85, 0x06, 0x68, 0x3a, 0x3e,
-	0xca, 0xb8, 0xb9, 0xe3, 0xdd, 0xc5, 0x77, 0x07, 0xf5, 0x82, 0xf7, 0x00, 0x56, 0xe7, 0x92, 0xa8,
-	0x06, 0x4b, 0x01, 0xe5, 0x22, 0xc2, 0xa0, 0x01, 0x9a, 0x4b, 0xbe, 0x05, 0x08, 0xc3, 0xca, 0x79,
-	0xbd, 0x1c, 0x76, 0x1d, 0x2d, 0xf2, 0xf3, 0xa0, 0x0e, 0xbc, 0x43, 0x00, 0x4b, 0x3d, 0x1e, 0xa7,
-	0x0a, 0x6d, 0xc0, 0x0a, 0x09, 0x82, 0x84, 0x4a, 0x69, 0x55, 0x36, 0xf1, 0x97, 0xc3, 0xb5, 0x5a,
-	0x36, 0xcd, 0x5d, 0xcb, 0x6c, 0xab, 0x84, 0xf1, 0x91, 0x9f, 0x17, 0x22, 0x02, 0x4b, 0xfa, 0xad,
-	0x49, 0xbc, 0x60, 0x86, 0x5f, 0x3e, 0x1b, 0x5e, 0xd2, 0xd9, 0xf0, 0x5b, 0x82, 0xf1, 0xcd, 0xce,
-	0xd1, 0x49, 0xbd, 0xf0, 0xe1, 0x5b, 0xbd, 0x39, 0x62, 0x6a, 0x9c, 0x0e, 0x5a, 0x43, 0x11, 0x65,
-	0x57, 0x92, 0x3d, 0xd6, 0x64, 0xb0, 0xdb, 0x56, 0x93, 0x98, 0x4a, 0x73, 0x40, 0xfa, 0x56, 0xb9,
-	0x5b, 0x7b, 0x6d, 0xad, 0x16, 0x5e, 0xfe, 0xf8, 0xb8, 0x9a, 0x37, 0xf6, 0xde, 0x03, 0x58, 0x7e,
-	0x92, 0xaa, 0xff, 0xd8, 0xb7, 0x93, 0xfb, 0xf6, 0x3e, 0x01, 0x58, 0xde, 0x4e, 0xe3, 0x38, 0x9c,
-	0xe8, 0xbe, 0x4a, 0x28, 0x12, 0x66, 0xcb, 0xf2, 0x6f, 0xfb, 0x1a, 0xe5, 0xee, 0xa3, 0xac, 0x2f,
-	0xf8, 0x7c, 0xb8, 0x76, 0x7b, 0xf5, 0x8f, 0xa7, 0xf7, 0xed, 0x57, 0x16, 0xb1, 0x51, 0x42, 0x14,
-	0x13, 0x5c, 0xb6, 0xf7, 0x3a, 0xb7, 0x3a, 0x2d, 0xeb, 0xb5, 0x87, 0x81, 0xf7, 0x0c, 0x2e, 0xdd,
-	0xd3, 0x9b, 0xb4, 0xc3, 0x99, 0xfa, 0xcd, 0x8e, 0xad, 0x40, 0x87, 0xee, 0xc7, 0x82, 0x53, 0xae,
-	0xcc, 0x92, 0x5d, 0xf6, 0x67, 0x58, 0xef, 0x1f, 0x09, 0x19, 0x91, 0x54, 0xe2, 0x62, 0xa3, 0xd8,
-	0x5c, 0xf2, 0x73, 0xe8, 0xbd, 0x59, 0x80, 0xce, 0x63, 0xaa, 0x48, 0x40, 0x14, 0x41, 0x0d, 0x58,
-	0x0d, 0xa8, 0x1c, 0x26, 0x2c, 0xd6, 0x26, 0x32, 0xf9, 0xf9, 0x14, 0xba, 0xa3, 0x2b, 0xb8, 0x88,
-	0xfa, 0x29, 0x67, 0x2a, 0xbf, 0x34, 0xf7, 0xc2, 0x2f, 0x6d, 0xe6, 0xd7, 0x87, 0x41, 0x1e, 0x4a,
-	0x84, 0xe0, 0xa2, 0x7e, 0xc5, 0xb8, 0x68, 0xb4, 0x4d, 0xac, 0xdd, 0x05, 0x4c, 0xc6, 0x21, 0x99,
-	0xe0, 0x45, 0x93, 0xce, 0xa1, 0xae, 0xe6, 0x24, 0xa2, 0xb8, 0x64, 0xab, 0x75, 0x8c, 0xae, 0xc2,
-	0xb2, 0x9c, 0x44, 0x03, 0x11, 0xe2, 0xb2, 0xc9, 0x66, 0x08, 0x2d, 0xc3, 0x62, 0x9a, 0x30, 0x5c,
-	0x31, 0x9b, 0x57, 0x99, 0x9e, 0xd4, 0x8b, 0x3b, 0x7e, 0xcf, 0xd7, 0x39, 0x74, 0x1d, 0x3a, 0x69,
-	0xc2, 0xfa, 0x63, 0x22, 0xc7, 0xd8, 0x31, 0x7c, 0x75, 0x7a, 0x52, 0xaf, 0xec, 0xf8, 0xbd, 0x87,
-	0x44, 0x8e, 0xfd, 0x4a, 0x9a, 0x30, 0x1d, 0x6c, 0x6e, 0x1d, 0x4d, 0x5d, 0x70, 0x3c, 0x75, 0xc1,
-	0xf7, 0xa9, 0x0b, 0xde, 0x9e, 0xba, 0x85, 0xe3, 0x53, 0xb7, 0xf0, 0xf5, 0xd4, 0x2d, 0x3c, 0xbf,
-	0xf1, 0x37, 0xd7, 0x67, 0x76, 0x60, 0x50, 0x36, 0xff, 0xa7, 0x9b, 0xbf, 0x02, 0x00, 0x00, 0xff,
-	0xff, 0x8f, 0x9f, 0xc3, 0x47, 0x40, 0x05, 0x00, 0x00,
+	// 651 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x54, 0x31, 0x6f, 0x13, 0x49,
+	0x14, 0xf6, 0xd8, 0xb1, 0xbd, 0x19, 0xdf, 0x35, 0x73, 0xd6, 0xdd, 0x24, 0xc5, 0xda, 0xda, 0xe2,
+	0x64, 0x22, 0xc5, 0x76, 0x02, 0x95, 0x85, 0x84, 0x70, 0x40, 0xc1, 0x48, 0x08, 0xb4, 0x51, 0x84,
+	0x44, 0x63, 0x8d, 0xbd, 0x83, 0x3d, 0xca, 0xee, 0xcc, 0x6a, 0x67, 0x36, 0x8a, 0x5b, 0x2a, 0xa0,
+	0xa2, 0xa4, 0x4c, 0x0b, 0x15, 0x45, 0x24, 0xfe, 0x42, 0x44, 0x15, 0x51, 0x51, 0x05, 0xe4, 0x14,
+	0xf0, 0x33, 0xd0, 0xcc, 0xec, 0x3a, 0x89, 0x14, 0x10, 0x0d, 0x12, 0xd5, 0xbe, 0xf7, 0xbe, 0x6f,
+	0xbe, 0xf7, 0xf9, 0xcd, 0x1b, 0x43, 0x77, 0x2c, 0x64, 0x24, 0x64, 0x67, 0x44, 0xf8, 0x5e, 0x67,
+	0x7f, 0x63, 0x44, 0x15, 0xd9, 0x30, 0x49, 0x3b, 0x4e, 0x84, 0x12, 0xe8, 0x1f, 0x8b, 0xb7, 0x4d,
+	0x29, 0xc3, 0x57, 0xeb, 0x13, 0x31, 0x11, 0x06, 0xef, 0xe8, 0xc8, 0x52, 0x57, 0x57, 0x2c, 0x75,
+	0x68, 0x81, 0xec, 0x9c, 0x85, 0xce, 0xbb, 0x48, 0xba, 0xe8, 0x32, 0x16, 0x8c, 0x67, 0xf8, 0x7f,
+	0x19, 0x1e, 0xc9, 0x49, 0x67, 0x7f, 0x43, 0x7f, 0x2c, 0xe0, 0xbd, 0x00, 0xb0, 0xf2, 0x88, 0x24,
+	0x24, 0x92, 0x68, 0x1b, 0xfe, 0x25, 0x29, 0x0f, 0x86, 0x94, 0x93, 0x51, 0x48, 0x03, 0x0c, 0x9a,
+	0xa5, 0x56, 0x6d, 0xb3, 0xd9, 0xbe, 0xc2, 0x60, 0x7b, 0x87, 0xf2, 0xe0, 0xae, 0xe5, 0xf5, 0x8b,
+	0x18, 0xf8, 0x35, 0x79, 0x5e, 0x40, 0x5d, 0x58, 0x0f, 0xe8, 0x53, 0x92, 0x86, 0x6a, 0x78, 0x49,
+	0xb0, 0xd8, 0x04, 0x2d, 0xc7, 0x47, 0x19, 0x76, 0x41, 0xa2, 0xb7, 0xf4, 0xfa, 0xb0, 0x51, 0xf0,
+	0xb6, 0x61, 0xed, 0x42, 0x11, 0xd5, 0x61, 0x39, 0xa0, 0x5c, 0x44, 0x18, 0x34, 0x41, 0x6b, 0xd9,
+	0xb7, 0x09, 0xc2, 0xb0, 0x7a, 0x59, 0x2f, 0x4f, 0x7b, 0x8e, 0x16, 0xf9, 0x76, 0xd8, 0x00, 0xde,
+	0x11, 0x80, 0xe5, 0x01, 0x8f, 0x53, 0x85, 0x36, 0x61, 0x95, 0x04, 0x41, 0x42, 0xa5, 0xb4, 0x2a,
+	0x7d, 0xfc, 0xf1, 0x68, 0xbd, 0x9e, 0xfd, 0xa2, 0xdb, 0x16, 0xd9, 0x51, 0x09, 0xe3, 0x13, 0x3f,
+	0x27, 0x22, 0x02, 0xcb, 0x7a, 0x72, 0x12, 0x17, 0xcd, 0x00, 0x56, 0xce, 0x07, 0x20, 0xe9, 0x62,
+	0x00, 0x5b, 0x82, 0xf1, 0x7e, 0xf7, 0xf8, 0xb4, 0x51, 0x78, 0xfb, 0xb9, 0xd1, 0x9a, 0x30, 0x35,
+	0x4d, 0x47, 0xed, 0xb1, 0x88, 0xb2, 0x6b, 0xc9, 0x3e, 0xeb, 0x32, 0xd8, 0xeb, 0xa8, 0x59, 0x4c,
+	0xa5, 0x39, 0x20, 0x7d, 0xab, 0xdc, 0xab, 0x3f, 0xb7, 0x56, 0x0b, 0xcf, 0xbe, 0xbe, 0x5b, 0xcb,
+	0x1b, 0x7b, 0x6f, 0x00, 0xac, 0x3c, 0x4c, 0xd5, 0x1f, 0xec, 0xdb, 0xc9, 0x7d, 0x7b, 0xef, 0x01,
+	0xac, 0xec, 0xa4, 0x71, 0x1c, 0xce, 0x74, 0x5f, 0x25, 0x14, 0x09, 0x31, 0xf8, 0x0d, 0x7d, 0x8d,
+	0x72, 0xef, 0x7e, 0xd6, 0x17, 0x7c, 0x38, 0x5a, 0xbf, 0xb9, 0xf6, 0xd3, 0xd3, 0x07, 0xf6, 0xa5,
+	0x45, 0x6c, 0x92, 0x10, 0xc5, 0x04, 0x97, 0x9d, 0xfd, 0xee, 0x8d, 0x6e, 0xdb, 0x7a, 0x1d, 0x60,
+	0xe0, 0x3d, 0x86, 0xcb, 0x77, 0xf4, 0x26, 0xed, 0x72, 0xa6, 0x7e, 0xb0, 0x63, 0xab, 0xd0, 0xa1,
+	0x07, 0xb1, 0xe0, 0x94, 0x2b, 0xb3, 0x64, 0x7f, 0xfb, 0x8b, 0x5c, 0xef, 0x1f, 0x09, 0x19, 0x91,
+	0x54, 0xe2, 0x52, 0xb3, 0xd4, 0x5a, 0xf6, 0xf3, 0xd4, 0x7b, 0x59, 0x84, 0xce, 0x03, 0xaa, 0x48,
+	0x40, 0x14, 0x41, 0x4d, 0x58, 0x0b, 0xa8, 0x1c, 0x27, 0x2c, 0xd6, 0x26, 0x32, 0xf9, 0x8b, 0x25,
+	0x74, 0x4b, 0x33, 0xb8, 0x88, 0x86, 0x29, 0x67, 0x2a, 0xbf, 0x34, 0xf7, 0xca, 0xd7, 0xb6, 0xf0,
+	0xeb, 0xc3, 0x20, 0x0f, 0x25, 0x42, 0x70, 0x49, 0x8f, 0x18, 0x97, 0x8c, 0xb6, 0x89, 0xb5, 0xbb,
+	0x80, 0xc9, 0x38, 0x24, 0x33, 0xbc, 0x64, 0xca, 0x79, 0xaa, 0xd9, 0x9c, 0x44, 0x14, 0x97, 0x2d,
+	0x5b, 0xc7, 0xe8, 0x5f, 0x58, 0x91, 0xb3, 0x68, 0x24, 0x42, 0x5c, 0x31, 0xd5, 0x2c, 0x43, 0x2b,
+	0xb0, 0x94, 0x26, 0x0c, 0x57, 0xcd, 0xe6, 0x55, 0xe7, 0xa7, 0x8d, 0xd2, 0xae, 0x3f, 0xf0, 0x75,
+	0x0d, 0xfd, 0x0f, 0x9d, 0x34, 0x61, 0xc3, 0x29, 0x91, 0x53, 0xec, 0x18, 0xbc, 0x36, 0x3f, 0x6d,
+	0x54, 0x77, 0xfd, 0xc1, 0x3d, 0x22, 0xa7, 0x7e, 0x35, 0x4d, 0x98, 0x0e, 0xfa, 0x5b, 0xc7, 0x73,
+	0x17, 0x9c, 0xcc, 0x5d, 0xf0, 0x65, 0xee, 0x82, 0x57, 0x67, 0x6e, 0xe1, 0xe4, 0xcc, 0x2d, 0x7c,
+	0x3a, 0x73, 0x0b, 0x4f, 0xae, 0xfd, 0xca, 0xf5, 0x99, 0x1d, 0x18, 0x55, 0xcc, 0x7f, 0xd4, 0xf5,
+	0xef, 0x03, 0x00, 0xec, 0xf7, 0xce, 0x43, 0x44, 0x05, 0x00, 0x00,
 }
 
 func (this *SendEnabled) Equal(that interface{}) bool {
@@ -0,0 +1,10 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// FrozenCoinsFn defines a hook, registered by a module in the bank keeper,
+// which returns the coins of an account frozen by the module among its
+// balances. The frozen coins are not spendable.
+type FrozenCoinsFn func(ctx sdk.Context, addr sdk.AccAddress, balances sdk.Coins) sdk.Coins
@@ -15,6 +15,22 @@ func (gs GenesisState) Validate() error {
 		return err
 	}
 
+	// the SendEnabled entries of the params are moved to the genesis ones in
+	// InitGenesis, so a denom must only be found once in both lists
+	seenSendEnabled := make(map[string]bool)
+	for _, p := range gs.Params.SendEnabled { //nolint:staticcheck // SA1019: Params.SendEnabled is deprecated
+		seenSendEnabled[p.Denom] = true
+	}
+	for _, p := range gs.SendEnabled {
+		if seenSendEnabled[p.Denom] {
+			return fmt.Errorf("duplicate send enabled found: '%s'", p.Denom)
+		}
+		if err := sdk.ValidateDenom(p.Denom); err != nil {
+			return err
+		}
+		seenSendEnabled[p.Denom] = true
+	}
+
 	seenBalances := make(map[string]bool)
 	seenMetadatas := make(map[string]bool)
 
@@ -62,18 +78,19 @@ func (gs GenesisState) Validate() error {
 }
 
 // NewGenesisState creates a new genesis state.
-func NewGenesisState(params Params, balances []Balance, supply sdk.Coins, denomMetaData []Metadata) *GenesisState {
+func NewGenesisState(params Params, balances []Balance, supply sdk.Coins, denomMetaData []Metadata, sendEnabled []SendEnabled) *GenesisState {
 	return &GenesisState{
 		Params:        params,
 		Balances:      balances,
 		Supply:        supply,
 		DenomMetadata: denomMetaData,
+		SendEnabled:   sendEnabled,
 	}
 }
 
 // DefaultGenesisState returns a default bank module genesis state.
 func DefaultGenesisState() *GenesisState {
-	return NewGenesisState(DefaultParams(), []Balance{}, sdk.Coins{}, []Metadata{})
+	return NewGenesisState(DefaultParams(), []Balance{}, sdk.Coins{}, []Metadata{}, []SendEnabled{})
 }
 
 // GetGenesisStateFromAppState returns x/bank GenesisState given raw application
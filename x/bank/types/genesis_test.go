@@ -54,6 +54,39 @@ func TestGenesisStateValidate(t *testing.T) {
 			},
 			true,
 		},
+		{
+			"invalid send enabled denom",
+			GenesisState{
+				SendEnabled: []SendEnabled{
+					{"", true},
+				},
+			},
+			true,
+		},
+		{
+			"dup send enabled in params and genesis",
+			GenesisState{
+				Params: Params{
+					SendEnabled: []*SendEnabled{
+						{"uatom", true},
+					},
+				},
+				SendEnabled: []SendEnabled{
+					{"uatom", false},
+				},
+			},
+			true,
+		},
+		{
+			"dup send enabled",
+			GenesisState{
+				SendEnabled: []SendEnabled{
+					{"uatom", true},
+					{"uatom", false},
+				},
+			},
+			true,
+		},
 		{
 			"dup balances",
 			GenesisState{
@@ -25,10 +25,15 @@ var (
 	SupplyKey           = []byte{0x00}
 	DenomMetadataPrefix = []byte{0x1}
 	DenomAddressPrefix  = []byte{0x03}
+	SendEnabledPrefix   = []byte{0x04}
 
 	// BalancesPrefix is the prefix for the account balances store. We use a byte
 	// (instead of `[]byte("balances")` to save some disk space).
 	BalancesPrefix = []byte{0x02}
+
+	// the values of the SendEnabled entries
+	trueB  = []byte{0x01}
+	falseB = []byte{0x00}
 )
 
 // AddressAndDenomFromBalancesStore returns an account address and denom from a balances prefix
@@ -73,3 +78,24 @@ func CreateDenomAddressPrefix(denom string) []byte {
 	copy(key[len(DenomAddressPrefix):], denom)
 	return key
 }
+
+// CreateSendEnabledKey creates the key of the SendEnabled entry of a denom.
+func CreateSendEnabledKey(denom string) []byte {
+	key := make([]byte, len(SendEnabledPrefix)+len(denom))
+	copy(key, SendEnabledPrefix)
+	copy(key[len(SendEnabledPrefix):], denom)
+	return key
+}
+
+// ToBoolB converts a bool into the value of a SendEnabled entry.
+func ToBoolB(value bool) []byte {
+	if value {
+		return trueB
+	}
+	return falseB
+}
+
+// IsTrueB returns whether the value of a SendEnabled entry is true.
+func IsTrueB(bz []byte) bool {
+	return len(bz) == 1 && bz[0] == trueB[0]
+}
@@ -9,6 +9,8 @@ import (
 const (
 	TypeMsgSend      = "send"
 	TypeMsgMultiSend = "multisend"
+
+	TypeMsgSetSendEnabled = "set_send_enabled"
 )
 
 var _ sdk.Msg = &MsgSend{}
@@ -102,6 +104,70 @@ func (msg MsgMultiSend) GetSigners() []sdk.AccAddress {
 	return addrs
 }
 
+var _ sdk.Msg = &MsgSetSendEnabled{}
+
+// NewMsgSetSendEnabled - construct a msg to update the SendEnabled entries,
+// and the default SendEnabled flag if setDefault is true.
+func NewMsgSetSendEnabled(authority string, sendEnabled []*SendEnabled, useDefaultFor []string, setDefault, defaultSendEnabled bool) *MsgSetSendEnabled {
+	return &MsgSetSendEnabled{
+		Authority:          authority,
+		SendEnabled:        sendEnabled,
+		UseDefaultFor:      useDefaultFor,
+		SetDefault:         setDefault,
+		DefaultSendEnabled: defaultSendEnabled,
+	}
+}
+
+// Route Implements Msg.
+func (msg MsgSetSendEnabled) Route() string { return RouterKey }
+
+// Type Implements Msg.
+func (msg MsgSetSendEnabled) Type() string { return TypeMsgSetSendEnabled }
+
+// ValidateBasic Implements Msg.
+func (msg MsgSetSendEnabled) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Authority); err != nil {
+		return sdkerrors.ErrInvalidAddress.Wrapf("invalid authority address: %s", err)
+	}
+
+	seen := make(map[string]bool)
+	for _, se := range msg.SendEnabled {
+		if se == nil {
+			return sdkerrors.ErrInvalidRequest.Wrap("nil send enabled entry")
+		}
+		if seen[se.Denom] {
+			return sdkerrors.ErrInvalidRequest.Wrapf("duplicate denom entries found for %q", se.Denom)
+		}
+		if err := sdk.ValidateDenom(se.Denom); err != nil {
+			return sdkerrors.ErrInvalidRequest.Wrapf("invalid send enabled denom: %s", err)
+		}
+		seen[se.Denom] = true
+	}
+
+	for _, denom := range msg.UseDefaultFor {
+		if seen[denom] {
+			return sdkerrors.ErrInvalidRequest.Wrapf("duplicate denom entries found for %q", denom)
+		}
+		if err := sdk.ValidateDenom(denom); err != nil {
+			return sdkerrors.ErrInvalidRequest.Wrapf("invalid use default for denom: %s", err)
+		}
+		seen[denom] = true
+	}
+
+	return nil
+}
+
+// GetSignBytes Implements Msg.
+func (msg MsgSetSendEnabled) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&msg))
+}
+
+// GetSigners Implements Msg.
+func (msg MsgSetSendEnabled) GetSigners() []sdk.AccAddress {
+	authority, _ := sdk.AccAddressFromBech32(msg.Authority)
+	return []sdk.AccAddress{authority}
+}
+
 // ValidateBasic - validate transaction input
 func (in Input) ValidateBasic() error {
 	if _, err := sdk.AccAddressFromBech32(in.Address); err != nil {
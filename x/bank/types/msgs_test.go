@@ -276,3 +276,38 @@ func TestMsgSendGetSigners(t *testing.T) {
 	require.Equal(t, 1, len(res))
 	require.True(t, from.Equals(res[0]))
 }
+
+func TestMsgSetSendEnabledValidation(t *testing.T) {
+	authority := sdk.AccAddress([]byte("authority___________")).String()
+
+	cases := []struct {
+		expectedErr string // empty means no error expected
+		msg         *MsgSetSendEnabled
+	}{
+		{"", NewMsgSetSendEnabled(authority, nil, nil, false, false)},
+		{"", NewMsgSetSendEnabled(authority, []*SendEnabled{NewSendEnabled("foocoin", true)}, []string{"barcoin"}, true, false)},
+		{"invalid authority address: empty address string is not allowed: invalid address", NewMsgSetSendEnabled("", nil, nil, false, false)},
+		{"nil send enabled entry: invalid request", NewMsgSetSendEnabled(authority, []*SendEnabled{nil}, nil, false, false)},
+		{"invalid send enabled denom: invalid denom: 0foo: invalid request", NewMsgSetSendEnabled(authority, []*SendEnabled{NewSendEnabled("0foo", true)}, nil, false, false)},
+		{"invalid use default for denom: invalid denom: 0foo: invalid request", NewMsgSetSendEnabled(authority, nil, []string{"0foo"}, false, false)},
+		{"duplicate denom entries found for \"foocoin\": invalid request", NewMsgSetSendEnabled(authority, []*SendEnabled{NewSendEnabled("foocoin", true), NewSendEnabled("foocoin", false)}, nil, false, false)},
+		{"duplicate denom entries found for \"foocoin\": invalid request", NewMsgSetSendEnabled(authority, []*SendEnabled{NewSendEnabled("foocoin", true)}, []string{"foocoin"}, false, false)},
+	}
+
+	for _, tc := range cases {
+		err := tc.msg.ValidateBasic()
+		if tc.expectedErr == "" {
+			require.Nil(t, err)
+		} else {
+			require.EqualError(t, err, tc.expectedErr)
+		}
+	}
+}
+
+func TestMsgSetSendEnabledGetSigners(t *testing.T) {
+	authority := sdk.AccAddress([]byte("authority___________"))
+	msg := NewMsgSetSendEnabled(authority.String(), nil, nil, false, false)
+	res := msg.GetSigners()
+	require.Equal(t, 1, len(res))
+	require.True(t, authority.Equals(res[0]))
+}
@@ -40,7 +40,7 @@ func DefaultParams() Params {
 	return Params{
 		SendEnabled: SendEnabledParams{},
 		// The default send enabled value allows send transfers for all coin denoms
-		DefaultSendEnabled: true,
+		DefaultSendEnabled: DefaultSendEnabled,
 	}
 }
 
@@ -84,7 +84,7 @@ func (p Params) SetSendEnabledParam(denom string, sendEnabled bool) Params {
 // ParamSetPairs implements params.ParamSet
 func (p *Params) ParamSetPairs() paramtypes.ParamSetPairs {
 	return paramtypes.ParamSetPairs{
-		paramtypes.NewParamSetPair(KeySendEnabled, &p.SendEnabled, validateSendEnabledParams),
+		paramtypes.NewParamSetPair(KeySendEnabled, &p.SendEnabled, validateNoSendEnabledParams),
 		paramtypes.NewParamSetPair(KeyDefaultSendEnabled, &p.DefaultSendEnabled, validateIsBool),
 	}
 }
@@ -111,6 +111,20 @@ func validateSendEnabledParams(i interface{}) error {
 	return nil
 }
 
+// validateNoSendEnabledParams rejects the SendEnabled entries in the params
+// store, as they are kept in their own store and are managed through
+// MsgSetSendEnabled.
+func validateNoSendEnabledParams(i interface{}) error {
+	params, ok := i.([]*SendEnabled)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+	if len(params) > 0 {
+		return fmt.Errorf("send enabled entries are no longer part of the params, use MsgSetSendEnabled instead")
+	}
+	return nil
+}
+
 // NewSendEnabled creates a new SendEnabled object
 // The denom may be left empty to control the global default setting of send_enabled
 func NewSendEnabled(denom string, sendEnabled bool) *SendEnabled {
@@ -0,0 +1,78 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// SendRestrictionFn defines a restriction on the transfers of coins, registered
+// by a module in the bank keeper. It can reject a transfer by returning an
+// error, or redirect it by returning a new recipient address, which is toAddr
+// otherwise.
+type SendRestrictionFn func(ctx sdk.Context, fromAddr, toAddr sdk.AccAddress, amt sdk.Coins) (newToAddr sdk.AccAddress, err error)
+
+var _ SendRestrictionFn = NoOpSendRestrictionFn
+
+// NoOpSendRestrictionFn is a SendRestrictionFn which allows all the transfers.
+func NoOpSendRestrictionFn(_ sdk.Context, _, toAddr sdk.AccAddress, _ sdk.Coins) (sdk.AccAddress, error) {
+	return toAddr, nil
+}
+
+// Then returns a SendRestrictionFn which runs r, then second with the recipient
+// returned by r, unless r fails.
+func (r SendRestrictionFn) Then(second SendRestrictionFn) SendRestrictionFn {
+	return ComposeSendRestrictions(r, second)
+}
+
+// ComposeSendRestrictions returns a SendRestrictionFn which runs the given
+// restrictions in order, each one with the recipient returned by the previous
+// one, and stops at the first error. The nil restrictions are ignored, and nil
+// is returned if they are all nil.
+func ComposeSendRestrictions(restrictions ...SendRestrictionFn) SendRestrictionFn {
+	toRun := make([]SendRestrictionFn, 0, len(restrictions))
+	for _, r := range restrictions {
+		if r != nil {
+			toRun = append(toRun, r)
+		}
+	}
+
+	switch len(toRun) {
+	case 0:
+		return nil
+	case 1:
+		return toRun[0]
+	}
+
+	return func(ctx sdk.Context, fromAddr, toAddr sdk.AccAddress, amt sdk.Coins) (sdk.AccAddress, error) {
+		var err error
+		for _, r := range toRun {
+			toAddr, err = r(ctx, fromAddr, toAddr, amt)
+			if err != nil {
+				return toAddr, err
+			}
+		}
+		return toAddr, nil
+	}
+}
+
+// sendRestrictionsBypassKey is the context key of the send restrictions bypass.
+type sendRestrictionsBypassKey struct{}
+
+// WithSendRestrictionsBypass returns a context in which the transfers of coins
+// are not subject to the send restrictions, e.g. for transfers between module
+// accounts.
+func WithSendRestrictionsBypass(ctx sdk.Context) sdk.Context {
+	return ctx.WithValue(sendRestrictionsBypassKey{}, true)
+}
+
+// WithoutSendRestrictionsBypass returns a context in which the transfers of
+// coins are subject to the send restrictions again.
+func WithoutSendRestrictionsBypass(ctx sdk.Context) sdk.Context {
+	return ctx.WithValue(sendRestrictionsBypassKey{}, false)
+}
+
+// HasSendRestrictionsBypass returns whether the transfers of coins bypass the
+// send restrictions in the context.
+func HasSendRestrictionsBypass(ctx sdk.Context) bool {
+	bypass, ok := ctx.Value(sendRestrictionsBypassKey{}).(bool)
+	return ok && bypass
+}
@@ -0,0 +1,105 @@
+package types_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/bank/types"
+)
+
+// recordingRestriction returns a SendRestrictionFn which appends its name to
+// calls, and returns newToAddr if set, or err.
+func recordingRestriction(name string, calls *[]string, newToAddr sdk.AccAddress, err error) types.SendRestrictionFn {
+	return func(_ sdk.Context, _, toAddr sdk.AccAddress, _ sdk.Coins) (sdk.AccAddress, error) {
+		*calls = append(*calls, name+":"+string(toAddr))
+		if err != nil {
+			return nil, err
+		}
+		if newToAddr != nil {
+			return newToAddr, nil
+		}
+		return toAddr, nil
+	}
+}
+
+func TestComposeSendRestrictions(t *testing.T) {
+	fromAddr := sdk.AccAddress("from")
+	toAddr := sdk.AccAddress("to")
+	redirectAddr := sdk.AccAddress("redirect")
+	amt := sdk.NewCoins(sdk.NewInt64Coin("stake", 10))
+	errRejected := errors.New("rejected")
+
+	require.Nil(t, types.ComposeSendRestrictions())
+	require.Nil(t, types.ComposeSendRestrictions(nil, nil))
+
+	var calls []string
+	testCases := []struct {
+		name         string
+		restrictions []types.SendRestrictionFn
+		expToAddr    sdk.AccAddress
+		expErr       error
+		expCalls     []string
+	}{
+		{
+			"single restriction",
+			[]types.SendRestrictionFn{nil, recordingRestriction("a", &calls, nil, nil), nil},
+			toAddr, nil, []string{"a:to"},
+		},
+		{
+			"restrictions run in order with the new recipient",
+			[]types.SendRestrictionFn{
+				recordingRestriction("a", &calls, nil, nil),
+				recordingRestriction("b", &calls, redirectAddr, nil),
+				recordingRestriction("c", &calls, nil, nil),
+			},
+			redirectAddr, nil, []string{"a:to", "b:to", "c:redirect"},
+		},
+		{
+			"restrictions stop at the first error",
+			[]types.SendRestrictionFn{
+				recordingRestriction("a", &calls, redirectAddr, nil),
+				recordingRestriction("b", &calls, nil, errRejected),
+				recordingRestriction("c", &calls, nil, nil),
+			},
+			nil, errRejected, []string{"a:to", "b:redirect"},
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			calls = nil
+			newToAddr, err := types.ComposeSendRestrictions(tc.restrictions...)(sdk.Context{}, fromAddr, toAddr, amt)
+			require.ErrorIs(t, err, tc.expErr)
+			require.Equal(t, tc.expToAddr, newToAddr)
+			require.Equal(t, tc.expCalls, calls)
+		})
+	}
+
+	// Then composes two restrictions
+	calls = nil
+	r := recordingRestriction("a", &calls, redirectAddr, nil).Then(recordingRestriction("b", &calls, nil, nil))
+	newToAddr, err := r(sdk.Context{}, fromAddr, toAddr, amt)
+	require.NoError(t, err)
+	require.Equal(t, redirectAddr, newToAddr)
+	require.Equal(t, []string{"a:to", "b:redirect"}, calls)
+
+	newToAddr, err = types.NoOpSendRestrictionFn(sdk.Context{}, fromAddr, toAddr, amt)
+	require.NoError(t, err)
+	require.Equal(t, toAddr, newToAddr)
+}
+
+func TestSendRestrictionsBypass(t *testing.T) {
+	ctx := sdk.NewContext(nil, tmproto.Header{}, false, nil)
+	require.False(t, types.HasSendRestrictionsBypass(ctx))
+
+	ctx = types.WithSendRestrictionsBypass(ctx)
+	require.True(t, types.HasSendRestrictionsBypass(ctx))
+
+	ctx = types.WithoutSendRestrictionsBypass(ctx)
+	require.False(t, types.HasSendRestrictionsBypass(ctx))
+}
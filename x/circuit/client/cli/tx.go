@@ -0,0 +1,146 @@
+package cli
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/spf13/cobra"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/version"
+	"github.com/cosmos/cosmos-sdk/x/circuit/types"
+)
+
+// GetTxCmd returns the transaction commands for this module
+func GetTxCmd() *cobra.Command {
+	circuitTxCmd := &cobra.Command{
+		Use:                        types.ModuleName,
+		Short:                      "circuit transactions subcommands",
+		DisableFlagParsing:         true,
+		SuggestionsMinimumDistance: 2,
+		RunE:                       client.ValidateCmd,
+	}
+
+	circuitTxCmd.AddCommand(
+		NewCmdAuthorizeCircuitBreaker(),
+		NewCmdTripCircuitBreaker(),
+		NewCmdResetCircuitBreaker(),
+	)
+
+	return circuitTxCmd
+}
+
+// NewCmdAuthorizeCircuitBreaker returns a CLI command handler for creating a
+// MsgAuthorizeCircuitBreaker transaction.
+func NewCmdAuthorizeCircuitBreaker() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "authorize [grantee] [level] [limit_type_urls]",
+		Args:  cobra.RangeArgs(2, 3),
+		Short: "grant circuit breaker permissions to an account",
+		Long: strings.TrimSpace(fmt.Sprintf(`Grant circuit breaker permissions to an account.
+The level is one of none, some-msgs, all-msgs or super-admin. The limit type urls
+are a comma separated list of msg type urls and are required with some-msgs.
+
+Example:
+$ %s tx %s authorize <grantee> some-msgs /cosmos.bank.v1beta1.MsgSend,/cosmos.bank.v1beta1.MsgMultiSend --from <granter>
+`, version.AppName, types.ModuleName),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			grantee, err := sdk.AccAddressFromBech32(args[0])
+			if err != nil {
+				return err
+			}
+
+			level, err := parseLevel(args[1])
+			if err != nil {
+				return err
+			}
+
+			var limitTypeURLs []string
+			if len(args) > 2 {
+				limitTypeURLs = strings.Split(args[2], ",")
+			}
+
+			perms := types.NewPermissions(level, limitTypeURLs)
+			msg := types.NewMsgAuthorizeCircuitBreaker(clientCtx.GetFromAddress().String(), grantee.String(), &perms)
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+// NewCmdTripCircuitBreaker returns a CLI command handler for creating a
+// MsgTripCircuitBreaker transaction.
+func NewCmdTripCircuitBreaker() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "disable [type_urls...]",
+		Args:  cobra.MinimumNArgs(1),
+		Short: "disable the execution of msg types",
+		Example: fmt.Sprintf(`$ %s tx %s disable /cosmos.bank.v1beta1.MsgSend /cosmos.bank.v1beta1.MsgMultiSend --from <account>`,
+			version.AppName, types.ModuleName),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgTripCircuitBreaker(clientCtx.GetFromAddress().String(), args)
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+// NewCmdResetCircuitBreaker returns a CLI command handler for creating a
+// MsgResetCircuitBreaker transaction.
+func NewCmdResetCircuitBreaker() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "reset [type_urls...]",
+		Args:  cobra.MinimumNArgs(1),
+		Short: "re-enable the execution of disabled msg types",
+		Example: fmt.Sprintf(`$ %s tx %s reset /cosmos.bank.v1beta1.MsgSend --from <account>`,
+			version.AppName, types.ModuleName),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgResetCircuitBreaker(clientCtx.GetFromAddress().String(), args)
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+// parseLevel parses a permission level given either as its short name
+// (e.g. "some-msgs") or its enum name (e.g. "LEVEL_SOME_MSGS").
+func parseLevel(s string) (types.Permissions_Level, error) {
+	name := strings.ToUpper(strings.ReplaceAll(s, "-", "_"))
+	if name == "NONE" {
+		name = "NONE_UNSPECIFIED"
+	}
+	if !strings.HasPrefix(name, "LEVEL_") {
+		name = "LEVEL_" + name
+	}
+
+	level, ok := types.Permissions_Level_value[name]
+	if !ok {
+		return 0, fmt.Errorf("unknown permission level %s", s)
+	}
+	return types.Permissions_Level(level), nil
+}
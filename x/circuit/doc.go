@@ -0,0 +1,10 @@
+/*
+Package circuit implements a circuit breaker for the execution of Msgs.
+
+Accounts holding circuit breaker permissions, as well as the module authority,
+can disable ("trip") the execution of specific Msg type URLs, e.g. during an
+incident, and re-enable ("reset") them later on. The keeper implements the
+baseapp.CircuitBreaker interface and must be registered on the app with
+BaseApp.SetCircuitBreaker.
+*/
+package circuit
@@ -0,0 +1,43 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/circuit/types"
+)
+
+// InitGenesis sets the circuit breaker permissions and disabled msg type URLs
+// from the genesis state.
+func (k Keeper) InitGenesis(ctx sdk.Context, genState *types.GenesisState) {
+	for _, ap := range genState.AccountPermissions {
+		addr, err := sdk.AccAddressFromBech32(ap.Address)
+		if err != nil {
+			panic(err)
+		}
+		k.SetPermissions(ctx, addr, *ap.Permissions)
+	}
+
+	for _, url := range genState.DisabledTypeUrls {
+		k.DisableMsg(ctx, url)
+	}
+}
+
+// ExportGenesis returns the circuit module's exported genesis.
+func (k Keeper) ExportGenesis(ctx sdk.Context) *types.GenesisState {
+	var accountPermissions []*types.GenesisAccountPermissions
+	k.IterateAccountPermissions(ctx, func(addr sdk.AccAddress, p types.Permissions) bool {
+		perms := p
+		accountPermissions = append(accountPermissions, &types.GenesisAccountPermissions{
+			Address:     addr.String(),
+			Permissions: &perms,
+		})
+		return false
+	})
+
+	var disabledTypeURLs []string
+	k.IterateDisableList(ctx, func(msgTypeURL string) bool {
+		disabledTypeURLs = append(disabledTypeURLs, msgTypeURL)
+		return false
+	})
+
+	return types.NewGenesisState(accountPermissions, disabledTypeURLs)
+}
@@ -0,0 +1,38 @@
+package keeper_test
+
+import (
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"github.com/cosmos/cosmos-sdk/x/circuit/types"
+)
+
+func (s *KeeperTestSuite) TestGRPCQueries() {
+	queryHelper := baseapp.NewQueryServerTestHelper(s.ctx, s.app.InterfaceRegistry())
+	types.RegisterQueryServer(queryHelper, s.app.CircuitKeeper)
+	queryClient := types.NewQueryClient(queryHelper)
+	ctx := s.ctx.Context()
+
+	s.authorize(s.addrs[0], types.Permissions_LEVEL_ALL_MSGS)
+	s.app.CircuitKeeper.DisableMsg(s.ctx, msgSendURL)
+
+	account, err := queryClient.Account(ctx, &types.QueryAccountRequest{Address: s.addrs[0].String()})
+	s.Require().NoError(err)
+	s.Require().Equal(types.Permissions_LEVEL_ALL_MSGS, account.Permission.Level)
+
+	account, err = queryClient.Account(ctx, &types.QueryAccountRequest{Address: s.addrs[1].String()})
+	s.Require().NoError(err)
+	s.Require().Equal(types.Permissions_LEVEL_NONE_UNSPECIFIED, account.Permission.Level)
+
+	_, err = queryClient.Account(ctx, &types.QueryAccountRequest{Address: "invalid"})
+	s.Require().Error(err)
+
+	accounts, err := queryClient.Accounts(ctx, &types.QueryAccountsRequest{Pagination: &query.PageRequest{CountTotal: true}})
+	s.Require().NoError(err)
+	s.Require().Len(accounts.Accounts, 1)
+	s.Require().Equal(s.addrs[0].String(), accounts.Accounts[0].Address)
+	s.Require().Equal(uint64(1), accounts.Pagination.Total)
+
+	disabled, err := queryClient.DisabledList(ctx, &types.QueryDisabledListRequest{})
+	s.Require().NoError(err)
+	s.Require().Equal([]string{msgSendURL}, disabled.DisabledList)
+}
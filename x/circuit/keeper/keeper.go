@@ -0,0 +1,136 @@
+package keeper
+
+import (
+	"context"
+
+	"github.com/tendermint/tendermint/libs/log"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/circuit/types"
+)
+
+// Keeper defines the circuit module's keeper. It implements the
+// baseapp.CircuitBreaker interface.
+type Keeper struct {
+	cdc      codec.BinaryCodec
+	storeKey storetypes.StoreKey
+
+	// the address capable of executing any circuit breaker action. Typically, this
+	// should be the x/gov module account.
+	authority string
+}
+
+// NewKeeper constructs a new circuit Keeper instance
+func NewKeeper(cdc codec.BinaryCodec, storeKey storetypes.StoreKey, authority string) Keeper {
+	if _, err := sdk.AccAddressFromBech32(authority); err != nil {
+		panic(err)
+	}
+
+	return Keeper{
+		cdc:       cdc,
+		storeKey:  storeKey,
+		authority: authority,
+	}
+}
+
+// GetAuthority returns the x/circuit module's authority.
+func (k Keeper) GetAuthority() string {
+	return k.authority
+}
+
+// Logger returns a module-specific logger.
+func (k Keeper) Logger(ctx sdk.Context) log.Logger {
+	return ctx.Logger().With("module", "x/"+types.ModuleName)
+}
+
+// IsAllowed returns false if the execution of the given msg type URL has been
+// disabled by the circuit breaker.
+func (k Keeper) IsAllowed(goCtx context.Context, msgTypeURL string) (bool, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	return !k.IsMsgDisabled(ctx, msgTypeURL), nil
+}
+
+// GetPermissions returns the circuit breaker permissions of an account. An
+// account without permissions gets LEVEL_NONE_UNSPECIFIED.
+func (k Keeper) GetPermissions(ctx sdk.Context, addr sdk.AccAddress) (perms types.Permissions) {
+	store := ctx.KVStore(k.storeKey)
+	b := store.Get(types.GetAccountPermissionKey(addr))
+	if b == nil {
+		return perms
+	}
+
+	k.cdc.MustUnmarshal(b, &perms)
+	return perms
+}
+
+// SetPermissions sets the circuit breaker permissions of an account. Setting
+// LEVEL_NONE_UNSPECIFIED removes the account permissions.
+func (k Keeper) SetPermissions(ctx sdk.Context, addr sdk.AccAddress, perms types.Permissions) {
+	store := ctx.KVStore(k.storeKey)
+	if perms.Level == types.Permissions_LEVEL_NONE_UNSPECIFIED {
+		store.Delete(types.GetAccountPermissionKey(addr))
+		return
+	}
+
+	b := k.cdc.MustMarshal(&perms)
+	store.Set(types.GetAccountPermissionKey(addr), b)
+}
+
+// IterateAccountPermissions iterates over the accounts holding circuit breaker
+// permissions.
+func (k Keeper) IterateAccountPermissions(ctx sdk.Context, handler func(addr sdk.AccAddress, perms types.Permissions) (stop bool)) {
+	store := ctx.KVStore(k.storeKey)
+	iter := sdk.KVStorePrefixIterator(store, types.AccountPermissionPrefix)
+	defer iter.Close()
+	for ; iter.Valid(); iter.Next() {
+		// the key is prefix | len(addr) | addr
+		addr := sdk.AccAddress(iter.Key()[len(types.AccountPermissionPrefix)+1:])
+		var perms types.Permissions
+		k.cdc.MustUnmarshal(iter.Value(), &perms)
+		if handler(addr, perms) {
+			break
+		}
+	}
+}
+
+// IsMsgDisabled returns true if the given msg type URL is disabled.
+func (k Keeper) IsMsgDisabled(ctx sdk.Context, msgTypeURL string) bool {
+	store := ctx.KVStore(k.storeKey)
+	return store.Has(types.GetDisableListKey(msgTypeURL))
+}
+
+// DisableMsg adds a msg type URL to the disable list.
+func (k Keeper) DisableMsg(ctx sdk.Context, msgTypeURL string) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.GetDisableListKey(msgTypeURL), []byte{})
+}
+
+// EnableMsg removes a msg type URL from the disable list.
+func (k Keeper) EnableMsg(ctx sdk.Context, msgTypeURL string) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.GetDisableListKey(msgTypeURL))
+}
+
+// IterateDisableList iterates over the disabled msg type URLs.
+func (k Keeper) IterateDisableList(ctx sdk.Context, handler func(msgTypeURL string) (stop bool)) {
+	store := ctx.KVStore(k.storeKey)
+	iter := sdk.KVStorePrefixIterator(store, types.DisableListPrefix)
+	defer iter.Close()
+	for ; iter.Valid(); iter.Next() {
+		if handler(string(iter.Key()[len(types.DisableListPrefix):])) {
+			break
+		}
+	}
+}
+
+// GetDisableList returns all the disabled msg type URLs.
+func (k Keeper) GetDisableList(ctx sdk.Context) []string {
+	urls := []string{}
+	k.IterateDisableList(ctx, func(msgTypeURL string) bool {
+		urls = append(urls, msgTypeURL)
+		return false
+	})
+	return urls
+}
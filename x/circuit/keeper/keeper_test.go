@@ -0,0 +1,145 @@
+package keeper_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+
+	"github.com/cosmos/cosmos-sdk/simapp"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	"github.com/cosmos/cosmos-sdk/x/circuit/keeper"
+	"github.com/cosmos/cosmos-sdk/x/circuit/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+)
+
+var (
+	msgSendURL      = sdk.MsgTypeURL(&banktypes.MsgSend{})
+	msgMultiSendURL = sdk.MsgTypeURL(&banktypes.MsgMultiSend{})
+)
+
+type KeeperTestSuite struct {
+	suite.Suite
+
+	app       *simapp.SimApp
+	ctx       sdk.Context
+	msgServer types.MsgServer
+	authority sdk.AccAddress
+	addrs     []sdk.AccAddress
+}
+
+func (s *KeeperTestSuite) SetupTest() {
+	s.app = simapp.Setup(s.T(), false)
+	s.ctx = s.app.BaseApp.NewContext(false, tmproto.Header{})
+	s.msgServer = keeper.NewMsgServerImpl(s.app.CircuitKeeper)
+	s.authority = authtypes.NewModuleAddress(govtypes.ModuleName)
+	s.addrs = simapp.AddTestAddrs(s.app, s.ctx, 3, sdk.NewInt(1000))
+}
+
+func TestKeeperTestSuite(t *testing.T) {
+	suite.Run(t, new(KeeperTestSuite))
+}
+
+func (s *KeeperTestSuite) authorize(grantee sdk.AccAddress, level types.Permissions_Level, urls ...string) {
+	perms := types.NewPermissions(level, urls)
+	_, err := s.msgServer.AuthorizeCircuitBreaker(sdk.WrapSDKContext(s.ctx),
+		types.NewMsgAuthorizeCircuitBreaker(s.authority.String(), grantee.String(), &perms))
+	s.Require().NoError(err)
+}
+
+func (s *KeeperTestSuite) TestAuthorizeCircuitBreaker() {
+	superAdmin, allMsgs, grantee := s.addrs[0], s.addrs[1], s.addrs[2]
+
+	s.authorize(superAdmin, types.Permissions_LEVEL_SUPER_ADMIN)
+	s.authorize(allMsgs, types.Permissions_LEVEL_ALL_MSGS)
+	s.Require().Equal(types.Permissions_LEVEL_SUPER_ADMIN, s.app.CircuitKeeper.GetPermissions(s.ctx, superAdmin).Level)
+
+	perms := types.NewPermissions(types.Permissions_LEVEL_SOME_MSGS, []string{msgSendURL})
+
+	// only the authority and super admins can grant permissions
+	_, err := s.msgServer.AuthorizeCircuitBreaker(sdk.WrapSDKContext(s.ctx),
+		types.NewMsgAuthorizeCircuitBreaker(allMsgs.String(), grantee.String(), &perms))
+	s.Require().ErrorIs(err, types.ErrUnauthorized)
+
+	_, err = s.msgServer.AuthorizeCircuitBreaker(sdk.WrapSDKContext(s.ctx),
+		types.NewMsgAuthorizeCircuitBreaker(superAdmin.String(), grantee.String(), &perms))
+	s.Require().NoError(err)
+	s.Require().Equal(perms, s.app.CircuitKeeper.GetPermissions(s.ctx, grantee))
+
+	// granting no permissions revokes them
+	s.authorize(grantee, types.Permissions_LEVEL_NONE_UNSPECIFIED)
+	s.Require().Equal(types.Permissions{}, s.app.CircuitKeeper.GetPermissions(s.ctx, grantee))
+}
+
+func (s *KeeperTestSuite) TestTripAndResetCircuitBreaker() {
+	someMsgs, other := s.addrs[0], s.addrs[1]
+	s.authorize(someMsgs, types.Permissions_LEVEL_SOME_MSGS, msgSendURL)
+
+	ctx := sdk.WrapSDKContext(s.ctx)
+
+	// accounts without permissions cannot trip the circuit breaker
+	_, err := s.msgServer.TripCircuitBreaker(ctx, types.NewMsgTripCircuitBreaker(other.String(), []string{msgSendURL}))
+	s.Require().ErrorIs(err, types.ErrUnauthorized)
+
+	// SOME_MSGS accounts are limited to their msg types
+	_, err = s.msgServer.TripCircuitBreaker(ctx, types.NewMsgTripCircuitBreaker(someMsgs.String(), []string{msgMultiSendURL}))
+	s.Require().ErrorIs(err, types.ErrUnauthorized)
+
+	_, err = s.msgServer.TripCircuitBreaker(ctx, types.NewMsgTripCircuitBreaker(someMsgs.String(), []string{msgSendURL}))
+	s.Require().NoError(err)
+
+	allowed, err := s.app.CircuitKeeper.IsAllowed(ctx, msgSendURL)
+	s.Require().NoError(err)
+	s.Require().False(allowed)
+	allowed, err = s.app.CircuitKeeper.IsAllowed(ctx, msgMultiSendURL)
+	s.Require().NoError(err)
+	s.Require().True(allowed)
+
+	_, err = s.msgServer.TripCircuitBreaker(ctx, types.NewMsgTripCircuitBreaker(s.authority.String(), []string{msgSendURL}))
+	s.Require().ErrorIs(err, types.ErrAlreadyDisabled)
+
+	// the circuit breaker cannot disable its own msgs
+	_, err = s.msgServer.TripCircuitBreaker(ctx, types.NewMsgTripCircuitBreaker(s.authority.String(),
+		[]string{sdk.MsgTypeURL(&types.MsgResetCircuitBreaker{})}))
+	s.Require().ErrorIs(err, types.ErrInvalidMsgTypeURL)
+
+	s.Require().Equal([]string{msgSendURL}, s.app.CircuitKeeper.GetDisableList(s.ctx))
+
+	_, err = s.msgServer.ResetCircuitBreaker(ctx, types.NewMsgResetCircuitBreaker(other.String(), []string{msgSendURL}))
+	s.Require().ErrorIs(err, types.ErrUnauthorized)
+
+	_, err = s.msgServer.ResetCircuitBreaker(ctx, types.NewMsgResetCircuitBreaker(someMsgs.String(), []string{msgSendURL}))
+	s.Require().NoError(err)
+	s.Require().False(s.app.CircuitKeeper.IsMsgDisabled(s.ctx, msgSendURL))
+
+	_, err = s.msgServer.ResetCircuitBreaker(ctx, types.NewMsgResetCircuitBreaker(someMsgs.String(), []string{msgSendURL}))
+	s.Require().ErrorIs(err, types.ErrNotDisabled)
+}
+
+func (s *KeeperTestSuite) TestDisabledMsgIsRejected() {
+	from, to := s.addrs[0], s.addrs[1]
+	s.app.CircuitKeeper.DisableMsg(s.ctx, msgSendURL)
+
+	msg := banktypes.NewMsgSend(from, to, sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 1)))
+	handler := s.app.MsgServiceRouter().Handler(msg)
+	_, err := handler(s.ctx, msg)
+	s.Require().ErrorContains(err, "circuit breaker disables execution of this message")
+
+	s.app.CircuitKeeper.EnableMsg(s.ctx, msgSendURL)
+	_, err = handler(s.ctx, msg)
+	s.Require().NoError(err)
+}
+
+func (s *KeeperTestSuite) TestGenesis() {
+	perms := types.NewPermissions(types.Permissions_LEVEL_SOME_MSGS, []string{msgSendURL})
+	genState := types.NewGenesisState(
+		[]*types.GenesisAccountPermissions{{Address: s.addrs[0].String(), Permissions: &perms}},
+		[]string{msgMultiSendURL},
+	)
+	s.Require().NoError(genState.Validate())
+
+	s.app.CircuitKeeper.InitGenesis(s.ctx, genState)
+	s.Require().Equal(genState, s.app.CircuitKeeper.ExportGenesis(s.ctx))
+}
@@ -0,0 +1,152 @@
+package keeper
+
+import (
+	"context"
+	"strings"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/circuit/types"
+)
+
+type msgServer struct {
+	Keeper
+}
+
+// NewMsgServerImpl returns an implementation of the circuit MsgServer interface
+// for the provided Keeper.
+func NewMsgServerImpl(k Keeper) types.MsgServer {
+	return &msgServer{
+		Keeper: k,
+	}
+}
+
+var _ types.MsgServer = msgServer{}
+
+// AuthorizeCircuitBreaker implements the Msg/AuthorizeCircuitBreaker Msg service.
+func (k msgServer) AuthorizeCircuitBreaker(goCtx context.Context, msg *types.MsgAuthorizeCircuitBreaker) (*types.MsgAuthorizeCircuitBreakerResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	granter, err := sdk.AccAddressFromBech32(msg.Granter)
+	if err != nil {
+		return nil, err
+	}
+
+	grantee, err := sdk.AccAddressFromBech32(msg.Grantee)
+	if err != nil {
+		return nil, err
+	}
+
+	// only the authority and super admins can grant permissions
+	if msg.Granter != k.authority && k.GetPermissions(ctx, granter).Level != types.Permissions_LEVEL_SUPER_ADMIN {
+		return nil, types.ErrUnauthorized.Wrapf("%s is not a super admin", msg.Granter)
+	}
+
+	k.SetPermissions(ctx, grantee, *msg.Permissions)
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeAuthorizeCircuitBreaker,
+			sdk.NewAttribute(types.AttributeKeyGranter, msg.Granter),
+			sdk.NewAttribute(types.AttributeKeyGrantee, msg.Grantee),
+			sdk.NewAttribute(types.AttributeKeyPermission, msg.Permissions.Level.String()),
+		),
+	})
+
+	return &types.MsgAuthorizeCircuitBreakerResponse{}, nil
+}
+
+// TripCircuitBreaker implements the Msg/TripCircuitBreaker Msg service.
+func (k msgServer) TripCircuitBreaker(goCtx context.Context, msg *types.MsgTripCircuitBreaker) (*types.MsgTripCircuitBreakerResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if err := k.validateTripper(ctx, msg.Authority, msg.MsgTypeUrls); err != nil {
+		return nil, err
+	}
+
+	for _, url := range msg.MsgTypeUrls {
+		// the circuit breaker must not be able to lock itself out
+		if isCircuitMsg(url) {
+			return nil, types.ErrInvalidMsgTypeURL.Wrapf("cannot disable %s", url)
+		}
+		if k.IsMsgDisabled(ctx, url) {
+			return nil, types.ErrAlreadyDisabled.Wrap(url)
+		}
+		k.DisableMsg(ctx, url)
+
+		ctx.EventManager().EmitEvent(
+			sdk.NewEvent(
+				types.EventTypeTripCircuitBreaker,
+				sdk.NewAttribute(types.AttributeKeyMsgTypeURL, url),
+			),
+		)
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(sdk.AttributeKeySender, msg.Authority),
+		),
+	)
+
+	return &types.MsgTripCircuitBreakerResponse{}, nil
+}
+
+// ResetCircuitBreaker implements the Msg/ResetCircuitBreaker Msg service.
+func (k msgServer) ResetCircuitBreaker(goCtx context.Context, msg *types.MsgResetCircuitBreaker) (*types.MsgResetCircuitBreakerResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if err := k.validateTripper(ctx, msg.Authority, msg.MsgTypeUrls); err != nil {
+		return nil, err
+	}
+
+	for _, url := range msg.MsgTypeUrls {
+		if !k.IsMsgDisabled(ctx, url) {
+			return nil, types.ErrNotDisabled.Wrap(url)
+		}
+		k.EnableMsg(ctx, url)
+
+		ctx.EventManager().EmitEvent(
+			sdk.NewEvent(
+				types.EventTypeResetCircuitBreaker,
+				sdk.NewAttribute(types.AttributeKeyMsgTypeURL, url),
+			),
+		)
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(sdk.AttributeKeySender, msg.Authority),
+		),
+	)
+
+	return &types.MsgResetCircuitBreakerResponse{}, nil
+}
+
+// validateTripper checks that the account is allowed to trip and reset the
+// circuit breaker for all the given msg type URLs.
+func (k Keeper) validateTripper(ctx sdk.Context, account string, msgTypeURLs []string) error {
+	if account == k.authority {
+		return nil
+	}
+
+	addr, err := sdk.AccAddressFromBech32(account)
+	if err != nil {
+		return err
+	}
+
+	perms := k.GetPermissions(ctx, addr)
+	for _, url := range msgTypeURLs {
+		if !perms.CanTrip(url) {
+			return types.ErrUnauthorized.Wrapf("%s cannot trip or reset %s", account, url)
+		}
+	}
+	return nil
+}
+
+// isCircuitMsg returns true if the msg type URL belongs to the circuit module.
+func isCircuitMsg(msgTypeURL string) bool {
+	return strings.HasPrefix(msgTypeURL, "/cosmos.circuit.")
+}
@@ -0,0 +1,14 @@
+package types
+
+import (
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// x/circuit module sentinel errors
+var (
+	ErrInvalidPermissions = sdkerrors.Register(ModuleName, 2, "invalid circuit breaker permissions")
+	ErrUnauthorized       = sdkerrors.Register(ModuleName, 3, "account is not authorized for this circuit breaker action")
+	ErrAlreadyDisabled    = sdkerrors.Register(ModuleName, 4, "message is already disabled")
+	ErrNotDisabled        = sdkerrors.Register(ModuleName, 5, "message is not disabled")
+	ErrInvalidMsgTypeURL  = sdkerrors.Register(ModuleName, 6, "invalid msg type url")
+)
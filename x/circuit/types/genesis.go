@@ -0,0 +1,44 @@
+package types
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// NewGenesisState creates a new GenesisState object
+func NewGenesisState(accountPermissions []*GenesisAccountPermissions, disabledTypeURLs []string) *GenesisState {
+	return &GenesisState{
+		AccountPermissions: accountPermissions,
+		DisabledTypeUrls:   disabledTypeURLs,
+	}
+}
+
+// DefaultGenesisState returns a default circuit module genesis state.
+func DefaultGenesisState() *GenesisState {
+	return &GenesisState{}
+}
+
+// Validate performs basic genesis state validation returning an error upon any
+// failure.
+func (gs GenesisState) Validate() error {
+	seen := make(map[string]bool, len(gs.AccountPermissions))
+	for _, ap := range gs.AccountPermissions {
+		if _, err := sdk.AccAddressFromBech32(ap.Address); err != nil {
+			return fmt.Errorf("invalid account address %s: %w", ap.Address, err)
+		}
+		if seen[ap.Address] {
+			return fmt.Errorf("duplicate permissions for account %s", ap.Address)
+		}
+		seen[ap.Address] = true
+
+		if ap.Permissions == nil {
+			return fmt.Errorf("missing permissions for account %s", ap.Address)
+		}
+		if err := ap.Permissions.Validate(); err != nil {
+			return fmt.Errorf("invalid permissions for account %s: %w", ap.Address, err)
+		}
+	}
+
+	return validateMsgTypeURLs(gs.DisabledTypeUrls)
+}
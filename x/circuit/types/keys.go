@@ -0,0 +1,33 @@
+package types
+
+import (
+	"github.com/cosmos/cosmos-sdk/types/address"
+)
+
+const (
+	// ModuleName is the name of this module
+	ModuleName = "circuit"
+
+	// StoreKey is the prefix under which we store this module's data
+	StoreKey = ModuleName
+
+	// RouterKey is the message route for the circuit module
+	RouterKey = ModuleName
+)
+
+// KVStore keys
+var (
+	AccountPermissionPrefix = []byte{0x01} // prefix for the circuit breaker permissions of an account
+	DisableListPrefix       = []byte{0x02} // prefix for the disabled msg type URLs
+)
+
+// GetAccountPermissionKey creates the key for the permissions of an account.
+func GetAccountPermissionKey(addr []byte) []byte {
+	return append(AccountPermissionPrefix, address.MustLengthPrefix(addr)...)
+}
+
+// GetDisableListKey creates the key under which a disabled msg type URL is
+// stored.
+func GetDisableListKey(msgTypeURL string) []byte {
+	return append(DisableListPrefix, []byte(msgTypeURL)...)
+}
@@ -0,0 +1,104 @@
+package types_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/circuit/types"
+)
+
+var (
+	addr1 = sdk.AccAddress("addr1_______________").String()
+	addr2 = sdk.AccAddress("addr2_______________").String()
+	url   = "/cosmos.bank.v1beta1.MsgSend"
+)
+
+func TestPermissionsValidate(t *testing.T) {
+	tests := []struct {
+		name   string
+		perms  types.Permissions
+		expErr bool
+	}{
+		{"super admin", types.NewPermissions(types.Permissions_LEVEL_SUPER_ADMIN, nil), false},
+		{"all msgs", types.NewPermissions(types.Permissions_LEVEL_ALL_MSGS, nil), false},
+		{"some msgs", types.NewPermissions(types.Permissions_LEVEL_SOME_MSGS, []string{url}), false},
+		{"some msgs without urls", types.NewPermissions(types.Permissions_LEVEL_SOME_MSGS, nil), true},
+		{"all msgs with urls", types.NewPermissions(types.Permissions_LEVEL_ALL_MSGS, []string{url}), true},
+		{"invalid url", types.NewPermissions(types.Permissions_LEVEL_SOME_MSGS, []string{"MsgSend"}), true},
+		{"duplicate url", types.NewPermissions(types.Permissions_LEVEL_SOME_MSGS, []string{url, url}), true},
+		{"unknown level", types.NewPermissions(types.Permissions_Level(42), nil), true},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.perms.Validate()
+			if tc.expErr {
+				require.Error(t, err)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestMsgAuthorizeCircuitBreaker(t *testing.T) {
+	perms := types.NewPermissions(types.Permissions_LEVEL_ALL_MSGS, nil)
+	badPerms := types.NewPermissions(types.Permissions_LEVEL_SOME_MSGS, nil)
+
+	tests := []struct {
+		name   string
+		msg    *types.MsgAuthorizeCircuitBreaker
+		expErr bool
+	}{
+		{"valid", types.NewMsgAuthorizeCircuitBreaker(addr1, addr2, &perms), false},
+		{"invalid granter", types.NewMsgAuthorizeCircuitBreaker("", addr2, &perms), true},
+		{"invalid grantee", types.NewMsgAuthorizeCircuitBreaker(addr1, "", &perms), true},
+		{"nil permissions", types.NewMsgAuthorizeCircuitBreaker(addr1, addr2, nil), true},
+		{"invalid permissions", types.NewMsgAuthorizeCircuitBreaker(addr1, addr2, &badPerms), true},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.msg.ValidateBasic()
+			if tc.expErr {
+				require.Error(t, err)
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, []sdk.AccAddress{sdk.MustAccAddressFromBech32(addr1)}, tc.msg.GetSigners())
+			}
+		})
+	}
+}
+
+func TestMsgTripAndResetCircuitBreaker(t *testing.T) {
+	tests := []struct {
+		name   string
+		addr   string
+		urls   []string
+		expErr bool
+	}{
+		{"valid", addr1, []string{url}, false},
+		{"invalid authority", "", []string{url}, true},
+		{"no urls", addr1, nil, true},
+		{"invalid url", addr1, []string{""}, true},
+		{"duplicate url", addr1, []string{url, url}, true},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			for _, msg := range []sdk.Msg{
+				types.NewMsgTripCircuitBreaker(tc.addr, tc.urls),
+				types.NewMsgResetCircuitBreaker(tc.addr, tc.urls),
+			} {
+				err := msg.ValidateBasic()
+				if tc.expErr {
+					require.Error(t, err)
+				} else {
+					require.NoError(t, err)
+				}
+			}
+		})
+	}
+}
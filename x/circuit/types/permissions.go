@@ -0,0 +1,65 @@
+package types
+
+import (
+	"fmt"
+)
+
+// NewPermissions creates a new Permissions instance
+func NewPermissions(level Permissions_Level, limitTypeURLs []string) Permissions {
+	return Permissions{
+		Level:         level,
+		LimitTypeUrls: limitTypeURLs,
+	}
+}
+
+// Validate checks that the permission level is known and that the limit type
+// URLs are only, and always, set with LEVEL_SOME_MSGS.
+func (p Permissions) Validate() error {
+	if _, ok := Permissions_Level_name[int32(p.Level)]; !ok {
+		return fmt.Errorf("unknown permission level %d", p.Level)
+	}
+
+	if p.Level != Permissions_LEVEL_SOME_MSGS {
+		if len(p.LimitTypeUrls) > 0 {
+			return fmt.Errorf("limit type urls can only be set with %s", Permissions_LEVEL_SOME_MSGS)
+		}
+		return nil
+	}
+
+	if len(p.LimitTypeUrls) == 0 {
+		return fmt.Errorf("limit type urls must be set with %s", Permissions_LEVEL_SOME_MSGS)
+	}
+	return validateMsgTypeURLs(p.LimitTypeUrls)
+}
+
+// CanTrip returns true if the permissions allow to trip or reset the circuit
+// breaker for the given msg type URL.
+func (p Permissions) CanTrip(msgTypeURL string) bool {
+	switch p.Level {
+	case Permissions_LEVEL_SUPER_ADMIN, Permissions_LEVEL_ALL_MSGS:
+		return true
+	case Permissions_LEVEL_SOME_MSGS:
+		for _, url := range p.LimitTypeUrls {
+			if url == msgTypeURL {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// validateMsgTypeURLs checks that the msg type URLs are non-empty, start with
+// a slash and are unique.
+func validateMsgTypeURLs(msgTypeURLs []string) error {
+	seen := make(map[string]bool, len(msgTypeURLs))
+	for _, url := range msgTypeURLs {
+		if len(url) < 2 || url[0] != '/' {
+			return ErrInvalidMsgTypeURL.Wrapf("%q", url)
+		}
+		if seen[url] {
+			return ErrInvalidMsgTypeURL.Wrapf("duplicate %s", url)
+		}
+		seen[url] = true
+	}
+	return nil
+}
@@ -25,6 +25,10 @@ func BeginBlocker(ctx sdk.Context, req abci.RequestBeginBlock, k keeper.Keeper)
 		}
 	}
 
+	// apply the scheduled ratio changes before the fees of the previous block
+	// are distributed
+	k.ApplyRatioChanges(ctx)
+
 	// TODO this is Tendermint-dependent
 	// ref https://github.com/cosmos/cosmos-sdk/issues/3095
 	if ctx.BlockHeight() > 1 {
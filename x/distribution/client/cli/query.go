@@ -33,8 +33,15 @@ func GetQueryCmd() *cobra.Command {
 		GetCmdQueryDelegatorRewards(),
 		GetCmdQueryCommunityPool(),
 		GetCmdQueryRatio(),
+		GetCmdQueryPendingRatioChanges(),
+		GetCmdQueryRatioHistory(),
 		GetCmdQueryBaseAddress(),
+		GetCmdQueryBaseRecipients(),
 		GetCmdQueryModerator(),
+		GetCmdQueryPendingModerator(),
+		GetCmdQueryFeeSplitTotals(),
+		GetCmdQueryFeeSplitSnapshots(),
+		GetCmdQueryFeeSplitSnapshot(),
 	)
 
 	return distQueryCmd
@@ -375,7 +382,8 @@ func GetCmdQueryRatio() *cobra.Command {
 		Args:  cobra.NoArgs,
 		Short: "Query the ratio for tx fee distribution",
 		Long: strings.TrimSpace(
-			fmt.Sprintf(`Query the fee distribution ratio info.
+			fmt.Sprintf(`Query the default fee distribution ratio and the ratios overriding it for
+specific denoms.
 
 Example:
 $ %s query distribution ratio
@@ -403,6 +411,88 @@ $ %s query distribution ratio
 	return cmd
 }
 
+// GetCmdQueryPendingRatioChanges returns the command for fetching the scheduled distribution ratio changes.
+func GetCmdQueryPendingRatioChanges() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "pending-ratio-changes",
+		Args:  cobra.NoArgs,
+		Short: "Query the scheduled tx fee distribution ratio changes",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query the fee distribution ratio changes that have not been applied yet.
+
+Example:
+$ %s query distribution pending-ratio-changes
+`,
+				version.AppName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			res, err := queryClient.PendingRatioChanges(cmd.Context(), &types.QueryPendingRatioChangesRequest{Pagination: pageReq})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "pending ratio changes")
+	return cmd
+}
+
+// GetCmdQueryRatioHistory returns the command for fetching the history of distribution ratios.
+func GetCmdQueryRatioHistory() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "ratio-history",
+		Args:  cobra.NoArgs,
+		Short: "Query the history of tx fee distribution ratios",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query the fee distribution ratios that became effective, ordered by height.
+
+Example:
+$ %s query distribution ratio-history
+`,
+				version.AppName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			res, err := queryClient.RatioHistory(cmd.Context(), &types.QueryRatioHistoryRequest{Pagination: pageReq})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "ratio history")
+	return cmd
+}
+
 // GetCmdQueryBaseAddress returns the command for fetching distribution ratio info.
 func GetCmdQueryBaseAddress() *cobra.Command {
 	cmd := &cobra.Command{
@@ -438,6 +528,41 @@ $ %s query distribution base-address
 	return cmd
 }
 
+// GetCmdQueryBaseRecipients returns the command for fetching the weighted list of base recipients.
+func GetCmdQueryBaseRecipients() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "base-recipients",
+		Args:  cobra.NoArgs,
+		Short: "Query the weighted list of base fee recipients",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query the accounts sharing the base part of the fees and their weights.
+
+Example:
+$ %s query distribution base-recipients
+`,
+				version.AppName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.BaseRecipients(cmd.Context(), &types.QueryBaseRecipientsRequest{})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
 // GetCmdQueryModerator returns the command for fetching distribution ratio info.
 func GetCmdQueryModerator() *cobra.Command {
 	cmd := &cobra.Command{
@@ -472,3 +597,155 @@ $ %s query distribution moderator-address
 	flags.AddQueryFlagsToCmd(cmd)
 	return cmd
 }
+
+// GetCmdQueryPendingModerator returns the command for fetching the pending moderator.
+func GetCmdQueryPendingModerator() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "pending-moderator",
+		Args:  cobra.NoArgs,
+		Short: "Query the moderator waiting to accept the moderator role",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query the moderator waiting to accept the moderator role.
+
+Example:
+$ %s query distribution pending-moderator
+`,
+				version.AppName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.PendingModerator(cmd.Context(), &types.QueryPendingModeratorRequest{})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+// GetCmdQueryFeeSplitTotals returns the command for fetching the cumulative fee split.
+func GetCmdQueryFeeSplitTotals() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "fee-split-totals",
+		Args:  cobra.NoArgs,
+		Short: "Query the cumulative amounts burned, sent to the base recipients and left for staking rewards",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query the cumulative amounts of fees burned, sent to the base recipients and
+left for staking rewards, per denom.
+
+Example:
+$ %s query distribution fee-split-totals
+`,
+				version.AppName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.FeeSplitTotals(cmd.Context(), &types.QueryFeeSplitTotalsRequest{})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+// GetCmdQueryFeeSplitSnapshots returns the command for fetching the fee split snapshots.
+func GetCmdQueryFeeSplitSnapshots() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "fee-split-snapshots",
+		Args:  cobra.NoArgs,
+		Short: "Query the fee splits of the blocks within the retention window",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query the fee splits of the blocks within the retention window, ordered by height.
+
+Example:
+$ %s query distribution fee-split-snapshots
+`,
+				version.AppName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			res, err := queryClient.FeeSplitSnapshots(cmd.Context(), &types.QueryFeeSplitSnapshotsRequest{Pagination: pageReq})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "fee split snapshots")
+	return cmd
+}
+
+// GetCmdQueryFeeSplitSnapshot returns the command for fetching the fee split of a block.
+func GetCmdQueryFeeSplitSnapshot() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "fee-split-snapshot [height]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Query the fee split of a block",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query the fee split of a block within the retention window.
+
+Example:
+$ %s query distribution fee-split-snapshot 100
+`,
+				version.AppName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			height, err := strconv.ParseInt(args[0], 10, 64)
+			if err != nil {
+				return fmt.Errorf("height %s not a valid int, please input a valid height", args[0])
+			}
+
+			res, err := queryClient.FeeSplitSnapshot(cmd.Context(), &types.QueryFeeSplitSnapshotRequest{Height: height})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
@@ -2,7 +2,9 @@ package cli
 
 import (
 	"fmt"
+	"strconv"
 	"strings"
+	"time"
 
 	"github.com/spf13/cobra"
 	"github.com/spf13/pflag"
@@ -20,6 +22,8 @@ import (
 var (
 	FlagCommission       = "commission"
 	FlagMaxMessagesPerTx = "max-msgs"
+	FlagActivationHeight = "activation-height"
+	FlagActivationTime   = "activation-time"
 )
 
 const (
@@ -41,9 +45,16 @@ func NewTxCmd() *cobra.Command {
 		NewWithdrawAllRewardsCmd(),
 		NewSetWithdrawAddrCmd(),
 		NewFundCommunityPoolCmd(),
+		NewWithdrawShareRecordRewardsCmd(),
 		NewChangeRatioCmd(),
+		NewCancelRatioChangeCmd(),
 		NewChangeBaseAddressCmd(),
+		NewSetBaseRecipientsCmd(),
 		NewChangeModeratorCmd(),
+		NewAcceptModeratorCmd(),
+		NewSetFeeSplitRetentionCmd(),
+		NewSetDenomRatioCmd(),
+		NewRemoveDenomRatioCmd(),
 	)
 
 	return distTxCmd
@@ -263,6 +274,38 @@ $ %s tx distribution fund-community-pool 100uatom --from mykey
 	return cmd
 }
 
+// NewWithdrawShareRecordRewardsCmd returns a CLI command handler for creating a MsgWithdrawShareRecordReward transaction.
+func NewWithdrawShareRecordRewardsCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "withdraw-share-record-rewards",
+		Args:  cobra.NoArgs,
+		Short: "Withdraw the rewards of the tokenize share records owned",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Withdraw the rewards of the delegations of all the tokenize share records owned by the sender.
+
+Example:
+$ %s tx distribution withdraw-share-record-rewards --from mykey
+`,
+				version.AppName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgWithdrawShareRecordReward(clientCtx.GetFromAddress())
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
 // GetCmdSubmitProposal implements the command to submit a community-pool-spend proposal
 func GetCmdSubmitProposal() *cobra.Command {
 	bech32PrefixAccAddr := sdk.GetConfig().GetBech32AccountAddrPrefix()
@@ -335,14 +378,17 @@ func NewChangeRatioCmd() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:   "change-ratio [staking_rewards] [base] [burn]",
 		Args:  cobra.ExactArgs(3),
-		Short: "Changes the values for fee distribution ratio",
+		Short: "Schedules new values for fee distribution ratio",
 		Long: strings.TrimSpace(
-			fmt.Sprintf(`Changes the values for fee distribution ratio
+			fmt.Sprintf(`Schedules new values for fee distribution ratio. The ratio is applied at the
+given activation height or time (RFC3339). If neither is given, it is applied
+at the beginning of the next block.
 
 Example:
-$ %s tx distribution change-ratio 0.333333333333333334 0.333333333333333333 0.333333333333333333 --from [moderator_address]
+$ %s tx distribution change-ratio 0.333333333333333334 0.333333333333333333 0.333333333333333333 --activation-height 120000 --from [moderator_address]
+$ %s tx distribution change-ratio 0.5 0.25 0.25 --activation-time 2023-06-01T00:00:00Z --from [moderator_address]
 `,
-				version.AppName,
+				version.AppName, version.AppName,
 			),
 		),
 		RunE: func(cmd *cobra.Command, args []string) error {
@@ -362,6 +408,62 @@ $ %s tx distribution change-ratio 0.333333333333333334 0.333333333333333333 0.33
 					Burn:           sdk.MustNewDecFromStr(burn),
 				})
 
+			msg.ActivationHeight, err = cmd.Flags().GetInt64(FlagActivationHeight)
+			if err != nil {
+				return err
+			}
+
+			activationTime, err := cmd.Flags().GetString(FlagActivationTime)
+			if err != nil {
+				return err
+			}
+			if activationTime != "" {
+				msg.ActivationTime, err = time.Parse(time.RFC3339, activationTime)
+				if err != nil {
+					return fmt.Errorf("invalid activation time: %w", err)
+				}
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	cmd.Flags().Int64(FlagActivationHeight, 0, "Block height from which the new ratio is applied")
+	cmd.Flags().String(FlagActivationTime, "", "Block time (RFC3339) from which the new ratio is applied")
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// NewCancelRatioChangeCmd returns a CLI command handler for creating a MsgCancelRatioChange transaction.
+func NewCancelRatioChangeCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "cancel-ratio-change [change_id]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Cancels a pending fee distribution ratio change",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Cancels a pending fee distribution ratio change
+
+Example:
+$ %s tx distribution cancel-ratio-change 1 --from [moderator_address]
+`,
+				version.AppName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+			moderatorAddr := clientCtx.GetFromAddress()
+
+			changeID, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return fmt.Errorf("change id %s not a valid uint, please input a valid change id", args[0])
+			}
+
+			msg := types.NewMsgCancelRatioChange(moderatorAddr, changeID)
+
 			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
 		},
 	}
@@ -405,14 +507,54 @@ $ %s tx distribution change-base-address usdx1... --from [moderator_address]
 	return cmd
 }
 
+// NewSetBaseRecipientsCmd returns a CLI command handler for creating a MsgSetBaseRecipients transaction.
+func NewSetBaseRecipientsCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "set-base-recipients [address:weight],[address:weight],...",
+		Args:  cobra.ExactArgs(1),
+		Short: "Sets the weighted list of base fee recipients",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Sets the weighted list of accounts sharing the base part of the fees.
+The weights must sum up to 1.
+
+Example:
+$ %s tx distribution set-base-recipients usdx1...:0.5,usdx1...:0.3,usdx1...:0.2 --from [moderator_address]
+`,
+				version.AppName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+			moderatorAddr := clientCtx.GetFromAddress()
+
+			recipients, err := parseBaseRecipients(args[0])
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgSetBaseRecipients(moderatorAddr, recipients)
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
 // NewChangeModerator returns a CLI command handler for creating a MsgChangeModerator transaction.
 func NewChangeModeratorCmd() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:   "change-moderator [new_moderator_address]",
 		Args:  cobra.ExactArgs(1),
-		Short: "Changes the values for the moderator address",
+		Short: "Proposes a new moderator address",
 		Long: strings.TrimSpace(
-			fmt.Sprintf(`Changes the values for the moderator address
+			fmt.Sprintf(`Proposes a new moderator address. The new moderator only takes over once
+it accepts the role with the accept-moderator command.
 
 Example:
 $ %s tx distribution change-moderator usdx1... --from [moderator_address]
@@ -438,3 +580,191 @@ $ %s tx distribution change-moderator usdx1... --from [moderator_address]
 
 	return cmd
 }
+
+// NewAcceptModeratorCmd returns a CLI command handler for creating a MsgAcceptModerator transaction.
+func NewAcceptModeratorCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "accept-moderator",
+		Args:  cobra.NoArgs,
+		Short: "Accepts the moderator role proposed by the current moderator",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Accepts the moderator role proposed by the current moderator
+
+Example:
+$ %s tx distribution accept-moderator --from [pending_moderator_address]
+`,
+				version.AppName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgAcceptModerator(clientCtx.GetFromAddress())
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// NewSetFeeSplitRetentionCmd returns a CLI command handler for creating a MsgSetFeeSplitRetention transaction.
+func NewSetFeeSplitRetentionCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "set-fee-split-retention [retention_blocks]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Sets the number of blocks for which fee split snapshots are kept",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Sets the number of blocks for which fee split snapshots are kept. Zero
+disables the snapshots, the cumulative totals are always kept.
+
+Example:
+$ %s tx distribution set-fee-split-retention 10000 --from [moderator_address]
+`,
+				version.AppName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+			moderatorAddr := clientCtx.GetFromAddress()
+
+			retentionBlocks, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return fmt.Errorf("retention blocks %s not a valid uint, please input a valid number of blocks", args[0])
+			}
+
+			msg := types.NewMsgSetFeeSplitRetention(moderatorAddr, retentionBlocks)
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// NewSetDenomRatioCmd returns a CLI command handler for creating a MsgSetDenomRatio transaction.
+func NewSetDenomRatioCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "set-denom-ratio [denom] [staking_rewards] [base] [burn]",
+		Args:  cobra.ExactArgs(4),
+		Short: "Schedules a fee distribution ratio override for a denom",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Schedules a fee distribution ratio override for the fees collected in a denom.
+The fees of denoms without an override are split by the default ratio. The
+override is applied at the given activation height or time (RFC3339). If
+neither is given, it is applied at the beginning of the next block.
+
+Example:
+$ %s tx distribution set-denom-ratio ibc/27394FB092D2ECCD56123C74F36E4C1F926001CEADA9CA97EA622B25F41E5EB2 0.5 0.5 0 --from [moderator_address]
+$ %s tx distribution set-denom-ratio ibc/27394FB092D2ECCD56123C74F36E4C1F926001CEADA9CA97EA622B25F41E5EB2 0.5 0.5 0 --activation-height 120000 --from [moderator_address]
+`,
+				version.AppName, version.AppName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+			moderatorAddr := clientCtx.GetFromAddress()
+			denom := args[0]
+
+			ratio := types.Ratio{}
+			for i, field := range []*sdk.Dec{&ratio.StakingRewards, &ratio.Base, &ratio.Burn} {
+				*field, err = sdk.NewDecFromStr(args[i+1])
+				if err != nil {
+					return fmt.Errorf("invalid ratio %s: %w", args[i+1], err)
+				}
+			}
+
+			msg := types.NewMsgSetDenomRatio(moderatorAddr, denom, ratio)
+
+			msg.ActivationHeight, err = cmd.Flags().GetInt64(FlagActivationHeight)
+			if err != nil {
+				return err
+			}
+
+			activationTime, err := cmd.Flags().GetString(FlagActivationTime)
+			if err != nil {
+				return err
+			}
+			if activationTime != "" {
+				msg.ActivationTime, err = time.Parse(time.RFC3339, activationTime)
+				if err != nil {
+					return fmt.Errorf("invalid activation time: %w", err)
+				}
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	cmd.Flags().Int64(FlagActivationHeight, 0, "Block height from which the ratio override is applied")
+	cmd.Flags().String(FlagActivationTime, "", "Block time (RFC3339) from which the ratio override is applied")
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// NewRemoveDenomRatioCmd returns a CLI command handler for creating a MsgRemoveDenomRatio transaction.
+func NewRemoveDenomRatioCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "remove-denom-ratio [denom]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Schedules the removal of the fee distribution ratio override of a denom",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Schedules the removal of the fee distribution ratio override of a denom, so
+that its fees are split by the default ratio again. The override is removed at
+the given activation height or time (RFC3339). If neither is given, it is
+removed at the beginning of the next block.
+
+Example:
+$ %s tx distribution remove-denom-ratio ibc/27394FB092D2ECCD56123C74F36E4C1F926001CEADA9CA97EA622B25F41E5EB2 --from [moderator_address]
+`,
+				version.AppName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgRemoveDenomRatio(clientCtx.GetFromAddress(), args[0])
+
+			msg.ActivationHeight, err = cmd.Flags().GetInt64(FlagActivationHeight)
+			if err != nil {
+				return err
+			}
+
+			activationTime, err := cmd.Flags().GetString(FlagActivationTime)
+			if err != nil {
+				return err
+			}
+			if activationTime != "" {
+				msg.ActivationTime, err = time.Parse(time.RFC3339, activationTime)
+				if err != nil {
+					return fmt.Errorf("invalid activation time: %w", err)
+				}
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	cmd.Flags().Int64(FlagActivationHeight, 0, "Block height from which the ratio override is removed")
+	cmd.Flags().String(FlagActivationTime, "", "Block time (RFC3339) from which the ratio override is removed")
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
@@ -1,9 +1,12 @@
 package cli
 
 import (
+	"fmt"
 	"os"
+	"strings"
 
 	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/x/distribution/types"
 )
 
@@ -22,3 +25,28 @@ func ParseCommunityPoolSpendProposalWithDeposit(cdc codec.JSONCodec, proposalFil
 
 	return proposal, nil
 }
+
+// parseBaseRecipients parses a comma separated list of address:weight pairs.
+func parseBaseRecipients(arg string) ([]types.BaseRecipient, error) {
+	var recipients []types.BaseRecipient
+	for _, pair := range strings.Split(arg, ",") {
+		addrWeight := strings.Split(strings.TrimSpace(pair), ":")
+		if len(addrWeight) != 2 {
+			return nil, fmt.Errorf("invalid base recipient %q, expected address:weight", pair)
+		}
+
+		addr, err := sdk.AccAddressFromBech32(addrWeight[0])
+		if err != nil {
+			return nil, err
+		}
+
+		weight, err := sdk.NewDecFromStr(addrWeight[1])
+		if err != nil {
+			return nil, fmt.Errorf("invalid weight for base recipient %s: %w", addrWeight[0], err)
+		}
+
+		recipients = append(recipients, types.NewBaseRecipient(addr, weight))
+	}
+
+	return recipients, nil
+}
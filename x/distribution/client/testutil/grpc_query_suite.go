@@ -519,7 +519,8 @@ func (s *GRPCQueryTestSuite) TestQueryRatioGRPC() {
 			fmt.Sprintf("%s/cosmos/distribution/v1beta1/ratio", baseURL),
 			&types.QueryRatioResponse{},
 			&types.QueryRatioResponse{
-				Ratio: types.DefaultGenesisState().Ratio,
+				Ratio:       types.DefaultGenesisState().Ratio,
+				DenomRatios: []types.DenomRatio{},
 			},
 		},
 	}
@@ -57,7 +57,7 @@ func (s *RatioDistributionTestSuite) SetupSuite() {
 	var distData distrtypes.GenesisState
 	s.Require().NoError(s.cfg.Codec.UnmarshalJSON(genesisState[distrtypes.ModuleName], &distData))
 	distData.ModeratorAddress = distModeratorAddr
-	distData.BaseAddress = distBaseAddr
+	distData.BaseRecipients = []distrtypes.BaseRecipient{{Address: distBaseAddr, Weight: sdk.OneDec()}}
 
 	distDataBz, err := s.cfg.Codec.MarshalJSON(&distData)
 	s.Require().NoError(err)
@@ -159,6 +159,7 @@ func (s *RatioDistributionTestSuite) TestFeeDistribution() {
 			fmt.Sprintf("--%s=true", flags.FlagSkipConfirmation),
 			fmt.Sprintf("--%s=%s", flags.FlagBroadcastMode, flags.BroadcastBlock),
 			fmt.Sprintf("--%s=%s", flags.FlagFees, sdk.NewCoins(sdk.NewCoin(s.cfg.BondDenom, sdk.NewInt(298))).String()),
+			fmt.Sprintf("--%s=200000", flags.FlagGas),
 		}
 		cmd = bankcli.NewSendTxCmd()
 		out, err = clitestutil.ExecTestCLICmd(clientCtx, cmd, argsTx)
@@ -69,7 +69,7 @@ func (s *IntegrationTestSuite) SetupSuite() {
 	var distData distrtypes.GenesisState
 	s.Require().NoError(s.cfg.Codec.UnmarshalJSON(genesisState[distrtypes.ModuleName], &distData))
 	distData.ModeratorAddress = distModeratorAddr
-	distData.BaseAddress = distBaseAddr
+	distData.BaseRecipients = []distrtypes.BaseRecipient{{Address: distBaseAddr, Weight: sdk.OneDec()}}
 
 	distDataBz, err := s.cfg.Codec.MarshalJSON(&distData)
 	s.Require().NoError(err)
@@ -542,12 +542,12 @@ func (s *IntegrationTestSuite) TestGetCmdQueryRatio() {
 		{
 			"json output",
 			[]string{fmt.Sprintf("--%s=json", tmcli.OutputFlag), fmt.Sprintf("--%s=3", flags.FlagHeight)},
-			`{"ratio":{"staking_rewards":"0.333333333333333334","base":"0.333333333333333333","burn":"0.333333333333333333"}}`,
+			`{"ratio":{"staking_rewards":"0.333333333333333334","base":"0.333333333333333333","burn":"0.333333333333333333"},"denom_ratios":[]}`,
 		},
 		{
 			"text output",
 			[]string{fmt.Sprintf("--%s=text", tmcli.OutputFlag), fmt.Sprintf("--%s=3", flags.FlagHeight)},
-			"ratio:\n  base: \"0.333333333333333333\"\n  burn: \"0.333333333333333333\"\n  staking_rewards: \"0.333333333333333334\"",
+			"denom_ratios: []\nratio:\n  base: \"0.333333333333333333\"\n  burn: \"0.333333333333333333\"\n  staking_rewards: \"0.333333333333333334\"",
 		},
 	}
 
@@ -931,6 +931,7 @@ func (s *IntegrationTestSuite) TestNewFundCommunityPoolCmd() {
 				fmt.Sprintf("--%s=true", flags.FlagSkipConfirmation),
 				fmt.Sprintf("--%s=%s", flags.FlagBroadcastMode, flags.BroadcastBlock),
 				fmt.Sprintf("--%s=%s", flags.FlagFees, sdk.NewCoins(sdk.NewCoin(s.cfg.BondDenom, sdk.NewInt(10))).String()),
+				fmt.Sprintf("--%s=200000", flags.FlagGas),
 			},
 			false, 0, &sdk.TxResponse{},
 		},
@@ -978,6 +979,7 @@ func (s *IntegrationTestSuite) TestGetCmdSubmitProposal() {
 		fmt.Sprintf("--%s=true", flags.FlagSkipConfirmation),
 		fmt.Sprintf("--%s=%s", flags.FlagBroadcastMode, flags.BroadcastBlock),
 		fmt.Sprintf("--%s=%s", flags.FlagFees, sdk.NewCoins(sdk.NewCoin(s.cfg.BondDenom, sdk.NewInt(10))).String()),
+		fmt.Sprintf("--%s=200000", flags.FlagGas),
 	}
 
 	invalidPropFile := testutil.WriteToNewTempFile(s.T(), invalidProp)
@@ -1086,6 +1088,7 @@ func (s *IntegrationTestSuite) TestNewChangeBaseAddressCmd() {
 			fmt.Sprintf("--%s=true", flags.FlagSkipConfirmation),
 			fmt.Sprintf("--%s=%s", flags.FlagBroadcastMode, flags.BroadcastBlock),
 			fmt.Sprintf("--%s=%s", flags.FlagFees, sdk.NewCoins(sdk.NewCoin(s.cfg.BondDenom, sdk.NewInt(10))).String()),
+			fmt.Sprintf("--%s=200000", flags.FlagGas),
 		}
 
 		s.Run(tc.name, func() {
@@ -1140,6 +1143,7 @@ func (s *IntegrationTestSuite) TestNewChangeModeratorCmd() {
 			fmt.Sprintf("--%s=true", flags.FlagSkipConfirmation),
 			fmt.Sprintf("--%s=%s", flags.FlagBroadcastMode, flags.BroadcastBlock),
 			fmt.Sprintf("--%s=%s", flags.FlagFees, sdk.NewCoins(sdk.NewCoin(s.cfg.BondDenom, sdk.NewInt(10))).String()),
+			fmt.Sprintf("--%s=200000", flags.FlagGas),
 		}
 
 		s.Run(tc.name, func() {
@@ -1209,6 +1213,7 @@ func (s *IntegrationTestSuite) TestNewChangeRatioCmd() {
 			fmt.Sprintf("--%s=true", flags.FlagSkipConfirmation),
 			fmt.Sprintf("--%s=%s", flags.FlagBroadcastMode, flags.BroadcastBlock),
 			fmt.Sprintf("--%s=%s", flags.FlagFees, sdk.NewCoins(sdk.NewCoin(s.cfg.BondDenom, sdk.NewInt(10))).String()),
+			fmt.Sprintf("--%s=200000", flags.FlagGas),
 		}
 
 		s.Run(tc.name, func() {
@@ -31,11 +31,11 @@ func (k Keeper) AllocateTokens(
 		panic(err)
 	}
 
-	ratio := k.GetRatio(ctx)
-
 	if len(feesCollectedInt) > 0 {
+		// each denom is split by its own ratio, falling back to the default ratio
+		burnFee, baseFee := k.CalculateFeeSplit(ctx, feesCollectedInt)
+
 		// burn fee: ratio.Burn
-		burnFee := k.CalculatePercentage(feesCollectedInt, ratio.Burn)
 		err = k.bankKeeper.BurnCoins(ctx, types.ModuleName, burnFee)
 		if err != nil {
 			panic(err)
@@ -50,24 +50,34 @@ func (k Keeper) AllocateTokens(
 		)
 		logger.Info("Event Emitted", "type", types.EventTypeBurnFee, "key", sdk.AttributeKeyAmount, "value", burnFee.String())
 
-		// base fee: ratio.Base
-		baseAddr := sdk.MustAccAddressFromBech32(k.GetBaseAddress(ctx))
-		baseFee := k.CalculatePercentage(feesCollectedInt, ratio.Base)
-		err = k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, baseAddr, baseFee)
-		if err != nil {
-			panic(err)
+		// base fee: ratio.Base, split between the base recipients by weight,
+		// or sent to the community pool if there are none
+		baseRecipients := k.GetBaseRecipients(ctx)
+		if len(baseRecipients) == 0 {
+			feePool := k.GetFeePool(ctx)
+			feePool.CommunityPool = feePool.CommunityPool.Add(sdk.NewDecCoinsFromCoins(baseFee...)...)
+			k.SetFeePool(ctx, feePool)
+		}
+		for i, share := range types.SplitBaseFee(baseFee, baseRecipients) {
+			baseAddr := sdk.MustAccAddressFromBech32(baseRecipients[i].Address)
+			err = k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, baseAddr, share)
+			if err != nil {
+				panic(err)
+			}
+
+			// emit base fee
+			ctx.EventManager().EmitEvent(
+				sdk.NewEvent(
+					types.EventTypeBaseFee,
+					sdk.NewAttribute(sdk.AttributeKeyAmount, share.String()),
+					sdk.NewAttribute(types.AttributeKeyRecipient, baseRecipients[i].Address),
+				),
+			)
+			logger.Info("Event Emitted", "type", types.EventTypeBaseFee, "key", sdk.AttributeKeyAmount, "value", share.String(), "recipient", baseRecipients[i].Address)
 		}
-
-		// emit base fee
-		ctx.EventManager().EmitEvent(
-			sdk.NewEvent(
-				types.EventTypeBaseFee,
-				sdk.NewAttribute(sdk.AttributeKeyAmount, baseFee.String()),
-			),
-		)
-		logger.Info("Event Emitted", "type", types.EventTypeBaseFee, "key", sdk.AttributeKeyAmount, "value", baseFee.String())
 
 		feesCollectedInt = feesCollectedInt.Sub(burnFee...).Sub(baseFee...)
+		k.RecordFeeSplit(ctx, types.NewFeeSplit(burnFee, baseFee, feesCollectedInt))
 
 		feesCollected := sdk.NewDecCoinsFromCoins(feesCollectedInt...)
 		logger.Info("Event Emitted", "type", types.EventTypeStakingRewards, "key", sdk.AttributeKeyAmount, "value", feesCollectedInt.String())
@@ -195,3 +205,20 @@ func (k Keeper) CalculatePercentage(coins sdk.Coins, percentage sdk.Dec) sdk.Coi
 	}
 	return result
 }
+
+// CalculateFeeSplit computes the burn and base parts of the collected fees.
+// The fees of each denom are split by the ratio set for the denom, or by the
+// default ratio if the denom has none.
+func (k Keeper) CalculateFeeSplit(ctx sdk.Context, fees sdk.Coins) (burn, base sdk.Coins) {
+	defaultRatio := k.GetRatio(ctx)
+	for _, coin := range fees {
+		ratio, found := k.GetDenomRatio(ctx, coin.Denom)
+		if !found {
+			ratio = defaultRatio
+		}
+
+		burn = burn.Add(k.CalculatePercentage(sdk.NewCoins(coin), ratio.Burn)...)
+		base = base.Add(k.CalculatePercentage(sdk.NewCoins(coin), ratio.Base)...)
+	}
+	return burn, base
+}
@@ -195,3 +195,100 @@ func TestAllocateTokensTruncation(t *testing.T) {
 	require.True(t, app.DistrKeeper.GetValidatorOutstandingRewards(ctx, valAddrs[1]).Rewards.IsValid())
 	require.True(t, app.DistrKeeper.GetValidatorOutstandingRewards(ctx, valAddrs[2]).Rewards.IsValid())
 }
+
+func TestAllocateTokensToBaseRecipients(t *testing.T) {
+	app := simapp.Setup(t, false)
+	ctx := app.BaseApp.NewContext(false, tmproto.Header{})
+
+	// reset fee pool
+	app.DistrKeeper.SetFeePool(ctx, disttypes.InitialFeePool())
+
+	addrs := simapp.AddTestAddrs(app, ctx, 3, sdk.ZeroInt())
+	app.DistrKeeper.SetRatio(ctx, disttypes.Ratio{
+		StakingRewards: sdk.NewDecWithPrec(5, 1),
+		Base:           sdk.NewDecWithPrec(5, 1),
+		Burn:           sdk.ZeroDec(),
+	})
+	app.DistrKeeper.SetBaseRecipients(ctx, []disttypes.BaseRecipient{
+		disttypes.NewBaseRecipient(addrs[0], sdk.NewDecWithPrec(5, 1)),
+		disttypes.NewBaseRecipient(addrs[1], sdk.NewDecWithPrec(3, 1)),
+		disttypes.NewBaseRecipient(addrs[2], sdk.NewDecWithPrec(2, 1)),
+	})
+
+	fees := sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(1001)))
+	feeCollector := app.AccountKeeper.GetModuleAccount(ctx, types.FeeCollectorName)
+	require.NoError(t, testutil.FundModuleAccount(app.BankKeeper, ctx, feeCollector.GetName(), fees))
+
+	app.DistrKeeper.AllocateTokens(ctx, 0, 0, valConsAddr2, []abci.VoteInfo{})
+
+	// base fee of 500 split 250/150/100, no truncation remainder is lost
+	require.Equal(t, sdk.NewInt(250), app.BankKeeper.GetBalance(ctx, addrs[0], sdk.DefaultBondDenom).Amount)
+	require.Equal(t, sdk.NewInt(150), app.BankKeeper.GetBalance(ctx, addrs[1], sdk.DefaultBondDenom).Amount)
+	require.Equal(t, sdk.NewInt(100), app.BankKeeper.GetBalance(ctx, addrs[2], sdk.DefaultBondDenom).Amount)
+
+	// no validators voted, the staking rewards go to the community pool
+	require.Equal(t, sdk.DecCoins{{Denom: sdk.DefaultBondDenom, Amount: sdk.NewDec(501)}}, app.DistrKeeper.GetFeePool(ctx).CommunityPool)
+}
+
+func TestAllocateTokensWithoutBaseRecipients(t *testing.T) {
+	app := simapp.Setup(t, false)
+	ctx := app.BaseApp.NewContext(false, tmproto.Header{})
+
+	// reset fee pool
+	app.DistrKeeper.SetFeePool(ctx, disttypes.InitialFeePool())
+
+	app.DistrKeeper.SetBaseRecipients(ctx, nil)
+	app.DistrKeeper.SetRatio(ctx, disttypes.Ratio{
+		StakingRewards: sdk.NewDecWithPrec(5, 1),
+		Base:           sdk.NewDecWithPrec(3, 1),
+		Burn:           sdk.NewDecWithPrec(2, 1),
+	})
+
+	fees := sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(100)))
+	feeCollector := app.AccountKeeper.GetModuleAccount(ctx, types.FeeCollectorName)
+	require.NoError(t, testutil.FundModuleAccount(app.BankKeeper, ctx, feeCollector.GetName(), fees))
+	distrAddr := app.DistrKeeper.GetDistributionAccount(ctx).GetAddress()
+	distrBalance := app.BankKeeper.GetBalance(ctx, distrAddr, sdk.DefaultBondDenom)
+
+	app.DistrKeeper.AllocateTokens(ctx, 0, 0, valConsAddr2, []abci.VoteInfo{})
+
+	// the base fee and the staking rewards go to the community pool, which is
+	// held by the distribution module account
+	require.Equal(t, sdk.DecCoins{{Denom: sdk.DefaultBondDenom, Amount: sdk.NewDec(80)}}, app.DistrKeeper.GetFeePool(ctx).CommunityPool)
+	require.Equal(t, distrBalance.AddAmount(sdk.NewInt(80)), app.BankKeeper.GetBalance(ctx, distrAddr, sdk.DefaultBondDenom))
+}
+
+func TestAllocateTokensWithDenomRatios(t *testing.T) {
+	app := simapp.Setup(t, false)
+	ctx := app.BaseApp.NewContext(false, tmproto.Header{})
+
+	// reset fee pool
+	app.DistrKeeper.SetFeePool(ctx, disttypes.InitialFeePool())
+
+	addrs := simapp.AddTestAddrs(app, ctx, 1, sdk.ZeroInt())
+	app.DistrKeeper.SetBaseRecipients(ctx, []disttypes.BaseRecipient{disttypes.NewBaseRecipient(addrs[0], sdk.OneDec())})
+	app.DistrKeeper.SetRatio(ctx, disttypes.Ratio{
+		StakingRewards: sdk.NewDecWithPrec(4, 1),
+		Base:           sdk.NewDecWithPrec(3, 1),
+		Burn:           sdk.NewDecWithPrec(3, 1),
+	})
+	// no burn on the foreign denom
+	app.DistrKeeper.SetDenomRatio(ctx, "ibcdenom", disttypes.Ratio{
+		StakingRewards: sdk.NewDecWithPrec(5, 1),
+		Base:           sdk.NewDecWithPrec(5, 1),
+		Burn:           sdk.ZeroDec(),
+	})
+
+	fees := sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(100)), sdk.NewCoin("ibcdenom", sdk.NewInt(100)))
+	feeCollector := app.AccountKeeper.GetModuleAccount(ctx, types.FeeCollectorName)
+	require.NoError(t, testutil.FundModuleAccount(app.BankKeeper, ctx, feeCollector.GetName(), fees))
+
+	burn, base := app.DistrKeeper.CalculateFeeSplit(ctx, fees)
+	require.Equal(t, sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(30))), burn)
+	require.Equal(t, sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(30)), sdk.NewCoin("ibcdenom", sdk.NewInt(50))), base)
+
+	app.DistrKeeper.AllocateTokens(ctx, 0, 0, valConsAddr2, []abci.VoteInfo{})
+
+	require.Equal(t, base, app.BankKeeper.GetAllBalances(ctx, addrs[0]))
+	require.Equal(t, sdk.NewDecCoinsFromCoins(sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(40)), sdk.NewCoin("ibcdenom", sdk.NewInt(50))), app.DistrKeeper.GetFeePool(ctx).CommunityPool)
+}
@@ -9,6 +9,8 @@ import (
 	"github.com/cosmos/cosmos-sdk/simapp"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/x/bank/testutil"
+	"github.com/cosmos/cosmos-sdk/x/distribution/keeper"
+	"github.com/cosmos/cosmos-sdk/x/distribution/types"
 	"github.com/cosmos/cosmos-sdk/x/staking"
 	"github.com/cosmos/cosmos-sdk/x/staking/teststaking"
 	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
@@ -704,3 +706,64 @@ func Test100PercentCommissionReward(t *testing.T) {
 	}
 	require.True(t, hasValue)
 }
+
+func TestWithdrawShareRecordRewards(t *testing.T) {
+	app := simapp.Setup(t, false)
+	ctx := app.BaseApp.NewContext(false, tmproto.Header{})
+
+	addr := simapp.AddTestAddrs(app, ctx, 3, app.StakingKeeper.TokensFromConsensusPower(ctx, 1000))
+	valAddrs := simapp.ConvertAddrsToValAddrs(addr)
+	delAddr, owner := addr[1], addr[2]
+	tstaking := teststaking.NewHelper(t, ctx, app.StakingKeeper)
+
+	// set module account coins for the rewards allocated twice below
+	initial := app.StakingKeeper.TokensFromConsensusPower(ctx, 20)
+	distrAcc := app.DistrKeeper.GetDistributionAccount(ctx)
+	require.NoError(t, testutil.FundModuleAccount(app.BankKeeper, ctx, distrAcc.GetName(), sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, initial.MulRaw(2)))))
+	app.AccountKeeper.SetModuleAccount(ctx, distrAcc)
+
+	// create validator with no commission, and a delegation of the same power
+	tstaking.Commission = stakingtypes.NewCommissionRates(sdk.ZeroDec(), sdk.ZeroDec(), sdk.ZeroDec())
+	tstaking.CreateValidatorWithValPower(valAddrs[0], valConsPk1, 100, true)
+	tstaking.DelegateWithPower(delAddr, valAddrs[0], 100)
+
+	// end block to bond validator and start new block
+	staking.EndBlocker(ctx, app.StakingKeeper)
+	ctx = ctx.WithBlockHeight(ctx.BlockHeight() + 1)
+
+	_, err := app.DistrKeeper.WithdrawShareRecordRewards(ctx, owner)
+	require.ErrorIs(t, err, types.ErrNoShareRecords)
+
+	// tokenize half of the delegation, the rewards of which are owned by owner
+	shareToken, record, err := app.StakingKeeper.TokenizeShares(ctx, delAddr, valAddrs[0], app.StakingKeeper.TokensFromConsensusPower(ctx, 50), owner)
+	require.NoError(t, err)
+
+	// next block
+	ctx = ctx.WithBlockHeight(ctx.BlockHeight() + 1)
+
+	// allocate some rewards, a quarter of which are the rewards of the record
+	val := app.StakingKeeper.Validator(ctx, valAddrs[0])
+	app.DistrKeeper.AllocateTokensToValidator(ctx, val, sdk.DecCoins{sdk.NewDecCoin(sdk.DefaultBondDenom, initial)})
+
+	ownerBalance := app.BankKeeper.GetBalance(ctx, owner, sdk.DefaultBondDenom)
+	rewards, err := app.DistrKeeper.WithdrawShareRecordRewards(ctx, owner)
+	require.NoError(t, err)
+	require.Equal(t, sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, initial.QuoRaw(4))), rewards)
+	require.Equal(t, ownerBalance.AddAmount(initial.QuoRaw(4)), app.BankKeeper.GetBalance(ctx, owner, sdk.DefaultBondDenom))
+	require.True(t, app.BankKeeper.GetAllBalances(ctx, record.GetModuleAddress()).IsZero())
+
+	// the rewards withdrawn on redeem are paid to the owner as well
+	ctx = ctx.WithBlockHeight(ctx.BlockHeight() + 1)
+	app.DistrKeeper.AllocateTokensToValidator(ctx, val, sdk.DecCoins{sdk.NewDecCoin(sdk.DefaultBondDenom, initial)})
+	_, _, err = app.StakingKeeper.RedeemTokensForShares(ctx, delAddr, shareToken)
+	require.NoError(t, err)
+	require.Equal(t, ownerBalance.AddAmount(initial.QuoRaw(2)), app.BankKeeper.GetBalance(ctx, owner, sdk.DefaultBondDenom))
+	require.True(t, app.BankKeeper.GetAllBalances(ctx, record.GetModuleAddress()).IsZero())
+
+	// the delegator got the rewards of the shares not tokenized
+	_, err = app.DistrKeeper.WithdrawDelegationRewards(ctx, delAddr, valAddrs[0])
+	require.NoError(t, err)
+
+	msg, broken := keeper.AllInvariants(app.DistrKeeper)(ctx)
+	require.False(t, broken, msg)
+}
@@ -0,0 +1,117 @@
+package keeper
+
+import (
+	gogotypes "github.com/gogo/protobuf/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/distribution/types"
+)
+
+// GetFeeSplitTotals returns the cumulative fee split.
+func (k Keeper) GetFeeSplitTotals(ctx sdk.Context) (totals types.FeeSplit) {
+	store := ctx.KVStore(k.storeKey)
+	b := store.Get(types.FeeSplitTotalsKey)
+	if b == nil {
+		return totals
+	}
+
+	k.cdc.MustUnmarshal(b, &totals)
+	return totals
+}
+
+// SetFeeSplitTotals sets the cumulative fee split.
+func (k Keeper) SetFeeSplitTotals(ctx sdk.Context, totals types.FeeSplit) {
+	store := ctx.KVStore(k.storeKey)
+	b := k.cdc.MustMarshal(&totals)
+	store.Set(types.FeeSplitTotalsKey, b)
+}
+
+// GetFeeSplitRetention returns the number of blocks for which fee split
+// snapshots are kept.
+func (k Keeper) GetFeeSplitRetention(ctx sdk.Context) uint64 {
+	store := ctx.KVStore(k.storeKey)
+	b := store.Get(types.FeeSplitRetentionKey)
+	if b == nil {
+		return types.DefaultFeeSplitRetentionBlocks
+	}
+
+	var retention gogotypes.UInt64Value
+	k.cdc.MustUnmarshal(b, &retention)
+	return retention.GetValue()
+}
+
+// SetFeeSplitRetention sets the number of blocks for which fee split snapshots
+// are kept. Zero disables the snapshots.
+func (k Keeper) SetFeeSplitRetention(ctx sdk.Context, retentionBlocks uint64) {
+	store := ctx.KVStore(k.storeKey)
+	b := k.cdc.MustMarshal(&gogotypes.UInt64Value{Value: retentionBlocks})
+	store.Set(types.FeeSplitRetentionKey, b)
+}
+
+// GetFeeSplitSnapshot returns the fee split snapshot of a height.
+func (k Keeper) GetFeeSplitSnapshot(ctx sdk.Context, height int64) (snapshot types.FeeSplitSnapshot, found bool) {
+	store := ctx.KVStore(k.storeKey)
+	b := store.Get(types.GetFeeSplitSnapshotKey(height))
+	if b == nil {
+		return snapshot, false
+	}
+
+	k.cdc.MustUnmarshal(b, &snapshot)
+	return snapshot, true
+}
+
+// SetFeeSplitSnapshot stores the fee split snapshot of a height.
+func (k Keeper) SetFeeSplitSnapshot(ctx sdk.Context, snapshot types.FeeSplitSnapshot) {
+	store := ctx.KVStore(k.storeKey)
+	b := k.cdc.MustMarshal(&snapshot)
+	store.Set(types.GetFeeSplitSnapshotKey(snapshot.Height), b)
+}
+
+// IterateFeeSplitSnapshots iterates over the fee split snapshots in height
+// order.
+func (k Keeper) IterateFeeSplitSnapshots(ctx sdk.Context, handler func(snapshot types.FeeSplitSnapshot) (stop bool)) {
+	store := ctx.KVStore(k.storeKey)
+	iter := sdk.KVStorePrefixIterator(store, types.FeeSplitSnapshotPrefix)
+	defer iter.Close()
+	for ; iter.Valid(); iter.Next() {
+		var snapshot types.FeeSplitSnapshot
+		k.cdc.MustUnmarshal(iter.Value(), &snapshot)
+		if handler(snapshot) {
+			break
+		}
+	}
+}
+
+// RecordFeeSplit adds the fee split of the current block to the cumulative
+// totals and stores a snapshot of it, pruning the snapshots that fell out of
+// the retention window.
+func (k Keeper) RecordFeeSplit(ctx sdk.Context, feeSplit types.FeeSplit) {
+	k.SetFeeSplitTotals(ctx, k.GetFeeSplitTotals(ctx).Add(feeSplit))
+
+	if k.GetFeeSplitRetention(ctx) > 0 && !feeSplit.IsZero() {
+		k.SetFeeSplitSnapshot(ctx, types.FeeSplitSnapshot{Height: ctx.BlockHeight(), FeeSplit: feeSplit})
+	}
+
+	k.pruneFeeSplitSnapshots(ctx)
+}
+
+// pruneFeeSplitSnapshots deletes the snapshots older than the retention window.
+func (k Keeper) pruneFeeSplitSnapshots(ctx sdk.Context) {
+	// snapshots from heights after cutoff are kept
+	cutoff := ctx.BlockHeight() - int64(k.GetFeeSplitRetention(ctx))
+	if cutoff < 1 {
+		return
+	}
+
+	store := ctx.KVStore(k.storeKey)
+	iter := store.Iterator(types.FeeSplitSnapshotPrefix, types.GetFeeSplitSnapshotKey(cutoff+1))
+	defer iter.Close()
+
+	var keys [][]byte
+	for ; iter.Valid(); iter.Next() {
+		keys = append(keys, iter.Key())
+	}
+	for _, key := range keys {
+		store.Delete(key)
+	}
+}
@@ -0,0 +1,84 @@
+package keeper_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	abci "github.com/tendermint/tendermint/abci/types"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+
+	"github.com/cosmos/cosmos-sdk/simapp"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/auth/types"
+	"github.com/cosmos/cosmos-sdk/x/bank/testutil"
+	disttypes "github.com/cosmos/cosmos-sdk/x/distribution/types"
+)
+
+func TestAllocateTokensRecordsFeeSplit(t *testing.T) {
+	app := simapp.Setup(t, false)
+	ctx := app.BaseApp.NewContext(false, tmproto.Header{Height: 5})
+
+	// reset fee split totals
+	app.DistrKeeper.SetFeeSplitTotals(ctx, disttypes.FeeSplit{})
+
+	app.DistrKeeper.SetRatio(ctx, disttypes.Ratio{
+		StakingRewards: sdk.NewDecWithPrec(5, 1),
+		Base:           sdk.NewDecWithPrec(3, 1),
+		Burn:           sdk.NewDecWithPrec(2, 1),
+	})
+
+	feeCollector := app.AccountKeeper.GetModuleAccount(ctx, types.FeeCollectorName)
+	fees := sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(100)))
+	for i := 0; i < 2; i++ {
+		require.NoError(t, testutil.FundModuleAccount(app.BankKeeper, ctx, feeCollector.GetName(), fees))
+		app.DistrKeeper.AllocateTokens(ctx, 0, 0, valConsAddr2, []abci.VoteInfo{})
+		ctx = ctx.WithBlockHeight(ctx.BlockHeight() + 1)
+	}
+
+	expected := disttypes.NewFeeSplit(
+		sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(20))),
+		sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(30))),
+		sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(50))),
+	)
+	require.Equal(t, expected.Add(expected), app.DistrKeeper.GetFeeSplitTotals(ctx))
+
+	snapshot, found := app.DistrKeeper.GetFeeSplitSnapshot(ctx, 5)
+	require.True(t, found)
+	require.Equal(t, expected, snapshot.FeeSplit)
+
+	// blocks without fees do not have a snapshot
+	app.DistrKeeper.AllocateTokens(ctx, 0, 0, valConsAddr2, []abci.VoteInfo{})
+	_, found = app.DistrKeeper.GetFeeSplitSnapshot(ctx, ctx.BlockHeight())
+	require.False(t, found)
+}
+
+func TestFeeSplitSnapshotRetention(t *testing.T) {
+	app := simapp.Setup(t, false)
+	ctx := app.BaseApp.NewContext(false, tmproto.Header{})
+
+	// reset fee split totals
+	app.DistrKeeper.SetFeeSplitTotals(ctx, disttypes.FeeSplit{})
+
+	split := disttypes.NewFeeSplit(sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(1))), nil, nil)
+	app.DistrKeeper.SetFeeSplitRetention(ctx, 3)
+	for height := int64(1); height <= 5; height++ {
+		app.DistrKeeper.RecordFeeSplit(ctx.WithBlockHeight(height), split)
+	}
+
+	var heights []int64
+	app.DistrKeeper.IterateFeeSplitSnapshots(ctx, func(snapshot disttypes.FeeSplitSnapshot) bool {
+		heights = append(heights, snapshot.Height)
+		return false
+	})
+	require.Equal(t, []int64{3, 4, 5}, heights)
+	require.Equal(t, sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(5))), app.DistrKeeper.GetFeeSplitTotals(ctx).Burned)
+
+	// disabling the snapshots prunes the remaining ones but keeps the totals
+	app.DistrKeeper.SetFeeSplitRetention(ctx, 0)
+	app.DistrKeeper.RecordFeeSplit(ctx.WithBlockHeight(6), split)
+	app.DistrKeeper.IterateFeeSplitSnapshots(ctx, func(snapshot disttypes.FeeSplitSnapshot) bool {
+		t.Fatalf("unexpected snapshot at height %d", snapshot.Height)
+		return true
+	})
+	require.Equal(t, sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(6))), app.DistrKeeper.GetFeeSplitTotals(ctx).Burned)
+}
@@ -95,8 +95,40 @@ func (k Keeper) InitGenesis(ctx sdk.Context, data types.GenesisState) {
 	}
 
 	k.SetRatio(ctx, data.Ratio)
-	k.SetBaseAddress(ctx, data.BaseAddress)
+	for _, dr := range data.DenomRatios {
+		k.SetDenomRatio(ctx, dr.Denom, dr.Ratio)
+	}
+	k.SetBaseRecipients(ctx, data.ResolveBaseRecipients())
 	k.SetModeratorAddress(ctx, data.ModeratorAddress)
+	if data.PendingModeratorAddress != "" {
+		k.SetPendingModeratorAddress(ctx, data.PendingModeratorAddress)
+	}
+
+	nextRatioChangeID := data.NextRatioChangeId
+	for _, change := range data.PendingRatioChanges {
+		k.SetRatioChange(ctx, change)
+		if change.Id >= nextRatioChangeID {
+			nextRatioChangeID = change.Id + 1
+		}
+	}
+	if nextRatioChangeID == 0 {
+		nextRatioChangeID = 1
+	}
+	k.SetNextRatioChangeID(ctx, nextRatioChangeID)
+
+	for _, entry := range data.RatioHistory {
+		k.SetRatioHistoryEntry(ctx, entry)
+	}
+	if len(data.RatioHistory) == 0 {
+		// record the genesis ratio so that the history is complete
+		k.applyRatio(ctx, 0, data.Ratio)
+	}
+
+	k.SetFeeSplitTotals(ctx, data.FeeSplitTotals)
+	k.SetFeeSplitRetention(ctx, data.FeeSplitRetentionBlocks)
+	for _, snapshot := range data.FeeSplitSnapshots {
+		k.SetFeeSplitSnapshot(ctx, snapshot)
+	}
 }
 
 // ExportGenesis returns a GenesisState for a given context and keeper.
@@ -186,8 +218,32 @@ func (k Keeper) ExportGenesis(ctx sdk.Context) *types.GenesisState {
 	)
 
 	ratio := k.GetRatio(ctx)
-	base_addr := k.GetBaseAddress(ctx)
+	baseRecipients := k.GetBaseRecipients(ctx)
 	moderator := k.GetModeratorAddress(ctx)
+	pendingModerator := k.GetPendingModeratorAddress(ctx)
 
-	return types.NewGenesisState(params, feePool, dwi, pp, outstanding, acc, his, cur, dels, slashes, ratio, base_addr, moderator)
+	ratioChanges := make([]types.RatioChange, 0)
+	k.IterateRatioChanges(ctx, func(change types.RatioChange) (stop bool) {
+		ratioChanges = append(ratioChanges, change)
+		return false
+	})
+
+	ratioHistory := make([]types.RatioHistoryEntry, 0)
+	k.IterateRatioHistory(ctx, func(entry types.RatioHistoryEntry) (stop bool) {
+		ratioHistory = append(ratioHistory, entry)
+		return false
+	})
+
+	feeSplitSnapshots := make([]types.FeeSplitSnapshot, 0)
+	k.IterateFeeSplitSnapshots(ctx, func(snapshot types.FeeSplitSnapshot) (stop bool) {
+		feeSplitSnapshots = append(feeSplitSnapshots, snapshot)
+		return false
+	})
+
+	return types.NewGenesisState(
+		params, feePool, dwi, pp, outstanding, acc, his, cur, dels, slashes, ratio, baseRecipients, moderator,
+		pendingModerator, ratioChanges, ratioHistory, k.GetNextRatioChangeID(ctx),
+		k.GetFeeSplitTotals(ctx), feeSplitSnapshots, k.GetFeeSplitRetention(ctx),
+		k.GetAllDenomRatios(ctx),
+	)
 }
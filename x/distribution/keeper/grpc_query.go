@@ -291,16 +291,78 @@ func (k Keeper) CommunityPool(c context.Context, req *types.QueryCommunityPoolRe
 func (k Keeper) Ratio(c context.Context, req *types.QueryRatioRequest) (*types.QueryRatioResponse, error) {
 	ctx := sdk.UnwrapSDKContext(c)
 	ratio := k.GetRatio(ctx)
+	denomRatios := k.GetAllDenomRatios(ctx)
 
-	return &types.QueryRatioResponse{Ratio: ratio}, nil
+	return &types.QueryRatioResponse{Ratio: ratio, DenomRatios: denomRatios}, nil
 }
 
-// BaseAddress queries the base address
+// PendingRatioChanges queries the scheduled fee distribution ratio changes
+func (k Keeper) PendingRatioChanges(c context.Context, req *types.QueryPendingRatioChangesRequest) (*types.QueryPendingRatioChangesResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.RatioChangePrefix)
+
+	changes := []types.RatioChange{}
+	pageRes, err := query.Paginate(store, req.Pagination, func(key []byte, value []byte) error {
+		var change types.RatioChange
+		if err := k.cdc.Unmarshal(value, &change); err != nil {
+			return err
+		}
+		changes = append(changes, change)
+		return nil
+	})
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryPendingRatioChangesResponse{Changes: changes, Pagination: pageRes}, nil
+}
+
+// RatioHistory queries the fee distribution ratios that became effective
+func (k Keeper) RatioHistory(c context.Context, req *types.QueryRatioHistoryRequest) (*types.QueryRatioHistoryResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.RatioHistoryPrefix)
+
+	history := []types.RatioHistoryEntry{}
+	pageRes, err := query.Paginate(store, req.Pagination, func(key []byte, value []byte) error {
+		var entry types.RatioHistoryEntry
+		if err := k.cdc.Unmarshal(value, &entry); err != nil {
+			return err
+		}
+		history = append(history, entry)
+		return nil
+	})
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryRatioHistoryResponse{History: history, Pagination: pageRes}, nil
+}
+
+// BaseAddress queries the address of the first base recipient
 func (k Keeper) BaseAddress(c context.Context, req *types.QueryBaseAddressRequest) (*types.QueryBaseAddressResponse, error) {
 	ctx := sdk.UnwrapSDKContext(c)
-	baseAddress := k.GetBaseAddress(ctx)
+	recipients := k.GetBaseRecipients(ctx)
+	if len(recipients) == 0 {
+		return &types.QueryBaseAddressResponse{}, nil
+	}
+
+	return &types.QueryBaseAddressResponse{BaseAddress: recipients[0].Address}, nil
+}
+
+// BaseRecipients queries the weighted list of base recipients
+func (k Keeper) BaseRecipients(c context.Context, req *types.QueryBaseRecipientsRequest) (*types.QueryBaseRecipientsResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+	recipients := k.GetBaseRecipients(ctx)
 
-	return &types.QueryBaseAddressResponse{BaseAddress: baseAddress}, nil
+	return &types.QueryBaseRecipientsResponse{Recipients: recipients}, nil
 }
 
 // Moderator queries the moderator address
@@ -310,3 +372,66 @@ func (k Keeper) Moderator(c context.Context, req *types.QueryModeratorRequest) (
 
 	return &types.QueryModeratorResponse{ModeratorAddress: moderator}, nil
 }
+
+// PendingModerator queries the moderator waiting to accept the moderator role
+func (k Keeper) PendingModerator(c context.Context, req *types.QueryPendingModeratorRequest) (*types.QueryPendingModeratorResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+	pending := k.GetPendingModeratorAddress(ctx)
+
+	return &types.QueryPendingModeratorResponse{PendingModeratorAddress: pending}, nil
+}
+
+// FeeSplitTotals queries the cumulative fee split
+func (k Keeper) FeeSplitTotals(c context.Context, req *types.QueryFeeSplitTotalsRequest) (*types.QueryFeeSplitTotalsResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+	totals := k.GetFeeSplitTotals(ctx)
+
+	return &types.QueryFeeSplitTotalsResponse{Totals: totals}, nil
+}
+
+// FeeSplitSnapshots queries the fee splits of the blocks within the retention window
+func (k Keeper) FeeSplitSnapshots(c context.Context, req *types.QueryFeeSplitSnapshotsRequest) (*types.QueryFeeSplitSnapshotsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.FeeSplitSnapshotPrefix)
+
+	snapshots := []types.FeeSplitSnapshot{}
+	pageRes, err := query.Paginate(store, req.Pagination, func(key []byte, value []byte) error {
+		var snapshot types.FeeSplitSnapshot
+		if err := k.cdc.Unmarshal(value, &snapshot); err != nil {
+			return err
+		}
+		snapshots = append(snapshots, snapshot)
+		return nil
+	})
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryFeeSplitSnapshotsResponse{
+		Snapshots:       snapshots,
+		RetentionBlocks: k.GetFeeSplitRetention(ctx),
+		Pagination:      pageRes,
+	}, nil
+}
+
+// FeeSplitSnapshot queries the fee split of a block
+func (k Keeper) FeeSplitSnapshot(c context.Context, req *types.QueryFeeSplitSnapshotRequest) (*types.QueryFeeSplitSnapshotResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	if req.Height <= 0 {
+		return nil, status.Error(codes.InvalidArgument, "height must be positive")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	snapshot, found := k.GetFeeSplitSnapshot(ctx, req.Height)
+	if !found {
+		return nil, types.ErrNoFeeSplitSnapshot.Wrapf("height %d", req.Height)
+	}
+
+	return &types.QueryFeeSplitSnapshotResponse{Snapshot: snapshot}, nil
+}
@@ -23,13 +23,17 @@ type Keeper struct {
 	stakingKeeper types.StakingKeeper
 
 	feeCollectorName string // name of the FeeCollector ModuleAccount
+
+	// the address capable of reassigning the moderator without the two-step
+	// handover. Usually the gov module account
+	authority string
 }
 
 // NewKeeper creates a new distribution Keeper instance
 func NewKeeper(
 	cdc codec.BinaryCodec, key storetypes.StoreKey, paramSpace paramtypes.Subspace,
 	ak types.AccountKeeper, bk types.BankKeeper, sk types.StakingKeeper,
-	feeCollectorName string,
+	feeCollectorName string, authority string,
 ) Keeper {
 	// ensure distribution module account is set
 	if addr := ak.GetModuleAddress(types.ModuleName); addr == nil {
@@ -49,9 +53,15 @@ func NewKeeper(
 		bankKeeper:       bk,
 		stakingKeeper:    sk,
 		feeCollectorName: feeCollectorName,
+		authority:        authority,
 	}
 }
 
+// GetAuthority returns the x/distribution module's authority.
+func (k Keeper) GetAuthority() string {
+	return k.authority
+}
+
 // Logger returns a module-specific logger.
 func (k Keeper) Logger(ctx sdk.Context) log.Logger {
 	return ctx.Logger().With("module", "x/"+types.ModuleName)
@@ -143,6 +153,41 @@ func (k Keeper) WithdrawValidatorCommission(ctx sdk.Context, valAddr sdk.ValAddr
 	return commission, nil
 }
 
+// WithdrawShareRecordRewards withdraws the rewards of the delegations of the
+// tokenize share records owned by owner, and sends them to owner. The rewards
+// previously withdrawn to the module accounts of the records are sent as well.
+func (k Keeper) WithdrawShareRecordRewards(ctx sdk.Context, owner sdk.AccAddress) (sdk.Coins, error) {
+	records := k.stakingKeeper.GetTokenizeShareRecordsByOwner(ctx, owner)
+	if len(records) == 0 {
+		return nil, types.ErrNoShareRecords
+	}
+
+	totalRewards := sdk.Coins{}
+	for _, record := range records {
+		moduleAddr := record.GetModuleAddress()
+		valAddr := record.GetValidatorAddress()
+
+		// the delegation is gone once the validator is removed or all the
+		// shares are unbonded
+		if k.stakingKeeper.Delegation(ctx, moduleAddr, valAddr) != nil {
+			if _, err := k.WithdrawDelegationRewards(ctx, moduleAddr, valAddr); err != nil {
+				return nil, err
+			}
+		}
+
+		rewards := k.bankKeeper.GetAllBalances(ctx, moduleAddr)
+		if rewards.IsZero() {
+			continue
+		}
+		if err := k.bankKeeper.SendCoins(ctx, moduleAddr, owner, rewards); err != nil {
+			return nil, err
+		}
+		totalRewards = totalRewards.Add(rewards...)
+	}
+
+	return totalRewards, nil
+}
+
 // GetTotalRewards returns the total amount of fee distribution rewards held in the store
 func (k Keeper) GetTotalRewards(ctx sdk.Context) (totalRewards sdk.DecCoins) {
 	k.IterateValidatorOutstandingRewards(ctx,
@@ -187,20 +232,48 @@ func (k Keeper) SetModeratorAddress(ctx sdk.Context, moderator_address string) {
 	store.Set(types.ModeratorAddrKey, []byte(moderator_address))
 }
 
-// GetBaseAddress returns the current base address
-func (k Keeper) GetBaseAddress(ctx sdk.Context) string {
+// GetPendingModeratorAddress returns the moderator waiting to accept the
+// moderator role, or an empty string if no handover is in progress.
+func (k Keeper) GetPendingModeratorAddress(ctx sdk.Context) string {
 	store := ctx.KVStore(k.storeKey)
-	bz := store.Get(types.BaseAddrKey)
+	bz := store.Get(types.PendingModeratorAddrKey)
 	if len(bz) == 0 {
 		return ""
 	}
 	return string(bz)
 }
 
-// SetBaseAddress adds/updates the base address.
-func (k Keeper) SetBaseAddress(ctx sdk.Context, base_address string) {
+// SetPendingModeratorAddress sets the moderator waiting to accept the
+// moderator role.
+func (k Keeper) SetPendingModeratorAddress(ctx sdk.Context, pendingModerator string) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.PendingModeratorAddrKey, []byte(pendingModerator))
+}
+
+// DeletePendingModeratorAddress cancels the moderator handover in progress.
+func (k Keeper) DeletePendingModeratorAddress(ctx sdk.Context) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.PendingModeratorAddrKey)
+}
+
+// GetBaseRecipients returns the weighted list of base fee recipients.
+func (k Keeper) GetBaseRecipients(ctx sdk.Context) []types.BaseRecipient {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.BaseRecipientsKey)
+	if bz == nil {
+		return nil
+	}
+
+	var recipients types.BaseRecipients
+	k.cdc.MustUnmarshal(bz, &recipients)
+	return recipients.Recipients
+}
+
+// SetBaseRecipients sets the weighted list of base fee recipients.
+func (k Keeper) SetBaseRecipients(ctx sdk.Context, recipients []types.BaseRecipient) {
 	store := ctx.KVStore(k.storeKey)
-	store.Set(types.BaseAddrKey, []byte(base_address))
+	bz := k.cdc.MustMarshal(&types.BaseRecipients{Recipients: recipients})
+	store.Set(types.BaseRecipientsKey, bz)
 }
 
 // get the ratio
@@ -0,0 +1,89 @@
+package keeper_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+
+	"github.com/cosmos/cosmos-sdk/simapp"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	"github.com/cosmos/cosmos-sdk/x/authz"
+	"github.com/cosmos/cosmos-sdk/x/distribution/keeper"
+	"github.com/cosmos/cosmos-sdk/x/distribution/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+)
+
+func TestModeratorHandover(t *testing.T) {
+	app := simapp.Setup(t, false)
+	ctx := app.BaseApp.NewContext(false, tmproto.Header{})
+	msgServer := keeper.NewMsgServerImpl(app.DistrKeeper)
+
+	addrs := simapp.AddTestAddrs(app, ctx, 3, sdk.NewInt(1000))
+	moderator, newModerator, other := addrs[0], addrs[1], addrs[2]
+	app.DistrKeeper.SetModeratorAddress(ctx, moderator.String())
+
+	// nothing to accept before a handover is proposed
+	_, err := msgServer.AcceptModerator(sdk.WrapSDKContext(ctx), types.NewMsgAcceptModerator(newModerator))
+	require.ErrorIs(t, err, types.ErrNoPendingModerator)
+
+	// only the moderator can propose a new moderator
+	_, err = msgServer.ChangeModerator(sdk.WrapSDKContext(ctx), types.NewMsgChangeModerator(other, newModerator))
+	require.ErrorIs(t, err, types.ErrInvalidModerator)
+
+	_, err = msgServer.ChangeModerator(sdk.WrapSDKContext(ctx), types.NewMsgChangeModerator(moderator, newModerator))
+	require.NoError(t, err)
+	require.Equal(t, moderator.String(), app.DistrKeeper.GetModeratorAddress(ctx))
+	require.Equal(t, newModerator.String(), app.DistrKeeper.GetPendingModeratorAddress(ctx))
+
+	// only the pending moderator can accept
+	_, err = msgServer.AcceptModerator(sdk.WrapSDKContext(ctx), types.NewMsgAcceptModerator(other))
+	require.ErrorIs(t, err, types.ErrInvalidModerator)
+
+	_, err = msgServer.AcceptModerator(sdk.WrapSDKContext(ctx), types.NewMsgAcceptModerator(newModerator))
+	require.NoError(t, err)
+	require.Equal(t, newModerator.String(), app.DistrKeeper.GetModeratorAddress(ctx))
+	require.Equal(t, "", app.DistrKeeper.GetPendingModeratorAddress(ctx))
+}
+
+func TestModeratorAuthorityOverride(t *testing.T) {
+	app := simapp.Setup(t, false)
+	ctx := app.BaseApp.NewContext(false, tmproto.Header{})
+	msgServer := keeper.NewMsgServerImpl(app.DistrKeeper)
+
+	addrs := simapp.AddTestAddrs(app, ctx, 2, sdk.NewInt(1000))
+	pending, newModerator := addrs[0], addrs[1]
+	app.DistrKeeper.SetPendingModeratorAddress(ctx, pending.String())
+
+	authority := authtypes.NewModuleAddress(govtypes.ModuleName)
+	require.Equal(t, authority.String(), app.DistrKeeper.GetAuthority())
+
+	// the authority replaces the moderator immediately and cancels the handover
+	_, err := msgServer.ChangeModerator(sdk.WrapSDKContext(ctx), types.NewMsgChangeModerator(authority, newModerator))
+	require.NoError(t, err)
+	require.Equal(t, newModerator.String(), app.DistrKeeper.GetModeratorAddress(ctx))
+	require.Equal(t, "", app.DistrKeeper.GetPendingModeratorAddress(ctx))
+}
+
+func TestModeratorThroughAuthz(t *testing.T) {
+	app := simapp.Setup(t, false)
+	ctx := app.BaseApp.NewContext(false, tmproto.Header{})
+
+	addrs := simapp.AddTestAddrs(app, ctx, 3, sdk.NewInt(1000))
+	moderator, grantee, newModerator := addrs[0], addrs[1], addrs[2]
+	app.DistrKeeper.SetModeratorAddress(ctx, moderator.String())
+
+	msg := types.NewMsgChangeModerator(moderator, newModerator)
+
+	// without a grant the grantee cannot act as moderator
+	_, err := app.AuthzKeeper.DispatchActions(ctx, grantee, []sdk.Msg{msg})
+	require.Error(t, err)
+
+	authorization := authz.NewGenericAuthorization(sdk.MsgTypeURL(msg))
+	require.NoError(t, app.AuthzKeeper.SaveGrant(ctx, grantee, moderator, authorization, nil))
+
+	_, err = app.AuthzKeeper.DispatchActions(ctx, grantee, []sdk.Msg{msg})
+	require.NoError(t, err)
+	require.Equal(t, newModerator.String(), app.DistrKeeper.GetPendingModeratorAddress(ctx))
+}
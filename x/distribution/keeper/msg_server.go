@@ -2,6 +2,7 @@ package keeper
 
 import (
 	"context"
+	"strconv"
 
 	"github.com/armon/go-metrics"
 
@@ -122,6 +123,34 @@ func (k msgServer) WithdrawValidatorCommission(goCtx context.Context, msg *types
 	return &types.MsgWithdrawValidatorCommissionResponse{Amount: amount}, nil
 }
 
+func (k msgServer) WithdrawShareRecordReward(goCtx context.Context, msg *types.MsgWithdrawShareRecordReward) (*types.MsgWithdrawShareRecordRewardResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	owner, err := sdk.AccAddressFromBech32(msg.OwnerAddress)
+	if err != nil {
+		return nil, err
+	}
+	amount, err := k.WithdrawShareRecordRewards(ctx, owner)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeWithdrawShareRecordReward,
+			sdk.NewAttribute(sdk.AttributeKeyAmount, amount.String()),
+			sdk.NewAttribute(types.AttributeKeyOwner, msg.OwnerAddress),
+		),
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(sdk.AttributeKeySender, msg.OwnerAddress),
+		),
+	})
+
+	return &types.MsgWithdrawShareRecordRewardResponse{Amount: amount}, nil
+}
+
 func (k msgServer) FundCommunityPool(goCtx context.Context, msg *types.MsgFundCommunityPool) (*types.MsgFundCommunityPoolResponse, error) {
 	ctx := sdk.UnwrapSDKContext(goCtx)
 
@@ -147,33 +176,60 @@ func (k msgServer) FundCommunityPool(goCtx context.Context, msg *types.MsgFundCo
 func (k msgServer) ChangeRatio(goCtx context.Context, msg *types.MsgChangeRatio) (*types.MsgChangeRatioResponse, error) {
 	ctx := sdk.UnwrapSDKContext(goCtx)
 
-	moderator := k.GetModeratorAddress(ctx)
-	if msg.ModeratorAddress != moderator {
-		return nil, types.ErrInvalidModerator.Wrapf("expected: %s, got: %s", moderator, msg.ModeratorAddress)
+	if err := k.validateModerator(ctx, msg.ModeratorAddress); err != nil {
+		return nil, err
 	}
 
-	k.Keeper.SetRatio(ctx, msg.Ratio)
+	change, err := k.Keeper.ScheduleRatioChange(ctx, msg.ModeratorAddress, msg.Ratio, msg.ActivationHeight, msg.ActivationTime)
+	if err != nil {
+		return nil, err
+	}
 
 	ctx.EventManager().EmitEvent(
 		sdk.NewEvent(
 			types.EventTypeChangeRatio,
 			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
 			sdk.NewAttribute(sdk.AttributeKeySender, msg.ModeratorAddress),
+			sdk.NewAttribute(types.AttributeKeyRatioChangeID, strconv.FormatUint(change.Id, 10)),
+			sdk.NewAttribute(types.AttributeKeyActivationHeight, strconv.FormatInt(change.ActivationHeight, 10)),
+			sdk.NewAttribute(types.AttributeKeyActivationTime, change.ActivationTime.String()),
 		),
 	)
 
-	return &types.MsgChangeRatioResponse{}, nil
+	return &types.MsgChangeRatioResponse{ChangeId: change.Id}, nil
+}
+
+func (k msgServer) CancelRatioChange(goCtx context.Context, msg *types.MsgCancelRatioChange) (*types.MsgCancelRatioChangeResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if err := k.validateModerator(ctx, msg.ModeratorAddress); err != nil {
+		return nil, err
+	}
+
+	if err := k.Keeper.CancelRatioChange(ctx, msg.ChangeId); err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeCancelRatioChange,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(sdk.AttributeKeySender, msg.ModeratorAddress),
+			sdk.NewAttribute(types.AttributeKeyRatioChangeID, strconv.FormatUint(msg.ChangeId, 10)),
+		),
+	)
+
+	return &types.MsgCancelRatioChangeResponse{}, nil
 }
 
 func (k msgServer) ChangeBaseAddress(goCtx context.Context, msg *types.MsgChangeBaseAddress) (*types.MsgChangeBaseAddressResponse, error) {
 	ctx := sdk.UnwrapSDKContext(goCtx)
 
-	moderator := k.GetModeratorAddress(ctx)
-	if msg.ModeratorAddress != moderator {
-		return nil, types.ErrInvalidModerator.Wrapf("expected: %s, got: %s", moderator, msg.ModeratorAddress)
+	if err := k.validateModerator(ctx, msg.ModeratorAddress); err != nil {
+		return nil, err
 	}
 
-	k.Keeper.SetBaseAddress(ctx, msg.NewBaseAddress)
+	k.Keeper.SetBaseRecipients(ctx, []types.BaseRecipient{{Address: msg.NewBaseAddress, Weight: sdk.OneDec()}})
 
 	ctx.EventManager().EmitEvent(
 		sdk.NewEvent(
@@ -186,23 +242,180 @@ func (k msgServer) ChangeBaseAddress(goCtx context.Context, msg *types.MsgChange
 	return &types.MsgChangeBaseAddressResponse{}, nil
 }
 
+func (k msgServer) SetBaseRecipients(goCtx context.Context, msg *types.MsgSetBaseRecipients) (*types.MsgSetBaseRecipientsResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if err := k.validateModerator(ctx, msg.ModeratorAddress); err != nil {
+		return nil, err
+	}
+
+	if err := types.ValidateBaseRecipients(msg.Recipients); err != nil {
+		return nil, types.ErrInvalidBaseRecipients.Wrapf("%s", err)
+	}
+
+	k.Keeper.SetBaseRecipients(ctx, msg.Recipients)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeSetBaseRecipients,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(sdk.AttributeKeySender, msg.ModeratorAddress),
+		),
+	)
+
+	return &types.MsgSetBaseRecipientsResponse{}, nil
+}
+
 func (k msgServer) ChangeModerator(goCtx context.Context, msg *types.MsgChangeModerator) (*types.MsgChangeModeratorResponse, error) {
 	ctx := sdk.UnwrapSDKContext(goCtx)
 
-	moderator := k.GetModeratorAddress(ctx)
-	if msg.ModeratorAddress != moderator {
-		return nil, types.ErrInvalidModerator.Wrapf("expected: %s, got: %s", moderator, msg.ModeratorAddress)
+	// the authority can always reassign the moderator, e.g. to recover from a
+	// lost moderator key, so it does not go through the handover
+	if msg.ModeratorAddress == k.authority {
+		k.Keeper.SetModeratorAddress(ctx, msg.NewModeratorAddress)
+		k.Keeper.DeletePendingModeratorAddress(ctx)
+
+		ctx.EventManager().EmitEvent(
+			sdk.NewEvent(
+				types.EventTypeChangeModerator,
+				sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+				sdk.NewAttribute(sdk.AttributeKeySender, msg.ModeratorAddress),
+				sdk.NewAttribute(types.AttributeKeyModerator, msg.NewModeratorAddress),
+			),
+		)
+
+		return &types.MsgChangeModeratorResponse{}, nil
+	}
+
+	if err := k.validateModerator(ctx, msg.ModeratorAddress); err != nil {
+		return nil, err
+	}
+
+	k.Keeper.SetPendingModeratorAddress(ctx, msg.NewModeratorAddress)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeProposeModerator,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(sdk.AttributeKeySender, msg.ModeratorAddress),
+			sdk.NewAttribute(types.AttributeKeyModerator, msg.NewModeratorAddress),
+		),
+	)
+
+	return &types.MsgChangeModeratorResponse{}, nil
+}
+
+func (k msgServer) AcceptModerator(goCtx context.Context, msg *types.MsgAcceptModerator) (*types.MsgAcceptModeratorResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	pending := k.GetPendingModeratorAddress(ctx)
+	if pending == "" {
+		return nil, types.ErrNoPendingModerator
+	}
+	if msg.NewModeratorAddress != pending {
+		return nil, types.ErrInvalidModerator.Wrapf("expected pending moderator: %s, got: %s", pending, msg.NewModeratorAddress)
 	}
 
 	k.Keeper.SetModeratorAddress(ctx, msg.NewModeratorAddress)
+	k.Keeper.DeletePendingModeratorAddress(ctx)
 
 	ctx.EventManager().EmitEvent(
 		sdk.NewEvent(
 			types.EventTypeChangeModerator,
 			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(sdk.AttributeKeySender, msg.NewModeratorAddress),
+			sdk.NewAttribute(types.AttributeKeyModerator, msg.NewModeratorAddress),
+		),
+	)
+
+	return &types.MsgAcceptModeratorResponse{}, nil
+}
+
+func (k msgServer) SetFeeSplitRetention(goCtx context.Context, msg *types.MsgSetFeeSplitRetention) (*types.MsgSetFeeSplitRetentionResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if err := k.validateModerator(ctx, msg.ModeratorAddress); err != nil {
+		return nil, err
+	}
+
+	k.Keeper.SetFeeSplitRetention(ctx, msg.RetentionBlocks)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeSetRetention,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
 			sdk.NewAttribute(sdk.AttributeKeySender, msg.ModeratorAddress),
+			sdk.NewAttribute(types.AttributeKeyRetentionBlocks, strconv.FormatUint(msg.RetentionBlocks, 10)),
 		),
 	)
 
-	return &types.MsgChangeModeratorResponse{}, nil
+	return &types.MsgSetFeeSplitRetentionResponse{}, nil
+}
+
+func (k msgServer) SetDenomRatio(goCtx context.Context, msg *types.MsgSetDenomRatio) (*types.MsgSetDenomRatioResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if err := k.validateModerator(ctx, msg.ModeratorAddress); err != nil {
+		return nil, err
+	}
+
+	change, err := k.Keeper.ScheduleDenomRatio(ctx, msg.ModeratorAddress, msg.Denom, msg.Ratio, msg.ActivationHeight, msg.ActivationTime)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeSetDenomRatio,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(sdk.AttributeKeySender, msg.ModeratorAddress),
+			sdk.NewAttribute(types.AttributeKeyRatioChangeID, strconv.FormatUint(change.Id, 10)),
+			sdk.NewAttribute(types.AttributeKeyActivationHeight, strconv.FormatInt(change.ActivationHeight, 10)),
+			sdk.NewAttribute(types.AttributeKeyActivationTime, change.ActivationTime.String()),
+			sdk.NewAttribute(types.AttributeKeyDenom, msg.Denom),
+			sdk.NewAttribute(types.AttributeKeyStakingRewards, msg.Ratio.StakingRewards.String()),
+			sdk.NewAttribute(types.AttributeKeyBase, msg.Ratio.Base.String()),
+			sdk.NewAttribute(types.AttributeKeyBurn, msg.Ratio.Burn.String()),
+		),
+	)
+
+	return &types.MsgSetDenomRatioResponse{ChangeId: change.Id}, nil
+}
+
+func (k msgServer) RemoveDenomRatio(goCtx context.Context, msg *types.MsgRemoveDenomRatio) (*types.MsgRemoveDenomRatioResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if err := k.validateModerator(ctx, msg.ModeratorAddress); err != nil {
+		return nil, err
+	}
+
+	change, err := k.Keeper.ScheduleDenomRatioRemoval(ctx, msg.ModeratorAddress, msg.Denom, msg.ActivationHeight, msg.ActivationTime)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeRemoveDenomRatio,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(sdk.AttributeKeySender, msg.ModeratorAddress),
+			sdk.NewAttribute(types.AttributeKeyRatioChangeID, strconv.FormatUint(change.Id, 10)),
+			sdk.NewAttribute(types.AttributeKeyActivationHeight, strconv.FormatInt(change.ActivationHeight, 10)),
+			sdk.NewAttribute(types.AttributeKeyActivationTime, change.ActivationTime.String()),
+			sdk.NewAttribute(types.AttributeKeyDenom, msg.Denom),
+		),
+	)
+
+	return &types.MsgRemoveDenomRatioResponse{ChangeId: change.Id}, nil
+}
+
+// validateModerator checks that the given address is the current moderator.
+// The moderator may be a x/group policy account or delegate its role through
+// x/authz, as both execute messages on behalf of the moderator address.
+func (k msgServer) validateModerator(ctx sdk.Context, addr string) error {
+	moderator := k.GetModeratorAddress(ctx)
+	if addr != moderator {
+		return types.ErrInvalidModerator.Wrapf("expected: %s, got: %s", moderator, addr)
+	}
+	return nil
 }
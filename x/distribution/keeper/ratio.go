@@ -0,0 +1,312 @@
+package keeper
+
+import (
+	"strconv"
+	"time"
+
+	gogotypes "github.com/gogo/protobuf/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/x/distribution/types"
+)
+
+// GetNextRatioChangeID returns the id that will be assigned to the next
+// scheduled ratio change.
+func (k Keeper) GetNextRatioChangeID(ctx sdk.Context) uint64 {
+	store := ctx.KVStore(k.storeKey)
+	b := store.Get(types.NextRatioChangeIDKey)
+	if b == nil {
+		return 1
+	}
+
+	var id gogotypes.UInt64Value
+	k.cdc.MustUnmarshal(b, &id)
+	return id.GetValue()
+}
+
+// SetNextRatioChangeID sets the id that will be assigned to the next scheduled
+// ratio change.
+func (k Keeper) SetNextRatioChangeID(ctx sdk.Context, id uint64) {
+	store := ctx.KVStore(k.storeKey)
+	b := k.cdc.MustMarshal(&gogotypes.UInt64Value{Value: id})
+	store.Set(types.NextRatioChangeIDKey, b)
+}
+
+// GetRatioChange returns a pending ratio change by id.
+func (k Keeper) GetRatioChange(ctx sdk.Context, id uint64) (change types.RatioChange, found bool) {
+	store := ctx.KVStore(k.storeKey)
+	b := store.Get(types.GetRatioChangeKey(id))
+	if b == nil {
+		return change, false
+	}
+
+	k.cdc.MustUnmarshal(b, &change)
+	return change, true
+}
+
+// SetRatioChange stores a pending ratio change.
+func (k Keeper) SetRatioChange(ctx sdk.Context, change types.RatioChange) {
+	store := ctx.KVStore(k.storeKey)
+	b := k.cdc.MustMarshal(&change)
+	store.Set(types.GetRatioChangeKey(change.Id), b)
+}
+
+// DeleteRatioChange removes a pending ratio change.
+func (k Keeper) DeleteRatioChange(ctx sdk.Context, id uint64) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.GetRatioChangeKey(id))
+}
+
+// IterateRatioChanges iterates over the pending ratio changes in id order.
+func (k Keeper) IterateRatioChanges(ctx sdk.Context, handler func(change types.RatioChange) (stop bool)) {
+	store := ctx.KVStore(k.storeKey)
+	iter := sdk.KVStorePrefixIterator(store, types.RatioChangePrefix)
+	defer iter.Close()
+	for ; iter.Valid(); iter.Next() {
+		var change types.RatioChange
+		k.cdc.MustUnmarshal(iter.Value(), &change)
+		if handler(change) {
+			break
+		}
+	}
+}
+
+// SetRatioHistoryEntry stores a ratio that became effective.
+func (k Keeper) SetRatioHistoryEntry(ctx sdk.Context, entry types.RatioHistoryEntry) {
+	store := ctx.KVStore(k.storeKey)
+	b := k.cdc.MustMarshal(&entry)
+	store.Set(types.GetRatioHistoryKey(entry.Height, entry.ChangeId), b)
+}
+
+// IterateRatioHistory iterates over the ratios that became effective in
+// chronological order.
+func (k Keeper) IterateRatioHistory(ctx sdk.Context, handler func(entry types.RatioHistoryEntry) (stop bool)) {
+	store := ctx.KVStore(k.storeKey)
+	iter := sdk.KVStorePrefixIterator(store, types.RatioHistoryPrefix)
+	defer iter.Close()
+	for ; iter.Valid(); iter.Next() {
+		var entry types.RatioHistoryEntry
+		k.cdc.MustUnmarshal(iter.Value(), &entry)
+		if handler(entry) {
+			break
+		}
+	}
+}
+
+// ScheduleRatioChange queues a new ratio to be applied at the given activation
+// height or time. If neither is set, the ratio is applied at the beginning of
+// the next block.
+func (k Keeper) ScheduleRatioChange(
+	ctx sdk.Context, moderator string, ratio types.Ratio, activationHeight int64, activationTime time.Time,
+) (types.RatioChange, error) {
+	if err := ratio.ValidateRatio(); err != nil {
+		return types.RatioChange{}, types.ErrInvalidRatio.Wrapf("%s", err)
+	}
+
+	return k.scheduleRatioChange(ctx, types.RatioChange{
+		Ratio:            ratio,
+		ModeratorAddress: moderator,
+	}, activationHeight, activationTime)
+}
+
+// ScheduleDenomRatio queues a ratio override for the fees collected in a denom
+// to be applied at the given activation height or time. If neither is set, the
+// override is applied at the beginning of the next block.
+func (k Keeper) ScheduleDenomRatio(
+	ctx sdk.Context, moderator, denom string, ratio types.Ratio, activationHeight int64, activationTime time.Time,
+) (types.RatioChange, error) {
+	if err := sdk.ValidateDenom(denom); err != nil {
+		return types.RatioChange{}, sdkerrors.ErrInvalidCoins.Wrapf("%s", err)
+	}
+	if err := ratio.ValidateRatio(); err != nil {
+		return types.RatioChange{}, types.ErrInvalidRatio.Wrapf("%s", err)
+	}
+
+	return k.scheduleRatioChange(ctx, types.RatioChange{
+		Ratio:            ratio,
+		ModeratorAddress: moderator,
+		Denom:            denom,
+	}, activationHeight, activationTime)
+}
+
+// ScheduleDenomRatioRemoval queues the removal of the ratio override of a
+// denom at the given activation height or time. If neither is set, the
+// override is removed at the beginning of the next block. The denom must have
+// an override, either effective or pending.
+func (k Keeper) ScheduleDenomRatioRemoval(
+	ctx sdk.Context, moderator, denom string, activationHeight int64, activationTime time.Time,
+) (types.RatioChange, error) {
+	_, found := k.GetDenomRatio(ctx, denom)
+	if !found {
+		k.IterateRatioChanges(ctx, func(change types.RatioChange) bool {
+			found = change.Denom == denom && !change.RemoveDenomRatio
+			return found
+		})
+	}
+	if !found {
+		return types.RatioChange{}, types.ErrInvalidRatio.Wrapf("no ratio set for denom %s", denom)
+	}
+
+	return k.scheduleRatioChange(ctx, types.RatioChange{
+		Ratio:            types.ZeroRatio(),
+		ModeratorAddress: moderator,
+		Denom:            denom,
+		RemoveDenomRatio: true,
+	}, activationHeight, activationTime)
+}
+
+// scheduleRatioChange validates the activation of a ratio change, assigns it
+// the next id and stores it.
+func (k Keeper) scheduleRatioChange(
+	ctx sdk.Context, change types.RatioChange, activationHeight int64, activationTime time.Time,
+) (types.RatioChange, error) {
+	switch {
+	case activationHeight > 0 && !activationTime.IsZero():
+		return types.RatioChange{}, types.ErrInvalidRatioActivation.Wrap("only one of activation height and activation time can be set")
+	case activationHeight > 0 && activationHeight <= ctx.BlockHeight():
+		return types.RatioChange{}, types.ErrInvalidRatioActivation.Wrapf(
+			"activation height %d must be greater than the current height %d", activationHeight, ctx.BlockHeight(),
+		)
+	case !activationTime.IsZero() && !activationTime.After(ctx.BlockTime()):
+		return types.RatioChange{}, types.ErrInvalidRatioActivation.Wrapf(
+			"activation time %s must be after the current block time %s", activationTime, ctx.BlockTime(),
+		)
+	case activationHeight == 0 && activationTime.IsZero():
+		activationHeight = ctx.BlockHeight() + 1
+	}
+
+	change.Id = k.GetNextRatioChangeID(ctx)
+	change.ActivationHeight = activationHeight
+	change.ActivationTime = activationTime
+	change.SubmitHeight = ctx.BlockHeight()
+
+	k.SetRatioChange(ctx, change)
+	k.SetNextRatioChangeID(ctx, change.Id+1)
+
+	return change, nil
+}
+
+// CancelRatioChange removes a pending ratio change before it is applied.
+func (k Keeper) CancelRatioChange(ctx sdk.Context, id uint64) error {
+	if _, found := k.GetRatioChange(ctx, id); !found {
+		return types.ErrRatioChangeNotFound.Wrapf("id %d", id)
+	}
+
+	k.DeleteRatioChange(ctx, id)
+	return nil
+}
+
+// ApplyRatioChanges applies every pending ratio change that is due at the
+// current block. Changes due in the same block are applied in id order, so the
+// most recently scheduled one wins.
+func (k Keeper) ApplyRatioChanges(ctx sdk.Context) {
+	var due []types.RatioChange
+	k.IterateRatioChanges(ctx, func(change types.RatioChange) bool {
+		if change.IsDue(ctx.BlockHeight(), ctx.BlockTime()) {
+			due = append(due, change)
+		}
+		return false
+	})
+
+	for _, change := range due {
+		k.DeleteRatioChange(ctx, change.Id)
+
+		attrs := []sdk.Attribute{
+			sdk.NewAttribute(types.AttributeKeyRatioChangeID, strconv.FormatUint(change.Id, 10)),
+		}
+		switch {
+		case change.Denom == "":
+			k.applyRatio(ctx, change.Id, change.Ratio)
+		case change.RemoveDenomRatio:
+			k.DeleteDenomRatio(ctx, change.Denom)
+			k.SetRatioHistoryEntry(ctx, types.RatioHistoryEntry{
+				ChangeId:          change.Id,
+				Ratio:             change.Ratio,
+				Height:            ctx.BlockHeight(),
+				Time:              ctx.BlockTime(),
+				Denom:             change.Denom,
+				DenomRatioRemoved: true,
+			})
+		default:
+			k.SetDenomRatio(ctx, change.Denom, change.Ratio)
+			k.SetRatioHistoryEntry(ctx, types.RatioHistoryEntry{
+				ChangeId: change.Id,
+				Ratio:    change.Ratio,
+				Height:   ctx.BlockHeight(),
+				Time:     ctx.BlockTime(),
+				Denom:    change.Denom,
+			})
+		}
+
+		if change.Denom != "" {
+			attrs = append(attrs, sdk.NewAttribute(types.AttributeKeyDenom, change.Denom))
+		}
+		if change.RemoveDenomRatio {
+			attrs = append(attrs, sdk.NewAttribute(types.AttributeKeyRemoveDenomRatio, "true"))
+		} else {
+			attrs = append(attrs,
+				sdk.NewAttribute(types.AttributeKeyStakingRewards, change.Ratio.StakingRewards.String()),
+				sdk.NewAttribute(types.AttributeKeyBase, change.Ratio.Base.String()),
+				sdk.NewAttribute(types.AttributeKeyBurn, change.Ratio.Burn.String()),
+			)
+		}
+
+		ctx.EventManager().EmitEvent(sdk.NewEvent(types.EventTypeApplyRatioChange, attrs...))
+	}
+}
+
+// applyRatio makes the given ratio effective and records it in the ratio
+// history.
+func (k Keeper) applyRatio(ctx sdk.Context, changeID uint64, ratio types.Ratio) {
+	k.SetRatio(ctx, ratio)
+	k.SetRatioHistoryEntry(ctx, types.RatioHistoryEntry{
+		ChangeId: changeID,
+		Ratio:    ratio,
+		Height:   ctx.BlockHeight(),
+		Time:     ctx.BlockTime(),
+	})
+}
+
+// GetDenomRatio returns the ratio override of a denom.
+func (k Keeper) GetDenomRatio(ctx sdk.Context, denom string) (ratio types.Ratio, found bool) {
+	store := ctx.KVStore(k.storeKey)
+	b := store.Get(types.GetDenomRatioKey(denom))
+	if b == nil {
+		return ratio, false
+	}
+
+	k.cdc.MustUnmarshal(b, &ratio)
+	return ratio, true
+}
+
+// SetDenomRatio overrides the default ratio for the fees collected in a denom.
+// The moderator schedules overrides with ScheduleDenomRatio, this setter is
+// applied by ApplyRatioChanges and InitGenesis.
+func (k Keeper) SetDenomRatio(ctx sdk.Context, denom string, ratio types.Ratio) {
+	store := ctx.KVStore(k.storeKey)
+	b := k.cdc.MustMarshal(&ratio)
+	store.Set(types.GetDenomRatioKey(denom), b)
+}
+
+// DeleteDenomRatio removes the ratio override of a denom.
+func (k Keeper) DeleteDenomRatio(ctx sdk.Context, denom string) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.GetDenomRatioKey(denom))
+}
+
+// GetAllDenomRatios returns all the ratio overrides ordered by denom.
+func (k Keeper) GetAllDenomRatios(ctx sdk.Context) []types.DenomRatio {
+	store := ctx.KVStore(k.storeKey)
+	iter := sdk.KVStorePrefixIterator(store, types.DenomRatioPrefix)
+	defer iter.Close()
+
+	denomRatios := make([]types.DenomRatio, 0)
+	for ; iter.Valid(); iter.Next() {
+		var ratio types.Ratio
+		k.cdc.MustUnmarshal(iter.Value(), &ratio)
+		denom := string(iter.Key()[len(types.DenomRatioPrefix):])
+		denomRatios = append(denomRatios, types.DenomRatio{Denom: denom, Ratio: ratio})
+	}
+	return denomRatios
+}
@@ -0,0 +1,185 @@
+package keeper_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+
+	"github.com/cosmos/cosmos-sdk/simapp"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/distribution/keeper"
+	"github.com/cosmos/cosmos-sdk/x/distribution/types"
+)
+
+func TestScheduleRatioChange(t *testing.T) {
+	app := simapp.Setup(t, false)
+	ctx := app.BaseApp.NewContext(false, tmproto.Header{Height: 10, Time: time.Unix(1000, 0)})
+
+	moderator := app.DistrKeeper.GetModeratorAddress(ctx)
+	initial := app.DistrKeeper.GetRatio(ctx)
+	ratio := types.Ratio{StakingRewards: sdk.NewDecWithPrec(5, 1), Base: sdk.NewDecWithPrec(3, 1), Burn: sdk.NewDecWithPrec(2, 1)}
+
+	// activation in the past is rejected
+	_, err := app.DistrKeeper.ScheduleRatioChange(ctx, moderator, ratio, 10, time.Time{})
+	require.ErrorIs(t, err, types.ErrInvalidRatioActivation)
+	_, err = app.DistrKeeper.ScheduleRatioChange(ctx, moderator, ratio, 0, time.Unix(1000, 0))
+	require.ErrorIs(t, err, types.ErrInvalidRatioActivation)
+
+	// no activation defaults to the next block
+	change, err := app.DistrKeeper.ScheduleRatioChange(ctx, moderator, ratio, 0, time.Time{})
+	require.NoError(t, err)
+	require.Equal(t, uint64(1), change.Id)
+	require.Equal(t, int64(11), change.ActivationHeight)
+
+	byTime, err := app.DistrKeeper.ScheduleRatioChange(ctx, moderator, types.InitialRatio(), 0, time.Unix(2000, 0))
+	require.NoError(t, err)
+	require.Equal(t, uint64(2), byTime.Id)
+
+	// nothing is applied before activation
+	app.DistrKeeper.ApplyRatioChanges(ctx)
+	require.Equal(t, initial, app.DistrKeeper.GetRatio(ctx))
+
+	ctx = ctx.WithBlockHeight(11).WithBlockTime(time.Unix(1500, 0))
+	app.DistrKeeper.ApplyRatioChanges(ctx)
+	require.Equal(t, ratio, app.DistrKeeper.GetRatio(ctx))
+	_, found := app.DistrKeeper.GetRatioChange(ctx, change.Id)
+	require.False(t, found)
+
+	ctx = ctx.WithBlockHeight(12).WithBlockTime(time.Unix(2000, 0))
+	app.DistrKeeper.ApplyRatioChanges(ctx)
+	require.Equal(t, types.InitialRatio(), app.DistrKeeper.GetRatio(ctx))
+
+	// the history holds the genesis ratio and both applied changes
+	var history []types.RatioHistoryEntry
+	app.DistrKeeper.IterateRatioHistory(ctx, func(entry types.RatioHistoryEntry) bool {
+		history = append(history, entry)
+		return false
+	})
+	require.Len(t, history, 3)
+	require.Equal(t, uint64(0), history[0].ChangeId)
+	require.Equal(t, change.Id, history[1].ChangeId)
+	require.Equal(t, int64(11), history[1].Height)
+	require.Equal(t, byTime.Id, history[2].ChangeId)
+	require.Equal(t, int64(12), history[2].Height)
+}
+
+func TestCancelRatioChange(t *testing.T) {
+	app := simapp.Setup(t, false)
+	ctx := app.BaseApp.NewContext(false, tmproto.Header{Height: 10})
+	msgServer := keeper.NewMsgServerImpl(app.DistrKeeper)
+
+	moderator := app.DistrKeeper.GetModeratorAddress(ctx)
+	initial := app.DistrKeeper.GetRatio(ctx)
+	ratio := types.Ratio{StakingRewards: sdk.OneDec(), Base: sdk.ZeroDec(), Burn: sdk.ZeroDec()}
+
+	res, err := msgServer.ChangeRatio(sdk.WrapSDKContext(ctx), &types.MsgChangeRatio{
+		ModeratorAddress: moderator,
+		Ratio:            ratio,
+		ActivationHeight: 20,
+	})
+	require.NoError(t, err)
+
+	pending, err := app.DistrKeeper.PendingRatioChanges(sdk.WrapSDKContext(ctx), &types.QueryPendingRatioChangesRequest{})
+	require.NoError(t, err)
+	require.Len(t, pending.Changes, 1)
+	require.Equal(t, res.ChangeId, pending.Changes[0].Id)
+
+	// only the moderator can cancel
+	_, err = msgServer.CancelRatioChange(sdk.WrapSDKContext(ctx), &types.MsgCancelRatioChange{
+		ModeratorAddress: sdk.AccAddress("not_the_moderator___").String(),
+		ChangeId:         res.ChangeId,
+	})
+	require.ErrorIs(t, err, types.ErrInvalidModerator)
+
+	_, err = msgServer.CancelRatioChange(sdk.WrapSDKContext(ctx), &types.MsgCancelRatioChange{
+		ModeratorAddress: moderator,
+		ChangeId:         res.ChangeId,
+	})
+	require.NoError(t, err)
+
+	_, err = msgServer.CancelRatioChange(sdk.WrapSDKContext(ctx), &types.MsgCancelRatioChange{
+		ModeratorAddress: moderator,
+		ChangeId:         res.ChangeId,
+	})
+	require.ErrorIs(t, err, types.ErrRatioChangeNotFound)
+
+	app.DistrKeeper.ApplyRatioChanges(ctx.WithBlockHeight(20))
+	require.Equal(t, initial, app.DistrKeeper.GetRatio(ctx))
+}
+
+func TestDenomRatios(t *testing.T) {
+	app := simapp.Setup(t, false)
+	ctx := app.BaseApp.NewContext(false, tmproto.Header{Height: 10, Time: time.Unix(1000, 0)})
+	msgServer := keeper.NewMsgServerImpl(app.DistrKeeper)
+
+	moderator := sdk.MustAccAddressFromBech32(app.DistrKeeper.GetModeratorAddress(ctx))
+	ratio := types.Ratio{StakingRewards: sdk.NewDecWithPrec(5, 1), Base: sdk.NewDecWithPrec(5, 1), Burn: sdk.ZeroDec()}
+
+	_, err := msgServer.RemoveDenomRatio(sdk.WrapSDKContext(ctx), types.NewMsgRemoveDenomRatio(moderator, "ibcdenom"))
+	require.ErrorIs(t, err, types.ErrInvalidRatio)
+
+	// the override is only applied at its activation height
+	msg := types.NewMsgSetDenomRatio(moderator, "ibcdenom", ratio)
+	msg.ActivationHeight = 12
+	setRes, err := msgServer.SetDenomRatio(sdk.WrapSDKContext(ctx), msg)
+	require.NoError(t, err)
+
+	res, err := app.DistrKeeper.Ratio(sdk.WrapSDKContext(ctx), &types.QueryRatioRequest{})
+	require.NoError(t, err)
+	require.Empty(t, res.DenomRatios)
+
+	change, found := app.DistrKeeper.GetRatioChange(ctx, setRes.ChangeId)
+	require.True(t, found)
+	require.Equal(t, "ibcdenom", change.Denom)
+	require.Equal(t, int64(12), change.ActivationHeight)
+
+	// the pending override can be removed before it is applied
+	removeRes, err := msgServer.RemoveDenomRatio(sdk.WrapSDKContext(ctx), types.NewMsgRemoveDenomRatio(moderator, "ibcdenom"))
+	require.NoError(t, err)
+	_, err = msgServer.CancelRatioChange(sdk.WrapSDKContext(ctx), types.NewMsgCancelRatioChange(moderator, removeRes.ChangeId))
+	require.NoError(t, err)
+
+	ctx = ctx.WithBlockHeight(11)
+	app.DistrKeeper.ApplyRatioChanges(ctx)
+	require.Empty(t, app.DistrKeeper.GetAllDenomRatios(ctx))
+
+	ctx = ctx.WithBlockHeight(12)
+	app.DistrKeeper.ApplyRatioChanges(ctx)
+	res, err = app.DistrKeeper.Ratio(sdk.WrapSDKContext(ctx), &types.QueryRatioRequest{})
+	require.NoError(t, err)
+	require.Equal(t, app.DistrKeeper.GetRatio(ctx), res.Ratio)
+	require.Equal(t, []types.DenomRatio{{Denom: "ibcdenom", Ratio: ratio}}, res.DenomRatios)
+
+	// the removal is scheduled by time
+	removeMsg := types.NewMsgRemoveDenomRatio(moderator, "ibcdenom")
+	removeMsg.ActivationTime = time.Unix(2000, 0)
+	removeRes, err = msgServer.RemoveDenomRatio(sdk.WrapSDKContext(ctx), removeMsg)
+	require.NoError(t, err)
+
+	ctx = ctx.WithBlockHeight(13).WithBlockTime(time.Unix(1500, 0))
+	app.DistrKeeper.ApplyRatioChanges(ctx)
+	require.Len(t, app.DistrKeeper.GetAllDenomRatios(ctx), 1)
+
+	ctx = ctx.WithBlockHeight(14).WithBlockTime(time.Unix(2000, 0))
+	app.DistrKeeper.ApplyRatioChanges(ctx)
+	require.Empty(t, app.DistrKeeper.GetAllDenomRatios(ctx))
+
+	// the history holds the genesis ratio, the override and its removal
+	var history []types.RatioHistoryEntry
+	app.DistrKeeper.IterateRatioHistory(ctx, func(entry types.RatioHistoryEntry) bool {
+		history = append(history, entry)
+		return false
+	})
+	require.Len(t, history, 3)
+	require.Equal(t, "", history[0].Denom)
+	require.Equal(t, setRes.ChangeId, history[1].ChangeId)
+	require.Equal(t, "ibcdenom", history[1].Denom)
+	require.Equal(t, ratio, history[1].Ratio)
+	require.False(t, history[1].DenomRatioRemoved)
+	require.Equal(t, removeRes.ChangeId, history[2].ChangeId)
+	require.Equal(t, "ibcdenom", history[2].Denom)
+	require.True(t, history[2].DenomRatioRemoved)
+	require.Equal(t, int64(14), history[2].Height)
+}
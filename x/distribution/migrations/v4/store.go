@@ -0,0 +1,35 @@
+package v4
+
+import (
+	"github.com/cosmos/cosmos-sdk/codec"
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/distribution/types"
+)
+
+// MigrateStore performs in-place store migrations from version 3 to 4. The
+// migration includes:
+//
+// - Replace the single base address with a weighted list of base recipients
+// holding the base address as only recipient.
+func MigrateStore(ctx sdk.Context, storeKey storetypes.StoreKey, cdc codec.BinaryCodec) error {
+	store := ctx.KVStore(storeKey)
+
+	bz := store.Get(types.BaseAddrKey)
+	if len(bz) == 0 {
+		return nil
+	}
+
+	baseAddr := string(bz)
+	if _, err := sdk.AccAddressFromBech32(baseAddr); err != nil {
+		return err
+	}
+
+	recipients := types.BaseRecipients{
+		Recipients: []types.BaseRecipient{{Address: baseAddr, Weight: sdk.OneDec()}},
+	}
+	store.Set(types.BaseRecipientsKey, cdc.MustMarshal(&recipients))
+	store.Delete(types.BaseAddrKey)
+
+	return nil
+}
@@ -0,0 +1,32 @@
+package v4_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/cosmos-sdk/simapp"
+	"github.com/cosmos/cosmos-sdk/testutil"
+	"github.com/cosmos/cosmos-sdk/testutil/testdata"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	v4distribution "github.com/cosmos/cosmos-sdk/x/distribution/migrations/v4"
+	"github.com/cosmos/cosmos-sdk/x/distribution/types"
+)
+
+func TestStoreMigration(t *testing.T) {
+	cdc := simapp.MakeTestEncodingConfig().Codec
+	distributionKey := sdk.NewKVStoreKey("distribution")
+	ctx := testutil.DefaultContext(distributionKey, sdk.NewTransientStoreKey("transient_test"))
+	store := ctx.KVStore(distributionKey)
+
+	_, _, addr := testdata.KeyTestPubAddr()
+	store.Set(types.BaseAddrKey, []byte(addr.String()))
+
+	require.NoError(t, v4distribution.MigrateStore(ctx, distributionKey, cdc))
+
+	require.Nil(t, store.Get(types.BaseAddrKey))
+
+	var recipients types.BaseRecipients
+	cdc.MustUnmarshal(store.Get(types.BaseRecipientsKey), &recipients)
+	require.Equal(t, []types.BaseRecipient{{Address: addr.String(), Weight: sdk.OneDec()}}, recipients.Recipients)
+}
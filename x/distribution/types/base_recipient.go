@@ -0,0 +1,74 @@
+package types
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"sigs.k8s.io/yaml"
+)
+
+// NewBaseRecipient creates a new BaseRecipient instance
+func NewBaseRecipient(addr sdk.AccAddress, weight sdk.Dec) BaseRecipient {
+	return BaseRecipient{
+		Address: addr.String(),
+		Weight:  weight,
+	}
+}
+
+func (r BaseRecipient) String() string {
+	out, _ := yaml.Marshal(r)
+	return string(out)
+}
+
+// ValidateBaseRecipients checks that the base recipients are unique valid
+// addresses with positive weights summing up to one.
+func ValidateBaseRecipients(recipients []BaseRecipient) error {
+	if len(recipients) == 0 {
+		return fmt.Errorf("base recipients cannot be empty")
+	}
+
+	seen := make(map[string]bool, len(recipients))
+	sum := sdk.ZeroDec()
+	for _, r := range recipients {
+		if _, err := sdk.AccAddressFromBech32(r.Address); err != nil {
+			return fmt.Errorf("invalid base recipient address %s: %w", r.Address, err)
+		}
+		if seen[r.Address] {
+			return fmt.Errorf("duplicate base recipient %s", r.Address)
+		}
+		seen[r.Address] = true
+
+		if r.Weight.IsNil() || !r.Weight.IsPositive() {
+			return fmt.Errorf("base recipient %s weight must be positive, is %v", r.Address, r.Weight)
+		}
+		sum = sum.Add(r.Weight)
+	}
+
+	if !sum.Equal(sdk.OneDec()) {
+		return fmt.Errorf("the base recipient weights should sum up to be 1.0, is %v", sum)
+	}
+
+	return nil
+}
+
+// SplitBaseFee splits the base fee between the recipients according to their
+// weights. The last recipient receives the truncation remainder, so the sum of
+// the shares always equals the base fee.
+func SplitBaseFee(baseFee sdk.Coins, recipients []BaseRecipient) []sdk.Coins {
+	shares := make([]sdk.Coins, len(recipients))
+	remaining := baseFee
+	for i, r := range recipients {
+		if i == len(recipients)-1 {
+			shares[i] = remaining
+			break
+		}
+
+		var share sdk.Coins
+		for _, coin := range baseFee {
+			share = share.Add(sdk.NewCoin(coin.Denom, r.Weight.MulInt(coin.Amount).TruncateInt()))
+		}
+		shares[i] = share
+		remaining = remaining.Sub(share...)
+	}
+	return shares
+}
@@ -0,0 +1,50 @@
+package types
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+func TestValidateBaseRecipients(t *testing.T) {
+	half := sdk.NewDecWithPrec(5, 1)
+	tests := []struct {
+		name       string
+		recipients []BaseRecipient
+		expectPass bool
+	}{
+		{"single recipient", []BaseRecipient{NewBaseRecipient(delAddr1, sdk.OneDec())}, true},
+		{"two recipients", []BaseRecipient{NewBaseRecipient(delAddr1, half), NewBaseRecipient(delAddr2, half)}, true},
+		{"empty", []BaseRecipient{}, false},
+		{"invalid address", []BaseRecipient{{Address: "invalid", Weight: sdk.OneDec()}}, false},
+		{"duplicate address", []BaseRecipient{NewBaseRecipient(delAddr1, half), NewBaseRecipient(delAddr1, half)}, false},
+		{"zero weight", []BaseRecipient{NewBaseRecipient(delAddr1, sdk.OneDec()), NewBaseRecipient(delAddr2, sdk.ZeroDec())}, false},
+		{"weights below one", []BaseRecipient{NewBaseRecipient(delAddr1, half)}, false},
+		{"weights above one", []BaseRecipient{NewBaseRecipient(delAddr1, sdk.OneDec()), NewBaseRecipient(delAddr2, half)}, false},
+	}
+	for _, tc := range tests {
+		err := ValidateBaseRecipients(tc.recipients)
+		if tc.expectPass {
+			require.NoError(t, err, tc.name)
+		} else {
+			require.Error(t, err, tc.name)
+		}
+	}
+}
+
+func TestSplitBaseFee(t *testing.T) {
+	third := sdk.OneDec().QuoInt64(3)
+	recipients := []BaseRecipient{
+		NewBaseRecipient(delAddr1, third),
+		NewBaseRecipient(delAddr2, third),
+		NewBaseRecipient(delAddr3, sdk.OneDec().Sub(third).Sub(third)),
+	}
+	baseFee := sdk.NewCoins(sdk.NewInt64Coin("stake", 100), sdk.NewInt64Coin("uatom", 2))
+
+	shares := SplitBaseFee(baseFee, recipients)
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin("stake", 33)), shares[0])
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin("stake", 33)), shares[1])
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin("stake", 34), sdk.NewInt64Coin("uatom", 2)), shares[2])
+}
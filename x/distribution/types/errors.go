@@ -20,4 +20,10 @@ var (
 	ErrNoDelegationExists      = sdkerrors.Register(ModuleName, 13, "delegation does not exist")
 	ErrInvalidRatio            = sdkerrors.Register(ModuleName, 14, "invalid ratio")
 	ErrInvalidModerator        = sdkerrors.Register(ModuleName, 15, "only moderator is allowed for this msg")
+	ErrInvalidRatioActivation  = sdkerrors.Register(ModuleName, 16, "invalid ratio change activation")
+	ErrRatioChangeNotFound     = sdkerrors.Register(ModuleName, 17, "ratio change not found")
+	ErrInvalidBaseRecipients   = sdkerrors.Register(ModuleName, 18, "invalid base recipients")
+	ErrNoPendingModerator      = sdkerrors.Register(ModuleName, 19, "no pending moderator")
+	ErrNoFeeSplitSnapshot      = sdkerrors.Register(ModuleName, 20, "fee split snapshot not found")
+	ErrNoShareRecords          = sdkerrors.Register(ModuleName, 21, "no tokenize share records owned")
 )
@@ -2,22 +2,42 @@ package types
 
 // distribution module event types
 const (
-	EventTypeSetWithdrawAddress = "set_withdraw_address"
-	EventTypeRewards            = "rewards"
-	EventTypeCommission         = "commission"
-	EventTypeWithdrawRewards    = "withdraw_rewards"
-	EventTypeWithdrawCommission = "withdraw_commission"
-	EventTypeProposerReward     = "proposer_reward"
-	EventTypeChangeRatio        = "change_ratio"
-	EventTypeChangeBaseAddress  = "change_base_address"
-	EventTypeChangeModerator    = "change_moderator"
-	EventTypeBurnFee            = "burn_fee"
-	EventTypeBaseFee            = "base_fee"
-	EventTypeStakingRewards     = "staking_rewards"
-	EventTypeStakingFee         = "staking_fee"
+	EventTypeSetWithdrawAddress        = "set_withdraw_address"
+	EventTypeRewards                   = "rewards"
+	EventTypeCommission                = "commission"
+	EventTypeWithdrawRewards           = "withdraw_rewards"
+	EventTypeWithdrawCommission        = "withdraw_commission"
+	EventTypeProposerReward            = "proposer_reward"
+	EventTypeChangeRatio               = "change_ratio"
+	EventTypeCancelRatioChange         = "cancel_ratio_change"
+	EventTypeApplyRatioChange          = "apply_ratio_change"
+	EventTypeSetDenomRatio             = "set_denom_ratio"
+	EventTypeRemoveDenomRatio          = "remove_denom_ratio"
+	EventTypeChangeBaseAddress         = "change_base_address"
+	EventTypeSetBaseRecipients         = "set_base_recipients"
+	EventTypeChangeModerator           = "change_moderator"
+	EventTypeProposeModerator          = "propose_moderator"
+	EventTypeSetRetention              = "set_fee_split_retention"
+	EventTypeBurnFee                   = "burn_fee"
+	EventTypeBaseFee                   = "base_fee"
+	EventTypeStakingRewards            = "staking_rewards"
+	EventTypeStakingFee                = "staking_fee"
+	EventTypeWithdrawShareRecordReward = "withdraw_share_record_reward"
 
-	AttributeKeyWithdrawAddress = "withdraw_address"
-	AttributeKeyValidator       = "validator"
-	AttributeKeyDelegator       = "delegator"
-	AttributeValueCategory      = ModuleName
+	AttributeKeyWithdrawAddress  = "withdraw_address"
+	AttributeKeyValidator        = "validator"
+	AttributeKeyDelegator        = "delegator"
+	AttributeKeyRatioChangeID    = "ratio_change_id"
+	AttributeKeyActivationHeight = "activation_height"
+	AttributeKeyActivationTime   = "activation_time"
+	AttributeKeyStakingRewards   = "staking_rewards"
+	AttributeKeyBase             = "base"
+	AttributeKeyBurn             = "burn"
+	AttributeKeyRecipient        = "recipient"
+	AttributeKeyModerator        = "moderator"
+	AttributeKeyRetentionBlocks  = "retention_blocks"
+	AttributeKeyDenom            = "denom"
+	AttributeKeyRemoveDenomRatio = "remove_denom_ratio"
+	AttributeKeyOwner            = "owner"
+	AttributeValueCategory       = ModuleName
 )
@@ -0,0 +1,66 @@
+package types
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// DefaultFeeSplitRetentionBlocks is the default number of blocks for which fee
+// split snapshots are kept.
+const DefaultFeeSplitRetentionBlocks uint64 = 10000
+
+// NewFeeSplit creates a new FeeSplit instance
+func NewFeeSplit(burned, base, rewards sdk.Coins) FeeSplit {
+	return FeeSplit{
+		Burned:  burned,
+		Base:    base,
+		Rewards: rewards,
+	}
+}
+
+// Add returns the sum of both fee splits.
+func (fs FeeSplit) Add(other FeeSplit) FeeSplit {
+	return FeeSplit{
+		Burned:  fs.Burned.Add(other.Burned...),
+		Base:    fs.Base.Add(other.Base...),
+		Rewards: fs.Rewards.Add(other.Rewards...),
+	}
+}
+
+// IsZero returns true if no fees were split.
+func (fs FeeSplit) IsZero() bool {
+	return fs.Burned.IsZero() && fs.Base.IsZero() && fs.Rewards.IsZero()
+}
+
+// Validate performs a basic validation of the fee split amounts.
+func (fs FeeSplit) Validate() error {
+	if err := fs.Burned.Validate(); err != nil {
+		return fmt.Errorf("invalid burned amount: %w", err)
+	}
+	if err := fs.Base.Validate(); err != nil {
+		return fmt.Errorf("invalid base amount: %w", err)
+	}
+	if err := fs.Rewards.Validate(); err != nil {
+		return fmt.Errorf("invalid rewards amount: %w", err)
+	}
+	return nil
+}
+
+// validateFeeSplitSnapshots validates the fee split snapshots for genesis state
+func validateFeeSplitSnapshots(snapshots []FeeSplitSnapshot) error {
+	seen := make(map[int64]bool, len(snapshots))
+	for _, snapshot := range snapshots {
+		if snapshot.Height <= 0 {
+			return fmt.Errorf("fee split snapshot height must be positive, is %d", snapshot.Height)
+		}
+		if seen[snapshot.Height] {
+			return fmt.Errorf("duplicate fee split snapshot at height %d", snapshot.Height)
+		}
+		if err := snapshot.FeeSplit.Validate(); err != nil {
+			return fmt.Errorf("invalid fee split snapshot at height %d: %w", snapshot.Height, err)
+		}
+		seen[snapshot.Height] = true
+	}
+	return nil
+}
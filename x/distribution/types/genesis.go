@@ -1,6 +1,8 @@
 package types
 
 import (
+	"fmt"
+
 	errorsmod "cosmossdk.io/errors"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
@@ -11,7 +13,9 @@ func NewGenesisState(
 	params Params, fp FeePool, dwis []DelegatorWithdrawInfo, pp sdk.ConsAddress, r []ValidatorOutstandingRewardsRecord,
 	acc []ValidatorAccumulatedCommissionRecord, historical []ValidatorHistoricalRewardsRecord,
 	cur []ValidatorCurrentRewardsRecord, dels []DelegatorStartingInfoRecord, slashes []ValidatorSlashEventRecord,
-	ratio Ratio, base_addr, moderator string,
+	ratio Ratio, baseRecipients []BaseRecipient, moderator string, pendingModerator string, pendingRatioChanges []RatioChange, ratioHistory []RatioHistoryEntry,
+	nextRatioChangeID uint64, feeSplitTotals FeeSplit, feeSplitSnapshots []FeeSplitSnapshot, feeSplitRetentionBlocks uint64,
+	denomRatios []DenomRatio,
 ) *GenesisState {
 	return &GenesisState{
 		Params:                          params,
@@ -25,8 +29,16 @@ func NewGenesisState(
 		DelegatorStartingInfos:          dels,
 		ValidatorSlashEvents:            slashes,
 		Ratio:                           ratio,
-		BaseAddress:                     base_addr,
+		BaseRecipients:                  baseRecipients,
 		ModeratorAddress:                moderator,
+		PendingModeratorAddress:         pendingModerator,
+		PendingRatioChanges:             pendingRatioChanges,
+		RatioHistory:                    ratioHistory,
+		NextRatioChangeId:               nextRatioChangeID,
+		FeeSplitTotals:                  feeSplitTotals,
+		FeeSplitSnapshots:               feeSplitSnapshots,
+		FeeSplitRetentionBlocks:         feeSplitRetentionBlocks,
+		DenomRatios:                     denomRatios,
 	}
 }
 
@@ -46,6 +58,15 @@ func DefaultGenesisState() *GenesisState {
 		Ratio:                           InitialRatio(),
 		BaseAddress:                     "",
 		ModeratorAddress:                "",
+		PendingRatioChanges:             []RatioChange{},
+		RatioHistory:                    []RatioHistoryEntry{},
+		NextRatioChangeId:               1,
+		BaseRecipients:                  []BaseRecipient{},
+		PendingModeratorAddress:         "",
+		FeeSplitTotals:                  FeeSplit{},
+		FeeSplitSnapshots:               []FeeSplitSnapshot{},
+		FeeSplitRetentionBlocks:         DefaultFeeSplitRetentionBlocks,
+		DenomRatios:                     []DenomRatio{},
 	}
 }
 
@@ -54,7 +75,16 @@ func ValidateGenesis(gs *GenesisState) error {
 	if err := validateAddress(gs.ModeratorAddress); err != nil {
 		return err
 	}
-	if err := validateAddress(gs.BaseAddress); err != nil {
+	if gs.PendingModeratorAddress != "" {
+		if _, err := sdk.AccAddressFromBech32(gs.PendingModeratorAddress); err != nil {
+			return fmt.Errorf("invalid pending moderator address: %w", err)
+		}
+	}
+	if len(gs.BaseRecipients) == 0 {
+		if err := validateAddress(gs.BaseAddress); err != nil {
+			return err
+		}
+	} else if err := ValidateBaseRecipients(gs.BaseRecipients); err != nil {
 		return err
 	}
 	if err := gs.Params.ValidateBasic(); err != nil {
@@ -63,9 +93,57 @@ func ValidateGenesis(gs *GenesisState) error {
 	if err := gs.Ratio.ValidateGenesis(); err != nil {
 		return err
 	}
+	if err := ValidateDenomRatios(gs.DenomRatios); err != nil {
+		return err
+	}
+	if err := validateRatioChanges(gs.PendingRatioChanges, gs.NextRatioChangeId); err != nil {
+		return err
+	}
+	for _, entry := range gs.RatioHistory {
+		if entry.DenomRatioRemoved {
+			continue
+		}
+		if err := entry.Ratio.ValidateRatio(); err != nil {
+			return fmt.Errorf("invalid ratio history entry at height %d: %w", entry.Height, err)
+		}
+	}
+	if err := gs.FeeSplitTotals.Validate(); err != nil {
+		return fmt.Errorf("invalid fee split totals: %w", err)
+	}
+	if err := validateFeeSplitSnapshots(gs.FeeSplitSnapshots); err != nil {
+		return err
+	}
 	return gs.FeePool.ValidateGenesis()
 }
 
+// validateRatioChanges validates the pending ratio changes for genesis state
+func validateRatioChanges(changes []RatioChange, nextID uint64) error {
+	seen := make(map[uint64]bool, len(changes))
+	for _, change := range changes {
+		if err := change.Validate(); err != nil {
+			return err
+		}
+		if seen[change.Id] {
+			return fmt.Errorf("duplicate ratio change id %d", change.Id)
+		}
+		if nextID != 0 && change.Id >= nextID {
+			return fmt.Errorf("ratio change id %d must be lower than the next ratio change id %d", change.Id, nextID)
+		}
+		seen[change.Id] = true
+	}
+	return nil
+}
+
+// ResolveBaseRecipients returns the base recipients of the genesis state. The
+// deprecated base address is used as single recipient when no base recipients
+// are set.
+func (gs GenesisState) ResolveBaseRecipients() []BaseRecipient {
+	if len(gs.BaseRecipients) == 0 && gs.BaseAddress != "" {
+		return []BaseRecipient{{Address: gs.BaseAddress, Weight: sdk.OneDec()}}
+	}
+	return gs.BaseRecipients
+}
+
 // method validates the address for genesis state
 func validateAddress(i interface{}) error {
 	v, ok := i.(string)
@@ -349,10 +349,36 @@ type GenesisState struct {
 	ValidatorSlashEvents []ValidatorSlashEventRecord `protobuf:"bytes,10,rep,name=validator_slash_events,json=validatorSlashEvents,proto3" json:"validator_slash_events"`
 	// Ratio defines the fee distribution ratio between rewards/base/burn
 	Ratio Ratio `protobuf:"bytes,11,opt,name=ratio,proto3" json:"ratio"`
-	// base_address hold the foundation address for receiving the 1/3 of the fees
-	BaseAddress string `protobuf:"bytes,12,opt,name=base_address,json=baseAddress,proto3" json:"base_address,omitempty"`
+	// base_address hold the foundation address for receiving the 1/3 of the fees.
+	// It is only used when base_recipients is empty.
+	//
+	// Deprecated: use base_recipients instead.
+	BaseAddress string `protobuf:"bytes,12,opt,name=base_address,json=baseAddress,proto3" json:"base_address,omitempty"` // Deprecated: Do not use.
 	// moderator is allowed to set the ratio and base address
 	ModeratorAddress string `protobuf:"bytes,13,opt,name=moderator_address,json=moderatorAddress,proto3" json:"moderator_address,omitempty"`
+	// pending_ratio_changes defines the scheduled ratio changes at genesis.
+	PendingRatioChanges []RatioChange `protobuf:"bytes,14,rep,name=pending_ratio_changes,json=pendingRatioChanges,proto3" json:"pending_ratio_changes"`
+	// ratio_history defines the ratios that became effective before genesis.
+	RatioHistory []RatioHistoryEntry `protobuf:"bytes,15,rep,name=ratio_history,json=ratioHistory,proto3" json:"ratio_history"`
+	// next_ratio_change_id defines the id assigned to the next scheduled ratio change.
+	NextRatioChangeId uint64 `protobuf:"varint,16,opt,name=next_ratio_change_id,json=nextRatioChangeId,proto3" json:"next_ratio_change_id,omitempty"`
+	// base_recipients defines the weighted list of accounts receiving the base
+	// part of the fees.
+	BaseRecipients []BaseRecipient `protobuf:"bytes,17,rep,name=base_recipients,json=baseRecipients,proto3" json:"base_recipients"`
+	// pending_moderator_address defines the proposed moderator that has not
+	// accepted the moderator role yet.
+	PendingModeratorAddress string `protobuf:"bytes,18,opt,name=pending_moderator_address,json=pendingModeratorAddress,proto3" json:"pending_moderator_address,omitempty"`
+	// fee_split_totals defines the cumulative fee split at genesis.
+	FeeSplitTotals FeeSplit `protobuf:"bytes,19,opt,name=fee_split_totals,json=feeSplitTotals,proto3" json:"fee_split_totals"`
+	// fee_split_snapshots defines the fee split snapshots within the retention
+	// window at genesis.
+	FeeSplitSnapshots []FeeSplitSnapshot `protobuf:"bytes,20,rep,name=fee_split_snapshots,json=feeSplitSnapshots,proto3" json:"fee_split_snapshots"`
+	// fee_split_retention_blocks defines the number of blocks for which fee
+	// split snapshots are kept.
+	FeeSplitRetentionBlocks uint64 `protobuf:"varint,21,opt,name=fee_split_retention_blocks,json=feeSplitRetentionBlocks,proto3" json:"fee_split_retention_blocks,omitempty"`
+	// denom_ratios defines the ratios overriding the default ratio for the fees
+	// collected in specific denoms.
+	DenomRatios []DenomRatio `protobuf:"bytes,22,rep,name=denom_ratios,json=denomRatios,proto3" json:"denom_ratios"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -404,67 +430,82 @@ func init() {
 }
 
 var fileDescriptor_76eed0f9489db580 = []byte{
-	// 953 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x56, 0xcf, 0x6f, 0x1b, 0x45,
-	0x14, 0xf6, 0x3a, 0x69, 0x9a, 0x8c, 0x53, 0x91, 0x4e, 0xd3, 0xb0, 0x49, 0xcb, 0x3a, 0x09, 0x3d,
-	0x14, 0x55, 0x5d, 0x93, 0x14, 0x01, 0x2a, 0xa2, 0x92, 0xed, 0x86, 0x1f, 0xa7, 0x46, 0x36, 0xa2,
-	0x12, 0x12, 0xb2, 0xc6, 0xbb, 0xe3, 0xf5, 0x80, 0xbd, 0x63, 0xcd, 0xcc, 0x6e, 0x8a, 0xc4, 0x09,
-	0x09, 0xa9, 0x47, 0x24, 0xf8, 0x03, 0x7a, 0x44, 0x48, 0xdc, 0xf8, 0x1b, 0x50, 0x8f, 0x15, 0x27,
-	0x0e, 0x08, 0x90, 0xc3, 0x81, 0x13, 0x77, 0x6e, 0x68, 0x67, 0x67, 0x67, 0x77, 0xe5, 0xcd, 0xe2,
-	0xb4, 0xc9, 0xc9, 0xde, 0x9d, 0xf7, 0xe3, 0xfb, 0xde, 0x7b, 0xfb, 0xbd, 0x01, 0xaf, 0x39, 0x94,
-	0x8f, 0x29, 0x6f, 0xb8, 0x84, 0x0b, 0x46, 0xfa, 0x81, 0x20, 0xd4, 0x6f, 0x84, 0x7b, 0x7d, 0x2c,
-	0xd0, 0x5e, 0xc3, 0xc3, 0x3e, 0xe6, 0x84, 0xdb, 0x13, 0x46, 0x05, 0x85, 0xd7, 0x62, 0x53, 0x3b,
-	0x6b, 0x6a, 0x2b, 0xd3, 0xad, 0x75, 0x8f, 0x7a, 0x54, 0xda, 0x35, 0xa2, 0x7f, 0xb1, 0xcb, 0x96,
-	0xa5, 0xa2, 0xf7, 0x11, 0xc7, 0x3a, 0xaa, 0x43, 0x89, 0xaf, 0xce, 0xed, 0xb2, 0xec, 0xb9, 0x3c,
-	0xb1, 0xfd, 0x66, 0x6c, 0xdf, 0x8b, 0x13, 0x29, 0x3c, 0xf2, 0x61, 0xf7, 0x47, 0x03, 0x5c, 0xbd,
-	0x8f, 0x47, 0xd8, 0x43, 0x82, 0xb2, 0x87, 0x44, 0x0c, 0x5d, 0x86, 0x8e, 0x3e, 0xf4, 0x07, 0x14,
-	0x1e, 0x80, 0xcb, 0x6e, 0x72, 0xd0, 0x43, 0xae, 0xcb, 0x30, 0xe7, 0xa6, 0xb1, 0x6d, 0xdc, 0x5c,
-	0x69, 0x99, 0xbf, 0xfc, 0x74, 0x7b, 0x5d, 0x85, 0x69, 0xc6, 0x27, 0x5d, 0xc1, 0x88, 0xef, 0x75,
-	0xd6, 0xb4, 0x8b, 0x7a, 0x0f, 0xdb, 0x60, 0xed, 0x48, 0x85, 0xd5, 0x51, 0xaa, 0xff, 0x13, 0xe5,
-	0xa5, 0xc4, 0x43, 0xbd, 0xbe, 0xbb, 0xfc, 0xf8, 0x49, 0xbd, 0xf2, 0xf7, 0x93, 0x7a, 0x65, 0xf7,
-	0x5f, 0x03, 0xec, 0x7c, 0x8c, 0x46, 0xc4, 0x8d, 0x72, 0x3c, 0x08, 0x04, 0x17, 0xc8, 0x77, 0x23,
-	0x1f, 0x7c, 0x84, 0x98, 0xcb, 0x3b, 0xd8, 0xa1, 0xcc, 0x8d, 0xb0, 0x87, 0x89, 0xd1, 0xfc, 0xd8,
-	0xb5, 0x4b, 0x82, 0xfd, 0x2b, 0x03, 0x5c, 0xa1, 0x69, 0x8e, 0x1e, 0x8b, 0x93, 0x98, 0xd5, 0xed,
-	0x85, 0x9b, 0xb5, 0xfd, 0xeb, 0xaa, 0x0d, 0x76, 0xd4, 0xa6, 0xa4, 0xa3, 0xf6, 0x7d, 0xec, 0xb4,
-	0x29, 0xf1, 0x5b, 0x77, 0x9e, 0xfe, 0x5e, 0xaf, 0xfc, 0xf0, 0x47, 0xfd, 0x96, 0x47, 0xc4, 0x30,
-	0xe8, 0xdb, 0x0e, 0x1d, 0xab, 0xca, 0xab, 0x9f, 0xdb, 0xdc, 0xfd, 0xbc, 0x21, 0xbe, 0x98, 0x60,
-	0x9e, 0xf8, 0xf0, 0x0e, 0xa4, 0x33, 0x8c, 0x32, 0xdc, 0x7f, 0x33, 0xc0, 0x0d, 0xcd, 0xbd, 0xe9,
-	0x38, 0xc1, 0x38, 0x18, 0x21, 0x81, 0xdd, 0x36, 0x1d, 0x8f, 0x09, 0xe7, 0x84, 0xfa, 0x67, 0x4b,
-	0xdf, 0x01, 0x35, 0x94, 0x66, 0x91, 0x5d, 0xab, 0xed, 0xbf, 0x63, 0x97, 0xcc, 0xb3, 0x5d, 0x0e,
-	0xaf, 0xb5, 0x18, 0x15, 0xa5, 0x93, 0x8d, 0x9a, 0xa1, 0xf7, 0x97, 0x01, 0xb6, 0xb5, 0xff, 0x07,
-	0x84, 0x0b, 0xca, 0x88, 0x83, 0x46, 0xe7, 0xd2, 0xd9, 0x0d, 0xb0, 0x34, 0xc1, 0x8c, 0xd0, 0x98,
-	0xd5, 0x62, 0x47, 0x3d, 0xc1, 0x87, 0xe0, 0x62, 0xd2, 0xe4, 0x05, 0x49, 0xf7, 0xad, 0xf9, 0xe8,
-	0xce, 0xc0, 0x55, 0x54, 0x93, 0x68, 0x19, 0x9a, 0x3f, 0x1b, 0xe0, 0x15, 0xed, 0xd7, 0x0e, 0x18,
-	0xc3, 0xbe, 0x38, 0x17, 0x8e, 0x1f, 0xa5, 0x5c, 0xe2, 0xd6, 0xbd, 0x31, 0x1f, 0x97, 0x3c, 0xa6,
-	0x93, 0x89, 0x7c, 0x57, 0x05, 0xd7, 0xb4, 0x74, 0x74, 0x05, 0x62, 0x82, 0xf8, 0x5e, 0x24, 0x1d,
-	0x29, 0x8d, 0xb3, 0x10, 0x90, 0xc2, 0x6a, 0x54, 0x4f, 0x5d, 0x8d, 0x4f, 0xc1, 0x25, 0xae, 0x30,
-	0xf6, 0x88, 0x3f, 0xa0, 0xaa, 0xbf, 0xfb, 0xa5, 0x35, 0x29, 0xa4, 0xa7, 0x2a, 0xb2, 0xca, 0x33,
-	0xef, 0x32, 0x65, 0x79, 0x5c, 0x05, 0x9b, 0xba, 0x96, 0xdd, 0x11, 0xe2, 0xc3, 0x83, 0x50, 0x96,
-	0xf3, 0x8c, 0xe7, 0x77, 0x88, 0x89, 0x37, 0x14, 0xc9, 0xfc, 0xc6, 0x4f, 0x99, 0xb9, 0x5e, 0xc8,
-	0xcd, 0xf5, 0x67, 0xe0, 0x6a, 0x9a, 0x96, 0x47, 0xa0, 0x7a, 0x38, 0x42, 0x65, 0x2e, 0xca, 0x2a,
-	0xbc, 0x3e, 0xdf, 0x64, 0xa4, 0x6c, 0x54, 0x0d, 0xae, 0x84, 0xb3, 0x47, 0x99, 0x52, 0xfc, 0xb3,
-	0x02, 0x56, 0xdf, 0x8f, 0x97, 0x61, 0x57, 0x20, 0x81, 0x61, 0x13, 0x2c, 0x4d, 0x10, 0x43, 0xe3,
-	0x98, 0x72, 0x6d, 0xff, 0xd5, 0xd2, 0xbc, 0x87, 0xd2, 0x54, 0xa5, 0x52, 0x8e, 0xf0, 0x00, 0x2c,
-	0x0f, 0x30, 0xee, 0x4d, 0x28, 0x1d, 0xa9, 0xb1, 0xbe, 0x51, 0x1a, 0xe4, 0x3d, 0x8c, 0x0f, 0x29,
-	0x1d, 0x25, 0x63, 0x3c, 0x88, 0x1f, 0x21, 0x03, 0x66, 0x3a, 0x9c, 0x7a, 0x41, 0x45, 0x83, 0x11,
-	0x7d, 0xf9, 0x0b, 0xf3, 0x4f, 0x46, 0x76, 0x67, 0xaa, 0x24, 0x1b, 0x6e, 0xd1, 0xa1, 0x9c, 0xe4,
-	0x09, 0xc3, 0x21, 0xa1, 0x81, 0x5c, 0xc5, 0x13, 0xca, 0x31, 0x93, 0x0d, 0x28, 0xed, 0x7d, 0xe2,
-	0x72, 0xa8, 0x3c, 0x60, 0x50, 0xbc, 0x94, 0x2e, 0x48, 0xd4, 0xf7, 0xe6, 0xeb, 0xe4, 0x49, 0x9b,
-	0x53, 0x31, 0x28, 0xd8, 0x43, 0xf0, 0x5b, 0x03, 0xec, 0x64, 0x46, 0x37, 0x95, 0xf0, 0x9e, 0xa3,
-	0x05, 0x9e, 0x9b, 0x4b, 0x12, 0x45, 0xf3, 0x05, 0x96, 0x44, 0x0e, 0x48, 0x3d, 0x2c, 0xb5, 0xe5,
-	0xf0, 0x6b, 0x03, 0x5c, 0x4f, 0x51, 0x0d, 0xb5, 0x0c, 0xeb, 0xb2, 0x5c, 0x94, 0x80, 0xde, 0x7d,
-	0x4e, 0x19, 0xcf, 0x81, 0xd9, 0x0a, 0x4f, 0xb4, 0x83, 0x5f, 0x82, 0xcd, 0x14, 0x86, 0x13, 0x2b,
-	0xa8, 0xc6, 0xb0, 0x2c, 0x31, 0xdc, 0x7d, 0x1e, 0xf9, 0xcd, 0x01, 0x78, 0x39, 0x2c, 0x36, 0x82,
-	0x8f, 0xb2, 0xd3, 0x9c, 0x93, 0x39, 0x6e, 0xae, 0xc8, 0xe4, 0x6f, 0x9f, 0x5e, 0xe7, 0x72, 0xa9,
-	0xd3, 0x99, 0xce, 0x9a, 0x70, 0xc8, 0xc0, 0x46, 0xa1, 0xb0, 0x70, 0x13, 0xc8, 0xbc, 0x6f, 0x9e,
-	0x56, 0x59, 0x72, 0x59, 0xd7, 0x0b, 0xf4, 0x85, 0xc3, 0x7b, 0xe0, 0x02, 0x43, 0x82, 0x50, 0xb3,
-	0x26, 0xbf, 0xff, 0xdd, 0xd2, 0x14, 0x9d, 0xc8, 0x52, 0x85, 0x8b, 0xdd, 0xe0, 0x0e, 0x58, 0x8d,
-	0xae, 0x6c, 0x5a, 0x7e, 0x57, 0xa3, 0x4f, 0xb0, 0x53, 0x8b, 0xde, 0x25, 0xfa, 0x7a, 0x0b, 0x5c,
-	0x1e, 0x53, 0x17, 0xb3, 0x9c, 0x4c, 0x5f, 0x92, 0x76, 0x6b, 0xfa, 0x60, 0xe6, 0x76, 0xda, 0x7a,
-	0xf0, 0xfd, 0xd4, 0x32, 0x9e, 0x4e, 0x2d, 0xe3, 0xd9, 0xd4, 0x32, 0xfe, 0x9c, 0x5a, 0xc6, 0x37,
-	0xc7, 0x56, 0xe5, 0xd9, 0xb1, 0x55, 0xf9, 0xf5, 0xd8, 0xaa, 0x7c, 0xb2, 0x57, 0x7a, 0x15, 0x7c,
-	0x94, 0xbf, 0xce, 0xcb, 0x9b, 0x61, 0x7f, 0x49, 0xde, 0xd2, 0xef, 0xfc, 0x17, 0x00, 0x00, 0xff,
-	0xff, 0xcb, 0xbc, 0x74, 0xe9, 0x70, 0x0c, 0x00, 0x00,
+	// 1194 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x57, 0xcf, 0x6f, 0x1b, 0xc5,
+	0x17, 0xf7, 0x3a, 0x69, 0x9a, 0x8e, 0x93, 0x26, 0x99, 0xfc, 0xda, 0xa4, 0xfd, 0x3a, 0x69, 0xbe,
+	0xad, 0x08, 0x54, 0xb1, 0x49, 0x8a, 0x00, 0xa5, 0xa2, 0x52, 0xec, 0x06, 0xe8, 0x01, 0x35, 0xb2,
+	0x0b, 0x55, 0x91, 0xd0, 0x6a, 0xbc, 0x3b, 0xb6, 0x87, 0xda, 0x3b, 0xab, 0x99, 0xb1, 0x93, 0x48,
+	0x9c, 0x90, 0x90, 0x7a, 0x44, 0x82, 0x3f, 0xa0, 0x47, 0x84, 0xc4, 0x8d, 0x3f, 0x01, 0xa1, 0x1e,
+	0x2b, 0x4e, 0x1c, 0x10, 0xa0, 0x84, 0x03, 0xff, 0x02, 0x37, 0x34, 0xb3, 0x33, 0xfb, 0xa3, 0x71,
+	0x6c, 0xa7, 0xa4, 0xa7, 0x64, 0xe6, 0xfd, 0xfa, 0x7c, 0xde, 0x7b, 0xf3, 0x9e, 0x17, 0xbc, 0xee,
+	0x52, 0xde, 0xa6, 0xbc, 0xe8, 0x11, 0x2e, 0x18, 0xa9, 0x75, 0x04, 0xa1, 0x7e, 0xb1, 0xbb, 0x59,
+	0xc3, 0x02, 0x6d, 0x16, 0x1b, 0xd8, 0xc7, 0x9c, 0xf0, 0x42, 0xc0, 0xa8, 0xa0, 0xf0, 0x4a, 0xa8,
+	0x5a, 0x48, 0xaa, 0x16, 0xb4, 0xea, 0xf2, 0x5c, 0x83, 0x36, 0xa8, 0xd2, 0x2b, 0xca, 0xff, 0x42,
+	0x93, 0xe5, 0xbc, 0xf6, 0x5e, 0x43, 0x1c, 0x47, 0x5e, 0x5d, 0x4a, 0x7c, 0x2d, 0x2f, 0xf4, 0x8b,
+	0x9e, 0x8a, 0x13, 0xea, 0x2f, 0x85, 0xfa, 0x4e, 0x18, 0x48, 0xe3, 0x51, 0x87, 0xb5, 0x1f, 0x2c,
+	0x30, 0x7f, 0x17, 0xb7, 0x70, 0x03, 0x09, 0xca, 0x1e, 0x12, 0xd1, 0xf4, 0x18, 0xda, 0xbf, 0xe7,
+	0xd7, 0x29, 0xdc, 0x05, 0x33, 0x9e, 0x11, 0x38, 0xc8, 0xf3, 0x18, 0xe6, 0xdc, 0xb6, 0x56, 0xad,
+	0xf5, 0x4b, 0x25, 0xfb, 0x97, 0x1f, 0x37, 0xe6, 0xb4, 0x9b, 0x9d, 0x50, 0x52, 0x15, 0x8c, 0xf8,
+	0x8d, 0xca, 0x74, 0x64, 0xa2, 0xef, 0x61, 0x19, 0x4c, 0xef, 0x6b, 0xb7, 0x91, 0x97, 0xec, 0x00,
+	0x2f, 0x53, 0xc6, 0x42, 0x5f, 0x6f, 0x8f, 0x3f, 0x79, 0xba, 0x92, 0xf9, 0xfb, 0xe9, 0x4a, 0x66,
+	0xed, 0x1f, 0x0b, 0x5c, 0xfb, 0x04, 0xb5, 0x88, 0x27, 0x63, 0xdc, 0xef, 0x08, 0x2e, 0x90, 0xef,
+	0x49, 0x1b, 0xbc, 0x8f, 0x98, 0xc7, 0x2b, 0xd8, 0xa5, 0xcc, 0x93, 0xd8, 0xbb, 0x46, 0x69, 0x78,
+	0xec, 0x91, 0x89, 0xc1, 0xfe, 0xa5, 0x05, 0x66, 0x69, 0x1c, 0xc3, 0x61, 0x61, 0x10, 0x3b, 0xbb,
+	0x3a, 0xb2, 0x9e, 0xdb, 0xba, 0xaa, 0xcb, 0x50, 0x90, 0x65, 0x32, 0x15, 0x2d, 0xdc, 0xc5, 0x6e,
+	0x99, 0x12, 0xbf, 0x74, 0xeb, 0xd9, 0xef, 0x2b, 0x99, 0xef, 0xff, 0x58, 0xb9, 0xd9, 0x20, 0xa2,
+	0xd9, 0xa9, 0x15, 0x5c, 0xda, 0xd6, 0x99, 0xd7, 0x7f, 0x36, 0xb8, 0xf7, 0xb8, 0x28, 0x0e, 0x03,
+	0xcc, 0x8d, 0x0d, 0xaf, 0x40, 0x7a, 0x82, 0x51, 0x82, 0xfb, 0x6f, 0x16, 0xb8, 0x1e, 0x71, 0xdf,
+	0x71, 0xdd, 0x4e, 0xbb, 0xd3, 0x42, 0x02, 0x7b, 0x65, 0xda, 0x6e, 0x13, 0xce, 0x09, 0xf5, 0xcf,
+	0x97, 0xbe, 0x0b, 0x72, 0x28, 0x8e, 0xa2, 0xaa, 0x96, 0xdb, 0xba, 0x5d, 0xe8, 0xd3, 0xcf, 0x85,
+	0xfe, 0xf0, 0x4a, 0xa3, 0x32, 0x29, 0x95, 0xa4, 0xd7, 0x04, 0xbd, 0xbf, 0x2c, 0xb0, 0x1a, 0xd9,
+	0x7f, 0x48, 0xb8, 0xa0, 0x8c, 0xb8, 0xa8, 0xf5, 0x4a, 0x2a, 0xbb, 0x00, 0xc6, 0x02, 0xcc, 0x08,
+	0x0d, 0x59, 0x8d, 0x56, 0xf4, 0x09, 0x3e, 0x04, 0x17, 0x4d, 0x91, 0x47, 0x14, 0xdd, 0x77, 0x86,
+	0xa3, 0x7b, 0x02, 0xae, 0xa6, 0x6a, 0xbc, 0x25, 0x68, 0xfe, 0x6c, 0x81, 0xff, 0x45, 0x76, 0xe5,
+	0x0e, 0x63, 0xd8, 0x17, 0xaf, 0x84, 0xe3, 0x83, 0x98, 0x4b, 0x58, 0xba, 0xb7, 0x86, 0xe3, 0x92,
+	0xc6, 0x74, 0x3a, 0x91, 0x6f, 0xb3, 0xe0, 0x4a, 0x34, 0x3a, 0xaa, 0x02, 0x31, 0x41, 0xfc, 0x86,
+	0x1c, 0x1d, 0x31, 0x8d, 0xf3, 0x18, 0x20, 0x3d, 0xb3, 0x91, 0x3d, 0x73, 0x36, 0x3e, 0x03, 0x93,
+	0x5c, 0x63, 0x74, 0x88, 0x5f, 0xa7, 0xba, 0xbe, 0x5b, 0x7d, 0x73, 0xd2, 0x93, 0x9e, 0xce, 0xc8,
+	0x04, 0x4f, 0xdc, 0x25, 0xd2, 0xf2, 0x24, 0x0b, 0x96, 0xa2, 0x5c, 0x56, 0x5b, 0x88, 0x37, 0x77,
+	0xbb, 0x2a, 0x9d, 0xe7, 0xdc, 0xbf, 0x4d, 0x4c, 0x1a, 0x4d, 0x61, 0xfa, 0x37, 0x3c, 0x25, 0xfa,
+	0x7a, 0x24, 0xd5, 0xd7, 0x9f, 0x83, 0xf9, 0x38, 0x2c, 0x97, 0xa0, 0x1c, 0x2c, 0x51, 0xd9, 0xa3,
+	0x2a, 0x0b, 0x6f, 0x0e, 0xd7, 0x19, 0x31, 0x1b, 0x9d, 0x83, 0xd9, 0xee, 0x49, 0x51, 0x22, 0x15,
+	0x3f, 0x4d, 0x81, 0x89, 0x0f, 0xc2, 0x65, 0x58, 0x15, 0x48, 0x60, 0xb8, 0x03, 0xc6, 0x02, 0xc4,
+	0x50, 0x3b, 0xa4, 0x9c, 0xdb, 0xfa, 0x7f, 0xdf, 0xb8, 0x7b, 0x4a, 0x55, 0x87, 0xd2, 0x86, 0x70,
+	0x17, 0x8c, 0xd7, 0x31, 0x76, 0x02, 0x4a, 0x5b, 0xba, 0xad, 0xaf, 0xf7, 0x75, 0xf2, 0x3e, 0xc6,
+	0x7b, 0x94, 0xb6, 0x4c, 0x1b, 0xd7, 0xc3, 0x23, 0x64, 0xc0, 0x8e, 0x9b, 0x33, 0x5a, 0x50, 0xb2,
+	0x31, 0xe4, 0xcb, 0x1f, 0x19, 0xbe, 0x33, 0x92, 0x3b, 0x53, 0x07, 0x59, 0xf0, 0x7a, 0x09, 0x55,
+	0x27, 0x07, 0x0c, 0x77, 0x09, 0xed, 0xa8, 0x55, 0x1c, 0x50, 0x8e, 0x99, 0x3d, 0x3a, 0xa8, 0xf6,
+	0xc6, 0x64, 0x4f, 0x5b, 0xc0, 0x4e, 0xef, 0xa5, 0x74, 0x41, 0xa1, 0xbe, 0x33, 0x5c, 0x25, 0x4f,
+	0xdb, 0x9c, 0x9a, 0x41, 0x8f, 0x3d, 0x04, 0xbf, 0xb1, 0xc0, 0xb5, 0x44, 0xeb, 0xc6, 0x23, 0xdc,
+	0x71, 0xa3, 0x01, 0xcf, 0xed, 0x31, 0x85, 0x62, 0xe7, 0x3f, 0x2c, 0x89, 0x14, 0x90, 0x95, 0x6e,
+	0x5f, 0x5d, 0x0e, 0xbf, 0xb2, 0xc0, 0xd5, 0x18, 0x55, 0x33, 0x1a, 0xc3, 0x51, 0x5a, 0x2e, 0x2a,
+	0x40, 0xef, 0xbd, 0xe4, 0x18, 0x4f, 0x81, 0x59, 0xee, 0x9e, 0xaa, 0x07, 0xbf, 0x00, 0x4b, 0x31,
+	0x0c, 0x37, 0x9c, 0xa0, 0x11, 0x86, 0x71, 0x85, 0x61, 0xfb, 0x65, 0xc6, 0x6f, 0x0a, 0xc0, 0x62,
+	0xb7, 0xb7, 0x12, 0x3c, 0x48, 0x76, 0x73, 0x6a, 0xcc, 0x71, 0xfb, 0x92, 0x0a, 0xfe, 0xee, 0xd9,
+	0xe7, 0x5c, 0x2a, 0xf4, 0x82, 0xd7, 0x4b, 0x85, 0x43, 0x06, 0x16, 0x7a, 0x0e, 0x16, 0x6e, 0x03,
+	0x15, 0xf7, 0xed, 0xb3, 0x4e, 0x96, 0x54, 0xd4, 0xb9, 0x1e, 0xf3, 0x85, 0xc3, 0x3b, 0xe0, 0x02,
+	0x43, 0x82, 0x50, 0x3b, 0xa7, 0xde, 0xff, 0x5a, 0xdf, 0x10, 0x15, 0xa9, 0xa9, 0xdd, 0x85, 0x66,
+	0xf0, 0x06, 0x98, 0x90, 0x3f, 0xd9, 0xa2, 0xf1, 0x3b, 0xa1, 0x9e, 0x60, 0xd6, 0xb6, 0x2a, 0x39,
+	0x79, 0x6f, 0x66, 0xec, 0x4d, 0x30, 0xd3, 0xa6, 0x1e, 0x66, 0xa9, 0x51, 0x3d, 0x29, 0x75, 0x2b,
+	0xd3, 0x91, 0xc0, 0x28, 0xd7, 0xc0, 0x7c, 0x80, 0xf5, 0x83, 0x94, 0x41, 0x1c, 0xb7, 0x89, 0xfc,
+	0x06, 0xe6, 0xf6, 0x65, 0x95, 0x86, 0xf5, 0xc1, 0x18, 0xcb, 0xca, 0xc0, 0x0c, 0x56, 0xed, 0x2c,
+	0x21, 0xe1, 0xf0, 0x11, 0x98, 0x0c, 0x7d, 0x87, 0x6d, 0x7e, 0x68, 0x4f, 0x29, 0xdf, 0x85, 0xc1,
+	0xbe, 0xc3, 0x7e, 0x3d, 0xdc, 0xf5, 0x05, 0x3b, 0x34, 0xeb, 0x8b, 0x25, 0x04, 0xb0, 0x08, 0xe6,
+	0x7c, 0x7c, 0x20, 0x52, 0xd8, 0x1d, 0xe2, 0xd9, 0xd3, 0x6a, 0x8b, 0xcc, 0x48, 0x59, 0x02, 0xca,
+	0x3d, 0x0f, 0x3e, 0x02, 0x53, 0x2a, 0x87, 0x0c, 0xbb, 0x24, 0x20, 0xaa, 0xe0, 0x33, 0x0a, 0xcd,
+	0x1b, 0x7d, 0xd1, 0x94, 0x10, 0xc7, 0x15, 0x63, 0xa2, 0x91, 0x5c, 0xae, 0x25, 0x2f, 0x39, 0xdc,
+	0x06, 0x4b, 0x26, 0x95, 0x27, 0xf3, 0x0f, 0x55, 0xfe, 0x17, 0xb5, 0xc2, 0x47, 0x2f, 0x96, 0xe1,
+	0x63, 0x30, 0x2d, 0xb7, 0x03, 0x0f, 0x5a, 0x44, 0x38, 0x82, 0x0a, 0xd4, 0xe2, 0xf6, 0xac, 0xea,
+	0x92, 0x1b, 0x83, 0xb6, 0x44, 0x55, 0xda, 0x18, 0x48, 0x75, 0x7d, 0x7e, 0xa0, 0x5c, 0x40, 0x17,
+	0xcc, 0xc6, 0x6e, 0xb9, 0x8f, 0x02, 0xde, 0xa4, 0x82, 0xdb, 0x73, 0x8a, 0xf1, 0xc6, 0x50, 0x9e,
+	0xab, 0xda, 0x4a, 0x47, 0x98, 0xa9, 0xbf, 0x70, 0xcf, 0xe1, 0x6d, 0xb0, 0x1c, 0x07, 0x61, 0x58,
+	0x60, 0x5f, 0x3a, 0x72, 0x6a, 0x2d, 0xea, 0x3e, 0xe6, 0xf6, 0xbc, 0xaa, 0xc4, 0xa2, 0x31, 0xab,
+	0x18, 0x79, 0x49, 0x89, 0xe1, 0x1e, 0x98, 0xf0, 0xb0, 0x4f, 0xdb, 0x61, 0x05, 0xb9, 0xbd, 0xa0,
+	0xa0, 0xbd, 0x36, 0xe0, 0xd5, 0xfb, 0xb4, 0x9d, 0x7c, 0x1f, 0x39, 0x2f, 0xba, 0x49, 0xfc, 0xd0,
+	0x2b, 0xdd, 0xff, 0xee, 0x28, 0x6f, 0x3d, 0x3b, 0xca, 0x5b, 0xcf, 0x8f, 0xf2, 0xd6, 0x9f, 0x47,
+	0x79, 0xeb, 0xeb, 0xe3, 0x7c, 0xe6, 0xf9, 0x71, 0x3e, 0xf3, 0xeb, 0x71, 0x3e, 0xf3, 0xe9, 0x66,
+	0xdf, 0x0f, 0x9c, 0x83, 0xf4, 0x47, 0xaa, 0xfa, 0xde, 0xa9, 0x8d, 0xa9, 0x6f, 0xcf, 0x5b, 0xff,
+	0x0e, 0x00, 0x17, 0x56, 0x23, 0x26, 0x46, 0x0f, 0x00, 0x00,
 }
 
 func (m *DelegatorWithdrawInfo) Marshal() (dAtA []byte, err error) {
@@ -790,6 +831,117 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.DenomRatios) > 0 {
+		for iNdEx := len(m.DenomRatios) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.DenomRatios[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0xb2
+		}
+	}
+	if m.FeeSplitRetentionBlocks != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.FeeSplitRetentionBlocks))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xa8
+	}
+	if len(m.FeeSplitSnapshots) > 0 {
+		for iNdEx := len(m.FeeSplitSnapshots) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.FeeSplitSnapshots[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0xa2
+		}
+	}
+	{
+		size, err := m.FeeSplitTotals.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1
+	i--
+	dAtA[i] = 0x9a
+	if len(m.PendingModeratorAddress) > 0 {
+		i -= len(m.PendingModeratorAddress)
+		copy(dAtA[i:], m.PendingModeratorAddress)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.PendingModeratorAddress)))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x92
+	}
+	if len(m.BaseRecipients) > 0 {
+		for iNdEx := len(m.BaseRecipients) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.BaseRecipients[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0x8a
+		}
+	}
+	if m.NextRatioChangeId != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.NextRatioChangeId))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x80
+	}
+	if len(m.RatioHistory) > 0 {
+		for iNdEx := len(m.RatioHistory) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.RatioHistory[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x7a
+		}
+	}
+	if len(m.PendingRatioChanges) > 0 {
+		for iNdEx := len(m.PendingRatioChanges) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.PendingRatioChanges[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x72
+		}
+	}
 	if len(m.ModeratorAddress) > 0 {
 		i -= len(m.ModeratorAddress)
 		copy(dAtA[i:], m.ModeratorAddress)
@@ -1143,6 +1295,48 @@ func (m *GenesisState) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovGenesis(uint64(l))
 	}
+	if len(m.PendingRatioChanges) > 0 {
+		for _, e := range m.PendingRatioChanges {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.RatioHistory) > 0 {
+		for _, e := range m.RatioHistory {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if m.NextRatioChangeId != 0 {
+		n += 2 + sovGenesis(uint64(m.NextRatioChangeId))
+	}
+	if len(m.BaseRecipients) > 0 {
+		for _, e := range m.BaseRecipients {
+			l = e.Size()
+			n += 2 + l + sovGenesis(uint64(l))
+		}
+	}
+	l = len(m.PendingModeratorAddress)
+	if l > 0 {
+		n += 2 + l + sovGenesis(uint64(l))
+	}
+	l = m.FeeSplitTotals.Size()
+	n += 2 + l + sovGenesis(uint64(l))
+	if len(m.FeeSplitSnapshots) > 0 {
+		for _, e := range m.FeeSplitSnapshots {
+			l = e.Size()
+			n += 2 + l + sovGenesis(uint64(l))
+		}
+	}
+	if m.FeeSplitRetentionBlocks != 0 {
+		n += 2 + sovGenesis(uint64(m.FeeSplitRetentionBlocks))
+	}
+	if len(m.DenomRatios) > 0 {
+		for _, e := range m.DenomRatios {
+			l = e.Size()
+			n += 2 + l + sovGenesis(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -2508,6 +2702,279 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 			}
 			m.ModeratorAddress = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 14:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PendingRatioChanges", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PendingRatioChanges = append(m.PendingRatioChanges, RatioChange{})
+			if err := m.PendingRatioChanges[len(m.PendingRatioChanges)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 15:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RatioHistory", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RatioHistory = append(m.RatioHistory, RatioHistoryEntry{})
+			if err := m.RatioHistory[len(m.RatioHistory)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 16:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NextRatioChangeId", wireType)
+			}
+			m.NextRatioChangeId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.NextRatioChangeId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 17:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BaseRecipients", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BaseRecipients = append(m.BaseRecipients, BaseRecipient{})
+			if err := m.BaseRecipients[len(m.BaseRecipients)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 18:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PendingModeratorAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PendingModeratorAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 19:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FeeSplitTotals", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.FeeSplitTotals.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 20:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FeeSplitSnapshots", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FeeSplitSnapshots = append(m.FeeSplitSnapshots, FeeSplitSnapshot{})
+			if err := m.FeeSplitSnapshots[len(m.FeeSplitSnapshots)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 21:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FeeSplitRetentionBlocks", wireType)
+			}
+			m.FeeSplitRetentionBlocks = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.FeeSplitRetentionBlocks |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 22:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DenomRatios", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DenomRatios = append(m.DenomRatios, DenomRatio{})
+			if err := m.DenomRatios[len(m.DenomRatios)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
@@ -42,6 +42,24 @@ const (
 // - 0x07<valAddrLen (1 Byte)><valAddr_Bytes>: ValidatorCurrentCommission
 //
 // - 0x08<valAddrLen (1 Byte)><valAddr_Bytes><height>: ValidatorSlashEvent
+//
+// - 0x12: uint64 (next ratio change id)
+//
+// - 0x13<changeID_Bytes>: RatioChange
+//
+// - 0x14<height_Bytes><changeID_Bytes>: RatioHistoryEntry
+//
+// - 0x15: BaseRecipients
+//
+// - 0x16: sdk.AccAddress (pending moderator)
+//
+// - 0x17: FeeSplit (cumulative totals)
+//
+// - 0x18<height_Bytes>: FeeSplitSnapshot
+//
+// - 0x19: uint64 (fee split snapshot retention blocks)
+//
+// - 0x1A<denom_Bytes>: Ratio
 var (
 	FeePoolKey                        = []byte{0x00} // key for global distribution state
 	ProposerKey                       = []byte{0x01} // key for the proposer operator address
@@ -54,9 +72,17 @@ var (
 	ValidatorAccumulatedCommissionPrefix = []byte{0x07} // key for accumulated validator commission
 	ValidatorSlashEventPrefix            = []byte{0x08} // key for validator slash fraction
 	ModeratorAddrKey                     = []byte{0x09} // key for storing the moderator
-	BaseAddrKey                          = []byte{0x10} // key for storing the base address
+	BaseAddrKey                          = []byte{0x10} // Deprecated: key for the base address, replaced by BaseRecipientsKey
 	RatioKey                             = []byte{0x11} // key for storing the distribution ratio
-
+	NextRatioChangeIDKey                 = []byte{0x12} // key for the next ratio change id
+	RatioChangePrefix                    = []byte{0x13} // key for pending ratio changes
+	RatioHistoryPrefix                   = []byte{0x14} // key for the history of applied ratios
+	BaseRecipientsKey                    = []byte{0x15} // key for the weighted list of base fee recipients
+	PendingModeratorAddrKey              = []byte{0x16} // key for the moderator waiting to accept the role
+	FeeSplitTotalsKey                    = []byte{0x17} // key for the cumulative fee split
+	FeeSplitSnapshotPrefix               = []byte{0x18} // key for the per-height fee split snapshots
+	FeeSplitRetentionKey                 = []byte{0x19} // key for the fee split snapshot retention
+	DenomRatioPrefix                     = []byte{0x1A} // key for the per-denom ratio overrides
 )
 
 // GetValidatorOutstandingRewardsAddress creates an address from a validator's outstanding rewards key.
@@ -217,3 +243,24 @@ func GetValidatorSlashEventKey(v sdk.ValAddress, height, period uint64) []byte {
 
 	return append(prefix, periodBz...)
 }
+
+// GetRatioChangeKey creates the key for a pending ratio change.
+func GetRatioChangeKey(id uint64) []byte {
+	return append(RatioChangePrefix, sdk.Uint64ToBigEndian(id)...)
+}
+
+// GetRatioHistoryKey creates the key for a ratio history entry. Entries are
+// ordered by the height at which they became effective.
+func GetRatioHistoryKey(height int64, changeID uint64) []byte {
+	return append(append(RatioHistoryPrefix, sdk.Uint64ToBigEndian(uint64(height))...), sdk.Uint64ToBigEndian(changeID)...)
+}
+
+// GetDenomRatioKey creates the key for the ratio override of a denom.
+func GetDenomRatioKey(denom string) []byte {
+	return append(DenomRatioPrefix, []byte(denom)...)
+}
+
+// GetFeeSplitSnapshotKey creates the key for the fee split snapshot of a height.
+func GetFeeSplitSnapshotKey(height int64) []byte {
+	return append(FeeSplitSnapshotPrefix, sdk.Uint64ToBigEndian(uint64(height))...)
+}
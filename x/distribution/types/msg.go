@@ -12,8 +12,15 @@ const (
 	TypeMsgWithdrawValidatorCommission = "withdraw_validator_commission"
 	TypeMsgFundCommunityPool           = "fund_community_pool"
 	TypeMsgChangeRatio                 = "change_ratio"
+	TypeMsgCancelRatioChange           = "cancel_ratio_change"
 	TypeMsgChangeBaseAddress           = "change_base_address"
+	TypeMsgSetBaseRecipients           = "set_base_recipients"
 	TypeMsgChangeModerator             = "change_moderator"
+	TypeMsgAcceptModerator             = "accept_moderator"
+	TypeMsgSetFeeSplitRetention        = "set_fee_split_retention"
+	TypeMsgSetDenomRatio               = "set_denom_ratio"
+	TypeMsgRemoveDenomRatio            = "remove_denom_ratio"
+	TypeMsgWithdrawShareRecordReward   = "withdraw_share_record_reward"
 )
 
 // Verify interface at compile time
@@ -155,6 +162,42 @@ func (msg MsgFundCommunityPool) ValidateBasic() error {
 	return nil
 }
 
+// NewMsgWithdrawShareRecordReward returns a new MsgWithdrawShareRecordReward
+// for the tokenize share records of an owner.
+func NewMsgWithdrawShareRecordReward(owner sdk.AccAddress) *MsgWithdrawShareRecordReward {
+	return &MsgWithdrawShareRecordReward{
+		OwnerAddress: owner.String(),
+	}
+}
+
+// Route returns the MsgWithdrawShareRecordReward message route.
+func (msg MsgWithdrawShareRecordReward) Route() string { return ModuleName }
+
+// Type returns the MsgWithdrawShareRecordReward message type.
+func (msg MsgWithdrawShareRecordReward) Type() string { return TypeMsgWithdrawShareRecordReward }
+
+// GetSigners returns the signer addresses that are expected to sign the result
+// of GetSignBytes.
+func (msg MsgWithdrawShareRecordReward) GetSigners() []sdk.AccAddress {
+	owner, _ := sdk.AccAddressFromBech32(msg.OwnerAddress)
+	return []sdk.AccAddress{owner}
+}
+
+// GetSignBytes returns the raw bytes for a MsgWithdrawShareRecordReward
+// message that the expected signer needs to sign.
+func (msg MsgWithdrawShareRecordReward) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(&msg)
+	return sdk.MustSortJSON(bz)
+}
+
+// ValidateBasic performs basic MsgWithdrawShareRecordReward message validation.
+func (msg MsgWithdrawShareRecordReward) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.OwnerAddress); err != nil {
+		return sdkerrors.ErrInvalidAddress.Wrapf("invalid owner address: %s", err)
+	}
+	return nil
+}
+
 // NewMsgChangeRatio returns a new MsgChangeRatio with a new distribution ratio
 func NewMsgChangeRatio(moderator sdk.AccAddress, ratio Ratio) *MsgChangeRatio {
 	return &MsgChangeRatio{
@@ -191,6 +234,51 @@ func (msg MsgChangeRatio) ValidateBasic() error {
 	if err := msg.Ratio.ValidateRatio(); err != nil {
 		return ErrInvalidRatio.Wrapf("%s", err)
 	}
+	if msg.ActivationHeight < 0 {
+		return ErrInvalidRatioActivation.Wrapf("negative activation height: %d", msg.ActivationHeight)
+	}
+	if msg.ActivationHeight > 0 && !msg.ActivationTime.IsZero() {
+		return ErrInvalidRatioActivation.Wrap("only one of activation height and activation time can be set")
+	}
+	return nil
+}
+
+// NewMsgCancelRatioChange returns a new MsgCancelRatioChange for a pending ratio change
+func NewMsgCancelRatioChange(moderator sdk.AccAddress, changeID uint64) *MsgCancelRatioChange {
+	return &MsgCancelRatioChange{
+		ModeratorAddress: moderator.String(),
+		ChangeId:         changeID,
+	}
+}
+
+// Route returns the MsgCancelRatioChange message route.
+func (msg MsgCancelRatioChange) Route() string { return ModuleName }
+
+// Type returns the MsgCancelRatioChange message type.
+func (msg MsgCancelRatioChange) Type() string { return TypeMsgCancelRatioChange }
+
+// GetSigners returns the signer addresses that are expected to sign the result
+// of GetSignBytes.
+func (msg MsgCancelRatioChange) GetSigners() []sdk.AccAddress {
+	moderator, _ := sdk.AccAddressFromBech32(msg.ModeratorAddress)
+	return []sdk.AccAddress{moderator}
+}
+
+// GetSignBytes returns the raw bytes for a MsgCancelRatioChange message that
+// the expected signer needs to sign.
+func (msg MsgCancelRatioChange) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(&msg)
+	return sdk.MustSortJSON(bz)
+}
+
+// ValidateBasic performs basic MsgCancelRatioChange message validation.
+func (msg MsgCancelRatioChange) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.ModeratorAddress); err != nil {
+		return sdkerrors.ErrInvalidAddress.Wrapf("invalid moderator address: %s", err)
+	}
+	if msg.ChangeId == 0 {
+		return ErrRatioChangeNotFound.Wrap("ratio change id cannot be zero")
+	}
 	return nil
 }
 
@@ -233,6 +321,45 @@ func (msg MsgChangeBaseAddress) ValidateBasic() error {
 	return nil
 }
 
+// NewMsgSetBaseRecipients returns a new MsgSetBaseRecipients with a new weighted list of base recipients
+func NewMsgSetBaseRecipients(moderator sdk.AccAddress, recipients []BaseRecipient) *MsgSetBaseRecipients {
+	return &MsgSetBaseRecipients{
+		ModeratorAddress: moderator.String(),
+		Recipients:       recipients,
+	}
+}
+
+// Route returns the MsgSetBaseRecipients message route.
+func (msg MsgSetBaseRecipients) Route() string { return ModuleName }
+
+// Type returns the MsgSetBaseRecipients message type.
+func (msg MsgSetBaseRecipients) Type() string { return TypeMsgSetBaseRecipients }
+
+// GetSigners returns the signer addresses that are expected to sign the result
+// of GetSignBytes.
+func (msg MsgSetBaseRecipients) GetSigners() []sdk.AccAddress {
+	moderator, _ := sdk.AccAddressFromBech32(msg.ModeratorAddress)
+	return []sdk.AccAddress{moderator}
+}
+
+// GetSignBytes returns the raw bytes for a MsgSetBaseRecipients message that
+// the expected signer needs to sign.
+func (msg MsgSetBaseRecipients) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(&msg)
+	return sdk.MustSortJSON(bz)
+}
+
+// ValidateBasic performs basic MsgSetBaseRecipients message validation.
+func (msg MsgSetBaseRecipients) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.ModeratorAddress); err != nil {
+		return sdkerrors.ErrInvalidAddress.Wrapf("invalid moderator address: %s", err)
+	}
+	if err := ValidateBaseRecipients(msg.Recipients); err != nil {
+		return ErrInvalidBaseRecipients.Wrapf("%s", err)
+	}
+	return nil
+}
+
 // NewMsgChangeModerator returns a new MsgChangeModerator with a new moderator
 func NewMsgChangeModerator(moderator sdk.AccAddress, newModerator sdk.AccAddress) *MsgChangeModerator {
 	return &MsgChangeModerator{
@@ -271,3 +398,169 @@ func (msg MsgChangeModerator) ValidateBasic() error {
 	}
 	return nil
 }
+
+// NewMsgAcceptModerator returns a new MsgAcceptModerator for the pending moderator
+func NewMsgAcceptModerator(newModerator sdk.AccAddress) *MsgAcceptModerator {
+	return &MsgAcceptModerator{
+		NewModeratorAddress: newModerator.String(),
+	}
+}
+
+// Route returns the MsgAcceptModerator message route.
+func (msg MsgAcceptModerator) Route() string { return ModuleName }
+
+// Type returns the MsgAcceptModerator message type.
+func (msg MsgAcceptModerator) Type() string { return TypeMsgAcceptModerator }
+
+// GetSigners returns the signer addresses that are expected to sign the result
+// of GetSignBytes.
+func (msg MsgAcceptModerator) GetSigners() []sdk.AccAddress {
+	newModerator, _ := sdk.AccAddressFromBech32(msg.NewModeratorAddress)
+	return []sdk.AccAddress{newModerator}
+}
+
+// GetSignBytes returns the raw bytes for a MsgAcceptModerator message that
+// the expected signer needs to sign.
+func (msg MsgAcceptModerator) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(&msg)
+	return sdk.MustSortJSON(bz)
+}
+
+// ValidateBasic performs basic MsgAcceptModerator message validation.
+func (msg MsgAcceptModerator) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.NewModeratorAddress); err != nil {
+		return sdkerrors.ErrInvalidAddress.Wrapf("invalid new moderator address: %s", err)
+	}
+	return nil
+}
+
+// NewMsgSetFeeSplitRetention returns a new MsgSetFeeSplitRetention with the
+// number of blocks for which fee split snapshots are kept
+func NewMsgSetFeeSplitRetention(moderator sdk.AccAddress, retentionBlocks uint64) *MsgSetFeeSplitRetention {
+	return &MsgSetFeeSplitRetention{
+		ModeratorAddress: moderator.String(),
+		RetentionBlocks:  retentionBlocks,
+	}
+}
+
+// Route returns the MsgSetFeeSplitRetention message route.
+func (msg MsgSetFeeSplitRetention) Route() string { return ModuleName }
+
+// Type returns the MsgSetFeeSplitRetention message type.
+func (msg MsgSetFeeSplitRetention) Type() string { return TypeMsgSetFeeSplitRetention }
+
+// GetSigners returns the signer addresses that are expected to sign the result
+// of GetSignBytes.
+func (msg MsgSetFeeSplitRetention) GetSigners() []sdk.AccAddress {
+	moderator, _ := sdk.AccAddressFromBech32(msg.ModeratorAddress)
+	return []sdk.AccAddress{moderator}
+}
+
+// GetSignBytes returns the raw bytes for a MsgSetFeeSplitRetention message that
+// the expected signer needs to sign.
+func (msg MsgSetFeeSplitRetention) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(&msg)
+	return sdk.MustSortJSON(bz)
+}
+
+// ValidateBasic performs basic MsgSetFeeSplitRetention message validation.
+func (msg MsgSetFeeSplitRetention) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.ModeratorAddress); err != nil {
+		return sdkerrors.ErrInvalidAddress.Wrapf("invalid moderator address: %s", err)
+	}
+	return nil
+}
+
+// NewMsgSetDenomRatio returns a new MsgSetDenomRatio with the ratio for a denom
+func NewMsgSetDenomRatio(moderator sdk.AccAddress, denom string, ratio Ratio) *MsgSetDenomRatio {
+	return &MsgSetDenomRatio{
+		ModeratorAddress: moderator.String(),
+		Denom:            denom,
+		Ratio:            ratio,
+	}
+}
+
+// Route returns the MsgSetDenomRatio message route.
+func (msg MsgSetDenomRatio) Route() string { return ModuleName }
+
+// Type returns the MsgSetDenomRatio message type.
+func (msg MsgSetDenomRatio) Type() string { return TypeMsgSetDenomRatio }
+
+// GetSigners returns the signer addresses that are expected to sign the result
+// of GetSignBytes.
+func (msg MsgSetDenomRatio) GetSigners() []sdk.AccAddress {
+	moderator, _ := sdk.AccAddressFromBech32(msg.ModeratorAddress)
+	return []sdk.AccAddress{moderator}
+}
+
+// GetSignBytes returns the raw bytes for a MsgSetDenomRatio message that
+// the expected signer needs to sign.
+func (msg MsgSetDenomRatio) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(&msg)
+	return sdk.MustSortJSON(bz)
+}
+
+// ValidateBasic performs basic MsgSetDenomRatio message validation.
+func (msg MsgSetDenomRatio) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.ModeratorAddress); err != nil {
+		return sdkerrors.ErrInvalidAddress.Wrapf("invalid moderator address: %s", err)
+	}
+	if err := sdk.ValidateDenom(msg.Denom); err != nil {
+		return sdkerrors.ErrInvalidCoins.Wrapf("%s", err)
+	}
+	if err := msg.Ratio.ValidateRatio(); err != nil {
+		return ErrInvalidRatio.Wrapf("%s", err)
+	}
+	if msg.ActivationHeight < 0 {
+		return ErrInvalidRatioActivation.Wrapf("negative activation height: %d", msg.ActivationHeight)
+	}
+	if msg.ActivationHeight > 0 && !msg.ActivationTime.IsZero() {
+		return ErrInvalidRatioActivation.Wrap("only one of activation height and activation time can be set")
+	}
+	return nil
+}
+
+// NewMsgRemoveDenomRatio returns a new MsgRemoveDenomRatio for a denom
+func NewMsgRemoveDenomRatio(moderator sdk.AccAddress, denom string) *MsgRemoveDenomRatio {
+	return &MsgRemoveDenomRatio{
+		ModeratorAddress: moderator.String(),
+		Denom:            denom,
+	}
+}
+
+// Route returns the MsgRemoveDenomRatio message route.
+func (msg MsgRemoveDenomRatio) Route() string { return ModuleName }
+
+// Type returns the MsgRemoveDenomRatio message type.
+func (msg MsgRemoveDenomRatio) Type() string { return TypeMsgRemoveDenomRatio }
+
+// GetSigners returns the signer addresses that are expected to sign the result
+// of GetSignBytes.
+func (msg MsgRemoveDenomRatio) GetSigners() []sdk.AccAddress {
+	moderator, _ := sdk.AccAddressFromBech32(msg.ModeratorAddress)
+	return []sdk.AccAddress{moderator}
+}
+
+// GetSignBytes returns the raw bytes for a MsgRemoveDenomRatio message that
+// the expected signer needs to sign.
+func (msg MsgRemoveDenomRatio) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(&msg)
+	return sdk.MustSortJSON(bz)
+}
+
+// ValidateBasic performs basic MsgRemoveDenomRatio message validation.
+func (msg MsgRemoveDenomRatio) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.ModeratorAddress); err != nil {
+		return sdkerrors.ErrInvalidAddress.Wrapf("invalid moderator address: %s", err)
+	}
+	if err := sdk.ValidateDenom(msg.Denom); err != nil {
+		return sdkerrors.ErrInvalidCoins.Wrapf("%s", err)
+	}
+	if msg.ActivationHeight < 0 {
+		return ErrInvalidRatioActivation.Wrapf("negative activation height: %d", msg.ActivationHeight)
+	}
+	if msg.ActivationHeight > 0 && !msg.ActivationTime.IsZero() {
+		return ErrInvalidRatioActivation.Wrap("only one of activation height and activation time can be set")
+	}
+	return nil
+}
@@ -2,6 +2,7 @@ package types
 
 import (
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/require"
 
@@ -93,3 +94,150 @@ func TestMsgDepositIntoCommunityPool(t *testing.T) {
 		}
 	}
 }
+
+// test ValidateBasic for MsgChangeRatio
+func TestMsgChangeRatio(t *testing.T) {
+	ratio := InitialRatio()
+	tests := []struct {
+		moderator        sdk.AccAddress
+		ratio            Ratio
+		activationHeight int64
+		activationTime   time.Time
+		expectPass       bool
+	}{
+		{delAddr1, ratio, 0, time.Time{}, true},
+		{delAddr1, ratio, 10, time.Time{}, true},
+		{delAddr1, ratio, 0, time.Unix(1700000000, 0), true},
+		{emptyDelAddr, ratio, 0, time.Time{}, false},
+		{delAddr1, Ratio{StakingRewards: sdk.OneDec(), Base: sdk.OneDec(), Burn: sdk.ZeroDec()}, 0, time.Time{}, false},
+		{delAddr1, ratio, -1, time.Time{}, false},
+		{delAddr1, ratio, 10, time.Unix(1700000000, 0), false},
+	}
+	for i, tc := range tests {
+		msg := NewMsgChangeRatio(tc.moderator, tc.ratio)
+		msg.ActivationHeight = tc.activationHeight
+		msg.ActivationTime = tc.activationTime
+		if tc.expectPass {
+			require.Nil(t, msg.ValidateBasic(), "test index: %v", i)
+		} else {
+			require.NotNil(t, msg.ValidateBasic(), "test index: %v", i)
+		}
+	}
+}
+
+// test ValidateBasic for MsgCancelRatioChange
+func TestMsgCancelRatioChange(t *testing.T) {
+	tests := []struct {
+		moderator  sdk.AccAddress
+		changeID   uint64
+		expectPass bool
+	}{
+		{delAddr1, 1, true},
+		{delAddr1, 0, false},
+		{emptyDelAddr, 1, false},
+	}
+	for i, tc := range tests {
+		msg := NewMsgCancelRatioChange(tc.moderator, tc.changeID)
+		if tc.expectPass {
+			require.Nil(t, msg.ValidateBasic(), "test index: %v", i)
+		} else {
+			require.NotNil(t, msg.ValidateBasic(), "test index: %v", i)
+		}
+	}
+}
+
+func TestMsgAcceptModerator(t *testing.T) {
+	tests := []struct {
+		newModerator sdk.AccAddress
+		expectPass   bool
+	}{
+		{delAddr1, true},
+		{emptyDelAddr, false},
+	}
+	for i, tc := range tests {
+		msg := NewMsgAcceptModerator(tc.newModerator)
+		if tc.expectPass {
+			require.Nil(t, msg.ValidateBasic(), "test index: %v", i)
+		} else {
+			require.NotNil(t, msg.ValidateBasic(), "test index: %v", i)
+		}
+	}
+}
+
+func TestMsgWithdrawShareRecordReward(t *testing.T) {
+	tests := []struct {
+		owner      sdk.AccAddress
+		expectPass bool
+	}{
+		{delAddr1, true},
+		{emptyDelAddr, false},
+	}
+	for i, tc := range tests {
+		msg := NewMsgWithdrawShareRecordReward(tc.owner)
+		if tc.expectPass {
+			require.Nil(t, msg.ValidateBasic(), "test index: %v", i)
+		} else {
+			require.NotNil(t, msg.ValidateBasic(), "test index: %v", i)
+		}
+	}
+}
+
+func TestMsgSetDenomRatio(t *testing.T) {
+	ratio := Ratio{StakingRewards: sdk.NewDecWithPrec(5, 1), Base: sdk.NewDecWithPrec(5, 1), Burn: sdk.ZeroDec()}
+	tests := []struct {
+		moderator        sdk.AccAddress
+		denom            string
+		ratio            Ratio
+		activationHeight int64
+		activationTime   time.Time
+		expectPass       bool
+	}{
+		{delAddr1, "ibc/27394FB092D2ECCD56123C74F36E4C1F926001CEADA9CA97EA622B25F41E5EB2", ratio, 0, time.Time{}, true},
+		{delAddr1, "", ratio, 0, time.Time{}, false},
+		{delAddr1, "stake", InitialRatio(), 0, time.Time{}, true},
+		{delAddr1, "stake", Ratio{StakingRewards: sdk.OneDec(), Base: sdk.OneDec(), Burn: sdk.ZeroDec()}, 0, time.Time{}, false},
+		{emptyDelAddr, "stake", ratio, 0, time.Time{}, false},
+		{delAddr1, "stake", ratio, 10, time.Time{}, true},
+		{delAddr1, "stake", ratio, 0, time.Unix(1700000000, 0), true},
+		{delAddr1, "stake", ratio, -1, time.Time{}, false},
+		{delAddr1, "stake", ratio, 10, time.Unix(1700000000, 0), false},
+	}
+	for i, tc := range tests {
+		msg := NewMsgSetDenomRatio(tc.moderator, tc.denom, tc.ratio)
+		msg.ActivationHeight = tc.activationHeight
+		msg.ActivationTime = tc.activationTime
+		if tc.expectPass {
+			require.Nil(t, msg.ValidateBasic(), "test index: %v", i)
+		} else {
+			require.NotNil(t, msg.ValidateBasic(), "test index: %v", i)
+		}
+	}
+}
+
+func TestMsgRemoveDenomRatio(t *testing.T) {
+	tests := []struct {
+		moderator        sdk.AccAddress
+		denom            string
+		activationHeight int64
+		activationTime   time.Time
+		expectPass       bool
+	}{
+		{delAddr1, "stake", 0, time.Time{}, true},
+		{delAddr1, "", 0, time.Time{}, false},
+		{emptyDelAddr, "stake", 0, time.Time{}, false},
+		{delAddr1, "stake", 10, time.Time{}, true},
+		{delAddr1, "stake", 0, time.Unix(1700000000, 0), true},
+		{delAddr1, "stake", -1, time.Time{}, false},
+		{delAddr1, "stake", 10, time.Unix(1700000000, 0), false},
+	}
+	for i, tc := range tests {
+		msg := NewMsgRemoveDenomRatio(tc.moderator, tc.denom)
+		msg.ActivationHeight = tc.activationHeight
+		msg.ActivationTime = tc.activationTime
+		if tc.expectPass {
+			require.Nil(t, msg.ValidateBasic(), "test index: %v", i)
+		} else {
+			require.NotNil(t, msg.ValidateBasic(), "test index: %v", i)
+		}
+	}
+}
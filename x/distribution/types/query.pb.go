@@ -993,6 +993,9 @@ var xxx_messageInfo_QueryRatioRequest proto.InternalMessageInfo
 // RPC method
 type QueryRatioResponse struct {
 	Ratio Ratio `protobuf:"bytes,1,opt,name=ratio,proto3" json:"ratio"`
+	// denom_ratios defines the ratios overriding the default ratio for the fees
+	// collected in specific denoms.
+	DenomRatios []DenomRatio `protobuf:"bytes,2,rep,name=denom_ratios,json=denomRatios,proto3" json:"denom_ratios"`
 }
 
 func (m *QueryRatioResponse) Reset()         { *m = QueryRatioResponse{} }
@@ -1035,6 +1038,219 @@ func (m *QueryRatioResponse) GetRatio() Ratio {
 	return Ratio{}
 }
 
+func (m *QueryRatioResponse) GetDenomRatios() []DenomRatio {
+	if m != nil {
+		return m.DenomRatios
+	}
+	return nil
+}
+
+// QueryPendingRatioChangesRequest is the request for the Query/PendingRatioChanges
+// RPC method
+type QueryPendingRatioChangesRequest struct {
+	// pagination defines an optional pagination for the request.
+	Pagination *query.PageRequest `protobuf:"bytes,1,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryPendingRatioChangesRequest) Reset()         { *m = QueryPendingRatioChangesRequest{} }
+func (m *QueryPendingRatioChangesRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryPendingRatioChangesRequest) ProtoMessage()    {}
+func (*QueryPendingRatioChangesRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5efd02cbc06efdc9, []int{22}
+}
+func (m *QueryPendingRatioChangesRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryPendingRatioChangesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryPendingRatioChangesRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryPendingRatioChangesRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryPendingRatioChangesRequest.Merge(m, src)
+}
+func (m *QueryPendingRatioChangesRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryPendingRatioChangesRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryPendingRatioChangesRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryPendingRatioChangesRequest proto.InternalMessageInfo
+
+func (m *QueryPendingRatioChangesRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+// QueryPendingRatioChangesResponse is the response type for the Query/PendingRatioChanges
+// RPC method
+type QueryPendingRatioChangesResponse struct {
+	// changes defines the scheduled ratio changes ordered by id.
+	Changes []RatioChange `protobuf:"bytes,1,rep,name=changes,proto3" json:"changes"`
+	// pagination defines the pagination in the response.
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryPendingRatioChangesResponse) Reset()         { *m = QueryPendingRatioChangesResponse{} }
+func (m *QueryPendingRatioChangesResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryPendingRatioChangesResponse) ProtoMessage()    {}
+func (*QueryPendingRatioChangesResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5efd02cbc06efdc9, []int{23}
+}
+func (m *QueryPendingRatioChangesResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryPendingRatioChangesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryPendingRatioChangesResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryPendingRatioChangesResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryPendingRatioChangesResponse.Merge(m, src)
+}
+func (m *QueryPendingRatioChangesResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryPendingRatioChangesResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryPendingRatioChangesResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryPendingRatioChangesResponse proto.InternalMessageInfo
+
+func (m *QueryPendingRatioChangesResponse) GetChanges() []RatioChange {
+	if m != nil {
+		return m.Changes
+	}
+	return nil
+}
+
+func (m *QueryPendingRatioChangesResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+// QueryRatioHistoryRequest is the request for the Query/RatioHistory
+// RPC method
+type QueryRatioHistoryRequest struct {
+	// pagination defines an optional pagination for the request.
+	Pagination *query.PageRequest `protobuf:"bytes,1,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryRatioHistoryRequest) Reset()         { *m = QueryRatioHistoryRequest{} }
+func (m *QueryRatioHistoryRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryRatioHistoryRequest) ProtoMessage()    {}
+func (*QueryRatioHistoryRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5efd02cbc06efdc9, []int{24}
+}
+func (m *QueryRatioHistoryRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryRatioHistoryRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryRatioHistoryRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryRatioHistoryRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryRatioHistoryRequest.Merge(m, src)
+}
+func (m *QueryRatioHistoryRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryRatioHistoryRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryRatioHistoryRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryRatioHistoryRequest proto.InternalMessageInfo
+
+func (m *QueryRatioHistoryRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+// QueryRatioHistoryResponse is the response type for the Query/RatioHistory
+// RPC method
+type QueryRatioHistoryResponse struct {
+	// history defines the effective ratios ordered by activation height.
+	History []RatioHistoryEntry `protobuf:"bytes,1,rep,name=history,proto3" json:"history"`
+	// pagination defines the pagination in the response.
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryRatioHistoryResponse) Reset()         { *m = QueryRatioHistoryResponse{} }
+func (m *QueryRatioHistoryResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryRatioHistoryResponse) ProtoMessage()    {}
+func (*QueryRatioHistoryResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5efd02cbc06efdc9, []int{25}
+}
+func (m *QueryRatioHistoryResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryRatioHistoryResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryRatioHistoryResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryRatioHistoryResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryRatioHistoryResponse.Merge(m, src)
+}
+func (m *QueryRatioHistoryResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryRatioHistoryResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryRatioHistoryResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryRatioHistoryResponse proto.InternalMessageInfo
+
+func (m *QueryRatioHistoryResponse) GetHistory() []RatioHistoryEntry {
+	if m != nil {
+		return m.History
+	}
+	return nil
+}
+
+func (m *QueryRatioHistoryResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
 // QueryBaseAddressRequest is the request for the Query/BurnAddress
 // RPC method
 type QueryBaseAddressRequest struct {
@@ -1044,7 +1260,7 @@ func (m *QueryBaseAddressRequest) Reset()         { *m = QueryBaseAddressRequest
 func (m *QueryBaseAddressRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryBaseAddressRequest) ProtoMessage()    {}
 func (*QueryBaseAddressRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_5efd02cbc06efdc9, []int{22}
+	return fileDescriptor_5efd02cbc06efdc9, []int{26}
 }
 func (m *QueryBaseAddressRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1083,7 +1299,7 @@ func (m *QueryBaseAddressResponse) Reset()         { *m = QueryBaseAddressRespon
 func (m *QueryBaseAddressResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryBaseAddressResponse) ProtoMessage()    {}
 func (*QueryBaseAddressResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_5efd02cbc06efdc9, []int{23}
+	return fileDescriptor_5efd02cbc06efdc9, []int{27}
 }
 func (m *QueryBaseAddressResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1119,6 +1335,90 @@ func (m *QueryBaseAddressResponse) GetBaseAddress() string {
 	return ""
 }
 
+// QueryBaseRecipientsRequest is the request for the Query/BaseRecipients
+// RPC method
+type QueryBaseRecipientsRequest struct {
+}
+
+func (m *QueryBaseRecipientsRequest) Reset()         { *m = QueryBaseRecipientsRequest{} }
+func (m *QueryBaseRecipientsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryBaseRecipientsRequest) ProtoMessage()    {}
+func (*QueryBaseRecipientsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5efd02cbc06efdc9, []int{28}
+}
+func (m *QueryBaseRecipientsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryBaseRecipientsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryBaseRecipientsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryBaseRecipientsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryBaseRecipientsRequest.Merge(m, src)
+}
+func (m *QueryBaseRecipientsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryBaseRecipientsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryBaseRecipientsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryBaseRecipientsRequest proto.InternalMessageInfo
+
+// QueryBaseRecipientsResponse is the response type for the Query/BaseRecipients
+// RPC method
+type QueryBaseRecipientsResponse struct {
+	Recipients []BaseRecipient `protobuf:"bytes,1,rep,name=recipients,proto3" json:"recipients"`
+}
+
+func (m *QueryBaseRecipientsResponse) Reset()         { *m = QueryBaseRecipientsResponse{} }
+func (m *QueryBaseRecipientsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryBaseRecipientsResponse) ProtoMessage()    {}
+func (*QueryBaseRecipientsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5efd02cbc06efdc9, []int{29}
+}
+func (m *QueryBaseRecipientsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryBaseRecipientsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryBaseRecipientsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryBaseRecipientsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryBaseRecipientsResponse.Merge(m, src)
+}
+func (m *QueryBaseRecipientsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryBaseRecipientsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryBaseRecipientsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryBaseRecipientsResponse proto.InternalMessageInfo
+
+func (m *QueryBaseRecipientsResponse) GetRecipients() []BaseRecipient {
+	if m != nil {
+		return m.Recipients
+	}
+	return nil
+}
+
 // QueryModeratorRequest is the request for the Query/Moderator
 // RPC method
 type QueryModeratorRequest struct {
@@ -1128,7 +1428,7 @@ func (m *QueryModeratorRequest) Reset()         { *m = QueryModeratorRequest{} }
 func (m *QueryModeratorRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryModeratorRequest) ProtoMessage()    {}
 func (*QueryModeratorRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_5efd02cbc06efdc9, []int{24}
+	return fileDescriptor_5efd02cbc06efdc9, []int{30}
 }
 func (m *QueryModeratorRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1167,7 +1467,7 @@ func (m *QueryModeratorResponse) Reset()         { *m = QueryModeratorResponse{}
 func (m *QueryModeratorResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryModeratorResponse) ProtoMessage()    {}
 func (*QueryModeratorResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_5efd02cbc06efdc9, []int{25}
+	return fileDescriptor_5efd02cbc06efdc9, []int{31}
 }
 func (m *QueryModeratorResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1203,152 +1503,574 @@ func (m *QueryModeratorResponse) GetModeratorAddress() string {
 	return ""
 }
 
-func init() {
-	proto.RegisterType((*QueryParamsRequest)(nil), "cosmos.distribution.v1beta1.QueryParamsRequest")
-	proto.RegisterType((*QueryParamsResponse)(nil), "cosmos.distribution.v1beta1.QueryParamsResponse")
-	proto.RegisterType((*QueryValidatorDistributionInfoRequest)(nil), "cosmos.distribution.v1beta1.QueryValidatorDistributionInfoRequest")
-	proto.RegisterType((*QueryValidatorDistributionInfoResponse)(nil), "cosmos.distribution.v1beta1.QueryValidatorDistributionInfoResponse")
-	proto.RegisterType((*QueryValidatorOutstandingRewardsRequest)(nil), "cosmos.distribution.v1beta1.QueryValidatorOutstandingRewardsRequest")
-	proto.RegisterType((*QueryValidatorOutstandingRewardsResponse)(nil), "cosmos.distribution.v1beta1.QueryValidatorOutstandingRewardsResponse")
-	proto.RegisterType((*QueryValidatorCommissionRequest)(nil), "cosmos.distribution.v1beta1.QueryValidatorCommissionRequest")
-	proto.RegisterType((*QueryValidatorCommissionResponse)(nil), "cosmos.distribution.v1beta1.QueryValidatorCommissionResponse")
-	proto.RegisterType((*QueryValidatorSlashesRequest)(nil), "cosmos.distribution.v1beta1.QueryValidatorSlashesRequest")
-	proto.RegisterType((*QueryValidatorSlashesResponse)(nil), "cosmos.distribution.v1beta1.QueryValidatorSlashesResponse")
-	proto.RegisterType((*QueryDelegationRewardsRequest)(nil), "cosmos.distribution.v1beta1.QueryDelegationRewardsRequest")
-	proto.RegisterType((*QueryDelegationRewardsResponse)(nil), "cosmos.distribution.v1beta1.QueryDelegationRewardsResponse")
-	proto.RegisterType((*QueryDelegationTotalRewardsRequest)(nil), "cosmos.distribution.v1beta1.QueryDelegationTotalRewardsRequest")
-	proto.RegisterType((*QueryDelegationTotalRewardsResponse)(nil), "cosmos.distribution.v1beta1.QueryDelegationTotalRewardsResponse")
-	proto.RegisterType((*QueryDelegatorValidatorsRequest)(nil), "cosmos.distribution.v1beta1.QueryDelegatorValidatorsRequest")
-	proto.RegisterType((*QueryDelegatorValidatorsResponse)(nil), "cosmos.distribution.v1beta1.QueryDelegatorValidatorsResponse")
-	proto.RegisterType((*QueryDelegatorWithdrawAddressRequest)(nil), "cosmos.distribution.v1beta1.QueryDelegatorWithdrawAddressRequest")
-	proto.RegisterType((*QueryDelegatorWithdrawAddressResponse)(nil), "cosmos.distribution.v1beta1.QueryDelegatorWithdrawAddressResponse")
-	proto.RegisterType((*QueryCommunityPoolRequest)(nil), "cosmos.distribution.v1beta1.QueryCommunityPoolRequest")
-	proto.RegisterType((*QueryCommunityPoolResponse)(nil), "cosmos.distribution.v1beta1.QueryCommunityPoolResponse")
-	proto.RegisterType((*QueryRatioRequest)(nil), "cosmos.distribution.v1beta1.QueryRatioRequest")
-	proto.RegisterType((*QueryRatioResponse)(nil), "cosmos.distribution.v1beta1.QueryRatioResponse")
-	proto.RegisterType((*QueryBaseAddressRequest)(nil), "cosmos.distribution.v1beta1.QueryBaseAddressRequest")
-	proto.RegisterType((*QueryBaseAddressResponse)(nil), "cosmos.distribution.v1beta1.QueryBaseAddressResponse")
-	proto.RegisterType((*QueryModeratorRequest)(nil), "cosmos.distribution.v1beta1.QueryModeratorRequest")
-	proto.RegisterType((*QueryModeratorResponse)(nil), "cosmos.distribution.v1beta1.QueryModeratorResponse")
+// QueryPendingModeratorRequest is the request for the Query/PendingModerator
+// RPC method
+type QueryPendingModeratorRequest struct {
 }
 
-func init() {
-	proto.RegisterFile("cosmos/distribution/v1beta1/query.proto", fileDescriptor_5efd02cbc06efdc9)
+func (m *QueryPendingModeratorRequest) Reset()         { *m = QueryPendingModeratorRequest{} }
+func (m *QueryPendingModeratorRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryPendingModeratorRequest) ProtoMessage()    {}
+func (*QueryPendingModeratorRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5efd02cbc06efdc9, []int{32}
 }
-
-var fileDescriptor_5efd02cbc06efdc9 = []byte{
-	// 1413 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x58, 0xdd, 0x6f, 0x14, 0x55,
-	0x14, 0xef, 0x5d, 0x5a, 0x90, 0x53, 0x10, 0x7a, 0xf9, 0xda, 0x0e, 0xb8, 0x6d, 0xa6, 0x40, 0x2b,
-	0x0d, 0x3b, 0xb4, 0x55, 0x54, 0x2a, 0x6a, 0xb7, 0x2d, 0x62, 0x40, 0x3e, 0x16, 0x42, 0xd1, 0x97,
-	0xcd, 0xec, 0xce, 0xb0, 0x9d, 0xb0, 0x3b, 0x77, 0x99, 0x99, 0x6d, 0x25, 0x84, 0x17, 0x91, 0xc4,
-	0x17, 0x13, 0x13, 0x7d, 0xe0, 0x91, 0x67, 0xa3, 0x6f, 0x1a, 0x13, 0xfe, 0x02, 0x1e, 0x89, 0x26,
-	0xc6, 0x27, 0x31, 0xc5, 0x18, 0x7c, 0xf0, 0xd9, 0x57, 0x33, 0xf7, 0x9e, 0x99, 0x9d, 0xd9, 0x8f,
-	0xd9, 0x99, 0x6e, 0xf7, 0x89, 0xe5, 0xdc, 0x7b, 0xce, 0xf9, 0xfd, 0xce, 0xb9, 0xe7, 0xce, 0xef,
-	0x16, 0x26, 0x4b, 0xcc, 0xae, 0x32, 0x5b, 0xd1, 0x0c, 0xdb, 0xb1, 0x8c, 0x62, 0xdd, 0x31, 0x98,
-	0xa9, 0xac, 0xcd, 0x14, 0x75, 0x47, 0x9d, 0x51, 0xee, 0xd4, 0x75, 0xeb, 0x6e, 0xb6, 0x66, 0x31,
-	0x87, 0xd1, 0xc3, 0x62, 0x63, 0x36, 0xb8, 0x31, 0x8b, 0x1b, 0xa5, 0x13, 0x18, 0xa5, 0xa8, 0xda,
-	0xba, 0xf0, 0xf2, 0x63, 0xd4, 0xd4, 0xb2, 0x61, 0xaa, 0x7c, 0x37, 0x0f, 0x24, 0xed, 0x2f, 0xb3,
-	0x32, 0xe3, 0x3f, 0x15, 0xf7, 0x17, 0x5a, 0x8f, 0x94, 0x19, 0x2b, 0x57, 0x74, 0x45, 0xad, 0x19,
-	0x8a, 0x6a, 0x9a, 0xcc, 0xe1, 0x2e, 0x36, 0xae, 0x66, 0x82, 0xf1, 0xbd, 0xc8, 0x25, 0x66, 0x78,
-	0x31, 0xb3, 0x51, 0x2c, 0x42, 0x88, 0xc5, 0xfe, 0x51, 0xb1, 0xbf, 0x20, 0x60, 0x20, 0x33, 0xfe,
-	0x1f, 0x79, 0x3f, 0xd0, 0xab, 0x2e, 0x81, 0x2b, 0xaa, 0xa5, 0x56, 0xed, 0xbc, 0x7e, 0xa7, 0xae,
-	0xdb, 0x8e, 0x7c, 0x13, 0xf6, 0x85, 0xac, 0x76, 0x8d, 0x99, 0xb6, 0x4e, 0x17, 0x60, 0x7b, 0x8d,
-	0x5b, 0xd2, 0x64, 0x9c, 0x4c, 0x0d, 0xcf, 0x4e, 0x64, 0x23, 0xaa, 0x94, 0x15, 0xce, 0xb9, 0xc1,
-	0xa7, 0x7f, 0x8c, 0x0d, 0xe4, 0xd1, 0x51, 0x36, 0xe1, 0x18, 0x8f, 0x7c, 0x43, 0xad, 0x18, 0x9a,
-	0xea, 0x30, 0x6b, 0x29, 0xe0, 0xfa, 0x91, 0x79, 0x8b, 0x21, 0x04, 0xba, 0x0c, 0x23, 0x6b, 0xde,
-	0x9e, 0x82, 0xaa, 0x69, 0x96, 0x6e, 0x8b, 0xb4, 0x3b, 0x73, 0xe9, 0x5f, 0x7e, 0x3c, 0xb9, 0x1f,
-	0x33, 0x2f, 0x88, 0x95, 0x6b, 0x8e, 0x65, 0x98, 0xe5, 0xfc, 0x5e, 0xdf, 0x05, 0xed, 0xf2, 0xf3,
-	0x14, 0x1c, 0xef, 0x96, 0x10, 0xd9, 0x2d, 0xc2, 0x5e, 0x56, 0xd3, 0xad, 0x44, 0x09, 0xf7, 0x78,
-	0x1e, 0x68, 0xa6, 0xf7, 0x61, 0xc4, 0xd6, 0x2b, 0xb7, 0x0a, 0x45, 0x66, 0x6a, 0x05, 0x4b, 0x5f,
-	0x57, 0x2d, 0xcd, 0x4e, 0xa7, 0xc6, 0xb7, 0x4d, 0x0d, 0xcf, 0x1e, 0xf1, 0xaa, 0xe5, 0xb6, 0xd5,
-	0xaf, 0xd2, 0x92, 0x5e, 0x5a, 0x64, 0x86, 0x99, 0x9b, 0x73, 0xcb, 0xf4, 0xdd, 0xf3, 0xb1, 0xe9,
-	0xb2, 0xe1, 0xac, 0xd6, 0x8b, 0xd9, 0x12, 0xab, 0x62, 0xa7, 0xf0, 0x9f, 0x93, 0xb6, 0x76, 0x5b,
-	0x71, 0xee, 0xd6, 0x74, 0xdb, 0xf3, 0xb1, 0xf3, 0x7b, 0xdc, 0x5c, 0x39, 0x66, 0x6a, 0x79, 0x91,
-	0x89, 0xde, 0x01, 0x28, 0xb1, 0x6a, 0xd5, 0xb0, 0x6d, 0x83, 0x99, 0xe9, 0x6d, 0xfd, 0xca, 0x1b,
-	0x48, 0x22, 0xd7, 0x60, 0x32, 0x5c, 0xe0, 0xcb, 0x75, 0xc7, 0x76, 0x54, 0x53, 0x73, 0xeb, 0x23,
-	0x60, 0x6d, 0x71, 0x4f, 0xbf, 0x20, 0x30, 0xd5, 0x3d, 0x25, 0x76, 0xf5, 0x26, 0xec, 0xf0, 0xda,
-	0x20, 0x0e, 0xed, 0xdb, 0x91, 0x87, 0x36, 0x22, 0x24, 0x9e, 0x64, 0x2f, 0x9c, 0xbc, 0x0a, 0x63,
-	0x61, 0x14, 0x8b, 0x7e, 0x51, 0xb6, 0x98, 0xf0, 0x43, 0x02, 0xe3, 0x9d, 0x53, 0x21, 0x51, 0x35,
-	0xd4, 0x7a, 0xc1, 0x75, 0x3e, 0x1e, 0xd7, 0x85, 0x52, 0xa9, 0x5e, 0xad, 0x57, 0x54, 0x47, 0xd7,
-	0x1a, 0x81, 0x91, 0x6e, 0xb0, 0xd5, 0x0f, 0x53, 0x70, 0x24, 0x8c, 0xe3, 0x5a, 0x45, 0xb5, 0x57,
-	0xf5, 0x2d, 0x6e, 0x30, 0x9d, 0x84, 0x3d, 0xb6, 0xa3, 0x5a, 0x8e, 0x61, 0x96, 0x0b, 0xab, 0xba,
-	0x51, 0x5e, 0x75, 0xd2, 0xa9, 0x71, 0x32, 0x35, 0x98, 0x7f, 0xd5, 0x33, 0x9f, 0xe7, 0x56, 0x3a,
-	0x01, 0xbb, 0x75, 0xde, 0x22, 0x6f, 0xdb, 0x36, 0xbe, 0x6d, 0x97, 0x30, 0xe2, 0xa6, 0x73, 0x00,
-	0x8d, 0x5b, 0x39, 0x3d, 0xc8, 0x0b, 0x73, 0x3c, 0x34, 0x13, 0xe2, 0xe2, 0x6f, 0xdc, 0x5b, 0x65,
-	0x1d, 0x09, 0xe5, 0x03, 0x9e, 0x67, 0x5e, 0xf9, 0xf2, 0xf1, 0xd8, 0xc0, 0xa3, 0xc7, 0x63, 0x44,
-	0x7e, 0x42, 0xe0, 0xb5, 0x0e, 0x75, 0xc0, 0x66, 0x5c, 0x81, 0x1d, 0xb6, 0x30, 0xa5, 0x09, 0x1f,
-	0xc2, 0x53, 0xf1, 0x3a, 0xc1, 0xe3, 0x2c, 0xaf, 0xe9, 0xa6, 0xe3, 0x9d, 0x36, 0x0c, 0x43, 0x3f,
-	0x0c, 0xb1, 0x48, 0x71, 0x16, 0x93, 0x5d, 0x59, 0x08, 0x38, 0x41, 0x1a, 0xf2, 0xcf, 0x1e, 0xf8,
-	0x25, 0xbd, 0xa2, 0x97, 0xb9, 0xad, 0x75, 0x4c, 0x35, 0xb1, 0x96, 0xa4, 0x8b, 0xbe, 0x8b, 0xd7,
-	0xc5, 0xb6, 0x87, 0x21, 0x95, 0xf4, 0x30, 0x88, 0xb2, 0xbf, 0x7c, 0x3c, 0x36, 0x20, 0x7f, 0x45,
-	0x20, 0xd3, 0x09, 0x39, 0xd6, 0xfd, 0x76, 0x70, 0xda, 0xfb, 0x74, 0xf9, 0xf9, 0x17, 0x40, 0x1d,
-	0xe4, 0x26, 0x38, 0xd7, 0x99, 0xa3, 0x56, 0xfa, 0x52, 0xcd, 0x40, 0x19, 0xfe, 0x26, 0x30, 0x11,
-	0x99, 0x17, 0x6b, 0x71, 0xa3, 0xb9, 0x16, 0xa7, 0x23, 0xcf, 0x60, 0x23, 0xda, 0x92, 0x97, 0x5b,
-	0x44, 0x6c, 0xba, 0xf7, 0x68, 0x19, 0x86, 0x1c, 0x37, 0x5f, 0xff, 0x3e, 0x6b, 0x22, 0xbe, 0x6c,
-	0xe1, 0x05, 0xeb, 0xe3, 0xf1, 0xc7, 0xa4, 0x7f, 0xc5, 0xbd, 0x88, 0x37, 0x6d, 0xdb, 0x9c, 0x58,
-	0xd8, 0x0c, 0x80, 0x7f, 0x4a, 0x45, 0x6d, 0x77, 0xe6, 0x03, 0x96, 0x40, 0xb4, 0x75, 0x38, 0x1a,
-	0x8e, 0xb6, 0x62, 0x38, 0xab, 0x9a, 0xa5, 0xae, 0x63, 0xe2, 0xbe, 0xd1, 0x58, 0x43, 0x99, 0xd5,
-	0x39, 0x71, 0x43, 0xf4, 0xac, 0xe3, 0x52, 0x7c, 0xd1, 0xb3, 0x1e, 0x0e, 0x16, 0xc8, 0x7b, 0x18,
-	0x46, 0x79, 0x5e, 0xf7, 0x33, 0x52, 0x37, 0x0d, 0xe7, 0xee, 0x15, 0xc6, 0x2a, 0x9e, 0xaa, 0x7c,
-	0x40, 0x40, 0x6a, 0xb7, 0x8a, 0x50, 0x74, 0x18, 0xac, 0x31, 0x56, 0xe9, 0xdf, 0xe0, 0xf2, 0xf0,
-	0xf2, 0x3e, 0x18, 0xe1, 0x20, 0xf2, 0xee, 0x59, 0xf7, 0xa0, 0x5d, 0x47, 0x19, 0x8c, 0x46, 0x44,
-	0xf4, 0x1e, 0x0c, 0x59, 0xae, 0x01, 0xbf, 0xa6, 0x72, 0xe4, 0xfc, 0x70, 0x57, 0x9c, 0x15, 0xe1,
-	0x26, 0x8f, 0xc2, 0x21, 0x1e, 0x35, 0xa7, 0xda, 0x7a, 0xb8, 0xe3, 0xf2, 0x0a, 0xa4, 0x5b, 0x97,
-	0x30, 0xed, 0x3c, 0xec, 0x72, 0x49, 0xc7, 0xee, 0xc7, 0x70, 0xb1, 0x11, 0x44, 0x3e, 0x04, 0x07,
-	0x78, 0xe0, 0x8f, 0x99, 0x26, 0x94, 0xa9, 0x97, 0xb1, 0x00, 0x07, 0x9b, 0x17, 0x30, 0xdf, 0x32,
-	0x8c, 0x54, 0x3d, 0x63, 0xfc, 0xd3, 0xe7, 0xbb, 0xa0, 0x7d, 0xf6, 0xc9, 0x01, 0x18, 0xe2, 0x19,
-	0xe8, 0x23, 0x02, 0xdb, 0x85, 0xfa, 0xa7, 0x4a, 0x64, 0xcd, 0x5a, 0x9f, 0x1e, 0xd2, 0xa9, 0xf8,
-	0x0e, 0x02, 0xbe, 0x3c, 0xfd, 0xf9, 0xaf, 0x7f, 0x7d, 0x93, 0x3a, 0x46, 0x27, 0x94, 0xa8, 0x67,
-	0x91, 0x78, 0x7f, 0xd0, 0x7f, 0x08, 0x8c, 0x76, 0x7c, 0x0a, 0xd0, 0x5c, 0xf7, 0xe4, 0xdd, 0x1e,
-	0x2e, 0xd2, 0x62, 0x4f, 0x31, 0x90, 0xd3, 0x22, 0xe7, 0x74, 0x96, 0xce, 0x47, 0x72, 0x6a, 0xdc,
-	0x39, 0xca, 0xbd, 0x96, 0x4f, 0xed, 0x7d, 0xfa, 0x20, 0x05, 0x87, 0x23, 0xf4, 0x2c, 0x5d, 0x4a,
-	0x80, 0xb4, 0xa3, 0xa8, 0x97, 0x96, 0x7b, 0x8c, 0x82, 0x8c, 0x57, 0x38, 0xe3, 0xab, 0xf4, 0x72,
-	0x0f, 0x8c, 0x15, 0xd6, 0x88, 0xef, 0x3d, 0xbe, 0xe8, 0x06, 0x81, 0x7d, 0x6d, 0x74, 0x33, 0x7d,
-	0x37, 0x01, 0xee, 0x16, 0x65, 0x2f, 0x9d, 0xdd, 0xa4, 0x37, 0xb2, 0xbd, 0xc4, 0xd9, 0x9e, 0xa7,
-	0xe7, 0x7a, 0x61, 0xdb, 0x50, 0xe6, 0xf4, 0x37, 0x02, 0x7b, 0x9b, 0xc5, 0x28, 0x7d, 0x27, 0x01,
-	0xc6, 0xb0, 0x90, 0x97, 0xce, 0x6c, 0xc6, 0x15, 0xb9, 0x5d, 0xe0, 0xdc, 0x96, 0xe9, 0x62, 0x2f,
-	0xdc, 0x3c, 0xd9, 0xfb, 0x2f, 0x81, 0x91, 0x16, 0xb9, 0x47, 0x63, 0xc0, 0xeb, 0xa4, 0x6e, 0xa5,
-	0xf9, 0x4d, 0xf9, 0x22, 0xb7, 0x02, 0xe7, 0xf6, 0x09, 0x5d, 0x89, 0xe4, 0xe6, 0x7f, 0x98, 0x6d,
-	0xe5, 0x5e, 0xcb, 0x77, 0xfd, 0xbe, 0x82, 0x27, 0xb3, 0xed, 0xcc, 0xbe, 0x24, 0x70, 0xb0, 0xbd,
-	0xae, 0xa3, 0xef, 0x27, 0x01, 0xde, 0x46, 0x89, 0x4a, 0x1f, 0x6c, 0x3e, 0x40, 0xa2, 0xd6, 0xc6,
-	0xa3, 0xcf, 0x07, 0xb3, 0x8d, 0xcc, 0x8a, 0x33, 0x98, 0x9d, 0x15, 0x61, 0x9c, 0xc1, 0x8c, 0xd0,
-	0x76, 0x31, 0x07, 0xb3, 0x0b, 0xc3, 0xc6, 0xd9, 0xa6, 0xff, 0x11, 0x48, 0x77, 0x12, 0x61, 0x74,
-	0x21, 0x01, 0xd6, 0xf6, 0xca, 0x51, 0xca, 0xf5, 0x12, 0x02, 0x39, 0x5f, 0xe7, 0x9c, 0x2f, 0xd1,
-	0x8b, 0xbd, 0x70, 0x6e, 0x56, 0x91, 0xf4, 0x27, 0x02, 0xbb, 0x43, 0x42, 0x8f, 0x9e, 0xee, 0x8e,
-	0xb5, 0x9d, 0x6e, 0x94, 0xde, 0x4a, 0xec, 0x87, 0xc4, 0xe6, 0x38, 0xb1, 0x93, 0x74, 0x3a, 0x92,
-	0x58, 0xc9, 0xf3, 0x2d, 0xb8, 0xfa, 0x90, 0x7e, 0x4b, 0x60, 0x88, 0x6b, 0x39, 0x9a, 0xed, 0x9e,
-	0x37, 0x28, 0x22, 0x25, 0x25, 0xf6, 0x7e, 0xc4, 0x77, 0x82, 0xe3, 0x3b, 0x4a, 0xe5, 0x48, 0x7c,
-	0x5c, 0x4b, 0xd2, 0x1f, 0x08, 0x0c, 0x07, 0xc4, 0x22, 0x7d, 0xa3, 0x7b, 0xb2, 0x56, 0xd9, 0x29,
-	0xbd, 0x99, 0xd0, 0x0b, 0x81, 0xce, 0x70, 0xa0, 0xd3, 0xf4, 0xf5, 0x48, 0xa0, 0x41, 0xd1, 0x4a,
-	0xbf, 0x27, 0xb0, 0xd3, 0x97, 0x9a, 0x74, 0xb6, 0x7b, 0xde, 0x66, 0xc1, 0x2a, 0xcd, 0x25, 0xf2,
-	0x41, 0xa4, 0xa7, 0x39, 0xd2, 0x53, 0x34, 0x1b, 0x89, 0xb4, 0x45, 0xee, 0xe6, 0x2e, 0x3c, 0xdd,
-	0xc8, 0x90, 0x67, 0x1b, 0x19, 0xf2, 0xe7, 0x46, 0x86, 0x7c, 0xfd, 0x22, 0x33, 0xf0, 0xec, 0x45,
-	0x66, 0xe0, 0xf7, 0x17, 0x99, 0x81, 0x4f, 0x67, 0x22, 0x9f, 0x18, 0x9f, 0x85, 0x13, 0xf0, 0x17,
-	0x47, 0x71, 0x3b, 0xff, 0xdb, 0xfa, 0xdc, 0xff, 0x01, 0x00, 0x00, 0xff, 0xff, 0x0a, 0xb5, 0x1d,
-	0xea, 0x6e, 0x18, 0x00, 0x00,
+func (m *QueryPendingModeratorRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryPendingModeratorRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryPendingModeratorRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryPendingModeratorRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryPendingModeratorRequest.Merge(m, src)
+}
+func (m *QueryPendingModeratorRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryPendingModeratorRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryPendingModeratorRequest.DiscardUnknown(m)
 }
 
-// Reference imports to suppress errors if they are not otherwise used.
-var _ context.Context
-var _ grpc.ClientConn
+var xxx_messageInfo_QueryPendingModeratorRequest proto.InternalMessageInfo
 
-// This is a compile-time assertion to ensure that this generated file
-// is compatible with the grpc package it is being compiled against.
-const _ = grpc.SupportPackageIsVersion4
+// QueryPendingModeratorResponse is the response type for the
+// Query/PendingModerator RPC method
+type QueryPendingModeratorResponse struct {
+	// pending_moderator_address is empty when no handover is in progress.
+	PendingModeratorAddress string `protobuf:"bytes,1,opt,name=pending_moderator_address,json=pendingModeratorAddress,proto3" json:"pending_moderator_address,omitempty"`
+}
 
-// QueryClient is the client API for Query service.
-//
-// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
-type QueryClient interface {
-	// Params queries params of the distribution module.
-	Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error)
-	// ValidatorDistributionInfo queries validator commision and self-delegation rewards for validator
-	ValidatorDistributionInfo(ctx context.Context, in *QueryValidatorDistributionInfoRequest, opts ...grpc.CallOption) (*QueryValidatorDistributionInfoResponse, error)
-	// ValidatorOutstandingRewards queries rewards of a validator address.
-	ValidatorOutstandingRewards(ctx context.Context, in *QueryValidatorOutstandingRewardsRequest, opts ...grpc.CallOption) (*QueryValidatorOutstandingRewardsResponse, error)
-	// ValidatorCommission queries accumulated commission for a validator.
-	ValidatorCommission(ctx context.Context, in *QueryValidatorCommissionRequest, opts ...grpc.CallOption) (*QueryValidatorCommissionResponse, error)
+func (m *QueryPendingModeratorResponse) Reset()         { *m = QueryPendingModeratorResponse{} }
+func (m *QueryPendingModeratorResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryPendingModeratorResponse) ProtoMessage()    {}
+func (*QueryPendingModeratorResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5efd02cbc06efdc9, []int{33}
+}
+func (m *QueryPendingModeratorResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryPendingModeratorResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryPendingModeratorResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryPendingModeratorResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryPendingModeratorResponse.Merge(m, src)
+}
+func (m *QueryPendingModeratorResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryPendingModeratorResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryPendingModeratorResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryPendingModeratorResponse proto.InternalMessageInfo
+
+func (m *QueryPendingModeratorResponse) GetPendingModeratorAddress() string {
+	if m != nil {
+		return m.PendingModeratorAddress
+	}
+	return ""
+}
+
+// QueryFeeSplitTotalsRequest is the request for the Query/FeeSplitTotals
+// RPC method
+type QueryFeeSplitTotalsRequest struct {
+}
+
+func (m *QueryFeeSplitTotalsRequest) Reset()         { *m = QueryFeeSplitTotalsRequest{} }
+func (m *QueryFeeSplitTotalsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryFeeSplitTotalsRequest) ProtoMessage()    {}
+func (*QueryFeeSplitTotalsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5efd02cbc06efdc9, []int{34}
+}
+func (m *QueryFeeSplitTotalsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryFeeSplitTotalsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryFeeSplitTotalsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryFeeSplitTotalsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryFeeSplitTotalsRequest.Merge(m, src)
+}
+func (m *QueryFeeSplitTotalsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryFeeSplitTotalsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryFeeSplitTotalsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryFeeSplitTotalsRequest proto.InternalMessageInfo
+
+// QueryFeeSplitTotalsResponse is the response type for the
+// Query/FeeSplitTotals RPC method
+type QueryFeeSplitTotalsResponse struct {
+	// totals defines the cumulative fee split since genesis.
+	Totals FeeSplit `protobuf:"bytes,1,opt,name=totals,proto3" json:"totals"`
+}
+
+func (m *QueryFeeSplitTotalsResponse) Reset()         { *m = QueryFeeSplitTotalsResponse{} }
+func (m *QueryFeeSplitTotalsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryFeeSplitTotalsResponse) ProtoMessage()    {}
+func (*QueryFeeSplitTotalsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5efd02cbc06efdc9, []int{35}
+}
+func (m *QueryFeeSplitTotalsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryFeeSplitTotalsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryFeeSplitTotalsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryFeeSplitTotalsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryFeeSplitTotalsResponse.Merge(m, src)
+}
+func (m *QueryFeeSplitTotalsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryFeeSplitTotalsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryFeeSplitTotalsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryFeeSplitTotalsResponse proto.InternalMessageInfo
+
+func (m *QueryFeeSplitTotalsResponse) GetTotals() FeeSplit {
+	if m != nil {
+		return m.Totals
+	}
+	return FeeSplit{}
+}
+
+// QueryFeeSplitSnapshotsRequest is the request for the
+// Query/FeeSplitSnapshots RPC method
+type QueryFeeSplitSnapshotsRequest struct {
+	// pagination defines an optional pagination for the request.
+	Pagination *query.PageRequest `protobuf:"bytes,1,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryFeeSplitSnapshotsRequest) Reset()         { *m = QueryFeeSplitSnapshotsRequest{} }
+func (m *QueryFeeSplitSnapshotsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryFeeSplitSnapshotsRequest) ProtoMessage()    {}
+func (*QueryFeeSplitSnapshotsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5efd02cbc06efdc9, []int{36}
+}
+func (m *QueryFeeSplitSnapshotsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryFeeSplitSnapshotsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryFeeSplitSnapshotsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryFeeSplitSnapshotsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryFeeSplitSnapshotsRequest.Merge(m, src)
+}
+func (m *QueryFeeSplitSnapshotsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryFeeSplitSnapshotsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryFeeSplitSnapshotsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryFeeSplitSnapshotsRequest proto.InternalMessageInfo
+
+func (m *QueryFeeSplitSnapshotsRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+// QueryFeeSplitSnapshotsResponse is the response type for the
+// Query/FeeSplitSnapshots RPC method
+type QueryFeeSplitSnapshotsResponse struct {
+	// snapshots defines the fee splits ordered by height. Blocks without
+	// collected fees have no snapshot.
+	Snapshots []FeeSplitSnapshot `protobuf:"bytes,1,rep,name=snapshots,proto3" json:"snapshots"`
+	// retention_blocks defines the number of blocks for which snapshots are kept.
+	RetentionBlocks uint64 `protobuf:"varint,2,opt,name=retention_blocks,json=retentionBlocks,proto3" json:"retention_blocks,omitempty"`
+	// pagination defines the pagination in the response.
+	Pagination *query.PageResponse `protobuf:"bytes,3,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryFeeSplitSnapshotsResponse) Reset()         { *m = QueryFeeSplitSnapshotsResponse{} }
+func (m *QueryFeeSplitSnapshotsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryFeeSplitSnapshotsResponse) ProtoMessage()    {}
+func (*QueryFeeSplitSnapshotsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5efd02cbc06efdc9, []int{37}
+}
+func (m *QueryFeeSplitSnapshotsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryFeeSplitSnapshotsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryFeeSplitSnapshotsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryFeeSplitSnapshotsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryFeeSplitSnapshotsResponse.Merge(m, src)
+}
+func (m *QueryFeeSplitSnapshotsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryFeeSplitSnapshotsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryFeeSplitSnapshotsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryFeeSplitSnapshotsResponse proto.InternalMessageInfo
+
+func (m *QueryFeeSplitSnapshotsResponse) GetSnapshots() []FeeSplitSnapshot {
+	if m != nil {
+		return m.Snapshots
+	}
+	return nil
+}
+
+func (m *QueryFeeSplitSnapshotsResponse) GetRetentionBlocks() uint64 {
+	if m != nil {
+		return m.RetentionBlocks
+	}
+	return 0
+}
+
+func (m *QueryFeeSplitSnapshotsResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+// QueryFeeSplitSnapshotRequest is the request for the Query/FeeSplitSnapshot
+// RPC method
+type QueryFeeSplitSnapshotRequest struct {
+	Height int64 `protobuf:"varint,1,opt,name=height,proto3" json:"height,omitempty"`
+}
+
+func (m *QueryFeeSplitSnapshotRequest) Reset()         { *m = QueryFeeSplitSnapshotRequest{} }
+func (m *QueryFeeSplitSnapshotRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryFeeSplitSnapshotRequest) ProtoMessage()    {}
+func (*QueryFeeSplitSnapshotRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5efd02cbc06efdc9, []int{38}
+}
+func (m *QueryFeeSplitSnapshotRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryFeeSplitSnapshotRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryFeeSplitSnapshotRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryFeeSplitSnapshotRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryFeeSplitSnapshotRequest.Merge(m, src)
+}
+func (m *QueryFeeSplitSnapshotRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryFeeSplitSnapshotRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryFeeSplitSnapshotRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryFeeSplitSnapshotRequest proto.InternalMessageInfo
+
+func (m *QueryFeeSplitSnapshotRequest) GetHeight() int64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+// QueryFeeSplitSnapshotResponse is the response type for the
+// Query/FeeSplitSnapshot RPC method
+type QueryFeeSplitSnapshotResponse struct {
+	Snapshot FeeSplitSnapshot `protobuf:"bytes,1,opt,name=snapshot,proto3" json:"snapshot"`
+}
+
+func (m *QueryFeeSplitSnapshotResponse) Reset()         { *m = QueryFeeSplitSnapshotResponse{} }
+func (m *QueryFeeSplitSnapshotResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryFeeSplitSnapshotResponse) ProtoMessage()    {}
+func (*QueryFeeSplitSnapshotResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5efd02cbc06efdc9, []int{39}
+}
+func (m *QueryFeeSplitSnapshotResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryFeeSplitSnapshotResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryFeeSplitSnapshotResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryFeeSplitSnapshotResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryFeeSplitSnapshotResponse.Merge(m, src)
+}
+func (m *QueryFeeSplitSnapshotResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryFeeSplitSnapshotResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryFeeSplitSnapshotResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryFeeSplitSnapshotResponse proto.InternalMessageInfo
+
+func (m *QueryFeeSplitSnapshotResponse) GetSnapshot() FeeSplitSnapshot {
+	if m != nil {
+		return m.Snapshot
+	}
+	return FeeSplitSnapshot{}
+}
+
+func init() {
+	proto.RegisterType((*QueryParamsRequest)(nil), "cosmos.distribution.v1beta1.QueryParamsRequest")
+	proto.RegisterType((*QueryParamsResponse)(nil), "cosmos.distribution.v1beta1.QueryParamsResponse")
+	proto.RegisterType((*QueryValidatorDistributionInfoRequest)(nil), "cosmos.distribution.v1beta1.QueryValidatorDistributionInfoRequest")
+	proto.RegisterType((*QueryValidatorDistributionInfoResponse)(nil), "cosmos.distribution.v1beta1.QueryValidatorDistributionInfoResponse")
+	proto.RegisterType((*QueryValidatorOutstandingRewardsRequest)(nil), "cosmos.distribution.v1beta1.QueryValidatorOutstandingRewardsRequest")
+	proto.RegisterType((*QueryValidatorOutstandingRewardsResponse)(nil), "cosmos.distribution.v1beta1.QueryValidatorOutstandingRewardsResponse")
+	proto.RegisterType((*QueryValidatorCommissionRequest)(nil), "cosmos.distribution.v1beta1.QueryValidatorCommissionRequest")
+	proto.RegisterType((*QueryValidatorCommissionResponse)(nil), "cosmos.distribution.v1beta1.QueryValidatorCommissionResponse")
+	proto.RegisterType((*QueryValidatorSlashesRequest)(nil), "cosmos.distribution.v1beta1.QueryValidatorSlashesRequest")
+	proto.RegisterType((*QueryValidatorSlashesResponse)(nil), "cosmos.distribution.v1beta1.QueryValidatorSlashesResponse")
+	proto.RegisterType((*QueryDelegationRewardsRequest)(nil), "cosmos.distribution.v1beta1.QueryDelegationRewardsRequest")
+	proto.RegisterType((*QueryDelegationRewardsResponse)(nil), "cosmos.distribution.v1beta1.QueryDelegationRewardsResponse")
+	proto.RegisterType((*QueryDelegationTotalRewardsRequest)(nil), "cosmos.distribution.v1beta1.QueryDelegationTotalRewardsRequest")
+	proto.RegisterType((*QueryDelegationTotalRewardsResponse)(nil), "cosmos.distribution.v1beta1.QueryDelegationTotalRewardsResponse")
+	proto.RegisterType((*QueryDelegatorValidatorsRequest)(nil), "cosmos.distribution.v1beta1.QueryDelegatorValidatorsRequest")
+	proto.RegisterType((*QueryDelegatorValidatorsResponse)(nil), "cosmos.distribution.v1beta1.QueryDelegatorValidatorsResponse")
+	proto.RegisterType((*QueryDelegatorWithdrawAddressRequest)(nil), "cosmos.distribution.v1beta1.QueryDelegatorWithdrawAddressRequest")
+	proto.RegisterType((*QueryDelegatorWithdrawAddressResponse)(nil), "cosmos.distribution.v1beta1.QueryDelegatorWithdrawAddressResponse")
+	proto.RegisterType((*QueryCommunityPoolRequest)(nil), "cosmos.distribution.v1beta1.QueryCommunityPoolRequest")
+	proto.RegisterType((*QueryCommunityPoolResponse)(nil), "cosmos.distribution.v1beta1.QueryCommunityPoolResponse")
+	proto.RegisterType((*QueryRatioRequest)(nil), "cosmos.distribution.v1beta1.QueryRatioRequest")
+	proto.RegisterType((*QueryRatioResponse)(nil), "cosmos.distribution.v1beta1.QueryRatioResponse")
+	proto.RegisterType((*QueryPendingRatioChangesRequest)(nil), "cosmos.distribution.v1beta1.QueryPendingRatioChangesRequest")
+	proto.RegisterType((*QueryPendingRatioChangesResponse)(nil), "cosmos.distribution.v1beta1.QueryPendingRatioChangesResponse")
+	proto.RegisterType((*QueryRatioHistoryRequest)(nil), "cosmos.distribution.v1beta1.QueryRatioHistoryRequest")
+	proto.RegisterType((*QueryRatioHistoryResponse)(nil), "cosmos.distribution.v1beta1.QueryRatioHistoryResponse")
+	proto.RegisterType((*QueryBaseAddressRequest)(nil), "cosmos.distribution.v1beta1.QueryBaseAddressRequest")
+	proto.RegisterType((*QueryBaseAddressResponse)(nil), "cosmos.distribution.v1beta1.QueryBaseAddressResponse")
+	proto.RegisterType((*QueryBaseRecipientsRequest)(nil), "cosmos.distribution.v1beta1.QueryBaseRecipientsRequest")
+	proto.RegisterType((*QueryBaseRecipientsResponse)(nil), "cosmos.distribution.v1beta1.QueryBaseRecipientsResponse")
+	proto.RegisterType((*QueryModeratorRequest)(nil), "cosmos.distribution.v1beta1.QueryModeratorRequest")
+	proto.RegisterType((*QueryModeratorResponse)(nil), "cosmos.distribution.v1beta1.QueryModeratorResponse")
+	proto.RegisterType((*QueryPendingModeratorRequest)(nil), "cosmos.distribution.v1beta1.QueryPendingModeratorRequest")
+	proto.RegisterType((*QueryPendingModeratorResponse)(nil), "cosmos.distribution.v1beta1.QueryPendingModeratorResponse")
+	proto.RegisterType((*QueryFeeSplitTotalsRequest)(nil), "cosmos.distribution.v1beta1.QueryFeeSplitTotalsRequest")
+	proto.RegisterType((*QueryFeeSplitTotalsResponse)(nil), "cosmos.distribution.v1beta1.QueryFeeSplitTotalsResponse")
+	proto.RegisterType((*QueryFeeSplitSnapshotsRequest)(nil), "cosmos.distribution.v1beta1.QueryFeeSplitSnapshotsRequest")
+	proto.RegisterType((*QueryFeeSplitSnapshotsResponse)(nil), "cosmos.distribution.v1beta1.QueryFeeSplitSnapshotsResponse")
+	proto.RegisterType((*QueryFeeSplitSnapshotRequest)(nil), "cosmos.distribution.v1beta1.QueryFeeSplitSnapshotRequest")
+	proto.RegisterType((*QueryFeeSplitSnapshotResponse)(nil), "cosmos.distribution.v1beta1.QueryFeeSplitSnapshotResponse")
+}
+
+func init() {
+	proto.RegisterFile("cosmos/distribution/v1beta1/query.proto", fileDescriptor_5efd02cbc06efdc9)
+}
+
+var fileDescriptor_5efd02cbc06efdc9 = []byte{
+	// 1951 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x5a, 0xcf, 0x6f, 0x1b, 0xc7,
+	0x15, 0xd6, 0x50, 0xb6, 0x1c, 0x3f, 0x39, 0xb6, 0x34, 0x76, 0x6d, 0x6a, 0xa5, 0x50, 0xc2, 0x3a,
+	0x8e, 0x14, 0x3b, 0xe2, 0xda, 0x72, 0xac, 0x28, 0x56, 0x9d, 0x56, 0x94, 0xe4, 0xba, 0x48, 0x6a,
+	0xcb, 0xb4, 0x11, 0xa7, 0xbd, 0x10, 0x4b, 0x72, 0x4c, 0x2e, 0x4c, 0xee, 0xd0, 0xbb, 0x4b, 0xa9,
+	0x82, 0xa1, 0x4b, 0xd3, 0x00, 0xb9, 0x14, 0x28, 0xd0, 0x02, 0xcd, 0xd1, 0xa7, 0x1e, 0x8a, 0x1e,
+	0x5a, 0x20, 0x41, 0xd1, 0xfc, 0x01, 0x45, 0x0a, 0xf4, 0x10, 0xb4, 0x40, 0xd1, 0x53, 0x53, 0xc8,
+	0x45, 0x9b, 0x1e, 0x7a, 0xee, 0xb5, 0xe0, 0xcc, 0x9b, 0xe5, 0x2e, 0x7f, 0x2c, 0x77, 0x29, 0xe9,
+	0x14, 0xf1, 0xcd, 0xbc, 0xf7, 0xbe, 0xef, 0xcd, 0x8f, 0x9d, 0xf7, 0xc5, 0x30, 0x5f, 0xe2, 0x6e,
+	0x9d, 0xbb, 0x46, 0xd9, 0x72, 0x3d, 0xc7, 0x2a, 0x36, 0x3d, 0x8b, 0xdb, 0xc6, 0xf6, 0xb5, 0x22,
+	0xf3, 0xcc, 0x6b, 0xc6, 0xd3, 0x26, 0x73, 0x76, 0xb3, 0x0d, 0x87, 0x7b, 0x9c, 0x4e, 0xcb, 0x89,
+	0xd9, 0xe0, 0xc4, 0x2c, 0x4e, 0xd4, 0x2e, 0x63, 0x94, 0xa2, 0xe9, 0x32, 0xe9, 0xe5, 0xc7, 0x68,
+	0x98, 0x15, 0xcb, 0x36, 0xc5, 0x6c, 0x11, 0x48, 0x3b, 0x57, 0xe1, 0x15, 0x2e, 0xfe, 0x34, 0x5a,
+	0x7f, 0xa1, 0x75, 0xa6, 0xc2, 0x79, 0xa5, 0xc6, 0x0c, 0xb3, 0x61, 0x19, 0xa6, 0x6d, 0x73, 0x4f,
+	0xb8, 0xb8, 0x38, 0x9a, 0x09, 0xc6, 0x57, 0x91, 0x4b, 0xdc, 0x52, 0x31, 0xb3, 0x51, 0x2c, 0x42,
+	0x88, 0xe5, 0xfc, 0x29, 0x39, 0xbf, 0x20, 0x61, 0x20, 0x33, 0xf1, 0x43, 0x3f, 0x07, 0xf4, 0x7e,
+	0x8b, 0xc0, 0x96, 0xe9, 0x98, 0x75, 0x37, 0xcf, 0x9e, 0x36, 0x99, 0xeb, 0xe9, 0x1f, 0xc0, 0xd9,
+	0x90, 0xd5, 0x6d, 0x70, 0xdb, 0x65, 0x74, 0x0d, 0xc6, 0x1a, 0xc2, 0x92, 0x26, 0x73, 0x64, 0x61,
+	0x7c, 0xe9, 0x62, 0x36, 0xa2, 0x4a, 0x59, 0xe9, 0x9c, 0x3b, 0xf6, 0xc5, 0xdf, 0x67, 0x47, 0xf2,
+	0xe8, 0xa8, 0xdb, 0x70, 0x49, 0x44, 0x7e, 0xdf, 0xac, 0x59, 0x65, 0xd3, 0xe3, 0xce, 0x46, 0xc0,
+	0xf5, 0xbb, 0xf6, 0x63, 0x8e, 0x10, 0xe8, 0x26, 0x4c, 0x6e, 0xab, 0x39, 0x05, 0xb3, 0x5c, 0x76,
+	0x98, 0x2b, 0xd3, 0x9e, 0xcc, 0xa5, 0xff, 0xfc, 0xe9, 0xe2, 0x39, 0xcc, 0xbc, 0x26, 0x47, 0x1e,
+	0x78, 0x8e, 0x65, 0x57, 0xf2, 0x13, 0xbe, 0x0b, 0xda, 0xf5, 0xaf, 0x52, 0xf0, 0xda, 0xa0, 0x84,
+	0xc8, 0x6e, 0x1d, 0x26, 0x78, 0x83, 0x39, 0x89, 0x12, 0x9e, 0x51, 0x1e, 0x68, 0xa6, 0x7b, 0x30,
+	0xe9, 0xb2, 0xda, 0xe3, 0x42, 0x91, 0xdb, 0xe5, 0x82, 0xc3, 0x76, 0x4c, 0xa7, 0xec, 0xa6, 0x53,
+	0x73, 0xa3, 0x0b, 0xe3, 0x4b, 0x33, 0xaa, 0x5a, 0xad, 0x65, 0xf5, 0xab, 0xb4, 0xc1, 0x4a, 0xeb,
+	0xdc, 0xb2, 0x73, 0xd7, 0x5b, 0x65, 0xfa, 0xd5, 0x57, 0xb3, 0x57, 0x2a, 0x96, 0x57, 0x6d, 0x16,
+	0xb3, 0x25, 0x5e, 0xc7, 0x95, 0xc2, 0xff, 0x2c, 0xba, 0xe5, 0x27, 0x86, 0xb7, 0xdb, 0x60, 0xae,
+	0xf2, 0x71, 0xf3, 0x67, 0x5a, 0xb9, 0x72, 0xdc, 0x2e, 0xe7, 0x65, 0x26, 0xfa, 0x14, 0xa0, 0xc4,
+	0xeb, 0x75, 0xcb, 0x75, 0x2d, 0x6e, 0xa7, 0x47, 0x8f, 0x2a, 0x6f, 0x20, 0x89, 0xde, 0x80, 0xf9,
+	0x70, 0x81, 0xef, 0x35, 0x3d, 0xd7, 0x33, 0xed, 0x72, 0xab, 0x3e, 0x12, 0xd6, 0x21, 0xaf, 0xe9,
+	0x8f, 0x09, 0x2c, 0x0c, 0x4e, 0x89, 0xab, 0xfa, 0x01, 0x9c, 0x50, 0xcb, 0x20, 0x37, 0xed, 0x4a,
+	0xe4, 0xa6, 0x8d, 0x08, 0x89, 0x3b, 0x59, 0x85, 0xd3, 0xab, 0x30, 0x1b, 0x46, 0xb1, 0xee, 0x17,
+	0xe5, 0x90, 0x09, 0x7f, 0x44, 0x60, 0xae, 0x7f, 0x2a, 0x24, 0x6a, 0x86, 0x96, 0x5e, 0x72, 0x5d,
+	0x8d, 0xc7, 0x75, 0xad, 0x54, 0x6a, 0xd6, 0x9b, 0x35, 0xd3, 0x63, 0xe5, 0x76, 0x60, 0xa4, 0x1b,
+	0x5c, 0xea, 0x8f, 0x52, 0x30, 0x13, 0xc6, 0xf1, 0xa0, 0x66, 0xba, 0x55, 0x76, 0xc8, 0x0b, 0x4c,
+	0xe7, 0xe1, 0x8c, 0xeb, 0x99, 0x8e, 0x67, 0xd9, 0x95, 0x42, 0x95, 0x59, 0x95, 0xaa, 0x97, 0x4e,
+	0xcd, 0x91, 0x85, 0x63, 0xf9, 0xd3, 0xca, 0x7c, 0x47, 0x58, 0xe9, 0x45, 0x78, 0x99, 0xd9, 0xe5,
+	0xc0, 0xb4, 0x51, 0x31, 0xed, 0x94, 0x34, 0xe2, 0xa4, 0xdb, 0x00, 0xed, 0x5b, 0x39, 0x7d, 0x4c,
+	0x14, 0xe6, 0xb5, 0xd0, 0x99, 0x90, 0x17, 0x7f, 0xfb, 0xde, 0xaa, 0x30, 0x24, 0x94, 0x0f, 0x78,
+	0xde, 0x7c, 0xe9, 0xe3, 0xe7, 0xb3, 0x23, 0x9f, 0x3c, 0x9f, 0x25, 0xfa, 0xe7, 0x04, 0x5e, 0xe9,
+	0x53, 0x07, 0x5c, 0x8c, 0x2d, 0x38, 0xe1, 0x4a, 0x53, 0x9a, 0x88, 0x43, 0x78, 0x35, 0xde, 0x4a,
+	0x88, 0x38, 0x9b, 0xdb, 0xcc, 0xf6, 0xd4, 0x6e, 0xc3, 0x30, 0xf4, 0x3b, 0x21, 0x16, 0x29, 0xc1,
+	0x62, 0x7e, 0x20, 0x0b, 0x09, 0x27, 0x48, 0x43, 0xff, 0x9d, 0x02, 0xbf, 0xc1, 0x6a, 0xac, 0x22,
+	0x6c, 0xdd, 0xc7, 0xb4, 0x2c, 0xc7, 0x92, 0xac, 0xa2, 0xef, 0xa2, 0x56, 0xb1, 0xe7, 0x66, 0x48,
+	0x25, 0xdd, 0x0c, 0xb2, 0xec, 0x5f, 0x3f, 0x9f, 0x1d, 0xd1, 0x7f, 0x42, 0x20, 0xd3, 0x0f, 0x39,
+	0xd6, 0xfd, 0x49, 0xf0, 0xb4, 0x1f, 0xd1, 0xe5, 0xe7, 0x5f, 0x00, 0x4d, 0xd0, 0x3b, 0xe0, 0x3c,
+	0xe4, 0x9e, 0x59, 0x3b, 0x92, 0x6a, 0x06, 0xca, 0xf0, 0x2f, 0x02, 0x17, 0x23, 0xf3, 0x62, 0x2d,
+	0xde, 0xef, 0xac, 0xc5, 0x72, 0xe4, 0x1e, 0x6c, 0x47, 0xdb, 0x50, 0xb9, 0x65, 0xc4, 0x8e, 0x7b,
+	0x8f, 0x56, 0xe0, 0xb8, 0xd7, 0xca, 0x77, 0x74, 0x9f, 0x35, 0x19, 0x5f, 0x77, 0xf0, 0x82, 0xf5,
+	0xf1, 0xf8, 0xc7, 0xe4, 0xe8, 0x8a, 0xfb, 0x1e, 0xcc, 0xf5, 0xcf, 0x89, 0x85, 0xcd, 0x00, 0xf8,
+	0xbb, 0x54, 0xd6, 0xf6, 0x64, 0x3e, 0x60, 0x09, 0x44, 0xdb, 0x81, 0x57, 0xc3, 0xd1, 0x1e, 0x59,
+	0x5e, 0xb5, 0xec, 0x98, 0x3b, 0x98, 0xf8, 0xc8, 0x68, 0x6c, 0xc3, 0xa5, 0x01, 0x89, 0xdb, 0x8f,
+	0x9e, 0x1d, 0x1c, 0x8a, 0xff, 0xe8, 0xd9, 0x09, 0x07, 0x0b, 0xe4, 0x9d, 0x86, 0x29, 0x91, 0xb7,
+	0xf5, 0x19, 0x69, 0xda, 0x96, 0xb7, 0xbb, 0xc5, 0x79, 0x4d, 0xbd, 0x2a, 0x3f, 0x24, 0xa0, 0xf5,
+	0x1a, 0x45, 0x28, 0x0c, 0x8e, 0x35, 0x38, 0xaf, 0x1d, 0xdd, 0xc1, 0x15, 0xe1, 0xf5, 0xb3, 0x30,
+	0x29, 0x40, 0xe4, 0x5b, 0x7b, 0x5d, 0x41, 0xfb, 0x25, 0x01, 0x1a, 0xb4, 0x22, 0xa4, 0x77, 0xe0,
+	0xb8, 0xd3, 0x32, 0xe0, 0xe7, 0x54, 0x8f, 0x3c, 0x40, 0xc2, 0x15, 0x0f, 0x8b, 0x74, 0xa3, 0x5b,
+	0x70, 0xaa, 0xcc, 0x6c, 0x5e, 0x2f, 0x88, 0x9f, 0xea, 0x21, 0x38, 0x3f, 0xe0, 0x1c, 0xda, 0xbc,
+	0x1e, 0x8c, 0x35, 0x5e, 0xf6, 0x2d, 0xae, 0x6e, 0xe1, 0x99, 0xd8, 0x92, 0x9f, 0x38, 0x61, 0x5d,
+	0xaf, 0x9a, 0x76, 0xa5, 0xfd, 0x11, 0x0e, 0x7f, 0xef, 0xc8, 0xb0, 0xdf, 0x3b, 0xfd, 0x33, 0xf5,
+	0xea, 0xe8, 0x99, 0x0b, 0x2b, 0x74, 0x07, 0x4e, 0x94, 0xa4, 0x09, 0xd7, 0x6d, 0x61, 0x70, 0x8d,
+	0x64, 0x0c, 0x75, 0xad, 0xa0, 0xfb, 0xe1, 0x7d, 0xe0, 0x8a, 0x90, 0x6e, 0x2f, 0xe5, 0x1d, 0xcb,
+	0xf5, 0xb8, 0xb3, 0x7b, 0xd8, 0xb5, 0xf9, 0x94, 0xc0, 0x54, 0x8f, 0x24, 0x58, 0x94, 0xbb, 0x70,
+	0xa2, 0x2a, 0x4d, 0x58, 0x94, 0xec, 0xe0, 0xa2, 0x60, 0x8c, 0x4d, 0xdb, 0x73, 0x76, 0x55, 0x69,
+	0x30, 0xc8, 0xe1, 0x95, 0x66, 0x0a, 0x2e, 0x08, 0xd4, 0x39, 0xd3, 0x65, 0xe1, 0x2b, 0x48, 0x7f,
+	0x04, 0xe9, 0xee, 0x21, 0xe4, 0xb3, 0x0a, 0xa7, 0x5a, 0x59, 0x62, 0x5f, 0x10, 0xe3, 0xc5, 0x76,
+	0x10, 0x7d, 0x06, 0x34, 0x3f, 0x70, 0x9e, 0x95, 0xac, 0x86, 0xc5, 0x6c, 0xcf, 0x4f, 0xcb, 0x61,
+	0xba, 0xe7, 0xa8, 0xff, 0x8e, 0x02, 0xc7, 0xb7, 0x62, 0x31, 0x2f, 0x47, 0x16, 0x33, 0x14, 0x48,
+	0xbd, 0x61, 0xdb, 0x31, 0xf4, 0x0b, 0xf0, 0x0d, 0x91, 0xf0, 0x7b, 0xbc, 0x2c, 0x3b, 0x37, 0x85,
+	0xa4, 0x00, 0xe7, 0x3b, 0x07, 0x10, 0xc4, 0x26, 0x4c, 0xd6, 0x95, 0x31, 0xfe, 0xed, 0xec, 0xbb,
+	0xa8, 0x42, 0x64, 0x60, 0x26, 0x78, 0x9c, 0xba, 0x00, 0x34, 0xe1, 0x95, 0x3e, 0xe3, 0x88, 0xe3,
+	0x21, 0x4c, 0x35, 0xf0, 0xb9, 0x9b, 0x1c, 0xcf, 0x85, 0x46, 0x47, 0xd8, 0xce, 0xf5, 0xb9, 0xcd,
+	0xd8, 0x83, 0x46, 0xcd, 0xf2, 0xc4, 0x5b, 0xc2, 0x5f, 0x9f, 0x22, 0x4c, 0xf7, 0x1c, 0xf5, 0x3f,
+	0x1f, 0x63, 0xe2, 0x5b, 0xad, 0x9a, 0xab, 0x4b, 0x91, 0x6b, 0xa3, 0x82, 0x28, 0x4d, 0x40, 0xba,
+	0xea, 0x15, 0x24, 0xae, 0x86, 0x1f, 0xd8, 0x66, 0xc3, 0xad, 0x72, 0xef, 0xd0, 0x6f, 0xb4, 0x7f,
+	0xab, 0x07, 0x64, 0x8f, 0x4c, 0x48, 0xe8, 0x3e, 0x9c, 0x74, 0x95, 0x11, 0xf7, 0xdb, 0x62, 0x2c,
+	0x4e, 0x2a, 0x14, 0x72, 0x6b, 0x47, 0xa1, 0xaf, 0xc3, 0x84, 0xc3, 0x3c, 0x66, 0xb7, 0xdc, 0x0a,
+	0xc5, 0x1a, 0x2f, 0x3d, 0x71, 0xb1, 0x9d, 0x39, 0xe3, 0xdb, 0x73, 0xc2, 0xdc, 0x71, 0xd0, 0x47,
+	0x87, 0x3f, 0xe8, 0xcb, 0xb8, 0xd7, 0x3a, 0xd1, 0xa9, 0x8a, 0x9e, 0x87, 0x31, 0xec, 0x98, 0x5a,
+	0xd5, 0x1c, 0xcd, 0xe3, 0x2f, 0xbd, 0xd1, 0x67, 0x29, 0xfc, 0xfa, 0xdc, 0x83, 0x97, 0x14, 0x33,
+	0x5c, 0x88, 0xa1, 0xca, 0xe3, 0x07, 0x59, 0xfa, 0xc5, 0x1c, 0x1c, 0x17, 0x29, 0xe9, 0x27, 0x04,
+	0xc6, 0xa4, 0x66, 0x44, 0x8d, 0xc8, 0x98, 0xdd, 0x82, 0x95, 0x76, 0x35, 0xbe, 0x83, 0x24, 0xa2,
+	0x5f, 0xf9, 0xd1, 0x5f, 0xfe, 0xf9, 0xb3, 0xd4, 0x25, 0x7a, 0xd1, 0x88, 0x12, 0xd3, 0xa4, 0x6a,
+	0x45, 0xff, 0x43, 0x60, 0xaa, 0xaf, 0x80, 0x44, 0x73, 0x83, 0x93, 0x0f, 0x92, 0xbb, 0xb4, 0xf5,
+	0x03, 0xc5, 0x40, 0x4e, 0xeb, 0x82, 0xd3, 0x2d, 0xba, 0x1a, 0xc9, 0xa9, 0xfd, 0x52, 0x35, 0x9e,
+	0x75, 0x35, 0x68, 0x7b, 0xf4, 0xc3, 0x14, 0x4c, 0x47, 0xa8, 0x20, 0x74, 0x23, 0x01, 0xd2, 0xbe,
+	0x52, 0x90, 0xb6, 0x79, 0xc0, 0x28, 0xc8, 0xf8, 0x91, 0x60, 0x7c, 0x9f, 0xde, 0x3b, 0x00, 0x63,
+	0x83, 0xb7, 0xe3, 0x2b, 0xc9, 0x8e, 0xee, 0x13, 0x38, 0xdb, 0x43, 0x6d, 0xa1, 0xdf, 0x4c, 0x80,
+	0xbb, 0x4b, 0x0f, 0xd2, 0x6e, 0x0d, 0xe9, 0x8d, 0x6c, 0xef, 0x0a, 0xb6, 0x77, 0xe8, 0xed, 0x83,
+	0xb0, 0x6d, 0xeb, 0x39, 0xf4, 0xaf, 0x04, 0x26, 0x3a, 0x25, 0x0c, 0xfa, 0x76, 0x02, 0x8c, 0x61,
+	0xf9, 0x47, 0xbb, 0x39, 0x8c, 0x2b, 0x72, 0x7b, 0x57, 0x70, 0xdb, 0xa4, 0xeb, 0x07, 0xe1, 0xa6,
+	0xc4, 0x92, 0xff, 0x12, 0x98, 0xec, 0x12, 0x09, 0x68, 0x0c, 0x78, 0xfd, 0x34, 0x11, 0x6d, 0x75,
+	0x28, 0x5f, 0xe4, 0x56, 0x10, 0xdc, 0xbe, 0x4f, 0x1f, 0x45, 0x72, 0xf3, 0xdb, 0x39, 0xd7, 0x78,
+	0xd6, 0xd5, 0x0d, 0xee, 0x19, 0xb8, 0x33, 0x7b, 0x9e, 0xd9, 0xaf, 0x09, 0x9c, 0xef, 0xad, 0x06,
+	0xd0, 0x6f, 0x25, 0x01, 0xde, 0x43, 0xbf, 0xd0, 0xbe, 0x3d, 0x7c, 0x80, 0x44, 0x4b, 0x1b, 0x8f,
+	0xbe, 0x38, 0x98, 0x3d, 0x9a, 0xf3, 0x38, 0x07, 0xb3, 0xbf, 0x8e, 0xa0, 0xdd, 0x1a, 0xd2, 0x3b,
+	0xd1, 0xc1, 0x1c, 0xc0, 0xb0, 0xbd, 0xb7, 0xe9, 0xff, 0x08, 0xa4, 0xfb, 0xb5, 0xee, 0x74, 0x2d,
+	0x01, 0xd6, 0xde, 0x7a, 0x83, 0x96, 0x3b, 0x48, 0x08, 0xe4, 0xfc, 0x50, 0x70, 0xbe, 0x4b, 0xdf,
+	0x3b, 0x08, 0xe7, 0x4e, 0xed, 0x81, 0x7e, 0x46, 0xe0, 0xe5, 0x90, 0x3c, 0x40, 0x97, 0x07, 0x63,
+	0xed, 0xa5, 0x36, 0x68, 0x6f, 0x25, 0xf6, 0x43, 0x62, 0xd7, 0x05, 0xb1, 0x45, 0x7a, 0x25, 0x92,
+	0x58, 0x49, 0xf9, 0x16, 0x5a, 0xaa, 0x02, 0xfd, 0x39, 0x81, 0xe3, 0xa2, 0x8f, 0xa3, 0xd9, 0xc1,
+	0x79, 0x83, 0xd2, 0x83, 0x66, 0xc4, 0x9e, 0x8f, 0xf8, 0x2e, 0x0b, 0x7c, 0xaf, 0x52, 0x3d, 0x12,
+	0x9f, 0x14, 0x20, 0xfe, 0x40, 0xe0, 0x6c, 0x8f, 0xf6, 0x3d, 0xce, 0x69, 0xe9, 0xaf, 0x30, 0x68,
+	0xb7, 0x86, 0xf4, 0x46, 0x02, 0x4b, 0x82, 0xc0, 0x1b, 0xf4, 0xf2, 0x60, 0x02, 0x06, 0x76, 0x2d,
+	0xf4, 0xb7, 0x04, 0x4e, 0x05, 0xfb, 0x64, 0x7a, 0x23, 0x66, 0xd9, 0xc2, 0x02, 0x80, 0xb6, 0x9c,
+	0xd4, 0x6d, 0x08, 0xcc, 0xaa, 0x6d, 0xff, 0x0d, 0x81, 0xf1, 0x40, 0x3b, 0x4d, 0xdf, 0x1c, 0x9c,
+	0xbb, 0xbb, 0x31, 0xd7, 0x6e, 0x24, 0xf4, 0x42, 0xc0, 0x6f, 0x0a, 0xc0, 0x57, 0xe8, 0xeb, 0x91,
+	0x80, 0x83, 0x6d, 0xfd, 0xc7, 0x29, 0x42, 0x7f, 0x4f, 0xe0, 0x74, 0xb8, 0x15, 0xa7, 0x6f, 0xc5,
+	0xcb, 0xdf, 0xd5, 0xda, 0x6b, 0x2b, 0xc9, 0x1d, 0x43, 0xd8, 0xb3, 0xf4, 0x8d, 0xc1, 0xd8, 0xdb,
+	0x9d, 0x3d, 0xfd, 0x35, 0x81, 0x93, 0x7e, 0x77, 0x4b, 0x97, 0x06, 0x67, 0xef, 0xec, 0xc0, 0xb5,
+	0xeb, 0x89, 0x7c, 0x10, 0xec, 0xb2, 0x00, 0x7b, 0x95, 0x66, 0x23, 0xc1, 0x76, 0x35, 0xec, 0xf4,
+	0x8f, 0x04, 0x26, 0x3a, 0x5b, 0xfd, 0x38, 0x8f, 0xaf, 0x3e, 0xf2, 0x81, 0x76, 0x73, 0x18, 0x57,
+	0xe4, 0xf0, 0x8e, 0xe0, 0xb0, 0x42, 0x97, 0x93, 0x71, 0xf0, 0x4f, 0xe7, 0xe7, 0x04, 0x4e, 0x87,
+	0x15, 0x82, 0x38, 0xdb, 0xa6, 0xa7, 0xe2, 0xa0, 0xad, 0x24, 0x77, 0x44, 0x16, 0x37, 0x04, 0x0b,
+	0x83, 0x2e, 0x46, 0xb2, 0x78, 0xcc, 0x58, 0xc1, 0x6d, 0x79, 0x1b, 0x52, 0x7e, 0x68, 0xdd, 0x91,
+	0x93, 0x5d, 0x82, 0x40, 0x9c, 0xc7, 0x62, 0x3f, 0xbd, 0x42, 0x5b, 0x1d, 0xca, 0x17, 0x59, 0xac,
+	0x08, 0x16, 0x4b, 0xf4, 0x6a, 0x4c, 0x16, 0x6d, 0xa1, 0xe1, 0x4f, 0x04, 0x26, 0x3a, 0xe3, 0xc6,
+	0xd9, 0x51, 0x7d, 0x44, 0x02, 0xed, 0xe6, 0x30, 0xae, 0xc8, 0x62, 0x4d, 0xb0, 0x58, 0xa5, 0x6f,
+	0x27, 0x65, 0x61, 0x3c, 0x93, 0x52, 0xc4, 0x5e, 0xee, 0xdd, 0x2f, 0xf6, 0x33, 0xe4, 0xcb, 0xfd,
+	0x0c, 0xf9, 0xc7, 0x7e, 0x86, 0xfc, 0xf4, 0x45, 0x66, 0xe4, 0xcb, 0x17, 0x99, 0x91, 0xbf, 0xbd,
+	0xc8, 0x8c, 0xfc, 0xe0, 0x5a, 0xa4, 0xea, 0xff, 0xc3, 0x70, 0x2e, 0xf1, 0x3f, 0x01, 0x8a, 0x63,
+	0xe2, 0x9f, 0xbb, 0x5c, 0xff, 0xff, 0x00, 0xa3, 0x89, 0x50, 0xd5, 0x01, 0x24, 0x00, 0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// QueryClient is the client API for Query service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type QueryClient interface {
+	// Params queries params of the distribution module.
+	Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error)
+	// ValidatorDistributionInfo queries validator commision and self-delegation rewards for validator
+	ValidatorDistributionInfo(ctx context.Context, in *QueryValidatorDistributionInfoRequest, opts ...grpc.CallOption) (*QueryValidatorDistributionInfoResponse, error)
+	// ValidatorOutstandingRewards queries rewards of a validator address.
+	ValidatorOutstandingRewards(ctx context.Context, in *QueryValidatorOutstandingRewardsRequest, opts ...grpc.CallOption) (*QueryValidatorOutstandingRewardsResponse, error)
+	// ValidatorCommission queries accumulated commission for a validator.
+	ValidatorCommission(ctx context.Context, in *QueryValidatorCommissionRequest, opts ...grpc.CallOption) (*QueryValidatorCommissionResponse, error)
 	// ValidatorSlashes queries slash events of a validator.
 	ValidatorSlashes(ctx context.Context, in *QueryValidatorSlashesRequest, opts ...grpc.CallOption) (*QueryValidatorSlashesResponse, error)
 	// DelegationRewards queries the total rewards accrued by a delegation.
@@ -1364,10 +2086,29 @@ type QueryClient interface {
 	CommunityPool(ctx context.Context, in *QueryCommunityPoolRequest, opts ...grpc.CallOption) (*QueryCommunityPoolResponse, error)
 	// Ratio queries the tx fee distribution ratio
 	Ratio(ctx context.Context, in *QueryRatioRequest, opts ...grpc.CallOption) (*QueryRatioResponse, error)
-	// BurnAddress queries the base_address for 1/3 fee
+	// PendingRatioChanges queries the scheduled tx fee distribution ratio changes
+	PendingRatioChanges(ctx context.Context, in *QueryPendingRatioChangesRequest, opts ...grpc.CallOption) (*QueryPendingRatioChangesResponse, error)
+	// RatioHistory queries the tx fee distribution ratios that became effective
+	RatioHistory(ctx context.Context, in *QueryRatioHistoryRequest, opts ...grpc.CallOption) (*QueryRatioHistoryResponse, error)
+	// BaseAddress queries the address of the first base fee recipient.
+	//
+	// Deprecated: use BaseRecipients instead.
 	BaseAddress(ctx context.Context, in *QueryBaseAddressRequest, opts ...grpc.CallOption) (*QueryBaseAddressResponse, error)
+	// BaseRecipients queries the weighted list of base fee recipients
+	BaseRecipients(ctx context.Context, in *QueryBaseRecipientsRequest, opts ...grpc.CallOption) (*QueryBaseRecipientsResponse, error)
 	// Moderator queries the moderator
 	Moderator(ctx context.Context, in *QueryModeratorRequest, opts ...grpc.CallOption) (*QueryModeratorResponse, error)
+	// PendingModerator queries the moderator waiting to accept the moderator
+	// role
+	PendingModerator(ctx context.Context, in *QueryPendingModeratorRequest, opts ...grpc.CallOption) (*QueryPendingModeratorResponse, error)
+	// FeeSplitTotals queries the cumulative amounts burned, sent to the base
+	// recipients and left for staking rewards
+	FeeSplitTotals(ctx context.Context, in *QueryFeeSplitTotalsRequest, opts ...grpc.CallOption) (*QueryFeeSplitTotalsResponse, error)
+	// FeeSplitSnapshots queries the fee splits of the blocks within the
+	// retention window
+	FeeSplitSnapshots(ctx context.Context, in *QueryFeeSplitSnapshotsRequest, opts ...grpc.CallOption) (*QueryFeeSplitSnapshotsResponse, error)
+	// FeeSplitSnapshot queries the fee split of a block
+	FeeSplitSnapshot(ctx context.Context, in *QueryFeeSplitSnapshotRequest, opts ...grpc.CallOption) (*QueryFeeSplitSnapshotResponse, error)
 }
 
 type queryClient struct {
@@ -1477,31 +2218,95 @@ func (c *queryClient) Ratio(ctx context.Context, in *QueryRatioRequest, opts ...
 	return out, nil
 }
 
-func (c *queryClient) BaseAddress(ctx context.Context, in *QueryBaseAddressRequest, opts ...grpc.CallOption) (*QueryBaseAddressResponse, error) {
-	out := new(QueryBaseAddressResponse)
-	err := c.cc.Invoke(ctx, "/cosmos.distribution.v1beta1.Query/BaseAddress", in, out, opts...)
+func (c *queryClient) PendingRatioChanges(ctx context.Context, in *QueryPendingRatioChangesRequest, opts ...grpc.CallOption) (*QueryPendingRatioChangesResponse, error) {
+	out := new(QueryPendingRatioChangesResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.distribution.v1beta1.Query/PendingRatioChanges", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *queryClient) Moderator(ctx context.Context, in *QueryModeratorRequest, opts ...grpc.CallOption) (*QueryModeratorResponse, error) {
-	out := new(QueryModeratorResponse)
-	err := c.cc.Invoke(ctx, "/cosmos.distribution.v1beta1.Query/Moderator", in, out, opts...)
+func (c *queryClient) RatioHistory(ctx context.Context, in *QueryRatioHistoryRequest, opts ...grpc.CallOption) (*QueryRatioHistoryResponse, error) {
+	out := new(QueryRatioHistoryResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.distribution.v1beta1.Query/RatioHistory", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-// QueryServer is the server API for Query service.
-type QueryServer interface {
-	// Params queries params of the distribution module.
-	Params(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error)
-	// ValidatorDistributionInfo queries validator commision and self-delegation rewards for validator
-	ValidatorDistributionInfo(context.Context, *QueryValidatorDistributionInfoRequest) (*QueryValidatorDistributionInfoResponse, error)
-	// ValidatorOutstandingRewards queries rewards of a validator address.
+// Deprecated: Do not use.
+func (c *queryClient) BaseAddress(ctx context.Context, in *QueryBaseAddressRequest, opts ...grpc.CallOption) (*QueryBaseAddressResponse, error) {
+	out := new(QueryBaseAddressResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.distribution.v1beta1.Query/BaseAddress", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) BaseRecipients(ctx context.Context, in *QueryBaseRecipientsRequest, opts ...grpc.CallOption) (*QueryBaseRecipientsResponse, error) {
+	out := new(QueryBaseRecipientsResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.distribution.v1beta1.Query/BaseRecipients", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) Moderator(ctx context.Context, in *QueryModeratorRequest, opts ...grpc.CallOption) (*QueryModeratorResponse, error) {
+	out := new(QueryModeratorResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.distribution.v1beta1.Query/Moderator", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) PendingModerator(ctx context.Context, in *QueryPendingModeratorRequest, opts ...grpc.CallOption) (*QueryPendingModeratorResponse, error) {
+	out := new(QueryPendingModeratorResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.distribution.v1beta1.Query/PendingModerator", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) FeeSplitTotals(ctx context.Context, in *QueryFeeSplitTotalsRequest, opts ...grpc.CallOption) (*QueryFeeSplitTotalsResponse, error) {
+	out := new(QueryFeeSplitTotalsResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.distribution.v1beta1.Query/FeeSplitTotals", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) FeeSplitSnapshots(ctx context.Context, in *QueryFeeSplitSnapshotsRequest, opts ...grpc.CallOption) (*QueryFeeSplitSnapshotsResponse, error) {
+	out := new(QueryFeeSplitSnapshotsResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.distribution.v1beta1.Query/FeeSplitSnapshots", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) FeeSplitSnapshot(ctx context.Context, in *QueryFeeSplitSnapshotRequest, opts ...grpc.CallOption) (*QueryFeeSplitSnapshotResponse, error) {
+	out := new(QueryFeeSplitSnapshotResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.distribution.v1beta1.Query/FeeSplitSnapshot", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// QueryServer is the server API for Query service.
+type QueryServer interface {
+	// Params queries params of the distribution module.
+	Params(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error)
+	// ValidatorDistributionInfo queries validator commision and self-delegation rewards for validator
+	ValidatorDistributionInfo(context.Context, *QueryValidatorDistributionInfoRequest) (*QueryValidatorDistributionInfoResponse, error)
+	// ValidatorOutstandingRewards queries rewards of a validator address.
 	ValidatorOutstandingRewards(context.Context, *QueryValidatorOutstandingRewardsRequest) (*QueryValidatorOutstandingRewardsResponse, error)
 	// ValidatorCommission queries accumulated commission for a validator.
 	ValidatorCommission(context.Context, *QueryValidatorCommissionRequest) (*QueryValidatorCommissionResponse, error)
@@ -1520,10 +2325,29 @@ type QueryServer interface {
 	CommunityPool(context.Context, *QueryCommunityPoolRequest) (*QueryCommunityPoolResponse, error)
 	// Ratio queries the tx fee distribution ratio
 	Ratio(context.Context, *QueryRatioRequest) (*QueryRatioResponse, error)
-	// BurnAddress queries the base_address for 1/3 fee
+	// PendingRatioChanges queries the scheduled tx fee distribution ratio changes
+	PendingRatioChanges(context.Context, *QueryPendingRatioChangesRequest) (*QueryPendingRatioChangesResponse, error)
+	// RatioHistory queries the tx fee distribution ratios that became effective
+	RatioHistory(context.Context, *QueryRatioHistoryRequest) (*QueryRatioHistoryResponse, error)
+	// BaseAddress queries the address of the first base fee recipient.
+	//
+	// Deprecated: use BaseRecipients instead.
 	BaseAddress(context.Context, *QueryBaseAddressRequest) (*QueryBaseAddressResponse, error)
+	// BaseRecipients queries the weighted list of base fee recipients
+	BaseRecipients(context.Context, *QueryBaseRecipientsRequest) (*QueryBaseRecipientsResponse, error)
 	// Moderator queries the moderator
 	Moderator(context.Context, *QueryModeratorRequest) (*QueryModeratorResponse, error)
+	// PendingModerator queries the moderator waiting to accept the moderator
+	// role
+	PendingModerator(context.Context, *QueryPendingModeratorRequest) (*QueryPendingModeratorResponse, error)
+	// FeeSplitTotals queries the cumulative amounts burned, sent to the base
+	// recipients and left for staking rewards
+	FeeSplitTotals(context.Context, *QueryFeeSplitTotalsRequest) (*QueryFeeSplitTotalsResponse, error)
+	// FeeSplitSnapshots queries the fee splits of the blocks within the
+	// retention window
+	FeeSplitSnapshots(context.Context, *QueryFeeSplitSnapshotsRequest) (*QueryFeeSplitSnapshotsResponse, error)
+	// FeeSplitSnapshot queries the fee split of a block
+	FeeSplitSnapshot(context.Context, *QueryFeeSplitSnapshotRequest) (*QueryFeeSplitSnapshotResponse, error)
 }
 
 // UnimplementedQueryServer can be embedded to have forward compatible implementations.
@@ -1563,12 +2387,33 @@ func (*UnimplementedQueryServer) CommunityPool(ctx context.Context, req *QueryCo
 func (*UnimplementedQueryServer) Ratio(ctx context.Context, req *QueryRatioRequest) (*QueryRatioResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Ratio not implemented")
 }
+func (*UnimplementedQueryServer) PendingRatioChanges(ctx context.Context, req *QueryPendingRatioChangesRequest) (*QueryPendingRatioChangesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PendingRatioChanges not implemented")
+}
+func (*UnimplementedQueryServer) RatioHistory(ctx context.Context, req *QueryRatioHistoryRequest) (*QueryRatioHistoryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RatioHistory not implemented")
+}
 func (*UnimplementedQueryServer) BaseAddress(ctx context.Context, req *QueryBaseAddressRequest) (*QueryBaseAddressResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method BaseAddress not implemented")
 }
+func (*UnimplementedQueryServer) BaseRecipients(ctx context.Context, req *QueryBaseRecipientsRequest) (*QueryBaseRecipientsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BaseRecipients not implemented")
+}
 func (*UnimplementedQueryServer) Moderator(ctx context.Context, req *QueryModeratorRequest) (*QueryModeratorResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Moderator not implemented")
 }
+func (*UnimplementedQueryServer) PendingModerator(ctx context.Context, req *QueryPendingModeratorRequest) (*QueryPendingModeratorResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PendingModerator not implemented")
+}
+func (*UnimplementedQueryServer) FeeSplitTotals(ctx context.Context, req *QueryFeeSplitTotalsRequest) (*QueryFeeSplitTotalsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method FeeSplitTotals not implemented")
+}
+func (*UnimplementedQueryServer) FeeSplitSnapshots(ctx context.Context, req *QueryFeeSplitSnapshotsRequest) (*QueryFeeSplitSnapshotsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method FeeSplitSnapshots not implemented")
+}
+func (*UnimplementedQueryServer) FeeSplitSnapshot(ctx context.Context, req *QueryFeeSplitSnapshotRequest) (*QueryFeeSplitSnapshotResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method FeeSplitSnapshot not implemented")
+}
 
 func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
 	s.RegisterService(&_Query_serviceDesc, srv)
@@ -1772,6 +2617,42 @@ func _Query_Ratio_Handler(srv interface{}, ctx context.Context, dec func(interfa
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_PendingRatioChanges_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryPendingRatioChangesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).PendingRatioChanges(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.distribution.v1beta1.Query/PendingRatioChanges",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).PendingRatioChanges(ctx, req.(*QueryPendingRatioChangesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_RatioHistory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryRatioHistoryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).RatioHistory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.distribution.v1beta1.Query/RatioHistory",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).RatioHistory(ctx, req.(*QueryRatioHistoryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Query_BaseAddress_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(QueryBaseAddressRequest)
 	if err := dec(in); err != nil {
@@ -1790,6 +2671,24 @@ func _Query_BaseAddress_Handler(srv interface{}, ctx context.Context, dec func(i
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_BaseRecipients_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryBaseRecipientsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).BaseRecipients(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.distribution.v1beta1.Query/BaseRecipients",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).BaseRecipients(ctx, req.(*QueryBaseRecipientsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Query_Moderator_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(QueryModeratorRequest)
 	if err := dec(in); err != nil {
@@ -1808,6 +2707,78 @@ func _Query_Moderator_Handler(srv interface{}, ctx context.Context, dec func(int
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_PendingModerator_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryPendingModeratorRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).PendingModerator(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.distribution.v1beta1.Query/PendingModerator",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).PendingModerator(ctx, req.(*QueryPendingModeratorRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_FeeSplitTotals_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryFeeSplitTotalsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).FeeSplitTotals(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.distribution.v1beta1.Query/FeeSplitTotals",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).FeeSplitTotals(ctx, req.(*QueryFeeSplitTotalsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_FeeSplitSnapshots_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryFeeSplitSnapshotsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).FeeSplitSnapshots(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.distribution.v1beta1.Query/FeeSplitSnapshots",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).FeeSplitSnapshots(ctx, req.(*QueryFeeSplitSnapshotsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_FeeSplitSnapshot_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryFeeSplitSnapshotRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).FeeSplitSnapshot(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.distribution.v1beta1.Query/FeeSplitSnapshot",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).FeeSplitSnapshot(ctx, req.(*QueryFeeSplitSnapshotRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Query_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "cosmos.distribution.v1beta1.Query",
 	HandlerType: (*QueryServer)(nil),
@@ -1856,14 +2827,42 @@ var _Query_serviceDesc = grpc.ServiceDesc{
 			MethodName: "Ratio",
 			Handler:    _Query_Ratio_Handler,
 		},
+		{
+			MethodName: "PendingRatioChanges",
+			Handler:    _Query_PendingRatioChanges_Handler,
+		},
+		{
+			MethodName: "RatioHistory",
+			Handler:    _Query_RatioHistory_Handler,
+		},
 		{
 			MethodName: "BaseAddress",
 			Handler:    _Query_BaseAddress_Handler,
 		},
+		{
+			MethodName: "BaseRecipients",
+			Handler:    _Query_BaseRecipients_Handler,
+		},
 		{
 			MethodName: "Moderator",
 			Handler:    _Query_Moderator_Handler,
 		},
+		{
+			MethodName: "PendingModerator",
+			Handler:    _Query_PendingModerator_Handler,
+		},
+		{
+			MethodName: "FeeSplitTotals",
+			Handler:    _Query_FeeSplitTotals_Handler,
+		},
+		{
+			MethodName: "FeeSplitSnapshots",
+			Handler:    _Query_FeeSplitSnapshots_Handler,
+		},
+		{
+			MethodName: "FeeSplitSnapshot",
+			Handler:    _Query_FeeSplitSnapshot_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "cosmos/distribution/v1beta1/query.proto",
@@ -2620,6 +3619,20 @@ func (m *QueryRatioResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.DenomRatios) > 0 {
+		for iNdEx := len(m.DenomRatios) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.DenomRatios[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
 	{
 		size, err := m.Ratio.MarshalToSizedBuffer(dAtA[:i])
 		if err != nil {
@@ -2633,7 +3646,7 @@ func (m *QueryRatioResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func (m *QueryBaseAddressRequest) Marshal() (dAtA []byte, err error) {
+func (m *QueryPendingRatioChangesRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -2643,20 +3656,32 @@ func (m *QueryBaseAddressRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *QueryBaseAddressRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryPendingRatioChangesRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryBaseAddressRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryPendingRatioChangesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
 	return len(dAtA) - i, nil
 }
 
-func (m *QueryBaseAddressResponse) Marshal() (dAtA []byte, err error) {
+func (m *QueryPendingRatioChangesResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -2666,27 +3691,46 @@ func (m *QueryBaseAddressResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *QueryBaseAddressResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryPendingRatioChangesResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryBaseAddressResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryPendingRatioChangesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.BaseAddress) > 0 {
-		i -= len(m.BaseAddress)
-		copy(dAtA[i:], m.BaseAddress)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.BaseAddress)))
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
 		i--
-		dAtA[i] = 0xa
+		dAtA[i] = 0x12
+	}
+	if len(m.Changes) > 0 {
+		for iNdEx := len(m.Changes) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Changes[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *QueryModeratorRequest) Marshal() (dAtA []byte, err error) {
+func (m *QueryRatioHistoryRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -2696,20 +3740,32 @@ func (m *QueryModeratorRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *QueryModeratorRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryRatioHistoryRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryModeratorRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryRatioHistoryRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
 	return len(dAtA) - i, nil
 }
 
-func (m *QueryModeratorResponse) Marshal() (dAtA []byte, err error) {
+func (m *QueryRatioHistoryResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -2719,244 +3775,532 @@ func (m *QueryModeratorResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *QueryModeratorResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryRatioHistoryResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryModeratorResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryRatioHistoryResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.ModeratorAddress) > 0 {
-		i -= len(m.ModeratorAddress)
-		copy(dAtA[i:], m.ModeratorAddress)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.ModeratorAddress)))
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
 		i--
-		dAtA[i] = 0xa
+		dAtA[i] = 0x12
 	}
-	return len(dAtA) - i, nil
+	if len(m.History) > 0 {
+		for iNdEx := len(m.History) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.History[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
 }
 
-func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
-	offset -= sovQuery(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *QueryBaseAddressRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *QueryParamsRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	return n
+
+func (m *QueryBaseAddressRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryParamsResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *QueryBaseAddressRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = m.Params.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryValidatorDistributionInfoRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryBaseAddressResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryBaseAddressResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryBaseAddressResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.ValidatorAddress)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	if len(m.BaseAddress) > 0 {
+		i -= len(m.BaseAddress)
+		copy(dAtA[i:], m.BaseAddress)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.BaseAddress)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryValidatorDistributionInfoResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryBaseRecipientsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryBaseRecipientsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryBaseRecipientsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.OperatorAddress)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	if len(m.SelfBondRewards) > 0 {
-		for _, e := range m.SelfBondRewards {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
-		}
-	}
-	if len(m.Commission) > 0 {
-		for _, e := range m.Commission {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
-		}
-	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryValidatorOutstandingRewardsRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryBaseRecipientsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryBaseRecipientsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryBaseRecipientsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.ValidatorAddress)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	if len(m.Recipients) > 0 {
+		for iNdEx := len(m.Recipients) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Recipients[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryValidatorOutstandingRewardsResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryModeratorRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryModeratorRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryModeratorRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = m.Rewards.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryValidatorCommissionRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryModeratorResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryModeratorResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryModeratorResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.ValidatorAddress)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	if len(m.ModeratorAddress) > 0 {
+		i -= len(m.ModeratorAddress)
+		copy(dAtA[i:], m.ModeratorAddress)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ModeratorAddress)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryValidatorCommissionResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryPendingModeratorRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryPendingModeratorRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryPendingModeratorRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = m.Commission.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryValidatorSlashesRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryPendingModeratorResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryPendingModeratorResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryPendingModeratorResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.ValidatorAddress)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	if len(m.PendingModeratorAddress) > 0 {
+		i -= len(m.PendingModeratorAddress)
+		copy(dAtA[i:], m.PendingModeratorAddress)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.PendingModeratorAddress)))
+		i--
+		dAtA[i] = 0xa
 	}
-	if m.StartingHeight != 0 {
-		n += 1 + sovQuery(uint64(m.StartingHeight))
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryFeeSplitTotalsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	if m.EndingHeight != 0 {
-		n += 1 + sovQuery(uint64(m.EndingHeight))
+	return dAtA[:n], nil
+}
+
+func (m *QueryFeeSplitTotalsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryFeeSplitTotalsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryFeeSplitTotalsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
+	return dAtA[:n], nil
+}
+
+func (m *QueryFeeSplitTotalsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryFeeSplitTotalsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Totals.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
 	}
-	return n
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryValidatorSlashesResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryFeeSplitSnapshotsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryFeeSplitSnapshotsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryFeeSplitSnapshotsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if len(m.Slashes) > 0 {
-		for _, e := range m.Slashes {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
 		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryFeeSplitSnapshotsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryFeeSplitSnapshotsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryFeeSplitSnapshotsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
 	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
 	}
-	return n
+	if m.RetentionBlocks != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.RetentionBlocks))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Snapshots) > 0 {
+		for iNdEx := len(m.Snapshots) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Snapshots[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryDelegationRewardsRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryFeeSplitSnapshotRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryFeeSplitSnapshotRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryFeeSplitSnapshotRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.DelegatorAddress)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	if m.Height != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x8
 	}
-	l = len(m.ValidatorAddress)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryFeeSplitSnapshotResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *QueryDelegationRewardsResponse) Size() (n int) {
+func (m *QueryFeeSplitSnapshotResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryFeeSplitSnapshotResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Snapshot.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
+	offset -= sovQuery(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *QueryParamsRequest) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	if len(m.Rewards) > 0 {
-		for _, e := range m.Rewards {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
-		}
+	return n
+}
+
+func (m *QueryParamsResponse) Size() (n int) {
+	if m == nil {
+		return 0
 	}
+	var l int
+	_ = l
+	l = m.Params.Size()
+	n += 1 + l + sovQuery(uint64(l))
 	return n
 }
 
-func (m *QueryDelegationTotalRewardsRequest) Size() (n int) {
+func (m *QueryValidatorDistributionInfoRequest) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	l = len(m.DelegatorAddress)
+	l = len(m.ValidatorAddress)
 	if l > 0 {
 		n += 1 + l + sovQuery(uint64(l))
 	}
 	return n
 }
 
-func (m *QueryDelegationTotalRewardsResponse) Size() (n int) {
+func (m *QueryValidatorDistributionInfoResponse) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	if len(m.Rewards) > 0 {
-		for _, e := range m.Rewards {
+	l = len(m.OperatorAddress)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if len(m.SelfBondRewards) > 0 {
+		for _, e := range m.SelfBondRewards {
 			l = e.Size()
 			n += 1 + l + sovQuery(uint64(l))
 		}
 	}
-	if len(m.Total) > 0 {
-		for _, e := range m.Total {
+	if len(m.Commission) > 0 {
+		for _, e := range m.Commission {
 			l = e.Size()
 			n += 1 + l + sovQuery(uint64(l))
 		}
@@ -2964,71 +4308,227 @@ func (m *QueryDelegationTotalRewardsResponse) Size() (n int) {
 	return n
 }
 
-func (m *QueryDelegatorValidatorsRequest) Size() (n int) {
+func (m *QueryValidatorOutstandingRewardsRequest) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	l = len(m.DelegatorAddress)
+	l = len(m.ValidatorAddress)
 	if l > 0 {
 		n += 1 + l + sovQuery(uint64(l))
 	}
 	return n
 }
 
-func (m *QueryDelegatorValidatorsResponse) Size() (n int) {
+func (m *QueryValidatorOutstandingRewardsResponse) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	if len(m.Validators) > 0 {
-		for _, s := range m.Validators {
-			l = len(s)
-			n += 1 + l + sovQuery(uint64(l))
-		}
-	}
+	l = m.Rewards.Size()
+	n += 1 + l + sovQuery(uint64(l))
 	return n
 }
 
-func (m *QueryDelegatorWithdrawAddressRequest) Size() (n int) {
+func (m *QueryValidatorCommissionRequest) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	l = len(m.DelegatorAddress)
+	l = len(m.ValidatorAddress)
 	if l > 0 {
 		n += 1 + l + sovQuery(uint64(l))
 	}
 	return n
 }
 
-func (m *QueryDelegatorWithdrawAddressResponse) Size() (n int) {
+func (m *QueryValidatorCommissionResponse) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	l = len(m.WithdrawAddress)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
+	l = m.Commission.Size()
+	n += 1 + l + sovQuery(uint64(l))
 	return n
 }
 
-func (m *QueryCommunityPoolRequest) Size() (n int) {
+func (m *QueryValidatorSlashesRequest) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	return n
-}
-
-func (m *QueryCommunityPoolResponse) Size() (n int) {
-	if m == nil {
+	l = len(m.ValidatorAddress)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.StartingHeight != 0 {
+		n += 1 + sovQuery(uint64(m.StartingHeight))
+	}
+	if m.EndingHeight != 0 {
+		n += 1 + sovQuery(uint64(m.EndingHeight))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryValidatorSlashesResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Slashes) > 0 {
+		for _, e := range m.Slashes {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryDelegationRewardsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.DelegatorAddress)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.ValidatorAddress)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryDelegationRewardsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Rewards) > 0 {
+		for _, e := range m.Rewards {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryDelegationTotalRewardsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.DelegatorAddress)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryDelegationTotalRewardsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Rewards) > 0 {
+		for _, e := range m.Rewards {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if len(m.Total) > 0 {
+		for _, e := range m.Total {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryDelegatorValidatorsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.DelegatorAddress)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryDelegatorValidatorsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Validators) > 0 {
+		for _, s := range m.Validators {
+			l = len(s)
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryDelegatorWithdrawAddressRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.DelegatorAddress)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryDelegatorWithdrawAddressResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.WithdrawAddress)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryCommunityPoolRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryCommunityPoolResponse) Size() (n int) {
+	if m == nil {
 		return 0
 	}
 	var l int
@@ -3059,6 +4559,76 @@ func (m *QueryRatioResponse) Size() (n int) {
 	_ = l
 	l = m.Ratio.Size()
 	n += 1 + l + sovQuery(uint64(l))
+	if len(m.DenomRatios) > 0 {
+		for _, e := range m.DenomRatios {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryPendingRatioChangesRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryPendingRatioChangesResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Changes) > 0 {
+		for _, e := range m.Changes {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryRatioHistoryRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryRatioHistoryResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.History) > 0 {
+		for _, e := range m.History {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
 	return n
 }
 
@@ -3084,35 +4654,1010 @@ func (m *QueryBaseAddressResponse) Size() (n int) {
 	return n
 }
 
+func (m *QueryBaseRecipientsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryBaseRecipientsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Recipients) > 0 {
+		for _, e := range m.Recipients {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
 func (m *QueryModeratorRequest) Size() (n int) {
 	if m == nil {
 		return 0
 	}
-	var l int
-	_ = l
-	return n
-}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryModeratorResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ModeratorAddress)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryPendingModeratorRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryPendingModeratorResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.PendingModeratorAddress)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryFeeSplitTotalsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryFeeSplitTotalsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Totals.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryFeeSplitSnapshotsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryFeeSplitSnapshotsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Snapshots) > 0 {
+		for _, e := range m.Snapshots {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.RetentionBlocks != 0 {
+		n += 1 + sovQuery(uint64(m.RetentionBlocks))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryFeeSplitSnapshotRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Height != 0 {
+		n += 1 + sovQuery(uint64(m.Height))
+	}
+	return n
+}
+
+func (m *QueryFeeSplitSnapshotResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Snapshot.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func sovQuery(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozQuery(x uint64) (n int) {
+	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryParamsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryParamsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryParamsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryValidatorDistributionInfoRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryValidatorDistributionInfoRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryValidatorDistributionInfoRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ValidatorAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryValidatorDistributionInfoResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryValidatorDistributionInfoResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryValidatorDistributionInfoResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OperatorAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.OperatorAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SelfBondRewards", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SelfBondRewards = append(m.SelfBondRewards, types.DecCoin{})
+			if err := m.SelfBondRewards[len(m.SelfBondRewards)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Commission", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Commission = append(m.Commission, types.DecCoin{})
+			if err := m.Commission[len(m.Commission)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryValidatorOutstandingRewardsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryValidatorOutstandingRewardsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryValidatorOutstandingRewardsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ValidatorAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryValidatorOutstandingRewardsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryValidatorOutstandingRewardsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryValidatorOutstandingRewardsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Rewards", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Rewards.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryValidatorCommissionRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryValidatorCommissionRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryValidatorCommissionRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ValidatorAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryValidatorCommissionResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryValidatorCommissionResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryValidatorCommissionResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Commission", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Commission.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryValidatorSlashesRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryValidatorSlashesRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryValidatorSlashesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ValidatorAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartingHeight", wireType)
+			}
+			m.StartingHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.StartingHeight |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EndingHeight", wireType)
+			}
+			m.EndingHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EndingHeight |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
 
-func (m *QueryModeratorResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.ModeratorAddress)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	return n
-}
-
-func sovQuery(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozQuery(x uint64) (n int) {
-	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	return nil
 }
-func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryValidatorSlashesResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3135,12 +5680,82 @@ func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryParamsRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryValidatorSlashesResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryParamsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryValidatorSlashesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Slashes", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Slashes = append(m.Slashes, ValidatorSlashEvent{})
+			if err := m.Slashes[len(m.Slashes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -3162,7 +5777,7 @@ func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryDelegationRewardsRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3185,17 +5800,17 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryParamsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryDelegationRewardsRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryDelegationRewardsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DelegatorAddress", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -3205,24 +5820,55 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.DelegatorAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
 			}
+			m.ValidatorAddress = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -3245,7 +5891,7 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryValidatorDistributionInfoRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryDelegationRewardsResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3268,17 +5914,17 @@ func (m *QueryValidatorDistributionInfoRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryValidatorDistributionInfoRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryDelegationRewardsResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryValidatorDistributionInfoRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryDelegationRewardsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Rewards", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -3288,23 +5934,25 @@ func (m *QueryValidatorDistributionInfoRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ValidatorAddress = string(dAtA[iNdEx:postIndex])
+			m.Rewards = append(m.Rewards, types.DecCoin{})
+			if err := m.Rewards[len(m.Rewards)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -3327,7 +5975,7 @@ func (m *QueryValidatorDistributionInfoRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryValidatorDistributionInfoResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryDelegationTotalRewardsRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3350,15 +5998,15 @@ func (m *QueryValidatorDistributionInfoResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryValidatorDistributionInfoResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryDelegationTotalRewardsRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryValidatorDistributionInfoResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryDelegationTotalRewardsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field OperatorAddress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DelegatorAddress", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3383,14 +6031,64 @@ func (m *QueryValidatorDistributionInfoResponse) Unmarshal(dAtA []byte) error {
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
-			if postIndex > l {
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DelegatorAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryDelegationTotalRewardsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
 				return io.ErrUnexpectedEOF
 			}
-			m.OperatorAddress = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 2:
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryDelegationTotalRewardsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryDelegationTotalRewardsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SelfBondRewards", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Rewards", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -3417,14 +6115,14 @@ func (m *QueryValidatorDistributionInfoResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.SelfBondRewards = append(m.SelfBondRewards, types.DecCoin{})
-			if err := m.SelfBondRewards[len(m.SelfBondRewards)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Rewards = append(m.Rewards, DelegationDelegatorReward{})
+			if err := m.Rewards[len(m.Rewards)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 3:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Commission", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Total", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -3451,8 +6149,8 @@ func (m *QueryValidatorDistributionInfoResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Commission = append(m.Commission, types.DecCoin{})
-			if err := m.Commission[len(m.Commission)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Total = append(m.Total, types.DecCoin{})
+			if err := m.Total[len(m.Total)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -3477,7 +6175,7 @@ func (m *QueryValidatorDistributionInfoResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryValidatorOutstandingRewardsRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryDelegatorValidatorsRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3500,15 +6198,15 @@ func (m *QueryValidatorOutstandingRewardsRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryValidatorOutstandingRewardsRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryDelegatorValidatorsRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryValidatorOutstandingRewardsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryDelegatorValidatorsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DelegatorAddress", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3536,7 +6234,7 @@ func (m *QueryValidatorOutstandingRewardsRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ValidatorAddress = string(dAtA[iNdEx:postIndex])
+			m.DelegatorAddress = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -3559,7 +6257,7 @@ func (m *QueryValidatorOutstandingRewardsRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryValidatorOutstandingRewardsResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryDelegatorValidatorsResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3582,17 +6280,17 @@ func (m *QueryValidatorOutstandingRewardsResponse) Unmarshal(dAtA []byte) error
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryValidatorOutstandingRewardsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryDelegatorValidatorsResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryValidatorOutstandingRewardsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryDelegatorValidatorsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Rewards", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Validators", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -3602,24 +6300,23 @@ func (m *QueryValidatorOutstandingRewardsResponse) Unmarshal(dAtA []byte) error
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Rewards.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Validators = append(m.Validators, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -3642,7 +6339,7 @@ func (m *QueryValidatorOutstandingRewardsResponse) Unmarshal(dAtA []byte) error
 	}
 	return nil
 }
-func (m *QueryValidatorCommissionRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryDelegatorWithdrawAddressRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3665,15 +6362,15 @@ func (m *QueryValidatorCommissionRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryValidatorCommissionRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryDelegatorWithdrawAddressRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryValidatorCommissionRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryDelegatorWithdrawAddressRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DelegatorAddress", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3701,7 +6398,7 @@ func (m *QueryValidatorCommissionRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ValidatorAddress = string(dAtA[iNdEx:postIndex])
+			m.DelegatorAddress = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -3724,7 +6421,7 @@ func (m *QueryValidatorCommissionRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryValidatorCommissionResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryDelegatorWithdrawAddressResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3747,17 +6444,17 @@ func (m *QueryValidatorCommissionResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryValidatorCommissionResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryDelegatorWithdrawAddressResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryValidatorCommissionResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryDelegatorWithdrawAddressResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Commission", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field WithdrawAddress", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -3767,24 +6464,23 @@ func (m *QueryValidatorCommissionResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Commission.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.WithdrawAddress = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -3807,7 +6503,7 @@ func (m *QueryValidatorCommissionResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryValidatorSlashesRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryCommunityPoolRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3830,85 +6526,65 @@ func (m *QueryValidatorSlashesRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryValidatorSlashesRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryCommunityPoolRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryValidatorSlashesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryCommunityPoolRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
-		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddress", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthQuery
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthQuery
 			}
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ValidatorAddress = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field StartingHeight", wireType)
-			}
-			m.StartingHeight = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.StartingHeight |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryCommunityPoolResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
 			}
-		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field EndingHeight", wireType)
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
 			}
-			m.EndingHeight = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.EndingHeight |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
 			}
-		case 4:
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryCommunityPoolResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryCommunityPoolResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Pool", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -3932,16 +6608,64 @@ func (m *QueryValidatorSlashesRequest) Unmarshal(dAtA []byte) error {
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
-			if postIndex > l {
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Pool = append(m.Pool, types.DecCoin{})
+			if err := m.Pool[len(m.Pool)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryRatioRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Pagination == nil {
-				m.Pagination = &query.PageRequest{}
-			}
-			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
 			}
-			iNdEx = postIndex
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryRatioRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryRatioRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -3963,7 +6687,7 @@ func (m *QueryValidatorSlashesRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryValidatorSlashesResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryRatioResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3986,15 +6710,15 @@ func (m *QueryValidatorSlashesResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryValidatorSlashesResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryRatioResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryValidatorSlashesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryRatioResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Slashes", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Ratio", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -4021,14 +6745,13 @@ func (m *QueryValidatorSlashesResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Slashes = append(m.Slashes, ValidatorSlashEvent{})
-			if err := m.Slashes[len(m.Slashes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Ratio.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DenomRatios", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -4055,10 +6778,8 @@ func (m *QueryValidatorSlashesResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Pagination == nil {
-				m.Pagination = &query.PageResponse{}
-			}
-			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.DenomRatios = append(m.DenomRatios, DenomRatio{})
+			if err := m.DenomRatios[len(m.DenomRatios)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -4083,7 +6804,7 @@ func (m *QueryValidatorSlashesResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryDelegationRewardsRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryPendingRatioChangesRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4106,17 +6827,17 @@ func (m *QueryDelegationRewardsRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryDelegationRewardsRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryPendingRatioChangesRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryDelegationRewardsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryPendingRatioChangesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DelegatorAddress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -4126,55 +6847,27 @@ func (m *QueryDelegationRewardsRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.DelegatorAddress = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddress", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
 			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-			m.ValidatorAddress = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -4197,7 +6890,7 @@ func (m *QueryDelegationRewardsRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryDelegationRewardsResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryPendingRatioChangesResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4220,15 +6913,15 @@ func (m *QueryDelegationRewardsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryDelegationRewardsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryPendingRatioChangesResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryDelegationRewardsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryPendingRatioChangesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Rewards", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Changes", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -4255,8 +6948,44 @@ func (m *QueryDelegationRewardsResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Rewards = append(m.Rewards, types.DecCoin{})
-			if err := m.Rewards[len(m.Rewards)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Changes = append(m.Changes, RatioChange{})
+			if err := m.Changes[len(m.Changes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -4281,7 +7010,7 @@ func (m *QueryDelegationRewardsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryDelegationTotalRewardsRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryRatioHistoryRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4304,17 +7033,17 @@ func (m *QueryDelegationTotalRewardsRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryDelegationTotalRewardsRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryRatioHistoryRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryDelegationTotalRewardsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryRatioHistoryRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DelegatorAddress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -4324,23 +7053,27 @@ func (m *QueryDelegationTotalRewardsRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.DelegatorAddress = string(dAtA[iNdEx:postIndex])
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -4363,7 +7096,7 @@ func (m *QueryDelegationTotalRewardsRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryDelegationTotalRewardsResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryRatioHistoryResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4386,15 +7119,15 @@ func (m *QueryDelegationTotalRewardsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryDelegationTotalRewardsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryRatioHistoryResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryDelegationTotalRewardsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryRatioHistoryResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Rewards", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field History", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -4421,14 +7154,14 @@ func (m *QueryDelegationTotalRewardsResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Rewards = append(m.Rewards, DelegationDelegatorReward{})
-			if err := m.Rewards[len(m.Rewards)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.History = append(m.History, RatioHistoryEntry{})
+			if err := m.History[len(m.History)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Total", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -4455,8 +7188,10 @@ func (m *QueryDelegationTotalRewardsResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Total = append(m.Total, types.DecCoin{})
-			if err := m.Total[len(m.Total)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -4481,7 +7216,7 @@ func (m *QueryDelegationTotalRewardsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryDelegatorValidatorsRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryBaseAddressRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4504,15 +7239,65 @@ func (m *QueryDelegatorValidatorsRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryDelegatorValidatorsRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryBaseAddressRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryDelegatorValidatorsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryBaseAddressRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryBaseAddressResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryBaseAddressResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryBaseAddressResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DelegatorAddress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field BaseAddress", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -4537,11 +7322,61 @@ func (m *QueryDelegatorValidatorsRequest) Unmarshal(dAtA []byte) error {
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
-			if postIndex > l {
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BaseAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryBaseRecipientsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
 				return io.ErrUnexpectedEOF
 			}
-			m.DelegatorAddress = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryBaseRecipientsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryBaseRecipientsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -4563,7 +7398,7 @@ func (m *QueryDelegatorValidatorsRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryDelegatorValidatorsResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryBaseRecipientsResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4586,17 +7421,17 @@ func (m *QueryDelegatorValidatorsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryDelegatorValidatorsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryBaseRecipientsResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryDelegatorValidatorsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryBaseRecipientsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Validators", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Recipients", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -4606,23 +7441,25 @@ func (m *QueryDelegatorValidatorsResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Validators = append(m.Validators, string(dAtA[iNdEx:postIndex]))
+			m.Recipients = append(m.Recipients, BaseRecipient{})
+			if err := m.Recipients[len(m.Recipients)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -4645,7 +7482,7 @@ func (m *QueryDelegatorValidatorsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryDelegatorWithdrawAddressRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryModeratorRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4668,44 +7505,12 @@ func (m *QueryDelegatorWithdrawAddressRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryDelegatorWithdrawAddressRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryModeratorRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryDelegatorWithdrawAddressRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryModeratorRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
-		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DelegatorAddress", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.DelegatorAddress = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -4727,7 +7532,7 @@ func (m *QueryDelegatorWithdrawAddressRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryDelegatorWithdrawAddressResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryModeratorResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4750,15 +7555,15 @@ func (m *QueryDelegatorWithdrawAddressResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryDelegatorWithdrawAddressResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryModeratorResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryDelegatorWithdrawAddressResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryModeratorResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field WithdrawAddress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ModeratorAddress", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -4786,7 +7591,7 @@ func (m *QueryDelegatorWithdrawAddressResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.WithdrawAddress = string(dAtA[iNdEx:postIndex])
+			m.ModeratorAddress = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -4809,7 +7614,7 @@ func (m *QueryDelegatorWithdrawAddressResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryCommunityPoolRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryPendingModeratorRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4832,10 +7637,10 @@ func (m *QueryCommunityPoolRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryCommunityPoolRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryPendingModeratorRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryCommunityPoolRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryPendingModeratorRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -4859,7 +7664,7 @@ func (m *QueryCommunityPoolRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryCommunityPoolResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryPendingModeratorResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4882,17 +7687,17 @@ func (m *QueryCommunityPoolResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryCommunityPoolResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryPendingModeratorResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryCommunityPoolResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryPendingModeratorResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pool", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PendingModeratorAddress", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -4902,25 +7707,23 @@ func (m *QueryCommunityPoolResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Pool = append(m.Pool, types.DecCoin{})
-			if err := m.Pool[len(m.Pool)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.PendingModeratorAddress = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -4943,7 +7746,7 @@ func (m *QueryCommunityPoolResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryRatioRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryFeeSplitTotalsRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4966,10 +7769,10 @@ func (m *QueryRatioRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryRatioRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryFeeSplitTotalsRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryRatioRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryFeeSplitTotalsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -4993,7 +7796,7 @@ func (m *QueryRatioRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryRatioResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryFeeSplitTotalsResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5016,15 +7819,15 @@ func (m *QueryRatioResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryRatioResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryFeeSplitTotalsResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryRatioResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryFeeSplitTotalsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Ratio", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Totals", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -5051,7 +7854,7 @@ func (m *QueryRatioResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Ratio.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Totals.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -5076,7 +7879,7 @@ func (m *QueryRatioResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryBaseAddressRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryFeeSplitSnapshotsRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5099,12 +7902,48 @@ func (m *QueryBaseAddressRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryBaseAddressRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryFeeSplitSnapshotsRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryBaseAddressRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryFeeSplitSnapshotsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -5126,7 +7965,7 @@ func (m *QueryBaseAddressRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryBaseAddressResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryFeeSplitSnapshotsResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5149,17 +7988,17 @@ func (m *QueryBaseAddressResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryBaseAddressResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryFeeSplitSnapshotsResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryBaseAddressResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryFeeSplitSnapshotsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BaseAddress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Snapshots", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -5169,23 +8008,80 @@ func (m *QueryBaseAddressResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.BaseAddress = string(dAtA[iNdEx:postIndex])
+			m.Snapshots = append(m.Snapshots, FeeSplitSnapshot{})
+			if err := m.Snapshots[len(m.Snapshots)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RetentionBlocks", wireType)
+			}
+			m.RetentionBlocks = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RetentionBlocks |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -5208,7 +8104,7 @@ func (m *QueryBaseAddressResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryModeratorRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryFeeSplitSnapshotRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5231,12 +8127,31 @@ func (m *QueryModeratorRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryModeratorRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryFeeSplitSnapshotRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryModeratorRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryFeeSplitSnapshotRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -5258,7 +8173,7 @@ func (m *QueryModeratorRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryModeratorResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryFeeSplitSnapshotResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5281,17 +8196,17 @@ func (m *QueryModeratorResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryModeratorResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryFeeSplitSnapshotResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryModeratorResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryFeeSplitSnapshotResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ModeratorAddress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Snapshot", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -5301,23 +8216,24 @@ func (m *QueryModeratorResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ModeratorAddress = string(dAtA[iNdEx:postIndex])
+			if err := m.Snapshot.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -2,6 +2,7 @@ package types
 
 import (
 	"fmt"
+	"time"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"sigs.k8s.io/yaml"
@@ -16,6 +17,16 @@ func InitialRatio() Ratio {
 	}
 }
 
+// ZeroRatio returns a ratio with all its parts set to zero. It is the ratio of
+// the ratio changes that remove a denom ratio override.
+func ZeroRatio() Ratio {
+	return Ratio{
+		StakingRewards: sdk.ZeroDec(),
+		Base:           sdk.ZeroDec(),
+		Burn:           sdk.ZeroDec(),
+	}
+}
+
 func (p Ratio) String() string {
 	out, _ := yaml.Marshal(p)
 	return string(out)
@@ -43,3 +54,57 @@ func (r Ratio) ValidateRatio() error {
 
 	return nil
 }
+
+// ValidateDenomRatios checks that the ratio overrides are set for unique valid
+// denoms and are valid ratios.
+func ValidateDenomRatios(denomRatios []DenomRatio) error {
+	seen := make(map[string]bool, len(denomRatios))
+	for _, dr := range denomRatios {
+		if err := sdk.ValidateDenom(dr.Denom); err != nil {
+			return err
+		}
+		if seen[dr.Denom] {
+			return fmt.Errorf("duplicate ratio for denom %s", dr.Denom)
+		}
+		if err := dr.Ratio.ValidateRatio(); err != nil {
+			return fmt.Errorf("invalid ratio for denom %s: %w", dr.Denom, err)
+		}
+		seen[dr.Denom] = true
+	}
+	return nil
+}
+
+// IsDue returns true if the ratio change has to be applied at the given block
+// height and time.
+func (c RatioChange) IsDue(height int64, blockTime time.Time) bool {
+	if c.ActivationHeight > 0 {
+		return height >= c.ActivationHeight
+	}
+	return !blockTime.Before(c.ActivationTime)
+}
+
+// Validate performs a stateless validation of a scheduled ratio change.
+func (c RatioChange) Validate() error {
+	if c.Id == 0 {
+		return fmt.Errorf("ratio change id cannot be zero")
+	}
+	if c.Denom != "" {
+		if err := sdk.ValidateDenom(c.Denom); err != nil {
+			return fmt.Errorf("invalid denom in ratio change %d: %w", c.Id, err)
+		}
+	}
+	if c.RemoveDenomRatio {
+		if c.Denom == "" {
+			return fmt.Errorf("ratio change %d removes a denom ratio without a denom", c.Id)
+		}
+	} else if err := c.Ratio.ValidateRatio(); err != nil {
+		return err
+	}
+	if c.ActivationHeight < 0 {
+		return fmt.Errorf("negative activation height in ratio change %d, is %d", c.Id, c.ActivationHeight)
+	}
+	if (c.ActivationHeight > 0) == !c.ActivationTime.IsZero() {
+		return fmt.Errorf("ratio change %d must have exactly one of activation height and activation time", c.Id)
+	}
+	return nil
+}
@@ -13,18 +13,22 @@ import (
 	_ "github.com/gogo/protobuf/gogoproto"
 	grpc1 "github.com/gogo/protobuf/grpc"
 	proto "github.com/gogo/protobuf/proto"
+	github_com_gogo_protobuf_types "github.com/gogo/protobuf/types"
 	grpc "google.golang.org/grpc"
 	codes "google.golang.org/grpc/codes"
 	status "google.golang.org/grpc/status"
+	_ "google.golang.org/protobuf/types/known/timestamppb"
 	io "io"
 	math "math"
 	math_bits "math/bits"
+	time "time"
 )
 
 // Reference imports to suppress errors if they are not otherwise used.
 var _ = proto.Marshal
 var _ = fmt.Errorf
 var _ = math.Inf
+var _ = time.Kitchen
 
 // This is a compile-time assertion to ensure that this generated file
 // is compatible with the proto package it is being compiled against.
@@ -359,10 +363,16 @@ func (m *MsgFundCommunityPoolResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MsgFundCommunityPoolResponse proto.InternalMessageInfo
 
-// MsgChangeRatio allows to set new tx fee distribution ratio
+// MsgChangeRatio allows to schedule a new tx fee distribution ratio. The ratio
+// is applied at activation_height or activation_time, whichever is set. If
+// neither is set the ratio is applied at the beginning of the next block.
 type MsgChangeRatio struct {
 	ModeratorAddress string `protobuf:"bytes,1,opt,name=moderator_address,json=moderatorAddress,proto3" json:"moderator_address,omitempty"`
 	Ratio            Ratio  `protobuf:"bytes,2,opt,name=ratio,proto3" json:"ratio"`
+	// activation_height is the block height from which the ratio is applied.
+	ActivationHeight int64 `protobuf:"varint,3,opt,name=activation_height,json=activationHeight,proto3" json:"activation_height,omitempty"`
+	// activation_time is the block time from which the ratio is applied.
+	ActivationTime time.Time `protobuf:"bytes,4,opt,name=activation_time,json=activationTime,proto3,stdtime" json:"activation_time"`
 }
 
 func (m *MsgChangeRatio) Reset()         { *m = MsgChangeRatio{} }
@@ -412,8 +422,24 @@ func (m *MsgChangeRatio) GetRatio() Ratio {
 	return Ratio{}
 }
 
+func (m *MsgChangeRatio) GetActivationHeight() int64 {
+	if m != nil {
+		return m.ActivationHeight
+	}
+	return 0
+}
+
+func (m *MsgChangeRatio) GetActivationTime() time.Time {
+	if m != nil {
+		return m.ActivationTime
+	}
+	return time.Time{}
+}
+
 // MsgChangeRatioResponse defines the Msg/ChangeRatio response type
 type MsgChangeRatioResponse struct {
+	// change_id is the id of the scheduled ratio change.
+	ChangeId uint64 `protobuf:"varint,1,opt,name=change_id,json=changeId,proto3" json:"change_id,omitempty"`
 }
 
 func (m *MsgChangeRatioResponse) Reset()         { *m = MsgChangeRatioResponse{} }
@@ -449,6 +475,103 @@ func (m *MsgChangeRatioResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MsgChangeRatioResponse proto.InternalMessageInfo
 
+func (m *MsgChangeRatioResponse) GetChangeId() uint64 {
+	if m != nil {
+		return m.ChangeId
+	}
+	return 0
+}
+
+// MsgCancelRatioChange allows to cancel a pending tx fee distribution ratio change
+type MsgCancelRatioChange struct {
+	ModeratorAddress string `protobuf:"bytes,1,opt,name=moderator_address,json=moderatorAddress,proto3" json:"moderator_address,omitempty"`
+	ChangeId         uint64 `protobuf:"varint,2,opt,name=change_id,json=changeId,proto3" json:"change_id,omitempty"`
+}
+
+func (m *MsgCancelRatioChange) Reset()         { *m = MsgCancelRatioChange{} }
+func (m *MsgCancelRatioChange) String() string { return proto.CompactTextString(m) }
+func (*MsgCancelRatioChange) ProtoMessage()    {}
+func (*MsgCancelRatioChange) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ed4f433d965e58ca, []int{10}
+}
+func (m *MsgCancelRatioChange) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgCancelRatioChange) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgCancelRatioChange.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgCancelRatioChange) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgCancelRatioChange.Merge(m, src)
+}
+func (m *MsgCancelRatioChange) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgCancelRatioChange) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgCancelRatioChange.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgCancelRatioChange proto.InternalMessageInfo
+
+func (m *MsgCancelRatioChange) GetModeratorAddress() string {
+	if m != nil {
+		return m.ModeratorAddress
+	}
+	return ""
+}
+
+func (m *MsgCancelRatioChange) GetChangeId() uint64 {
+	if m != nil {
+		return m.ChangeId
+	}
+	return 0
+}
+
+// MsgCancelRatioChangeResponse defines the Msg/CancelRatioChange response type
+type MsgCancelRatioChangeResponse struct {
+}
+
+func (m *MsgCancelRatioChangeResponse) Reset()         { *m = MsgCancelRatioChangeResponse{} }
+func (m *MsgCancelRatioChangeResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgCancelRatioChangeResponse) ProtoMessage()    {}
+func (*MsgCancelRatioChangeResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ed4f433d965e58ca, []int{11}
+}
+func (m *MsgCancelRatioChangeResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgCancelRatioChangeResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgCancelRatioChangeResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgCancelRatioChangeResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgCancelRatioChangeResponse.Merge(m, src)
+}
+func (m *MsgCancelRatioChangeResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgCancelRatioChangeResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgCancelRatioChangeResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgCancelRatioChangeResponse proto.InternalMessageInfo
+
 // MsgChangeBaseAddress allows to set new base address
 type MsgChangeBaseAddress struct {
 	ModeratorAddress string `protobuf:"bytes,1,opt,name=moderator_address,json=moderatorAddress,proto3" json:"moderator_address,omitempty"`
@@ -459,7 +582,7 @@ func (m *MsgChangeBaseAddress) Reset()         { *m = MsgChangeBaseAddress{} }
 func (m *MsgChangeBaseAddress) String() string { return proto.CompactTextString(m) }
 func (*MsgChangeBaseAddress) ProtoMessage()    {}
 func (*MsgChangeBaseAddress) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ed4f433d965e58ca, []int{10}
+	return fileDescriptor_ed4f433d965e58ca, []int{12}
 }
 func (m *MsgChangeBaseAddress) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -510,7 +633,7 @@ func (m *MsgChangeBaseAddressResponse) Reset()         { *m = MsgChangeBaseAddre
 func (m *MsgChangeBaseAddressResponse) String() string { return proto.CompactTextString(m) }
 func (*MsgChangeBaseAddressResponse) ProtoMessage()    {}
 func (*MsgChangeBaseAddressResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ed4f433d965e58ca, []int{11}
+	return fileDescriptor_ed4f433d965e58ca, []int{13}
 }
 func (m *MsgChangeBaseAddressResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -539,8 +662,99 @@ func (m *MsgChangeBaseAddressResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MsgChangeBaseAddressResponse proto.InternalMessageInfo
 
-// MsgChangeModerator allows to set new moderator
+// MsgSetBaseRecipients allows to set a new weighted list of base fee recipients
+type MsgSetBaseRecipients struct {
+	ModeratorAddress string          `protobuf:"bytes,1,opt,name=moderator_address,json=moderatorAddress,proto3" json:"moderator_address,omitempty"`
+	Recipients       []BaseRecipient `protobuf:"bytes,2,rep,name=recipients,proto3" json:"recipients"`
+}
+
+func (m *MsgSetBaseRecipients) Reset()         { *m = MsgSetBaseRecipients{} }
+func (m *MsgSetBaseRecipients) String() string { return proto.CompactTextString(m) }
+func (*MsgSetBaseRecipients) ProtoMessage()    {}
+func (*MsgSetBaseRecipients) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ed4f433d965e58ca, []int{14}
+}
+func (m *MsgSetBaseRecipients) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSetBaseRecipients) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSetBaseRecipients.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgSetBaseRecipients) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSetBaseRecipients.Merge(m, src)
+}
+func (m *MsgSetBaseRecipients) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSetBaseRecipients) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSetBaseRecipients.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgSetBaseRecipients proto.InternalMessageInfo
+
+func (m *MsgSetBaseRecipients) GetModeratorAddress() string {
+	if m != nil {
+		return m.ModeratorAddress
+	}
+	return ""
+}
+
+func (m *MsgSetBaseRecipients) GetRecipients() []BaseRecipient {
+	if m != nil {
+		return m.Recipients
+	}
+	return nil
+}
+
+// MsgSetBaseRecipientsResponse defines the Msg/SetBaseRecipients response type
+type MsgSetBaseRecipientsResponse struct {
+}
+
+func (m *MsgSetBaseRecipientsResponse) Reset()         { *m = MsgSetBaseRecipientsResponse{} }
+func (m *MsgSetBaseRecipientsResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgSetBaseRecipientsResponse) ProtoMessage()    {}
+func (*MsgSetBaseRecipientsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ed4f433d965e58ca, []int{15}
+}
+func (m *MsgSetBaseRecipientsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSetBaseRecipientsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSetBaseRecipientsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgSetBaseRecipientsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSetBaseRecipientsResponse.Merge(m, src)
+}
+func (m *MsgSetBaseRecipientsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSetBaseRecipientsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSetBaseRecipientsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgSetBaseRecipientsResponse proto.InternalMessageInfo
+
+// MsgChangeModerator allows to propose a new moderator
 type MsgChangeModerator struct {
+	// moderator_address is the current moderator or the governance authority.
 	ModeratorAddress    string `protobuf:"bytes,1,opt,name=moderator_address,json=moderatorAddress,proto3" json:"moderator_address,omitempty"`
 	NewModeratorAddress string `protobuf:"bytes,2,opt,name=new_moderator_address,json=newModeratorAddress,proto3" json:"new_moderator_address,omitempty"`
 }
@@ -549,7 +763,7 @@ func (m *MsgChangeModerator) Reset()         { *m = MsgChangeModerator{} }
 func (m *MsgChangeModerator) String() string { return proto.CompactTextString(m) }
 func (*MsgChangeModerator) ProtoMessage()    {}
 func (*MsgChangeModerator) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ed4f433d965e58ca, []int{12}
+	return fileDescriptor_ed4f433d965e58ca, []int{16}
 }
 func (m *MsgChangeModerator) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -600,7 +814,7 @@ func (m *MsgChangeModeratorResponse) Reset()         { *m = MsgChangeModeratorRe
 func (m *MsgChangeModeratorResponse) String() string { return proto.CompactTextString(m) }
 func (*MsgChangeModeratorResponse) ProtoMessage()    {}
 func (*MsgChangeModeratorResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ed4f433d965e58ca, []int{13}
+	return fileDescriptor_ed4f433d965e58ca, []int{17}
 }
 func (m *MsgChangeModeratorResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -629,168 +843,638 @@ func (m *MsgChangeModeratorResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MsgChangeModeratorResponse proto.InternalMessageInfo
 
-func init() {
-	proto.RegisterType((*MsgSetWithdrawAddress)(nil), "cosmos.distribution.v1beta1.MsgSetWithdrawAddress")
-	proto.RegisterType((*MsgSetWithdrawAddressResponse)(nil), "cosmos.distribution.v1beta1.MsgSetWithdrawAddressResponse")
-	proto.RegisterType((*MsgWithdrawDelegatorReward)(nil), "cosmos.distribution.v1beta1.MsgWithdrawDelegatorReward")
-	proto.RegisterType((*MsgWithdrawDelegatorRewardResponse)(nil), "cosmos.distribution.v1beta1.MsgWithdrawDelegatorRewardResponse")
-	proto.RegisterType((*MsgWithdrawValidatorCommission)(nil), "cosmos.distribution.v1beta1.MsgWithdrawValidatorCommission")
-	proto.RegisterType((*MsgWithdrawValidatorCommissionResponse)(nil), "cosmos.distribution.v1beta1.MsgWithdrawValidatorCommissionResponse")
-	proto.RegisterType((*MsgFundCommunityPool)(nil), "cosmos.distribution.v1beta1.MsgFundCommunityPool")
-	proto.RegisterType((*MsgFundCommunityPoolResponse)(nil), "cosmos.distribution.v1beta1.MsgFundCommunityPoolResponse")
-	proto.RegisterType((*MsgChangeRatio)(nil), "cosmos.distribution.v1beta1.MsgChangeRatio")
-	proto.RegisterType((*MsgChangeRatioResponse)(nil), "cosmos.distribution.v1beta1.MsgChangeRatioResponse")
-	proto.RegisterType((*MsgChangeBaseAddress)(nil), "cosmos.distribution.v1beta1.MsgChangeBaseAddress")
-	proto.RegisterType((*MsgChangeBaseAddressResponse)(nil), "cosmos.distribution.v1beta1.MsgChangeBaseAddressResponse")
-	proto.RegisterType((*MsgChangeModerator)(nil), "cosmos.distribution.v1beta1.MsgChangeModerator")
-	proto.RegisterType((*MsgChangeModeratorResponse)(nil), "cosmos.distribution.v1beta1.MsgChangeModeratorResponse")
+// MsgAcceptModerator allows the pending moderator to accept the moderator role
+type MsgAcceptModerator struct {
+	NewModeratorAddress string `protobuf:"bytes,1,opt,name=new_moderator_address,json=newModeratorAddress,proto3" json:"new_moderator_address,omitempty"`
 }
 
-func init() {
-	proto.RegisterFile("cosmos/distribution/v1beta1/tx.proto", fileDescriptor_ed4f433d965e58ca)
+func (m *MsgAcceptModerator) Reset()         { *m = MsgAcceptModerator{} }
+func (m *MsgAcceptModerator) String() string { return proto.CompactTextString(m) }
+func (*MsgAcceptModerator) ProtoMessage()    {}
+func (*MsgAcceptModerator) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ed4f433d965e58ca, []int{18}
 }
-
-var fileDescriptor_ed4f433d965e58ca = []byte{
-	// 786 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x56, 0xcf, 0x6b, 0x13, 0x4f,
-	0x1c, 0xcd, 0x7c, 0xfb, 0xb5, 0xd2, 0x29, 0xb4, 0xe9, 0xda, 0x1f, 0xe9, 0xb6, 0x6e, 0xca, 0x22,
-	0x52, 0x94, 0x6e, 0x4c, 0x0a, 0x16, 0x23, 0x28, 0x26, 0xd6, 0x93, 0x8b, 0x92, 0x82, 0x82, 0x97,
-	0xb2, 0xc9, 0x0e, 0xdb, 0xc1, 0xee, 0x4e, 0xd8, 0x99, 0x74, 0x5b, 0x3c, 0x29, 0x82, 0x7a, 0x10,
-	0x04, 0xff, 0x00, 0x7b, 0x14, 0x2f, 0x7a, 0xf0, 0xa2, 0x37, 0xf1, 0x52, 0xf4, 0x52, 0x3c, 0x79,
-	0x52, 0x49, 0x0f, 0xfa, 0x67, 0xc8, 0xfe, 0x9a, 0x6e, 0xba, 0x49, 0x36, 0xb1, 0xa5, 0xa7, 0x84,
-	0x9d, 0xf7, 0xde, 0xe7, 0xbd, 0x99, 0xcf, 0x7e, 0x66, 0xe1, 0x99, 0x1a, 0xa1, 0x26, 0xa1, 0x39,
-	0x1d, 0x53, 0x66, 0xe3, 0x6a, 0x83, 0x61, 0x62, 0xe5, 0x36, 0xf2, 0x55, 0xc4, 0xb4, 0x7c, 0x8e,
-	0x6d, 0x2a, 0x75, 0x9b, 0x30, 0x22, 0xcc, 0xf8, 0x28, 0x25, 0x8a, 0x52, 0x02, 0x94, 0x38, 0x6e,
-	0x10, 0x83, 0x78, 0xb8, 0x9c, 0xfb, 0xcf, 0xa7, 0x88, 0x52, 0x20, 0x5c, 0xd5, 0x28, 0xe2, 0x82,
-	0x35, 0x82, 0xad, 0x60, 0x5d, 0xe9, 0x56, 0xb8, 0xa5, 0x8e, 0x8f, 0x9f, 0xf6, 0xf1, 0xab, 0x7e,
-	0xa1, 0xc0, 0x8f, 0xbf, 0x34, 0x15, 0x48, 0x99, 0xd4, 0xc8, 0x6d, 0xe4, 0xdd, 0x1f, 0x7f, 0x41,
-	0xfe, 0x0c, 0xe0, 0x84, 0x4a, 0x8d, 0x15, 0xc4, 0xee, 0x62, 0xb6, 0xa6, 0xdb, 0x9a, 0x73, 0x4d,
-	0xd7, 0x6d, 0x44, 0xa9, 0xb0, 0x0c, 0xc7, 0x74, 0xb4, 0x8e, 0x0c, 0x8d, 0x11, 0x7b, 0x55, 0xf3,
-	0x1f, 0x66, 0xc0, 0x1c, 0x98, 0x1f, 0x2a, 0x65, 0xbe, 0xbd, 0x5f, 0x18, 0x0f, 0xf4, 0x03, 0xf8,
-	0x0a, 0xb3, 0xb1, 0x65, 0x54, 0xd2, 0x9c, 0x12, 0xca, 0x94, 0x61, 0xda, 0x09, 0x94, 0xb9, 0xca,
-	0x7f, 0x09, 0x2a, 0xa3, 0x4e, 0xab, 0x97, 0xa2, 0xf4, 0x74, 0x3b, 0x9b, 0xfa, 0xb3, 0x9d, 0x4d,
-	0x3d, 0xfa, 0xfd, 0xee, 0x5c, 0xdc, 0x96, 0x9c, 0x85, 0xa7, 0xdb, 0x86, 0xa8, 0x20, 0x5a, 0x27,
-	0x16, 0x45, 0xf2, 0x17, 0x00, 0x45, 0x95, 0x1a, 0xe1, 0xf2, 0xf5, 0x50, 0xa1, 0x82, 0x1c, 0xcd,
-	0xd6, 0x8f, 0x2a, 0xeb, 0x32, 0x1c, 0xdb, 0xd0, 0xd6, 0xb1, 0xde, 0x22, 0x93, 0x14, 0x36, 0xcd,
-	0x29, 0xbd, 0xa6, 0x7d, 0x06, 0xa0, 0xdc, 0x39, 0x4c, 0x98, 0x59, 0xa8, 0xc1, 0x41, 0xcd, 0x24,
-	0x0d, 0x8b, 0x65, 0xc0, 0xdc, 0xc0, 0xfc, 0x70, 0x61, 0x3a, 0xe8, 0x27, 0xc5, 0xed, 0xb7, 0xb0,
-	0x35, 0x95, 0x32, 0xc1, 0x56, 0xe9, 0xc2, 0xce, 0x8f, 0x6c, 0xea, 0xcd, 0xcf, 0xec, 0xbc, 0x81,
-	0xd9, 0x5a, 0xa3, 0xaa, 0xd4, 0x88, 0x19, 0xf4, 0x4f, 0xf0, 0xb3, 0x40, 0xf5, 0xfb, 0x39, 0xb6,
-	0x55, 0x47, 0xd4, 0x23, 0xd0, 0x4a, 0x20, 0x2d, 0x3f, 0x01, 0x50, 0x8a, 0x78, 0xb9, 0x13, 0x66,
-	0x29, 0x13, 0xd3, 0xc4, 0x94, 0x62, 0x62, 0xb5, 0xdf, 0x15, 0x70, 0xc8, 0x5d, 0x89, 0x29, 0xca,
-	0xcf, 0x01, 0x3c, 0xdb, 0xdd, 0xc9, 0xf1, 0xee, 0xcc, 0x57, 0x00, 0xc7, 0x55, 0x6a, 0xdc, 0x68,
-	0x58, 0xba, 0x6b, 0xa1, 0x61, 0x61, 0xb6, 0x75, 0x9b, 0x90, 0xf5, 0x63, 0xa9, 0x2e, 0x5c, 0x84,
-	0x43, 0x3a, 0xaa, 0x13, 0x8a, 0x19, 0xb1, 0x13, 0x5b, 0x70, 0x1f, 0x5a, 0x9c, 0x8c, 0xee, 0xf2,
-	0xfe, 0x73, 0x59, 0x82, 0xb3, 0xed, 0xc2, 0xf0, 0x17, 0xec, 0x2d, 0x80, 0x23, 0x2a, 0x35, 0xca,
-	0x6b, 0x9a, 0x65, 0xa0, 0x8a, 0xc6, 0x30, 0x71, 0xcf, 0xdd, 0x24, 0x3a, 0xb2, 0xfb, 0x3b, 0x77,
-	0x4e, 0x09, 0x5f, 0xaa, 0x2b, 0xf0, 0x84, 0xed, 0xea, 0x79, 0x29, 0x86, 0x0b, 0xb2, 0xd2, 0x65,
-	0xd0, 0x2a, 0x5e, 0xe5, 0xd2, 0xff, 0xee, 0xb6, 0x55, 0x7c, 0x5a, 0x71, 0xd2, 0xeb, 0x97, 0x98,
-	0x13, 0x39, 0x03, 0x27, 0x5b, 0x0d, 0xf3, 0x2c, 0x1f, 0xfc, 0x93, 0xf3, 0x97, 0x4a, 0x1a, 0x45,
-	0x91, 0xf7, 0xfb, 0x28, 0x12, 0x95, 0x60, 0xda, 0x42, 0xce, 0xaa, 0x7b, 0xdc, 0x3d, 0x4f, 0x89,
-	0x11, 0x0b, 0x39, 0x11, 0x2b, 0x1d, 0x53, 0xf9, 0xe7, 0x14, 0xb3, 0xce, 0xb3, 0x7d, 0x02, 0x50,
-	0xe0, 0x00, 0x35, 0xa4, 0x1f, 0x55, 0xb2, 0x9b, 0x70, 0xc2, 0x4d, 0x16, 0x97, 0x4a, 0x8a, 0x77,
-	0xca, 0x42, 0x8e, 0x7a, 0x40, 0xad, 0x63, 0xc6, 0x59, 0x6f, 0x96, 0x1f, 0x88, 0x10, 0x26, 0x2c,
-	0x7c, 0x3c, 0x09, 0x07, 0x54, 0x6a, 0x08, 0x8f, 0x01, 0x14, 0xda, 0x5c, 0x6b, 0x85, 0xae, 0xfd,
-	0xd3, 0xf6, 0x16, 0x11, 0x8b, 0xfd, 0x73, 0xf8, 0xac, 0x79, 0x09, 0xe0, 0x54, 0xa7, 0x6b, 0x67,
-	0x29, 0x49, 0xb7, 0x03, 0x51, 0xbc, 0xfa, 0x8f, 0x44, 0xee, 0xea, 0x15, 0x80, 0x33, 0xdd, 0x66,
-	0xf6, 0xe5, 0x5e, 0x0b, 0xb4, 0x21, 0x8b, 0xe5, 0x43, 0x90, 0xb9, 0xc3, 0x87, 0x00, 0x8e, 0xc5,
-	0x67, 0x67, 0x3e, 0x49, 0x3a, 0x46, 0x11, 0x2f, 0xf5, 0x4d, 0xe1, 0x1e, 0x08, 0x1c, 0x8e, 0x0e,
-	0xb4, 0xf3, 0x49, 0x4a, 0x11, 0xb0, 0xb8, 0xd8, 0x07, 0xb8, 0x25, 0x74, 0x7c, 0xec, 0xe4, 0x7b,
-	0x93, 0x8a, 0x50, 0x92, 0x43, 0x77, 0x9c, 0x10, 0xc2, 0x03, 0x38, 0x7a, 0x70, 0x3a, 0xe4, 0x7a,
-	0x53, 0xe3, 0x04, 0x71, 0xa9, 0x4f, 0x42, 0x58, 0xbc, 0x74, 0xeb, 0x75, 0x53, 0x02, 0x3b, 0x4d,
-	0x09, 0xec, 0x36, 0x25, 0xf0, 0xab, 0x29, 0x81, 0x17, 0x7b, 0x52, 0x6a, 0x77, 0x4f, 0x4a, 0x7d,
-	0xdf, 0x93, 0x52, 0xf7, 0xf2, 0x5d, 0xaf, 0xc1, 0xcd, 0xd6, 0x0f, 0x65, 0xef, 0x56, 0xac, 0x0e,
-	0x7a, 0x9f, 0xb9, 0x8b, 0x7f, 0x03, 0x00, 0x00, 0xff, 0xff, 0x79, 0xd6, 0xbc, 0x02, 0xc5, 0x0b,
-	0x00, 0x00,
+func (m *MsgAcceptModerator) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgAcceptModerator) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgAcceptModerator.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgAcceptModerator) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgAcceptModerator.Merge(m, src)
+}
+func (m *MsgAcceptModerator) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgAcceptModerator) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgAcceptModerator.DiscardUnknown(m)
 }
 
-func (this *MsgSetWithdrawAddressResponse) Equal(that interface{}) bool {
-	if that == nil {
-		return this == nil
+var xxx_messageInfo_MsgAcceptModerator proto.InternalMessageInfo
+
+func (m *MsgAcceptModerator) GetNewModeratorAddress() string {
+	if m != nil {
+		return m.NewModeratorAddress
 	}
+	return ""
+}
 
-	that1, ok := that.(*MsgSetWithdrawAddressResponse)
-	if !ok {
-		that2, ok := that.(MsgSetWithdrawAddressResponse)
-		if ok {
-			that1 = &that2
-		} else {
-			return false
+// MsgAcceptModeratorResponse defines the Msg/AcceptModerator response type
+type MsgAcceptModeratorResponse struct {
+}
+
+func (m *MsgAcceptModeratorResponse) Reset()         { *m = MsgAcceptModeratorResponse{} }
+func (m *MsgAcceptModeratorResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgAcceptModeratorResponse) ProtoMessage()    {}
+func (*MsgAcceptModeratorResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ed4f433d965e58ca, []int{19}
+}
+func (m *MsgAcceptModeratorResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgAcceptModeratorResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgAcceptModeratorResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if that1 == nil {
-		return this == nil
-	} else if this == nil {
-		return false
-	}
-	return true
 }
-func (this *MsgWithdrawDelegatorRewardResponse) Equal(that interface{}) bool {
-	if that == nil {
-		return this == nil
-	}
+func (m *MsgAcceptModeratorResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgAcceptModeratorResponse.Merge(m, src)
+}
+func (m *MsgAcceptModeratorResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgAcceptModeratorResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgAcceptModeratorResponse.DiscardUnknown(m)
+}
 
-	that1, ok := that.(*MsgWithdrawDelegatorRewardResponse)
-	if !ok {
-		that2, ok := that.(MsgWithdrawDelegatorRewardResponse)
-		if ok {
-			that1 = &that2
-		} else {
-			return false
+var xxx_messageInfo_MsgAcceptModeratorResponse proto.InternalMessageInfo
+
+// MsgSetFeeSplitRetention allows to set the fee split snapshot retention
+type MsgSetFeeSplitRetention struct {
+	ModeratorAddress string `protobuf:"bytes,1,opt,name=moderator_address,json=moderatorAddress,proto3" json:"moderator_address,omitempty"`
+	// retention_blocks is the number of blocks for which fee split snapshots
+	// are kept. Zero disables the snapshots.
+	RetentionBlocks uint64 `protobuf:"varint,2,opt,name=retention_blocks,json=retentionBlocks,proto3" json:"retention_blocks,omitempty"`
+}
+
+func (m *MsgSetFeeSplitRetention) Reset()         { *m = MsgSetFeeSplitRetention{} }
+func (m *MsgSetFeeSplitRetention) String() string { return proto.CompactTextString(m) }
+func (*MsgSetFeeSplitRetention) ProtoMessage()    {}
+func (*MsgSetFeeSplitRetention) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ed4f433d965e58ca, []int{20}
+}
+func (m *MsgSetFeeSplitRetention) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSetFeeSplitRetention) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSetFeeSplitRetention.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if that1 == nil {
-		return this == nil
-	} else if this == nil {
-		return false
-	}
-	if len(this.Amount) != len(that1.Amount) {
-		return false
-	}
-	for i := range this.Amount {
-		if !this.Amount[i].Equal(&that1.Amount[i]) {
-			return false
-		}
+}
+func (m *MsgSetFeeSplitRetention) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSetFeeSplitRetention.Merge(m, src)
+}
+func (m *MsgSetFeeSplitRetention) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSetFeeSplitRetention) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSetFeeSplitRetention.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgSetFeeSplitRetention proto.InternalMessageInfo
+
+func (m *MsgSetFeeSplitRetention) GetModeratorAddress() string {
+	if m != nil {
+		return m.ModeratorAddress
 	}
-	return true
+	return ""
 }
-func (this *MsgWithdrawValidatorCommissionResponse) Equal(that interface{}) bool {
-	if that == nil {
-		return this == nil
+
+func (m *MsgSetFeeSplitRetention) GetRetentionBlocks() uint64 {
+	if m != nil {
+		return m.RetentionBlocks
 	}
+	return 0
+}
 
-	that1, ok := that.(*MsgWithdrawValidatorCommissionResponse)
-	if !ok {
-		that2, ok := that.(MsgWithdrawValidatorCommissionResponse)
-		if ok {
-			that1 = &that2
-		} else {
-			return false
+// MsgSetFeeSplitRetentionResponse defines the Msg/SetFeeSplitRetention response type
+type MsgSetFeeSplitRetentionResponse struct {
+}
+
+func (m *MsgSetFeeSplitRetentionResponse) Reset()         { *m = MsgSetFeeSplitRetentionResponse{} }
+func (m *MsgSetFeeSplitRetentionResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgSetFeeSplitRetentionResponse) ProtoMessage()    {}
+func (*MsgSetFeeSplitRetentionResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ed4f433d965e58ca, []int{21}
+}
+func (m *MsgSetFeeSplitRetentionResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSetFeeSplitRetentionResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSetFeeSplitRetentionResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if that1 == nil {
-		return this == nil
-	} else if this == nil {
-		return false
+}
+func (m *MsgSetFeeSplitRetentionResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSetFeeSplitRetentionResponse.Merge(m, src)
+}
+func (m *MsgSetFeeSplitRetentionResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSetFeeSplitRetentionResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSetFeeSplitRetentionResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgSetFeeSplitRetentionResponse proto.InternalMessageInfo
+
+// MsgSetDenomRatio allows to schedule a ratio override for the fees collected
+// in a denom. Like MsgChangeRatio, the override is applied at
+// activation_height or activation_time, or at the beginning of the next block
+// if neither is set.
+type MsgSetDenomRatio struct {
+	ModeratorAddress string `protobuf:"bytes,1,opt,name=moderator_address,json=moderatorAddress,proto3" json:"moderator_address,omitempty"`
+	Denom            string `protobuf:"bytes,2,opt,name=denom,proto3" json:"denom,omitempty"`
+	Ratio            Ratio  `protobuf:"bytes,3,opt,name=ratio,proto3" json:"ratio"`
+	// activation_height is the block height from which the ratio is applied.
+	ActivationHeight int64 `protobuf:"varint,4,opt,name=activation_height,json=activationHeight,proto3" json:"activation_height,omitempty"`
+	// activation_time is the block time from which the ratio is applied.
+	ActivationTime time.Time `protobuf:"bytes,5,opt,name=activation_time,json=activationTime,proto3,stdtime" json:"activation_time"`
+}
+
+func (m *MsgSetDenomRatio) Reset()         { *m = MsgSetDenomRatio{} }
+func (m *MsgSetDenomRatio) String() string { return proto.CompactTextString(m) }
+func (*MsgSetDenomRatio) ProtoMessage()    {}
+func (*MsgSetDenomRatio) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ed4f433d965e58ca, []int{22}
+}
+func (m *MsgSetDenomRatio) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSetDenomRatio) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSetDenomRatio.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	if len(this.Amount) != len(that1.Amount) {
-		return false
+}
+func (m *MsgSetDenomRatio) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSetDenomRatio.Merge(m, src)
+}
+func (m *MsgSetDenomRatio) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSetDenomRatio) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSetDenomRatio.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgSetDenomRatio proto.InternalMessageInfo
+
+func (m *MsgSetDenomRatio) GetModeratorAddress() string {
+	if m != nil {
+		return m.ModeratorAddress
 	}
-	for i := range this.Amount {
-		if !this.Amount[i].Equal(&that1.Amount[i]) {
-			return false
+	return ""
+}
+
+func (m *MsgSetDenomRatio) GetDenom() string {
+	if m != nil {
+		return m.Denom
+	}
+	return ""
+}
+
+func (m *MsgSetDenomRatio) GetRatio() Ratio {
+	if m != nil {
+		return m.Ratio
+	}
+	return Ratio{}
+}
+
+func (m *MsgSetDenomRatio) GetActivationHeight() int64 {
+	if m != nil {
+		return m.ActivationHeight
+	}
+	return 0
+}
+
+func (m *MsgSetDenomRatio) GetActivationTime() time.Time {
+	if m != nil {
+		return m.ActivationTime
+	}
+	return time.Time{}
+}
+
+// MsgSetDenomRatioResponse defines the Msg/SetDenomRatio response type
+type MsgSetDenomRatioResponse struct {
+	// change_id is the id of the scheduled ratio change.
+	ChangeId uint64 `protobuf:"varint,1,opt,name=change_id,json=changeId,proto3" json:"change_id,omitempty"`
+}
+
+func (m *MsgSetDenomRatioResponse) Reset()         { *m = MsgSetDenomRatioResponse{} }
+func (m *MsgSetDenomRatioResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgSetDenomRatioResponse) ProtoMessage()    {}
+func (*MsgSetDenomRatioResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ed4f433d965e58ca, []int{23}
+}
+func (m *MsgSetDenomRatioResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSetDenomRatioResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSetDenomRatioResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	return true
 }
-func (this *MsgFundCommunityPoolResponse) Equal(that interface{}) bool {
+func (m *MsgSetDenomRatioResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSetDenomRatioResponse.Merge(m, src)
+}
+func (m *MsgSetDenomRatioResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSetDenomRatioResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSetDenomRatioResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgSetDenomRatioResponse proto.InternalMessageInfo
+
+func (m *MsgSetDenomRatioResponse) GetChangeId() uint64 {
+	if m != nil {
+		return m.ChangeId
+	}
+	return 0
+}
+
+// MsgRemoveDenomRatio allows to schedule the removal of the ratio override of
+// a denom. The removal is applied at activation_height or activation_time, or
+// at the beginning of the next block if neither is set.
+type MsgRemoveDenomRatio struct {
+	ModeratorAddress string `protobuf:"bytes,1,opt,name=moderator_address,json=moderatorAddress,proto3" json:"moderator_address,omitempty"`
+	Denom            string `protobuf:"bytes,2,opt,name=denom,proto3" json:"denom,omitempty"`
+	// activation_height is the block height from which the override is removed.
+	ActivationHeight int64 `protobuf:"varint,3,opt,name=activation_height,json=activationHeight,proto3" json:"activation_height,omitempty"`
+	// activation_time is the block time from which the override is removed.
+	ActivationTime time.Time `protobuf:"bytes,4,opt,name=activation_time,json=activationTime,proto3,stdtime" json:"activation_time"`
+}
+
+func (m *MsgRemoveDenomRatio) Reset()         { *m = MsgRemoveDenomRatio{} }
+func (m *MsgRemoveDenomRatio) String() string { return proto.CompactTextString(m) }
+func (*MsgRemoveDenomRatio) ProtoMessage()    {}
+func (*MsgRemoveDenomRatio) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ed4f433d965e58ca, []int{24}
+}
+func (m *MsgRemoveDenomRatio) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgRemoveDenomRatio) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRemoveDenomRatio.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgRemoveDenomRatio) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRemoveDenomRatio.Merge(m, src)
+}
+func (m *MsgRemoveDenomRatio) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgRemoveDenomRatio) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRemoveDenomRatio.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgRemoveDenomRatio proto.InternalMessageInfo
+
+func (m *MsgRemoveDenomRatio) GetModeratorAddress() string {
+	if m != nil {
+		return m.ModeratorAddress
+	}
+	return ""
+}
+
+func (m *MsgRemoveDenomRatio) GetDenom() string {
+	if m != nil {
+		return m.Denom
+	}
+	return ""
+}
+
+func (m *MsgRemoveDenomRatio) GetActivationHeight() int64 {
+	if m != nil {
+		return m.ActivationHeight
+	}
+	return 0
+}
+
+func (m *MsgRemoveDenomRatio) GetActivationTime() time.Time {
+	if m != nil {
+		return m.ActivationTime
+	}
+	return time.Time{}
+}
+
+// MsgRemoveDenomRatioResponse defines the Msg/RemoveDenomRatio response type
+type MsgRemoveDenomRatioResponse struct {
+	// change_id is the id of the scheduled ratio change.
+	ChangeId uint64 `protobuf:"varint,1,opt,name=change_id,json=changeId,proto3" json:"change_id,omitempty"`
+}
+
+func (m *MsgRemoveDenomRatioResponse) Reset()         { *m = MsgRemoveDenomRatioResponse{} }
+func (m *MsgRemoveDenomRatioResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgRemoveDenomRatioResponse) ProtoMessage()    {}
+func (*MsgRemoveDenomRatioResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ed4f433d965e58ca, []int{25}
+}
+func (m *MsgRemoveDenomRatioResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgRemoveDenomRatioResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRemoveDenomRatioResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgRemoveDenomRatioResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRemoveDenomRatioResponse.Merge(m, src)
+}
+func (m *MsgRemoveDenomRatioResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgRemoveDenomRatioResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRemoveDenomRatioResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgRemoveDenomRatioResponse proto.InternalMessageInfo
+
+func (m *MsgRemoveDenomRatioResponse) GetChangeId() uint64 {
+	if m != nil {
+		return m.ChangeId
+	}
+	return 0
+}
+
+// MsgWithdrawShareRecordReward withdraws the rewards of the tokenized
+// delegations of all the tokenize share records of an owner, to the owner.
+type MsgWithdrawShareRecordReward struct {
+	OwnerAddress string `protobuf:"bytes,1,opt,name=owner_address,json=ownerAddress,proto3" json:"owner_address,omitempty"`
+}
+
+func (m *MsgWithdrawShareRecordReward) Reset()         { *m = MsgWithdrawShareRecordReward{} }
+func (m *MsgWithdrawShareRecordReward) String() string { return proto.CompactTextString(m) }
+func (*MsgWithdrawShareRecordReward) ProtoMessage()    {}
+func (*MsgWithdrawShareRecordReward) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ed4f433d965e58ca, []int{26}
+}
+func (m *MsgWithdrawShareRecordReward) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgWithdrawShareRecordReward) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgWithdrawShareRecordReward.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgWithdrawShareRecordReward) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgWithdrawShareRecordReward.Merge(m, src)
+}
+func (m *MsgWithdrawShareRecordReward) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgWithdrawShareRecordReward) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgWithdrawShareRecordReward.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgWithdrawShareRecordReward proto.InternalMessageInfo
+
+// MsgWithdrawShareRecordRewardResponse defines the Msg/WithdrawShareRecordReward response type.
+type MsgWithdrawShareRecordRewardResponse struct {
+	Amount github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,1,rep,name=amount,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"amount"`
+}
+
+func (m *MsgWithdrawShareRecordRewardResponse) Reset()         { *m = MsgWithdrawShareRecordRewardResponse{} }
+func (m *MsgWithdrawShareRecordRewardResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgWithdrawShareRecordRewardResponse) ProtoMessage()    {}
+func (*MsgWithdrawShareRecordRewardResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ed4f433d965e58ca, []int{27}
+}
+func (m *MsgWithdrawShareRecordRewardResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgWithdrawShareRecordRewardResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgWithdrawShareRecordRewardResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgWithdrawShareRecordRewardResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgWithdrawShareRecordRewardResponse.Merge(m, src)
+}
+func (m *MsgWithdrawShareRecordRewardResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgWithdrawShareRecordRewardResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgWithdrawShareRecordRewardResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgWithdrawShareRecordRewardResponse proto.InternalMessageInfo
+
+func (m *MsgWithdrawShareRecordRewardResponse) GetAmount() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Amount
+	}
+	return nil
+}
+
+func init() {
+	proto.RegisterType((*MsgSetWithdrawAddress)(nil), "cosmos.distribution.v1beta1.MsgSetWithdrawAddress")
+	proto.RegisterType((*MsgSetWithdrawAddressResponse)(nil), "cosmos.distribution.v1beta1.MsgSetWithdrawAddressResponse")
+	proto.RegisterType((*MsgWithdrawDelegatorReward)(nil), "cosmos.distribution.v1beta1.MsgWithdrawDelegatorReward")
+	proto.RegisterType((*MsgWithdrawDelegatorRewardResponse)(nil), "cosmos.distribution.v1beta1.MsgWithdrawDelegatorRewardResponse")
+	proto.RegisterType((*MsgWithdrawValidatorCommission)(nil), "cosmos.distribution.v1beta1.MsgWithdrawValidatorCommission")
+	proto.RegisterType((*MsgWithdrawValidatorCommissionResponse)(nil), "cosmos.distribution.v1beta1.MsgWithdrawValidatorCommissionResponse")
+	proto.RegisterType((*MsgFundCommunityPool)(nil), "cosmos.distribution.v1beta1.MsgFundCommunityPool")
+	proto.RegisterType((*MsgFundCommunityPoolResponse)(nil), "cosmos.distribution.v1beta1.MsgFundCommunityPoolResponse")
+	proto.RegisterType((*MsgChangeRatio)(nil), "cosmos.distribution.v1beta1.MsgChangeRatio")
+	proto.RegisterType((*MsgChangeRatioResponse)(nil), "cosmos.distribution.v1beta1.MsgChangeRatioResponse")
+	proto.RegisterType((*MsgCancelRatioChange)(nil), "cosmos.distribution.v1beta1.MsgCancelRatioChange")
+	proto.RegisterType((*MsgCancelRatioChangeResponse)(nil), "cosmos.distribution.v1beta1.MsgCancelRatioChangeResponse")
+	proto.RegisterType((*MsgChangeBaseAddress)(nil), "cosmos.distribution.v1beta1.MsgChangeBaseAddress")
+	proto.RegisterType((*MsgChangeBaseAddressResponse)(nil), "cosmos.distribution.v1beta1.MsgChangeBaseAddressResponse")
+	proto.RegisterType((*MsgSetBaseRecipients)(nil), "cosmos.distribution.v1beta1.MsgSetBaseRecipients")
+	proto.RegisterType((*MsgSetBaseRecipientsResponse)(nil), "cosmos.distribution.v1beta1.MsgSetBaseRecipientsResponse")
+	proto.RegisterType((*MsgChangeModerator)(nil), "cosmos.distribution.v1beta1.MsgChangeModerator")
+	proto.RegisterType((*MsgChangeModeratorResponse)(nil), "cosmos.distribution.v1beta1.MsgChangeModeratorResponse")
+	proto.RegisterType((*MsgAcceptModerator)(nil), "cosmos.distribution.v1beta1.MsgAcceptModerator")
+	proto.RegisterType((*MsgAcceptModeratorResponse)(nil), "cosmos.distribution.v1beta1.MsgAcceptModeratorResponse")
+	proto.RegisterType((*MsgSetFeeSplitRetention)(nil), "cosmos.distribution.v1beta1.MsgSetFeeSplitRetention")
+	proto.RegisterType((*MsgSetFeeSplitRetentionResponse)(nil), "cosmos.distribution.v1beta1.MsgSetFeeSplitRetentionResponse")
+	proto.RegisterType((*MsgSetDenomRatio)(nil), "cosmos.distribution.v1beta1.MsgSetDenomRatio")
+	proto.RegisterType((*MsgSetDenomRatioResponse)(nil), "cosmos.distribution.v1beta1.MsgSetDenomRatioResponse")
+	proto.RegisterType((*MsgRemoveDenomRatio)(nil), "cosmos.distribution.v1beta1.MsgRemoveDenomRatio")
+	proto.RegisterType((*MsgRemoveDenomRatioResponse)(nil), "cosmos.distribution.v1beta1.MsgRemoveDenomRatioResponse")
+	proto.RegisterType((*MsgWithdrawShareRecordReward)(nil), "cosmos.distribution.v1beta1.MsgWithdrawShareRecordReward")
+	proto.RegisterType((*MsgWithdrawShareRecordRewardResponse)(nil), "cosmos.distribution.v1beta1.MsgWithdrawShareRecordRewardResponse")
+}
+
+func init() {
+	proto.RegisterFile("cosmos/distribution/v1beta1/tx.proto", fileDescriptor_ed4f433d965e58ca)
+}
+
+var fileDescriptor_ed4f433d965e58ca = []byte{
+	// 1266 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x58, 0xcd, 0x6f, 0x1b, 0xc5,
+	0x1b, 0xf6, 0x38, 0x49, 0xd5, 0xbc, 0xf9, 0x35, 0x71, 0xdc, 0x34, 0x71, 0x36, 0xf9, 0xd9, 0xc1,
+	0xaa, 0x50, 0x68, 0x95, 0x75, 0x9d, 0x52, 0x42, 0xc2, 0x97, 0x62, 0xb7, 0x15, 0x48, 0x58, 0x54,
+	0x1b, 0x04, 0x12, 0x17, 0x6b, 0xed, 0x1d, 0xd6, 0xab, 0xda, 0x3b, 0xd6, 0xce, 0x38, 0x6e, 0x84,
+	0x54, 0x09, 0x84, 0xf8, 0x14, 0x52, 0x05, 0x27, 0x4e, 0x54, 0xe2, 0x82, 0xe0, 0xc2, 0x81, 0x0b,
+	0x47, 0xd4, 0x03, 0x15, 0x5c, 0x2a, 0x4e, 0x9c, 0x08, 0x4a, 0x0e, 0xf0, 0x67, 0xa0, 0xfd, 0x1a,
+	0xef, 0x7a, 0x6d, 0xef, 0x6e, 0x6a, 0x22, 0x4e, 0xa9, 0x67, 0xde, 0xe7, 0x79, 0x9f, 0xe7, 0x9d,
+	0xaf, 0x77, 0x0b, 0x17, 0xeb, 0x84, 0xb6, 0x08, 0x2d, 0x28, 0x1a, 0x65, 0x86, 0x56, 0xeb, 0x30,
+	0x8d, 0xe8, 0x85, 0xfd, 0x62, 0x0d, 0x33, 0xb9, 0x58, 0x60, 0x77, 0xc4, 0xb6, 0x41, 0x18, 0x49,
+	0xaf, 0xd8, 0x51, 0xa2, 0x37, 0x4a, 0x74, 0xa2, 0x84, 0x05, 0x95, 0xa8, 0xc4, 0x8a, 0x2b, 0x98,
+	0xff, 0xb2, 0x21, 0x42, 0xd6, 0x21, 0xae, 0xc9, 0x14, 0x73, 0xc2, 0x3a, 0xd1, 0x74, 0x67, 0x5e,
+	0x1c, 0x95, 0xd8, 0x97, 0xc7, 0x8e, 0x5f, 0xb6, 0xe3, 0xab, 0x76, 0x22, 0x47, 0x8f, 0x3d, 0xb5,
+	0xe4, 0x50, 0xb5, 0xa8, 0x5a, 0xd8, 0x2f, 0x9a, 0x7f, 0x9c, 0x89, 0x9c, 0x4a, 0x88, 0xda, 0xc4,
+	0x05, 0xeb, 0x57, 0xad, 0xf3, 0x76, 0x81, 0x69, 0x2d, 0x4c, 0x99, 0xdc, 0x6a, 0xdb, 0x01, 0xf9,
+	0x07, 0x08, 0x2e, 0x54, 0xa8, 0xba, 0x87, 0xd9, 0x9b, 0x1a, 0x6b, 0x28, 0x86, 0xdc, 0xdd, 0x55,
+	0x14, 0x03, 0x53, 0x9a, 0xbe, 0x01, 0xf3, 0x0a, 0x6e, 0x62, 0x55, 0x66, 0xc4, 0xa8, 0xca, 0xf6,
+	0x60, 0x06, 0xad, 0xa1, 0xf5, 0xe9, 0x52, 0xe6, 0xb7, 0x1f, 0x36, 0x16, 0x1c, 0x01, 0x4e, 0xf8,
+	0x1e, 0x33, 0x34, 0x5d, 0x95, 0x52, 0x1c, 0xe2, 0xd2, 0x94, 0x21, 0xd5, 0x75, 0x98, 0x39, 0x4b,
+	0x32, 0x84, 0x65, 0xae, 0xeb, 0xd7, 0xb2, 0x93, 0xfd, 0xe8, 0x7e, 0x2e, 0xf1, 0xf7, 0xfd, 0x5c,
+	0xe2, 0xbd, 0xbf, 0xbe, 0xbf, 0x14, 0x94, 0x95, 0xcf, 0xc1, 0xff, 0x07, 0x9a, 0x90, 0x30, 0x6d,
+	0x13, 0x9d, 0xe2, 0xfc, 0x2f, 0x08, 0x84, 0x0a, 0x55, 0xdd, 0xe9, 0xeb, 0x2e, 0x83, 0x84, 0xbb,
+	0xb2, 0xa1, 0x8c, 0xcb, 0xeb, 0x0d, 0x98, 0xdf, 0x97, 0x9b, 0x9a, 0xe2, 0xa3, 0x09, 0x33, 0x9b,
+	0xe2, 0x90, 0xa8, 0x6e, 0x3f, 0x46, 0x90, 0x1f, 0x6e, 0xc6, 0xf5, 0x9c, 0xae, 0xc3, 0x19, 0xb9,
+	0x45, 0x3a, 0x3a, 0xcb, 0xa0, 0xb5, 0x89, 0xf5, 0x99, 0xcd, 0x65, 0x67, 0xc3, 0x89, 0xe6, 0x86,
+	0x74, 0xf7, 0xae, 0x58, 0x26, 0x9a, 0x5e, 0xba, 0xf2, 0xf0, 0x8f, 0x5c, 0xe2, 0xdb, 0xc3, 0xdc,
+	0xba, 0xaa, 0xb1, 0x46, 0xa7, 0x26, 0xd6, 0x49, 0xcb, 0xd9, 0x60, 0xce, 0x9f, 0x0d, 0xaa, 0xdc,
+	0x2e, 0xb0, 0x83, 0x36, 0xa6, 0x16, 0x80, 0x4a, 0x0e, 0x75, 0xfe, 0x43, 0x04, 0x59, 0x8f, 0x96,
+	0x37, 0x5c, 0x2f, 0x65, 0xd2, 0x6a, 0x69, 0x94, 0x6a, 0x44, 0x1f, 0x5c, 0x15, 0xf4, 0x98, 0x55,
+	0x09, 0x30, 0xe6, 0x3f, 0x43, 0xf0, 0xe4, 0x68, 0x25, 0xa7, 0x5b, 0x99, 0x5f, 0x11, 0x2c, 0x54,
+	0xa8, 0x7a, 0xb3, 0xa3, 0x2b, 0xa6, 0x84, 0x8e, 0xae, 0xb1, 0x83, 0x5b, 0x84, 0x34, 0x4f, 0x25,
+	0x7b, 0xfa, 0x19, 0x98, 0x56, 0x70, 0x9b, 0x50, 0x8d, 0x11, 0x23, 0x74, 0x0b, 0xf6, 0x42, 0x77,
+	0x16, 0xbd, 0x55, 0xee, 0x8d, 0xe7, 0xb3, 0xb0, 0x3a, 0xc8, 0x0c, 0x3f, 0x60, 0xdf, 0x25, 0x61,
+	0xb6, 0x42, 0xd5, 0x72, 0x43, 0xd6, 0x55, 0x2c, 0xc9, 0x4c, 0x23, 0xe6, 0xba, 0xb7, 0x88, 0x82,
+	0x8d, 0x78, 0xeb, 0xce, 0x21, 0xee, 0xa1, 0x7a, 0x11, 0xa6, 0x0c, 0x93, 0xcf, 0x72, 0x31, 0xb3,
+	0x99, 0x17, 0x47, 0xdc, 0xc4, 0xa2, 0x95, 0xb9, 0x34, 0x69, 0x96, 0x4d, 0xb2, 0x61, 0xe9, 0xcb,
+	0x30, 0x2f, 0xd7, 0x99, 0xb6, 0x6f, 0xfe, 0xd0, 0xab, 0x0d, 0xac, 0xa9, 0x0d, 0x96, 0x99, 0x58,
+	0x43, 0xeb, 0x13, 0x52, 0xaa, 0x37, 0xf1, 0xb2, 0x35, 0x9e, 0xae, 0xc0, 0x9c, 0x27, 0xd8, 0xbc,
+	0x2c, 0x33, 0x93, 0x56, 0x5a, 0x41, 0xb4, 0x6f, 0x52, 0xd1, 0xbd, 0x49, 0xc5, 0xd7, 0xdd, 0x9b,
+	0xb4, 0x74, 0xd6, 0x4c, 0x77, 0xef, 0x30, 0x87, 0xa4, 0xd9, 0x1e, 0xd8, 0x9c, 0xde, 0x59, 0xb4,
+	0xf6, 0x6a, 0xa0, 0x0a, 0xf9, 0x6b, 0xb0, 0xe8, 0x2f, 0x16, 0xdf, 0x9a, 0x2b, 0x30, 0x5d, 0xb7,
+	0x86, 0xab, 0x9a, 0x62, 0x15, 0x6b, 0x52, 0x3a, 0x6b, 0x0f, 0xbc, 0xa2, 0xe4, 0x3f, 0xb7, 0xb7,
+	0x54, 0x59, 0xd6, 0xeb, 0xb8, 0x69, 0xe1, 0x6c, 0x8a, 0x71, 0x95, 0xda, 0x97, 0x3c, 0xe9, 0x4f,
+	0x3e, 0xd4, 0x8b, 0xbd, 0x33, 0x02, 0x9a, 0xf8, 0xce, 0xf8, 0xd1, 0x11, 0x6d, 0x8d, 0x96, 0x64,
+	0x8a, 0x3d, 0xb7, 0xe5, 0x38, 0x44, 0x97, 0x20, 0xa5, 0xe3, 0x6e, 0xd5, 0x3c, 0x3c, 0x91, 0xef,
+	0xdc, 0x59, 0x1d, 0x77, 0x3d, 0x52, 0xc2, 0xbc, 0xf5, 0x4b, 0xe7, 0xde, 0x7e, 0xb6, 0xbd, 0xed,
+	0x61, 0x66, 0xce, 0x4a, 0xb8, 0xae, 0xb5, 0x35, 0xac, 0xb3, 0xb1, 0x79, 0xbb, 0x05, 0x60, 0x70,
+	0xd2, 0x4c, 0xd2, 0xba, 0x2e, 0x2e, 0x8d, 0x3c, 0x00, 0x3e, 0x1d, 0xce, 0x41, 0xf0, 0x70, 0x84,
+	0x38, 0x0d, 0x18, 0xe1, 0x4e, 0x7f, 0x42, 0x90, 0xe6, 0xa5, 0xa8, 0xb8, 0xf0, 0x71, 0xf9, 0x7c,
+	0x15, 0x2e, 0x98, 0x6b, 0x18, 0xa4, 0x0a, 0x5b, 0xc8, 0xf3, 0x3a, 0xee, 0x56, 0xfa, 0xd8, 0x86,
+	0x7a, 0x5c, 0x05, 0x21, 0x68, 0x81, 0x3b, 0xbc, 0x6b, 0x19, 0xdc, 0xad, 0xd7, 0x71, 0x9b, 0xf5,
+	0x0c, 0x0e, 0x55, 0x86, 0x4e, 0xa2, 0x4c, 0x30, 0x95, 0x0d, 0x26, 0x74, 0xd4, 0xf5, 0xe5, 0xe7,
+	0xea, 0xbe, 0x46, 0xb0, 0x64, 0x2f, 0xd0, 0x4d, 0x8c, 0xf7, 0xda, 0x4d, 0x8d, 0x49, 0x98, 0x61,
+	0x9d, 0x39, 0x0f, 0xec, 0x38, 0x16, 0xe1, 0x29, 0x48, 0x19, 0x2e, 0x67, 0xb5, 0xd6, 0x24, 0xf5,
+	0xdb, 0xd4, 0xb9, 0x04, 0xe6, 0xf8, 0x78, 0xc9, 0x1a, 0x1e, 0x5a, 0xe1, 0x27, 0x20, 0x37, 0x44,
+	0x24, 0x37, 0xf2, 0x20, 0x09, 0x29, 0x3b, 0xe6, 0x3a, 0xd6, 0x49, 0x6b, 0xac, 0x4f, 0xc5, 0x02,
+	0x4c, 0x29, 0x26, 0xa9, 0xbd, 0x6d, 0x24, 0xfb, 0x47, 0xef, 0x01, 0x99, 0x18, 0xe3, 0x03, 0x32,
+	0x19, 0xfd, 0x01, 0x99, 0xfa, 0x17, 0x1e, 0x90, 0x2d, 0xc8, 0xf4, 0x17, 0x31, 0xda, 0x13, 0xf2,
+	0x41, 0x12, 0xce, 0x57, 0xa8, 0x2a, 0xe1, 0x16, 0xd9, 0xc7, 0xa7, 0xb5, 0x02, 0xff, 0xc5, 0x27,
+	0x78, 0x07, 0x56, 0x06, 0xd4, 0x21, 0x5a, 0x11, 0x0f, 0x60, 0xd5, 0xd3, 0x69, 0xee, 0x35, 0x64,
+	0xc3, 0xbc, 0x32, 0x89, 0xa1, 0xd8, 0x1d, 0x78, 0xfa, 0x05, 0x38, 0x47, 0xba, 0x3a, 0x8e, 0x5e,
+	0xc8, 0xff, 0x59, 0xe1, 0xfc, 0x96, 0xf0, 0xf6, 0x60, 0x7e, 0xa6, 0xfc, 0xa7, 0x08, 0x2e, 0x8e,
+	0xca, 0x7d, 0xaa, 0x3d, 0xee, 0xe6, 0xe1, 0x2c, 0x4c, 0x54, 0xa8, 0x9a, 0x7e, 0x1f, 0x41, 0x7a,
+	0xc0, 0x27, 0xe4, 0xe6, 0xc8, 0xa3, 0x36, 0xf0, 0x8b, 0x4d, 0xd8, 0x89, 0x8f, 0xe1, 0x9e, 0xbf,
+	0x40, 0xb0, 0x34, 0xec, 0x13, 0x6f, 0x2b, 0x8c, 0x77, 0x08, 0x50, 0x78, 0xe9, 0x84, 0x40, 0xae,
+	0xea, 0x2b, 0x04, 0x2b, 0xa3, 0xbe, 0x8f, 0x9e, 0x8b, 0x9a, 0x60, 0x00, 0x58, 0x28, 0x3f, 0x06,
+	0x98, 0x2b, 0x7c, 0x17, 0xc1, 0x7c, 0xf0, 0x3b, 0xa5, 0x18, 0x46, 0x1d, 0x80, 0x08, 0xdb, 0xb1,
+	0x21, 0x5c, 0x03, 0x81, 0x19, 0xef, 0xc7, 0xc3, 0xe5, 0x30, 0x26, 0x4f, 0xb0, 0x70, 0x35, 0x46,
+	0xb0, 0xcf, 0x74, 0xb0, 0x93, 0x0e, 0x35, 0x1d, 0x80, 0x08, 0xdb, 0xb1, 0x21, 0x7e, 0x0d, 0x81,
+	0xc6, 0xb8, 0x18, 0xcd, 0x8e, 0x07, 0x22, 0x6c, 0xc7, 0x86, 0xf8, 0x34, 0x04, 0x1b, 0xd8, 0x62,
+	0x84, 0x63, 0xe8, 0x87, 0x08, 0xdb, 0xb1, 0x21, 0x5c, 0xc3, 0x3b, 0x30, 0xd7, 0xdf, 0x59, 0x16,
+	0xa2, 0x39, 0xe2, 0x00, 0x61, 0x2b, 0x26, 0xc0, 0x9b, 0xbc, 0xbf, 0xeb, 0x0b, 0x4d, 0xde, 0x07,
+	0x10, 0xb6, 0x62, 0x02, 0x78, 0xf2, 0x4f, 0x10, 0x2c, 0x0c, 0x6c, 0xea, 0x9e, 0x8e, 0x50, 0xcd,
+	0x00, 0x4a, 0x78, 0xfe, 0x24, 0x28, 0x2e, 0xa6, 0x03, 0xe7, 0xfc, 0x7d, 0xd9, 0x46, 0x04, 0xba,
+	0x5e, 0xb8, 0x70, 0x2d, 0x56, 0x38, 0x4f, 0x7b, 0x17, 0x52, 0x81, 0x7e, 0xe4, 0x4a, 0x18, 0x55,
+	0x3f, 0x42, 0x78, 0x36, 0x2e, 0x82, 0xe7, 0xff, 0x12, 0xc1, 0xf2, 0xf0, 0xc7, 0x7c, 0x3b, 0xea,
+	0x0d, 0x1b, 0x80, 0x0a, 0xbb, 0x27, 0x86, 0xba, 0xda, 0x4a, 0xaf, 0x7d, 0x73, 0x94, 0x45, 0x0f,
+	0x8f, 0xb2, 0xe8, 0xd1, 0x51, 0x16, 0xfd, 0x79, 0x94, 0x45, 0xf7, 0x8e, 0xb3, 0x89, 0x47, 0xc7,
+	0xd9, 0xc4, 0xef, 0xc7, 0xd9, 0xc4, 0x5b, 0xc5, 0x91, 0x2f, 0xf6, 0x1d, 0xff, 0x7f, 0x2d, 0x5b,
+	0x0f, 0x78, 0xed, 0x8c, 0xd5, 0x3d, 0x5d, 0xfd, 0x67, 0x00, 0xbe, 0xeb, 0x42, 0x73, 0xf7, 0x16,
+	0x00, 0x00,
+}
+
+func (this *MsgSetWithdrawAddressResponse) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
 	}
 
-	that1, ok := that.(*MsgFundCommunityPoolResponse)
+	that1, ok := that.(*MsgSetWithdrawAddressResponse)
 	if !ok {
-		that2, ok := that.(MsgFundCommunityPoolResponse)
+		that2, ok := that.(MsgSetWithdrawAddressResponse)
 		if ok {
 			that1 = &that2
 		} else {
@@ -804,14 +1488,14 @@ func (this *MsgFundCommunityPoolResponse) Equal(that interface{}) bool {
 	}
 	return true
 }
-func (this *MsgChangeRatio) Equal(that interface{}) bool {
+func (this *MsgWithdrawDelegatorRewardResponse) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
 	}
 
-	that1, ok := that.(*MsgChangeRatio)
+	that1, ok := that.(*MsgWithdrawDelegatorRewardResponse)
 	if !ok {
-		that2, ok := that.(MsgChangeRatio)
+		that2, ok := that.(MsgWithdrawDelegatorRewardResponse)
 		if ok {
 			that1 = &that2
 		} else {
@@ -823,10 +1507,95 @@ func (this *MsgChangeRatio) Equal(that interface{}) bool {
 	} else if this == nil {
 		return false
 	}
-	if this.ModeratorAddress != that1.ModeratorAddress {
-		return false
-	}
-	if !this.Ratio.Equal(&that1.Ratio) {
+	if len(this.Amount) != len(that1.Amount) {
+		return false
+	}
+	for i := range this.Amount {
+		if !this.Amount[i].Equal(&that1.Amount[i]) {
+			return false
+		}
+	}
+	return true
+}
+func (this *MsgWithdrawValidatorCommissionResponse) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*MsgWithdrawValidatorCommissionResponse)
+	if !ok {
+		that2, ok := that.(MsgWithdrawValidatorCommissionResponse)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if len(this.Amount) != len(that1.Amount) {
+		return false
+	}
+	for i := range this.Amount {
+		if !this.Amount[i].Equal(&that1.Amount[i]) {
+			return false
+		}
+	}
+	return true
+}
+func (this *MsgFundCommunityPoolResponse) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*MsgFundCommunityPoolResponse)
+	if !ok {
+		that2, ok := that.(MsgFundCommunityPoolResponse)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	return true
+}
+func (this *MsgChangeRatio) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*MsgChangeRatio)
+	if !ok {
+		that2, ok := that.(MsgChangeRatio)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.ModeratorAddress != that1.ModeratorAddress {
+		return false
+	}
+	if !this.Ratio.Equal(&that1.Ratio) {
+		return false
+	}
+	if this.ActivationHeight != that1.ActivationHeight {
+		return false
+	}
+	if !this.ActivationTime.Equal(that1.ActivationTime) {
 		return false
 	}
 	return true
@@ -850,6 +1619,57 @@ func (this *MsgChangeRatioResponse) Equal(that interface{}) bool {
 	} else if this == nil {
 		return false
 	}
+	if this.ChangeId != that1.ChangeId {
+		return false
+	}
+	return true
+}
+func (this *MsgCancelRatioChange) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*MsgCancelRatioChange)
+	if !ok {
+		that2, ok := that.(MsgCancelRatioChange)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.ModeratorAddress != that1.ModeratorAddress {
+		return false
+	}
+	if this.ChangeId != that1.ChangeId {
+		return false
+	}
+	return true
+}
+func (this *MsgCancelRatioChangeResponse) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*MsgCancelRatioChangeResponse)
+	if !ok {
+		that2, ok := that.(MsgCancelRatioChangeResponse)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
 	return true
 }
 func (this *MsgChangeBaseAddress) Equal(that interface{}) bool {
@@ -900,6 +1720,59 @@ func (this *MsgChangeBaseAddressResponse) Equal(that interface{}) bool {
 	}
 	return true
 }
+func (this *MsgSetBaseRecipients) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*MsgSetBaseRecipients)
+	if !ok {
+		that2, ok := that.(MsgSetBaseRecipients)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.ModeratorAddress != that1.ModeratorAddress {
+		return false
+	}
+	if len(this.Recipients) != len(that1.Recipients) {
+		return false
+	}
+	for i := range this.Recipients {
+		if !this.Recipients[i].Equal(&that1.Recipients[i]) {
+			return false
+		}
+	}
+	return true
+}
+func (this *MsgSetBaseRecipientsResponse) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*MsgSetBaseRecipientsResponse)
+	if !ok {
+		that2, ok := that.(MsgSetBaseRecipientsResponse)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	return true
+}
 func (this *MsgChangeModerator) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
@@ -948,657 +1821,855 @@ func (this *MsgChangeModeratorResponse) Equal(that interface{}) bool {
 	}
 	return true
 }
-
-// Reference imports to suppress errors if they are not otherwise used.
-var _ context.Context
-var _ grpc.ClientConn
-
-// This is a compile-time assertion to ensure that this generated file
-// is compatible with the grpc package it is being compiled against.
-const _ = grpc.SupportPackageIsVersion4
-
-// MsgClient is the client API for Msg service.
-//
-// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
-type MsgClient interface {
-	// SetWithdrawAddress defines a method to change the withdraw address
-	// for a delegator (or validator self-delegation).
-	SetWithdrawAddress(ctx context.Context, in *MsgSetWithdrawAddress, opts ...grpc.CallOption) (*MsgSetWithdrawAddressResponse, error)
-	// WithdrawDelegatorReward defines a method to withdraw rewards of delegator
-	// from a single validator.
-	WithdrawDelegatorReward(ctx context.Context, in *MsgWithdrawDelegatorReward, opts ...grpc.CallOption) (*MsgWithdrawDelegatorRewardResponse, error)
-	// WithdrawValidatorCommission defines a method to withdraw the
-	// full commission to the validator address.
-	WithdrawValidatorCommission(ctx context.Context, in *MsgWithdrawValidatorCommission, opts ...grpc.CallOption) (*MsgWithdrawValidatorCommissionResponse, error)
-	// FundCommunityPool defines a method to allow an account to directly
-	// fund the community pool.
-	FundCommunityPool(ctx context.Context, in *MsgFundCommunityPool, opts ...grpc.CallOption) (*MsgFundCommunityPoolResponse, error)
-	// ChangeRatio defines a mthod to allow change the fee distribution ratio
-	ChangeRatio(ctx context.Context, in *MsgChangeRatio, opts ...grpc.CallOption) (*MsgChangeRatioResponse, error)
-	// ChangeBaseAddress defines a method to allow changing the base address
-	ChangeBaseAddress(ctx context.Context, in *MsgChangeBaseAddress, opts ...grpc.CallOption) (*MsgChangeBaseAddressResponse, error)
-	// ChangeModerator defines a method to allow changing the moderator
-	ChangeModerator(ctx context.Context, in *MsgChangeModerator, opts ...grpc.CallOption) (*MsgChangeModeratorResponse, error)
-}
-
-type msgClient struct {
-	cc grpc1.ClientConn
-}
-
-func NewMsgClient(cc grpc1.ClientConn) MsgClient {
-	return &msgClient{cc}
-}
-
-func (c *msgClient) SetWithdrawAddress(ctx context.Context, in *MsgSetWithdrawAddress, opts ...grpc.CallOption) (*MsgSetWithdrawAddressResponse, error) {
-	out := new(MsgSetWithdrawAddressResponse)
-	err := c.cc.Invoke(ctx, "/cosmos.distribution.v1beta1.Msg/SetWithdrawAddress", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (this *MsgAcceptModerator) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
 	}
-	return out, nil
-}
 
-func (c *msgClient) WithdrawDelegatorReward(ctx context.Context, in *MsgWithdrawDelegatorReward, opts ...grpc.CallOption) (*MsgWithdrawDelegatorRewardResponse, error) {
-	out := new(MsgWithdrawDelegatorRewardResponse)
-	err := c.cc.Invoke(ctx, "/cosmos.distribution.v1beta1.Msg/WithdrawDelegatorReward", in, out, opts...)
-	if err != nil {
-		return nil, err
+	that1, ok := that.(*MsgAcceptModerator)
+	if !ok {
+		that2, ok := that.(MsgAcceptModerator)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
 	}
-	return out, nil
-}
-
-func (c *msgClient) WithdrawValidatorCommission(ctx context.Context, in *MsgWithdrawValidatorCommission, opts ...grpc.CallOption) (*MsgWithdrawValidatorCommissionResponse, error) {
-	out := new(MsgWithdrawValidatorCommissionResponse)
-	err := c.cc.Invoke(ctx, "/cosmos.distribution.v1beta1.Msg/WithdrawValidatorCommission", in, out, opts...)
-	if err != nil {
-		return nil, err
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
 	}
-	return out, nil
-}
-
-func (c *msgClient) FundCommunityPool(ctx context.Context, in *MsgFundCommunityPool, opts ...grpc.CallOption) (*MsgFundCommunityPoolResponse, error) {
-	out := new(MsgFundCommunityPoolResponse)
-	err := c.cc.Invoke(ctx, "/cosmos.distribution.v1beta1.Msg/FundCommunityPool", in, out, opts...)
-	if err != nil {
-		return nil, err
+	if this.NewModeratorAddress != that1.NewModeratorAddress {
+		return false
 	}
-	return out, nil
+	return true
 }
-
-func (c *msgClient) ChangeRatio(ctx context.Context, in *MsgChangeRatio, opts ...grpc.CallOption) (*MsgChangeRatioResponse, error) {
-	out := new(MsgChangeRatioResponse)
-	err := c.cc.Invoke(ctx, "/cosmos.distribution.v1beta1.Msg/ChangeRatio", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (this *MsgAcceptModeratorResponse) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
 	}
-	return out, nil
-}
 
-func (c *msgClient) ChangeBaseAddress(ctx context.Context, in *MsgChangeBaseAddress, opts ...grpc.CallOption) (*MsgChangeBaseAddressResponse, error) {
-	out := new(MsgChangeBaseAddressResponse)
-	err := c.cc.Invoke(ctx, "/cosmos.distribution.v1beta1.Msg/ChangeBaseAddress", in, out, opts...)
-	if err != nil {
-		return nil, err
+	that1, ok := that.(*MsgAcceptModeratorResponse)
+	if !ok {
+		that2, ok := that.(MsgAcceptModeratorResponse)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
 	}
-	return out, nil
-}
-
-func (c *msgClient) ChangeModerator(ctx context.Context, in *MsgChangeModerator, opts ...grpc.CallOption) (*MsgChangeModeratorResponse, error) {
-	out := new(MsgChangeModeratorResponse)
-	err := c.cc.Invoke(ctx, "/cosmos.distribution.v1beta1.Msg/ChangeModerator", in, out, opts...)
-	if err != nil {
-		return nil, err
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
 	}
-	return out, nil
-}
-
-// MsgServer is the server API for Msg service.
-type MsgServer interface {
-	// SetWithdrawAddress defines a method to change the withdraw address
-	// for a delegator (or validator self-delegation).
-	SetWithdrawAddress(context.Context, *MsgSetWithdrawAddress) (*MsgSetWithdrawAddressResponse, error)
-	// WithdrawDelegatorReward defines a method to withdraw rewards of delegator
-	// from a single validator.
-	WithdrawDelegatorReward(context.Context, *MsgWithdrawDelegatorReward) (*MsgWithdrawDelegatorRewardResponse, error)
-	// WithdrawValidatorCommission defines a method to withdraw the
-	// full commission to the validator address.
-	WithdrawValidatorCommission(context.Context, *MsgWithdrawValidatorCommission) (*MsgWithdrawValidatorCommissionResponse, error)
-	// FundCommunityPool defines a method to allow an account to directly
-	// fund the community pool.
-	FundCommunityPool(context.Context, *MsgFundCommunityPool) (*MsgFundCommunityPoolResponse, error)
-	// ChangeRatio defines a mthod to allow change the fee distribution ratio
-	ChangeRatio(context.Context, *MsgChangeRatio) (*MsgChangeRatioResponse, error)
-	// ChangeBaseAddress defines a method to allow changing the base address
-	ChangeBaseAddress(context.Context, *MsgChangeBaseAddress) (*MsgChangeBaseAddressResponse, error)
-	// ChangeModerator defines a method to allow changing the moderator
-	ChangeModerator(context.Context, *MsgChangeModerator) (*MsgChangeModeratorResponse, error)
-}
-
-// UnimplementedMsgServer can be embedded to have forward compatible implementations.
-type UnimplementedMsgServer struct {
-}
-
-func (*UnimplementedMsgServer) SetWithdrawAddress(ctx context.Context, req *MsgSetWithdrawAddress) (*MsgSetWithdrawAddressResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method SetWithdrawAddress not implemented")
-}
-func (*UnimplementedMsgServer) WithdrawDelegatorReward(ctx context.Context, req *MsgWithdrawDelegatorReward) (*MsgWithdrawDelegatorRewardResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method WithdrawDelegatorReward not implemented")
-}
-func (*UnimplementedMsgServer) WithdrawValidatorCommission(ctx context.Context, req *MsgWithdrawValidatorCommission) (*MsgWithdrawValidatorCommissionResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method WithdrawValidatorCommission not implemented")
-}
-func (*UnimplementedMsgServer) FundCommunityPool(ctx context.Context, req *MsgFundCommunityPool) (*MsgFundCommunityPoolResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method FundCommunityPool not implemented")
-}
-func (*UnimplementedMsgServer) ChangeRatio(ctx context.Context, req *MsgChangeRatio) (*MsgChangeRatioResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method ChangeRatio not implemented")
-}
-func (*UnimplementedMsgServer) ChangeBaseAddress(ctx context.Context, req *MsgChangeBaseAddress) (*MsgChangeBaseAddressResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method ChangeBaseAddress not implemented")
-}
-func (*UnimplementedMsgServer) ChangeModerator(ctx context.Context, req *MsgChangeModerator) (*MsgChangeModeratorResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method ChangeModerator not implemented")
-}
-
-func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
-	s.RegisterService(&_Msg_serviceDesc, srv)
+	return true
 }
+func (this *MsgSetFeeSplitRetention) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
 
-func _Msg_SetWithdrawAddress_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgSetWithdrawAddress)
-	if err := dec(in); err != nil {
-		return nil, err
+	that1, ok := that.(*MsgSetFeeSplitRetention)
+	if !ok {
+		that2, ok := that.(MsgSetFeeSplitRetention)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
 	}
-	if interceptor == nil {
-		return srv.(MsgServer).SetWithdrawAddress(ctx, in)
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
 	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/cosmos.distribution.v1beta1.Msg/SetWithdrawAddress",
+	if this.ModeratorAddress != that1.ModeratorAddress {
+		return false
 	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).SetWithdrawAddress(ctx, req.(*MsgSetWithdrawAddress))
+	if this.RetentionBlocks != that1.RetentionBlocks {
+		return false
 	}
-	return interceptor(ctx, in, info, handler)
+	return true
 }
-
-func _Msg_WithdrawDelegatorReward_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgWithdrawDelegatorReward)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).WithdrawDelegatorReward(ctx, in)
+func (this *MsgSetFeeSplitRetentionResponse) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
 	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/cosmos.distribution.v1beta1.Msg/WithdrawDelegatorReward",
+
+	that1, ok := that.(*MsgSetFeeSplitRetentionResponse)
+	if !ok {
+		that2, ok := that.(MsgSetFeeSplitRetentionResponse)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
 	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).WithdrawDelegatorReward(ctx, req.(*MsgWithdrawDelegatorReward))
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
 	}
-	return interceptor(ctx, in, info, handler)
+	return true
 }
-
-func _Msg_WithdrawValidatorCommission_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgWithdrawValidatorCommission)
-	if err := dec(in); err != nil {
-		return nil, err
+func (this *MsgSetDenomRatio) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
 	}
-	if interceptor == nil {
-		return srv.(MsgServer).WithdrawValidatorCommission(ctx, in)
+
+	that1, ok := that.(*MsgSetDenomRatio)
+	if !ok {
+		that2, ok := that.(MsgSetDenomRatio)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
 	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/cosmos.distribution.v1beta1.Msg/WithdrawValidatorCommission",
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
 	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).WithdrawValidatorCommission(ctx, req.(*MsgWithdrawValidatorCommission))
+	if this.ModeratorAddress != that1.ModeratorAddress {
+		return false
 	}
-	return interceptor(ctx, in, info, handler)
-}
-
-func _Msg_FundCommunityPool_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgFundCommunityPool)
-	if err := dec(in); err != nil {
-		return nil, err
+	if this.Denom != that1.Denom {
+		return false
 	}
-	if interceptor == nil {
-		return srv.(MsgServer).FundCommunityPool(ctx, in)
+	if !this.Ratio.Equal(&that1.Ratio) {
+		return false
 	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/cosmos.distribution.v1beta1.Msg/FundCommunityPool",
+	if this.ActivationHeight != that1.ActivationHeight {
+		return false
 	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).FundCommunityPool(ctx, req.(*MsgFundCommunityPool))
+	if !this.ActivationTime.Equal(that1.ActivationTime) {
+		return false
 	}
-	return interceptor(ctx, in, info, handler)
+	return true
 }
-
-func _Msg_ChangeRatio_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgChangeRatio)
-	if err := dec(in); err != nil {
-		return nil, err
+func (this *MsgSetDenomRatioResponse) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
 	}
-	if interceptor == nil {
-		return srv.(MsgServer).ChangeRatio(ctx, in)
+
+	that1, ok := that.(*MsgSetDenomRatioResponse)
+	if !ok {
+		that2, ok := that.(MsgSetDenomRatioResponse)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
 	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/cosmos.distribution.v1beta1.Msg/ChangeRatio",
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
 	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).ChangeRatio(ctx, req.(*MsgChangeRatio))
+	if this.ChangeId != that1.ChangeId {
+		return false
 	}
-	return interceptor(ctx, in, info, handler)
+	return true
 }
+func (this *MsgRemoveDenomRatio) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
 
-func _Msg_ChangeBaseAddress_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgChangeBaseAddress)
-	if err := dec(in); err != nil {
-		return nil, err
+	that1, ok := that.(*MsgRemoveDenomRatio)
+	if !ok {
+		that2, ok := that.(MsgRemoveDenomRatio)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
 	}
-	if interceptor == nil {
-		return srv.(MsgServer).ChangeBaseAddress(ctx, in)
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
 	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/cosmos.distribution.v1beta1.Msg/ChangeBaseAddress",
+	if this.ModeratorAddress != that1.ModeratorAddress {
+		return false
 	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).ChangeBaseAddress(ctx, req.(*MsgChangeBaseAddress))
+	if this.Denom != that1.Denom {
+		return false
 	}
-	return interceptor(ctx, in, info, handler)
-}
-
-func _Msg_ChangeModerator_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgChangeModerator)
-	if err := dec(in); err != nil {
-		return nil, err
+	if this.ActivationHeight != that1.ActivationHeight {
+		return false
 	}
-	if interceptor == nil {
-		return srv.(MsgServer).ChangeModerator(ctx, in)
+	if !this.ActivationTime.Equal(that1.ActivationTime) {
+		return false
 	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/cosmos.distribution.v1beta1.Msg/ChangeModerator",
+	return true
+}
+func (this *MsgRemoveDenomRatioResponse) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
 	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).ChangeModerator(ctx, req.(*MsgChangeModerator))
+
+	that1, ok := that.(*MsgRemoveDenomRatioResponse)
+	if !ok {
+		that2, ok := that.(MsgRemoveDenomRatioResponse)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
 	}
-	return interceptor(ctx, in, info, handler)
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.ChangeId != that1.ChangeId {
+		return false
+	}
+	return true
 }
+func (this *MsgWithdrawShareRecordRewardResponse) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
 
-var _Msg_serviceDesc = grpc.ServiceDesc{
-	ServiceName: "cosmos.distribution.v1beta1.Msg",
-	HandlerType: (*MsgServer)(nil),
-	Methods: []grpc.MethodDesc{
-		{
-			MethodName: "SetWithdrawAddress",
-			Handler:    _Msg_SetWithdrawAddress_Handler,
-		},
-		{
-			MethodName: "WithdrawDelegatorReward",
-			Handler:    _Msg_WithdrawDelegatorReward_Handler,
-		},
-		{
-			MethodName: "WithdrawValidatorCommission",
-			Handler:    _Msg_WithdrawValidatorCommission_Handler,
-		},
-		{
-			MethodName: "FundCommunityPool",
-			Handler:    _Msg_FundCommunityPool_Handler,
-		},
-		{
-			MethodName: "ChangeRatio",
-			Handler:    _Msg_ChangeRatio_Handler,
-		},
-		{
-			MethodName: "ChangeBaseAddress",
-			Handler:    _Msg_ChangeBaseAddress_Handler,
-		},
-		{
-			MethodName: "ChangeModerator",
-			Handler:    _Msg_ChangeModerator_Handler,
-		},
-	},
-	Streams:  []grpc.StreamDesc{},
-	Metadata: "cosmos/distribution/v1beta1/tx.proto",
+	that1, ok := that.(*MsgWithdrawShareRecordRewardResponse)
+	if !ok {
+		that2, ok := that.(MsgWithdrawShareRecordRewardResponse)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if len(this.Amount) != len(that1.Amount) {
+		return false
+	}
+	for i := range this.Amount {
+		if !this.Amount[i].Equal(&that1.Amount[i]) {
+			return false
+		}
+	}
+	return true
 }
 
-func (m *MsgSetWithdrawAddress) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// MsgClient is the client API for Msg service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type MsgClient interface {
+	// SetWithdrawAddress defines a method to change the withdraw address
+	// for a delegator (or validator self-delegation).
+	SetWithdrawAddress(ctx context.Context, in *MsgSetWithdrawAddress, opts ...grpc.CallOption) (*MsgSetWithdrawAddressResponse, error)
+	// WithdrawDelegatorReward defines a method to withdraw rewards of delegator
+	// from a single validator.
+	WithdrawDelegatorReward(ctx context.Context, in *MsgWithdrawDelegatorReward, opts ...grpc.CallOption) (*MsgWithdrawDelegatorRewardResponse, error)
+	// WithdrawValidatorCommission defines a method to withdraw the
+	// full commission to the validator address.
+	WithdrawValidatorCommission(ctx context.Context, in *MsgWithdrawValidatorCommission, opts ...grpc.CallOption) (*MsgWithdrawValidatorCommissionResponse, error)
+	// FundCommunityPool defines a method to allow an account to directly
+	// fund the community pool.
+	FundCommunityPool(ctx context.Context, in *MsgFundCommunityPool, opts ...grpc.CallOption) (*MsgFundCommunityPoolResponse, error)
+	// ChangeRatio defines a mthod to allow change the fee distribution ratio
+	ChangeRatio(ctx context.Context, in *MsgChangeRatio, opts ...grpc.CallOption) (*MsgChangeRatioResponse, error)
+	// CancelRatioChange defines a method to allow cancelling a pending fee
+	// distribution ratio change
+	CancelRatioChange(ctx context.Context, in *MsgCancelRatioChange, opts ...grpc.CallOption) (*MsgCancelRatioChangeResponse, error)
+	// ChangeBaseAddress defines a method to allow changing the base address. It
+	// replaces the base recipients with the new address as single recipient.
+	ChangeBaseAddress(ctx context.Context, in *MsgChangeBaseAddress, opts ...grpc.CallOption) (*MsgChangeBaseAddressResponse, error)
+	// SetBaseRecipients defines a method to allow replacing the weighted list of
+	// base fee recipients
+	SetBaseRecipients(ctx context.Context, in *MsgSetBaseRecipients, opts ...grpc.CallOption) (*MsgSetBaseRecipientsResponse, error)
+	// ChangeModerator defines a method to propose a new moderator. The new
+	// moderator only takes over once it accepts the role with AcceptModerator.
+	// When signed by the governance authority the moderator is replaced
+	// immediately.
+	ChangeModerator(ctx context.Context, in *MsgChangeModerator, opts ...grpc.CallOption) (*MsgChangeModeratorResponse, error)
+	// AcceptModerator defines a method for the pending moderator to accept the
+	// moderator role.
+	AcceptModerator(ctx context.Context, in *MsgAcceptModerator, opts ...grpc.CallOption) (*MsgAcceptModeratorResponse, error)
+	// SetFeeSplitRetention defines a method to allow changing the number of
+	// blocks for which fee split snapshots are kept
+	SetFeeSplitRetention(ctx context.Context, in *MsgSetFeeSplitRetention, opts ...grpc.CallOption) (*MsgSetFeeSplitRetentionResponse, error)
+	// SetDenomRatio defines a method to allow scheduling a ratio override for the
+	// fees collected in a denom
+	SetDenomRatio(ctx context.Context, in *MsgSetDenomRatio, opts ...grpc.CallOption) (*MsgSetDenomRatioResponse, error)
+	// RemoveDenomRatio defines a method to allow scheduling the removal of the
+	// ratio override of a denom, so that its fees are split by the default ratio
+	// again
+	RemoveDenomRatio(ctx context.Context, in *MsgRemoveDenomRatio, opts ...grpc.CallOption) (*MsgRemoveDenomRatioResponse, error)
+	// WithdrawShareRecordReward defines a method to withdraw the rewards of the
+	// tokenized delegations of all the tokenize share records of an owner.
+	WithdrawShareRecordReward(ctx context.Context, in *MsgWithdrawShareRecordReward, opts ...grpc.CallOption) (*MsgWithdrawShareRecordRewardResponse, error)
+}
+
+type msgClient struct {
+	cc grpc1.ClientConn
+}
+
+func NewMsgClient(cc grpc1.ClientConn) MsgClient {
+	return &msgClient{cc}
+}
+
+func (c *msgClient) SetWithdrawAddress(ctx context.Context, in *MsgSetWithdrawAddress, opts ...grpc.CallOption) (*MsgSetWithdrawAddressResponse, error) {
+	out := new(MsgSetWithdrawAddressResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.distribution.v1beta1.Msg/SetWithdrawAddress", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *MsgSetWithdrawAddress) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (c *msgClient) WithdrawDelegatorReward(ctx context.Context, in *MsgWithdrawDelegatorReward, opts ...grpc.CallOption) (*MsgWithdrawDelegatorRewardResponse, error) {
+	out := new(MsgWithdrawDelegatorRewardResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.distribution.v1beta1.Msg/WithdrawDelegatorReward", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *MsgSetWithdrawAddress) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.WithdrawAddress) > 0 {
-		i -= len(m.WithdrawAddress)
-		copy(dAtA[i:], m.WithdrawAddress)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.WithdrawAddress)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.DelegatorAddress) > 0 {
-		i -= len(m.DelegatorAddress)
-		copy(dAtA[i:], m.DelegatorAddress)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.DelegatorAddress)))
-		i--
-		dAtA[i] = 0xa
+func (c *msgClient) WithdrawValidatorCommission(ctx context.Context, in *MsgWithdrawValidatorCommission, opts ...grpc.CallOption) (*MsgWithdrawValidatorCommissionResponse, error) {
+	out := new(MsgWithdrawValidatorCommissionResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.distribution.v1beta1.Msg/WithdrawValidatorCommission", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *MsgSetWithdrawAddressResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *msgClient) FundCommunityPool(ctx context.Context, in *MsgFundCommunityPool, opts ...grpc.CallOption) (*MsgFundCommunityPoolResponse, error) {
+	out := new(MsgFundCommunityPoolResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.distribution.v1beta1.Msg/FundCommunityPool", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *MsgSetWithdrawAddressResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (c *msgClient) ChangeRatio(ctx context.Context, in *MsgChangeRatio, opts ...grpc.CallOption) (*MsgChangeRatioResponse, error) {
+	out := new(MsgChangeRatioResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.distribution.v1beta1.Msg/ChangeRatio", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *MsgSetWithdrawAddressResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func (c *msgClient) CancelRatioChange(ctx context.Context, in *MsgCancelRatioChange, opts ...grpc.CallOption) (*MsgCancelRatioChangeResponse, error) {
+	out := new(MsgCancelRatioChangeResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.distribution.v1beta1.Msg/CancelRatioChange", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *MsgWithdrawDelegatorReward) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *msgClient) ChangeBaseAddress(ctx context.Context, in *MsgChangeBaseAddress, opts ...grpc.CallOption) (*MsgChangeBaseAddressResponse, error) {
+	out := new(MsgChangeBaseAddressResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.distribution.v1beta1.Msg/ChangeBaseAddress", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *MsgWithdrawDelegatorReward) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (c *msgClient) SetBaseRecipients(ctx context.Context, in *MsgSetBaseRecipients, opts ...grpc.CallOption) (*MsgSetBaseRecipientsResponse, error) {
+	out := new(MsgSetBaseRecipientsResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.distribution.v1beta1.Msg/SetBaseRecipients", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *MsgWithdrawDelegatorReward) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.ValidatorAddress) > 0 {
-		i -= len(m.ValidatorAddress)
-		copy(dAtA[i:], m.ValidatorAddress)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.ValidatorAddress)))
-		i--
-		dAtA[i] = 0x12
+func (c *msgClient) ChangeModerator(ctx context.Context, in *MsgChangeModerator, opts ...grpc.CallOption) (*MsgChangeModeratorResponse, error) {
+	out := new(MsgChangeModeratorResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.distribution.v1beta1.Msg/ChangeModerator", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	if len(m.DelegatorAddress) > 0 {
-		i -= len(m.DelegatorAddress)
-		copy(dAtA[i:], m.DelegatorAddress)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.DelegatorAddress)))
-		i--
-		dAtA[i] = 0xa
+	return out, nil
+}
+
+func (c *msgClient) AcceptModerator(ctx context.Context, in *MsgAcceptModerator, opts ...grpc.CallOption) (*MsgAcceptModeratorResponse, error) {
+	out := new(MsgAcceptModeratorResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.distribution.v1beta1.Msg/AcceptModerator", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *MsgWithdrawDelegatorRewardResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *msgClient) SetFeeSplitRetention(ctx context.Context, in *MsgSetFeeSplitRetention, opts ...grpc.CallOption) (*MsgSetFeeSplitRetentionResponse, error) {
+	out := new(MsgSetFeeSplitRetentionResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.distribution.v1beta1.Msg/SetFeeSplitRetention", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *MsgWithdrawDelegatorRewardResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (c *msgClient) SetDenomRatio(ctx context.Context, in *MsgSetDenomRatio, opts ...grpc.CallOption) (*MsgSetDenomRatioResponse, error) {
+	out := new(MsgSetDenomRatioResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.distribution.v1beta1.Msg/SetDenomRatio", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *MsgWithdrawDelegatorRewardResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Amount) > 0 {
-		for iNdEx := len(m.Amount) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Amount[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintTx(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
-		}
+func (c *msgClient) RemoveDenomRatio(ctx context.Context, in *MsgRemoveDenomRatio, opts ...grpc.CallOption) (*MsgRemoveDenomRatioResponse, error) {
+	out := new(MsgRemoveDenomRatioResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.distribution.v1beta1.Msg/RemoveDenomRatio", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *MsgWithdrawValidatorCommission) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *msgClient) WithdrawShareRecordReward(ctx context.Context, in *MsgWithdrawShareRecordReward, opts ...grpc.CallOption) (*MsgWithdrawShareRecordRewardResponse, error) {
+	out := new(MsgWithdrawShareRecordRewardResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.distribution.v1beta1.Msg/WithdrawShareRecordReward", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *MsgWithdrawValidatorCommission) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// MsgServer is the server API for Msg service.
+type MsgServer interface {
+	// SetWithdrawAddress defines a method to change the withdraw address
+	// for a delegator (or validator self-delegation).
+	SetWithdrawAddress(context.Context, *MsgSetWithdrawAddress) (*MsgSetWithdrawAddressResponse, error)
+	// WithdrawDelegatorReward defines a method to withdraw rewards of delegator
+	// from a single validator.
+	WithdrawDelegatorReward(context.Context, *MsgWithdrawDelegatorReward) (*MsgWithdrawDelegatorRewardResponse, error)
+	// WithdrawValidatorCommission defines a method to withdraw the
+	// full commission to the validator address.
+	WithdrawValidatorCommission(context.Context, *MsgWithdrawValidatorCommission) (*MsgWithdrawValidatorCommissionResponse, error)
+	// FundCommunityPool defines a method to allow an account to directly
+	// fund the community pool.
+	FundCommunityPool(context.Context, *MsgFundCommunityPool) (*MsgFundCommunityPoolResponse, error)
+	// ChangeRatio defines a mthod to allow change the fee distribution ratio
+	ChangeRatio(context.Context, *MsgChangeRatio) (*MsgChangeRatioResponse, error)
+	// CancelRatioChange defines a method to allow cancelling a pending fee
+	// distribution ratio change
+	CancelRatioChange(context.Context, *MsgCancelRatioChange) (*MsgCancelRatioChangeResponse, error)
+	// ChangeBaseAddress defines a method to allow changing the base address. It
+	// replaces the base recipients with the new address as single recipient.
+	ChangeBaseAddress(context.Context, *MsgChangeBaseAddress) (*MsgChangeBaseAddressResponse, error)
+	// SetBaseRecipients defines a method to allow replacing the weighted list of
+	// base fee recipients
+	SetBaseRecipients(context.Context, *MsgSetBaseRecipients) (*MsgSetBaseRecipientsResponse, error)
+	// ChangeModerator defines a method to propose a new moderator. The new
+	// moderator only takes over once it accepts the role with AcceptModerator.
+	// When signed by the governance authority the moderator is replaced
+	// immediately.
+	ChangeModerator(context.Context, *MsgChangeModerator) (*MsgChangeModeratorResponse, error)
+	// AcceptModerator defines a method for the pending moderator to accept the
+	// moderator role.
+	AcceptModerator(context.Context, *MsgAcceptModerator) (*MsgAcceptModeratorResponse, error)
+	// SetFeeSplitRetention defines a method to allow changing the number of
+	// blocks for which fee split snapshots are kept
+	SetFeeSplitRetention(context.Context, *MsgSetFeeSplitRetention) (*MsgSetFeeSplitRetentionResponse, error)
+	// SetDenomRatio defines a method to allow scheduling a ratio override for the
+	// fees collected in a denom
+	SetDenomRatio(context.Context, *MsgSetDenomRatio) (*MsgSetDenomRatioResponse, error)
+	// RemoveDenomRatio defines a method to allow scheduling the removal of the
+	// ratio override of a denom, so that its fees are split by the default ratio
+	// again
+	RemoveDenomRatio(context.Context, *MsgRemoveDenomRatio) (*MsgRemoveDenomRatioResponse, error)
+	// WithdrawShareRecordReward defines a method to withdraw the rewards of the
+	// tokenized delegations of all the tokenize share records of an owner.
+	WithdrawShareRecordReward(context.Context, *MsgWithdrawShareRecordReward) (*MsgWithdrawShareRecordRewardResponse, error)
 }
 
-func (m *MsgWithdrawValidatorCommission) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.ValidatorAddress) > 0 {
-		i -= len(m.ValidatorAddress)
-		copy(dAtA[i:], m.ValidatorAddress)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.ValidatorAddress)))
-		i--
-		dAtA[i] = 0xa
-	}
-	return len(dAtA) - i, nil
+// UnimplementedMsgServer can be embedded to have forward compatible implementations.
+type UnimplementedMsgServer struct {
 }
 
-func (m *MsgWithdrawValidatorCommissionResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (*UnimplementedMsgServer) SetWithdrawAddress(ctx context.Context, req *MsgSetWithdrawAddress) (*MsgSetWithdrawAddressResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetWithdrawAddress not implemented")
 }
-
-func (m *MsgWithdrawValidatorCommissionResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (*UnimplementedMsgServer) WithdrawDelegatorReward(ctx context.Context, req *MsgWithdrawDelegatorReward) (*MsgWithdrawDelegatorRewardResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method WithdrawDelegatorReward not implemented")
 }
-
-func (m *MsgWithdrawValidatorCommissionResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Amount) > 0 {
-		for iNdEx := len(m.Amount) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Amount[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintTx(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
-		}
-	}
-	return len(dAtA) - i, nil
+func (*UnimplementedMsgServer) WithdrawValidatorCommission(ctx context.Context, req *MsgWithdrawValidatorCommission) (*MsgWithdrawValidatorCommissionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method WithdrawValidatorCommission not implemented")
 }
-
-func (m *MsgFundCommunityPool) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (*UnimplementedMsgServer) FundCommunityPool(ctx context.Context, req *MsgFundCommunityPool) (*MsgFundCommunityPoolResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method FundCommunityPool not implemented")
 }
-
-func (m *MsgFundCommunityPool) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (*UnimplementedMsgServer) ChangeRatio(ctx context.Context, req *MsgChangeRatio) (*MsgChangeRatioResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ChangeRatio not implemented")
 }
-
-func (m *MsgFundCommunityPool) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Depositor) > 0 {
-		i -= len(m.Depositor)
-		copy(dAtA[i:], m.Depositor)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Depositor)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.Amount) > 0 {
-		for iNdEx := len(m.Amount) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Amount[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintTx(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
-		}
-	}
-	return len(dAtA) - i, nil
+func (*UnimplementedMsgServer) CancelRatioChange(ctx context.Context, req *MsgCancelRatioChange) (*MsgCancelRatioChangeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CancelRatioChange not implemented")
 }
-
-func (m *MsgFundCommunityPoolResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (*UnimplementedMsgServer) ChangeBaseAddress(ctx context.Context, req *MsgChangeBaseAddress) (*MsgChangeBaseAddressResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ChangeBaseAddress not implemented")
 }
-
-func (m *MsgFundCommunityPoolResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (*UnimplementedMsgServer) SetBaseRecipients(ctx context.Context, req *MsgSetBaseRecipients) (*MsgSetBaseRecipientsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetBaseRecipients not implemented")
+}
+func (*UnimplementedMsgServer) ChangeModerator(ctx context.Context, req *MsgChangeModerator) (*MsgChangeModeratorResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ChangeModerator not implemented")
+}
+func (*UnimplementedMsgServer) AcceptModerator(ctx context.Context, req *MsgAcceptModerator) (*MsgAcceptModeratorResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AcceptModerator not implemented")
+}
+func (*UnimplementedMsgServer) SetFeeSplitRetention(ctx context.Context, req *MsgSetFeeSplitRetention) (*MsgSetFeeSplitRetentionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetFeeSplitRetention not implemented")
+}
+func (*UnimplementedMsgServer) SetDenomRatio(ctx context.Context, req *MsgSetDenomRatio) (*MsgSetDenomRatioResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetDenomRatio not implemented")
+}
+func (*UnimplementedMsgServer) RemoveDenomRatio(ctx context.Context, req *MsgRemoveDenomRatio) (*MsgRemoveDenomRatioResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RemoveDenomRatio not implemented")
+}
+func (*UnimplementedMsgServer) WithdrawShareRecordReward(ctx context.Context, req *MsgWithdrawShareRecordReward) (*MsgWithdrawShareRecordRewardResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method WithdrawShareRecordReward not implemented")
 }
 
-func (m *MsgFundCommunityPoolResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
+	s.RegisterService(&_Msg_serviceDesc, srv)
 }
 
-func (m *MsgChangeRatio) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _Msg_SetWithdrawAddress_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSetWithdrawAddress)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
-}
-
-func (m *MsgChangeRatio) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+	if interceptor == nil {
+		return srv.(MsgServer).SetWithdrawAddress(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.distribution.v1beta1.Msg/SetWithdrawAddress",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SetWithdrawAddress(ctx, req.(*MsgSetWithdrawAddress))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgChangeRatio) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size, err := m.Ratio.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
-		}
-		i -= size
-		i = encodeVarintTx(dAtA, i, uint64(size))
+func _Msg_WithdrawDelegatorReward_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgWithdrawDelegatorReward)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	i--
-	dAtA[i] = 0x12
-	if len(m.ModeratorAddress) > 0 {
-		i -= len(m.ModeratorAddress)
-		copy(dAtA[i:], m.ModeratorAddress)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.ModeratorAddress)))
-		i--
-		dAtA[i] = 0xa
+	if interceptor == nil {
+		return srv.(MsgServer).WithdrawDelegatorReward(ctx, in)
 	}
-	return len(dAtA) - i, nil
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.distribution.v1beta1.Msg/WithdrawDelegatorReward",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).WithdrawDelegatorReward(ctx, req.(*MsgWithdrawDelegatorReward))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgChangeRatioResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _Msg_WithdrawValidatorCommission_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgWithdrawValidatorCommission)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	if interceptor == nil {
+		return srv.(MsgServer).WithdrawValidatorCommission(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.distribution.v1beta1.Msg/WithdrawValidatorCommission",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).WithdrawValidatorCommission(ctx, req.(*MsgWithdrawValidatorCommission))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgChangeRatioResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func _Msg_FundCommunityPool_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgFundCommunityPool)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).FundCommunityPool(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.distribution.v1beta1.Msg/FundCommunityPool",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).FundCommunityPool(ctx, req.(*MsgFundCommunityPool))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgChangeRatioResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func _Msg_ChangeRatio_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgChangeRatio)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).ChangeRatio(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.distribution.v1beta1.Msg/ChangeRatio",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).ChangeRatio(ctx, req.(*MsgChangeRatio))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgChangeBaseAddress) Marshal() (dAtA []byte, err error) {
+func _Msg_CancelRatioChange_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgCancelRatioChange)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).CancelRatioChange(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.distribution.v1beta1.Msg/CancelRatioChange",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).CancelRatioChange(ctx, req.(*MsgCancelRatioChange))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_ChangeBaseAddress_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgChangeBaseAddress)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).ChangeBaseAddress(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.distribution.v1beta1.Msg/ChangeBaseAddress",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).ChangeBaseAddress(ctx, req.(*MsgChangeBaseAddress))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_SetBaseRecipients_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSetBaseRecipients)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SetBaseRecipients(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.distribution.v1beta1.Msg/SetBaseRecipients",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SetBaseRecipients(ctx, req.(*MsgSetBaseRecipients))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_ChangeModerator_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgChangeModerator)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).ChangeModerator(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.distribution.v1beta1.Msg/ChangeModerator",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).ChangeModerator(ctx, req.(*MsgChangeModerator))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_AcceptModerator_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgAcceptModerator)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).AcceptModerator(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.distribution.v1beta1.Msg/AcceptModerator",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).AcceptModerator(ctx, req.(*MsgAcceptModerator))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_SetFeeSplitRetention_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSetFeeSplitRetention)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SetFeeSplitRetention(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.distribution.v1beta1.Msg/SetFeeSplitRetention",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SetFeeSplitRetention(ctx, req.(*MsgSetFeeSplitRetention))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_SetDenomRatio_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSetDenomRatio)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SetDenomRatio(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.distribution.v1beta1.Msg/SetDenomRatio",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SetDenomRatio(ctx, req.(*MsgSetDenomRatio))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_RemoveDenomRatio_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgRemoveDenomRatio)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).RemoveDenomRatio(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.distribution.v1beta1.Msg/RemoveDenomRatio",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).RemoveDenomRatio(ctx, req.(*MsgRemoveDenomRatio))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_WithdrawShareRecordReward_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgWithdrawShareRecordReward)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).WithdrawShareRecordReward(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.distribution.v1beta1.Msg/WithdrawShareRecordReward",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).WithdrawShareRecordReward(ctx, req.(*MsgWithdrawShareRecordReward))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _Msg_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "cosmos.distribution.v1beta1.Msg",
+	HandlerType: (*MsgServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "SetWithdrawAddress",
+			Handler:    _Msg_SetWithdrawAddress_Handler,
+		},
+		{
+			MethodName: "WithdrawDelegatorReward",
+			Handler:    _Msg_WithdrawDelegatorReward_Handler,
+		},
+		{
+			MethodName: "WithdrawValidatorCommission",
+			Handler:    _Msg_WithdrawValidatorCommission_Handler,
+		},
+		{
+			MethodName: "FundCommunityPool",
+			Handler:    _Msg_FundCommunityPool_Handler,
+		},
+		{
+			MethodName: "ChangeRatio",
+			Handler:    _Msg_ChangeRatio_Handler,
+		},
+		{
+			MethodName: "CancelRatioChange",
+			Handler:    _Msg_CancelRatioChange_Handler,
+		},
+		{
+			MethodName: "ChangeBaseAddress",
+			Handler:    _Msg_ChangeBaseAddress_Handler,
+		},
+		{
+			MethodName: "SetBaseRecipients",
+			Handler:    _Msg_SetBaseRecipients_Handler,
+		},
+		{
+			MethodName: "ChangeModerator",
+			Handler:    _Msg_ChangeModerator_Handler,
+		},
+		{
+			MethodName: "AcceptModerator",
+			Handler:    _Msg_AcceptModerator_Handler,
+		},
+		{
+			MethodName: "SetFeeSplitRetention",
+			Handler:    _Msg_SetFeeSplitRetention_Handler,
+		},
+		{
+			MethodName: "SetDenomRatio",
+			Handler:    _Msg_SetDenomRatio_Handler,
+		},
+		{
+			MethodName: "RemoveDenomRatio",
+			Handler:    _Msg_RemoveDenomRatio_Handler,
+		},
+		{
+			MethodName: "WithdrawShareRecordReward",
+			Handler:    _Msg_WithdrawShareRecordReward_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "cosmos/distribution/v1beta1/tx.proto",
+}
+
+func (m *MsgSetWithdrawAddress) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -1608,34 +2679,34 @@ func (m *MsgChangeBaseAddress) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *MsgChangeBaseAddress) MarshalTo(dAtA []byte) (int, error) {
+func (m *MsgSetWithdrawAddress) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgChangeBaseAddress) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *MsgSetWithdrawAddress) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.NewBaseAddress) > 0 {
-		i -= len(m.NewBaseAddress)
-		copy(dAtA[i:], m.NewBaseAddress)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.NewBaseAddress)))
+	if len(m.WithdrawAddress) > 0 {
+		i -= len(m.WithdrawAddress)
+		copy(dAtA[i:], m.WithdrawAddress)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.WithdrawAddress)))
 		i--
 		dAtA[i] = 0x12
 	}
-	if len(m.ModeratorAddress) > 0 {
-		i -= len(m.ModeratorAddress)
-		copy(dAtA[i:], m.ModeratorAddress)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.ModeratorAddress)))
+	if len(m.DelegatorAddress) > 0 {
+		i -= len(m.DelegatorAddress)
+		copy(dAtA[i:], m.DelegatorAddress)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.DelegatorAddress)))
 		i--
 		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *MsgChangeBaseAddressResponse) Marshal() (dAtA []byte, err error) {
+func (m *MsgSetWithdrawAddressResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -1645,12 +2716,12 @@ func (m *MsgChangeBaseAddressResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *MsgChangeBaseAddressResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *MsgSetWithdrawAddressResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgChangeBaseAddressResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *MsgSetWithdrawAddressResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -1658,7 +2729,7 @@ func (m *MsgChangeBaseAddressResponse) MarshalToSizedBuffer(dAtA []byte) (int, e
 	return len(dAtA) - i, nil
 }
 
-func (m *MsgChangeModerator) Marshal() (dAtA []byte, err error) {
+func (m *MsgWithdrawDelegatorReward) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -1668,34 +2739,34 @@ func (m *MsgChangeModerator) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *MsgChangeModerator) MarshalTo(dAtA []byte) (int, error) {
+func (m *MsgWithdrawDelegatorReward) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgChangeModerator) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *MsgWithdrawDelegatorReward) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.NewModeratorAddress) > 0 {
-		i -= len(m.NewModeratorAddress)
-		copy(dAtA[i:], m.NewModeratorAddress)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.NewModeratorAddress)))
+	if len(m.ValidatorAddress) > 0 {
+		i -= len(m.ValidatorAddress)
+		copy(dAtA[i:], m.ValidatorAddress)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ValidatorAddress)))
 		i--
 		dAtA[i] = 0x12
 	}
-	if len(m.ModeratorAddress) > 0 {
-		i -= len(m.ModeratorAddress)
-		copy(dAtA[i:], m.ModeratorAddress)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.ModeratorAddress)))
+	if len(m.DelegatorAddress) > 0 {
+		i -= len(m.DelegatorAddress)
+		copy(dAtA[i:], m.DelegatorAddress)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.DelegatorAddress)))
 		i--
 		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *MsgChangeModeratorResponse) Marshal() (dAtA []byte, err error) {
+func (m *MsgWithdrawDelegatorRewardResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -1705,227 +2776,2332 @@ func (m *MsgChangeModeratorResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *MsgChangeModeratorResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *MsgWithdrawDelegatorRewardResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgChangeModeratorResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *MsgWithdrawDelegatorRewardResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
+	if len(m.Amount) > 0 {
+		for iNdEx := len(m.Amount) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Amount[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
-	offset -= sovTx(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *MsgWithdrawValidatorCommission) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *MsgSetWithdrawAddress) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+
+func (m *MsgWithdrawValidatorCommission) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgWithdrawValidatorCommission) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.DelegatorAddress)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = len(m.WithdrawAddress)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if len(m.ValidatorAddress) > 0 {
+		i -= len(m.ValidatorAddress)
+		copy(dAtA[i:], m.ValidatorAddress)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ValidatorAddress)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *MsgSetWithdrawAddressResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MsgWithdrawValidatorCommissionResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *MsgWithdrawDelegatorReward) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.DelegatorAddress)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = len(m.ValidatorAddress)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	return n
+func (m *MsgWithdrawValidatorCommissionResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgWithdrawDelegatorRewardResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *MsgWithdrawValidatorCommissionResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
 	if len(m.Amount) > 0 {
-		for _, e := range m.Amount {
-			l = e.Size()
-			n += 1 + l + sovTx(uint64(l))
+		for iNdEx := len(m.Amount) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Amount[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
 		}
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *MsgWithdrawValidatorCommission) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.ValidatorAddress)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+func (m *MsgFundCommunityPool) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *MsgWithdrawValidatorCommissionResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if len(m.Amount) > 0 {
-		for _, e := range m.Amount {
-			l = e.Size()
-			n += 1 + l + sovTx(uint64(l))
-		}
-	}
-	return n
+func (m *MsgFundCommunityPool) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgFundCommunityPool) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *MsgFundCommunityPool) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
+	if len(m.Depositor) > 0 {
+		i -= len(m.Depositor)
+		copy(dAtA[i:], m.Depositor)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Depositor)))
+		i--
+		dAtA[i] = 0x12
+	}
 	if len(m.Amount) > 0 {
-		for _, e := range m.Amount {
-			l = e.Size()
-			n += 1 + l + sovTx(uint64(l))
+		for iNdEx := len(m.Amount) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Amount[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
 		}
 	}
-	l = len(m.Depositor)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *MsgFundCommunityPoolResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MsgFundCommunityPoolResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *MsgChangeRatio) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.ModeratorAddress)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = m.Ratio.Size()
-	n += 1 + l + sovTx(uint64(l))
-	return n
+func (m *MsgFundCommunityPoolResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgChangeRatioResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *MsgFundCommunityPoolResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *MsgChangeBaseAddress) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MsgChangeRatio) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgChangeRatio) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgChangeRatio) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.ModeratorAddress)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	n1, err1 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.ActivationTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.ActivationTime):])
+	if err1 != nil {
+		return 0, err1
 	}
-	l = len(m.NewBaseAddress)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	i -= n1
+	i = encodeVarintTx(dAtA, i, uint64(n1))
+	i--
+	dAtA[i] = 0x22
+	if m.ActivationHeight != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.ActivationHeight))
+		i--
+		dAtA[i] = 0x18
 	}
-	return n
+	{
+		size, err := m.Ratio.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.ModeratorAddress) > 0 {
+		i -= len(m.ModeratorAddress)
+		copy(dAtA[i:], m.ModeratorAddress)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ModeratorAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *MsgChangeBaseAddressResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MsgChangeRatioResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgChangeRatioResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgChangeRatioResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	return n
+	if m.ChangeId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.ChangeId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *MsgChangeModerator) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MsgCancelRatioChange) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgCancelRatioChange) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCancelRatioChange) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.ModeratorAddress)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if m.ChangeId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.ChangeId))
+		i--
+		dAtA[i] = 0x10
 	}
-	l = len(m.NewModeratorAddress)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if len(m.ModeratorAddress) > 0 {
+		i -= len(m.ModeratorAddress)
+		copy(dAtA[i:], m.ModeratorAddress)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ModeratorAddress)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *MsgChangeModeratorResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MsgCancelRatioChangeResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgCancelRatioChangeResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCancelRatioChangeResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	return n
+	return len(dAtA) - i, nil
 }
 
-func sovTx(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
+func (m *MsgChangeBaseAddress) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
 }
-func sozTx(x uint64) (n int) {
-	return sovTx(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+
+func (m *MsgChangeBaseAddress) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgChangeBaseAddress) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.NewBaseAddress) > 0 {
+		i -= len(m.NewBaseAddress)
+		copy(dAtA[i:], m.NewBaseAddress)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.NewBaseAddress)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ModeratorAddress) > 0 {
+		i -= len(m.ModeratorAddress)
+		copy(dAtA[i:], m.ModeratorAddress)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ModeratorAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgChangeBaseAddressResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgChangeBaseAddressResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgChangeBaseAddressResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSetBaseRecipients) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSetBaseRecipients) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetBaseRecipients) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Recipients) > 0 {
+		for iNdEx := len(m.Recipients) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Recipients[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.ModeratorAddress) > 0 {
+		i -= len(m.ModeratorAddress)
+		copy(dAtA[i:], m.ModeratorAddress)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ModeratorAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSetBaseRecipientsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSetBaseRecipientsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetBaseRecipientsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgChangeModerator) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgChangeModerator) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgChangeModerator) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.NewModeratorAddress) > 0 {
+		i -= len(m.NewModeratorAddress)
+		copy(dAtA[i:], m.NewModeratorAddress)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.NewModeratorAddress)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ModeratorAddress) > 0 {
+		i -= len(m.ModeratorAddress)
+		copy(dAtA[i:], m.ModeratorAddress)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ModeratorAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgChangeModeratorResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgChangeModeratorResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgChangeModeratorResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgAcceptModerator) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgAcceptModerator) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgAcceptModerator) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.NewModeratorAddress) > 0 {
+		i -= len(m.NewModeratorAddress)
+		copy(dAtA[i:], m.NewModeratorAddress)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.NewModeratorAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgAcceptModeratorResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgAcceptModeratorResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgAcceptModeratorResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSetFeeSplitRetention) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSetFeeSplitRetention) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetFeeSplitRetention) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.RetentionBlocks != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.RetentionBlocks))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.ModeratorAddress) > 0 {
+		i -= len(m.ModeratorAddress)
+		copy(dAtA[i:], m.ModeratorAddress)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ModeratorAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSetFeeSplitRetentionResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSetFeeSplitRetentionResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetFeeSplitRetentionResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSetDenomRatio) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSetDenomRatio) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetDenomRatio) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	n3, err3 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.ActivationTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.ActivationTime):])
+	if err3 != nil {
+		return 0, err3
+	}
+	i -= n3
+	i = encodeVarintTx(dAtA, i, uint64(n3))
+	i--
+	dAtA[i] = 0x2a
+	if m.ActivationHeight != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.ActivationHeight))
+		i--
+		dAtA[i] = 0x20
+	}
+	{
+		size, err := m.Ratio.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ModeratorAddress) > 0 {
+		i -= len(m.ModeratorAddress)
+		copy(dAtA[i:], m.ModeratorAddress)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ModeratorAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSetDenomRatioResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSetDenomRatioResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetDenomRatioResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ChangeId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.ChangeId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRemoveDenomRatio) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRemoveDenomRatio) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRemoveDenomRatio) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	n5, err5 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.ActivationTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.ActivationTime):])
+	if err5 != nil {
+		return 0, err5
+	}
+	i -= n5
+	i = encodeVarintTx(dAtA, i, uint64(n5))
+	i--
+	dAtA[i] = 0x22
+	if m.ActivationHeight != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.ActivationHeight))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ModeratorAddress) > 0 {
+		i -= len(m.ModeratorAddress)
+		copy(dAtA[i:], m.ModeratorAddress)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ModeratorAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRemoveDenomRatioResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRemoveDenomRatioResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRemoveDenomRatioResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ChangeId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.ChangeId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgWithdrawShareRecordReward) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgWithdrawShareRecordReward) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgWithdrawShareRecordReward) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.OwnerAddress) > 0 {
+		i -= len(m.OwnerAddress)
+		copy(dAtA[i:], m.OwnerAddress)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.OwnerAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgWithdrawShareRecordRewardResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgWithdrawShareRecordRewardResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgWithdrawShareRecordRewardResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Amount) > 0 {
+		for iNdEx := len(m.Amount) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Amount[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
+	offset -= sovTx(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *MsgSetWithdrawAddress) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.DelegatorAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.WithdrawAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgSetWithdrawAddressResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgWithdrawDelegatorReward) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.DelegatorAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.ValidatorAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgWithdrawDelegatorRewardResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Amount) > 0 {
+		for _, e := range m.Amount {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgWithdrawValidatorCommission) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ValidatorAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgWithdrawValidatorCommissionResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Amount) > 0 {
+		for _, e := range m.Amount {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgFundCommunityPool) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Amount) > 0 {
+		for _, e := range m.Amount {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	l = len(m.Depositor)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgFundCommunityPoolResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgChangeRatio) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ModeratorAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.Ratio.Size()
+	n += 1 + l + sovTx(uint64(l))
+	if m.ActivationHeight != 0 {
+		n += 1 + sovTx(uint64(m.ActivationHeight))
+	}
+	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.ActivationTime)
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgChangeRatioResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ChangeId != 0 {
+		n += 1 + sovTx(uint64(m.ChangeId))
+	}
+	return n
+}
+
+func (m *MsgCancelRatioChange) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ModeratorAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.ChangeId != 0 {
+		n += 1 + sovTx(uint64(m.ChangeId))
+	}
+	return n
+}
+
+func (m *MsgCancelRatioChangeResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgChangeBaseAddress) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ModeratorAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.NewBaseAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgChangeBaseAddressResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgSetBaseRecipients) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ModeratorAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.Recipients) > 0 {
+		for _, e := range m.Recipients {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgSetBaseRecipientsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgChangeModerator) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ModeratorAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.NewModeratorAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgChangeModeratorResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgAcceptModerator) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.NewModeratorAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgAcceptModeratorResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgSetFeeSplitRetention) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ModeratorAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.RetentionBlocks != 0 {
+		n += 1 + sovTx(uint64(m.RetentionBlocks))
+	}
+	return n
+}
+
+func (m *MsgSetFeeSplitRetentionResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgSetDenomRatio) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ModeratorAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.Ratio.Size()
+	n += 1 + l + sovTx(uint64(l))
+	if m.ActivationHeight != 0 {
+		n += 1 + sovTx(uint64(m.ActivationHeight))
+	}
+	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.ActivationTime)
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgSetDenomRatioResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ChangeId != 0 {
+		n += 1 + sovTx(uint64(m.ChangeId))
+	}
+	return n
+}
+
+func (m *MsgRemoveDenomRatio) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ModeratorAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.ActivationHeight != 0 {
+		n += 1 + sovTx(uint64(m.ActivationHeight))
+	}
+	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.ActivationTime)
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgRemoveDenomRatioResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ChangeId != 0 {
+		n += 1 + sovTx(uint64(m.ChangeId))
+	}
+	return n
+}
+
+func (m *MsgWithdrawShareRecordReward) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.OwnerAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgWithdrawShareRecordRewardResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Amount) > 0 {
+		for _, e := range m.Amount {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func sovTx(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozTx(x uint64) (n int) {
+	return sovTx(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *MsgSetWithdrawAddress) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSetWithdrawAddress: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSetWithdrawAddress: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DelegatorAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DelegatorAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WithdrawAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.WithdrawAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgSetWithdrawAddressResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSetWithdrawAddressResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSetWithdrawAddressResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgWithdrawDelegatorReward) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgWithdrawDelegatorReward: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgWithdrawDelegatorReward: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DelegatorAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DelegatorAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ValidatorAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgWithdrawDelegatorRewardResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgWithdrawDelegatorRewardResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgWithdrawDelegatorRewardResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Amount = append(m.Amount, types.Coin{})
+			if err := m.Amount[len(m.Amount)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgWithdrawValidatorCommission) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgWithdrawValidatorCommission: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgWithdrawValidatorCommission: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ValidatorAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgWithdrawValidatorCommissionResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgWithdrawValidatorCommissionResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgWithdrawValidatorCommissionResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Amount = append(m.Amount, types.Coin{})
+			if err := m.Amount[len(m.Amount)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgFundCommunityPool) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgFundCommunityPool: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgFundCommunityPool: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Amount = append(m.Amount, types.Coin{})
+			if err := m.Amount[len(m.Amount)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Depositor", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Depositor = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgFundCommunityPoolResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgFundCommunityPoolResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgFundCommunityPoolResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgChangeRatio) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgChangeRatio: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgChangeRatio: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ModeratorAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ModeratorAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Ratio", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Ratio.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ActivationHeight", wireType)
+			}
+			m.ActivationHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ActivationHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ActivationTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.ActivationTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgChangeRatioResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgChangeRatioResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgChangeRatioResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChangeId", wireType)
+			}
+			m.ChangeId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ChangeId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgCancelRatioChange) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgCancelRatioChange: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgCancelRatioChange: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ModeratorAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ModeratorAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChangeId", wireType)
+			}
+			m.ChangeId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ChangeId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgCancelRatioChangeResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgCancelRatioChangeResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgCancelRatioChangeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
 }
-func (m *MsgSetWithdrawAddress) Unmarshal(dAtA []byte) error {
+func (m *MsgChangeBaseAddress) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1948,15 +5124,15 @@ func (m *MsgSetWithdrawAddress) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgSetWithdrawAddress: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgChangeBaseAddress: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgSetWithdrawAddress: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgChangeBaseAddress: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DelegatorAddress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ModeratorAddress", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1984,11 +5160,11 @@ func (m *MsgSetWithdrawAddress) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.DelegatorAddress = string(dAtA[iNdEx:postIndex])
+			m.ModeratorAddress = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field WithdrawAddress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field NewBaseAddress", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2016,7 +5192,7 @@ func (m *MsgSetWithdrawAddress) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.WithdrawAddress = string(dAtA[iNdEx:postIndex])
+			m.NewBaseAddress = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -2039,7 +5215,7 @@ func (m *MsgSetWithdrawAddress) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgSetWithdrawAddressResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgChangeBaseAddressResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2062,10 +5238,10 @@ func (m *MsgSetWithdrawAddressResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgSetWithdrawAddressResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgChangeBaseAddressResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgSetWithdrawAddressResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgChangeBaseAddressResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -2089,7 +5265,7 @@ func (m *MsgSetWithdrawAddressResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgWithdrawDelegatorReward) Unmarshal(dAtA []byte) error {
+func (m *MsgSetBaseRecipients) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2112,15 +5288,15 @@ func (m *MsgWithdrawDelegatorReward) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgWithdrawDelegatorReward: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgSetBaseRecipients: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgWithdrawDelegatorReward: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgSetBaseRecipients: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DelegatorAddress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ModeratorAddress", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2148,13 +5324,13 @@ func (m *MsgWithdrawDelegatorReward) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.DelegatorAddress = string(dAtA[iNdEx:postIndex])
+			m.ModeratorAddress = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Recipients", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -2164,23 +5340,25 @@ func (m *MsgWithdrawDelegatorReward) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ValidatorAddress = string(dAtA[iNdEx:postIndex])
+			m.Recipients = append(m.Recipients, BaseRecipient{})
+			if err := m.Recipients[len(m.Recipients)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -2203,7 +5381,7 @@ func (m *MsgWithdrawDelegatorReward) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgWithdrawDelegatorRewardResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgSetBaseRecipientsResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2226,46 +5404,12 @@ func (m *MsgWithdrawDelegatorRewardResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgWithdrawDelegatorRewardResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgSetBaseRecipientsResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgWithdrawDelegatorRewardResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgSetBaseRecipientsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
-		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Amount = append(m.Amount, types.Coin{})
-			if err := m.Amount[len(m.Amount)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -2287,7 +5431,7 @@ func (m *MsgWithdrawDelegatorRewardResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgWithdrawValidatorCommission) Unmarshal(dAtA []byte) error {
+func (m *MsgChangeModerator) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2310,15 +5454,15 @@ func (m *MsgWithdrawValidatorCommission) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgWithdrawValidatorCommission: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgChangeModerator: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgWithdrawValidatorCommission: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgChangeModerator: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ModeratorAddress", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2346,7 +5490,39 @@ func (m *MsgWithdrawValidatorCommission) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ValidatorAddress = string(dAtA[iNdEx:postIndex])
+			m.ModeratorAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NewModeratorAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.NewModeratorAddress = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -2369,7 +5545,7 @@ func (m *MsgWithdrawValidatorCommission) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgWithdrawValidatorCommissionResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgChangeModeratorResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2392,17 +5568,67 @@ func (m *MsgWithdrawValidatorCommissionResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgWithdrawValidatorCommissionResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgChangeModeratorResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgWithdrawValidatorCommissionResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgChangeModeratorResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgAcceptModerator) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgAcceptModerator: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgAcceptModerator: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field NewModeratorAddress", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -2412,26 +5638,74 @@ func (m *MsgWithdrawValidatorCommissionResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Amount = append(m.Amount, types.Coin{})
-			if err := m.Amount[len(m.Amount)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.NewModeratorAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
 				return err
 			}
-			iNdEx = postIndex
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgAcceptModeratorResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgAcceptModeratorResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgAcceptModeratorResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -2453,7 +5727,7 @@ func (m *MsgWithdrawValidatorCommissionResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgFundCommunityPool) Unmarshal(dAtA []byte) error {
+func (m *MsgSetFeeSplitRetention) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2476,17 +5750,17 @@ func (m *MsgFundCommunityPool) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgFundCommunityPool: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgSetFeeSplitRetention: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgFundCommunityPool: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgSetFeeSplitRetention: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ModeratorAddress", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -2496,31 +5770,29 @@ func (m *MsgFundCommunityPool) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Amount = append(m.Amount, types.Coin{})
-			if err := m.Amount[len(m.Amount)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.ModeratorAddress = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Depositor", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RetentionBlocks", wireType)
 			}
-			var stringLen uint64
+			m.RetentionBlocks = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -2530,24 +5802,11 @@ func (m *MsgFundCommunityPool) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.RetentionBlocks |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Depositor = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -2569,7 +5828,7 @@ func (m *MsgFundCommunityPool) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgFundCommunityPoolResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgSetFeeSplitRetentionResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2592,10 +5851,10 @@ func (m *MsgFundCommunityPoolResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgFundCommunityPoolResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgSetFeeSplitRetentionResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgFundCommunityPoolResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgSetFeeSplitRetentionResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -2619,7 +5878,7 @@ func (m *MsgFundCommunityPoolResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgChangeRatio) Unmarshal(dAtA []byte) error {
+func (m *MsgSetDenomRatio) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2642,10 +5901,10 @@ func (m *MsgChangeRatio) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgChangeRatio: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgSetDenomRatio: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgChangeRatio: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgSetDenomRatio: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -2681,6 +5940,38 @@ func (m *MsgChangeRatio) Unmarshal(dAtA []byte) error {
 			m.ModeratorAddress = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Ratio", wireType)
 			}
@@ -2713,6 +6004,58 @@ func (m *MsgChangeRatio) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ActivationHeight", wireType)
+			}
+			m.ActivationHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ActivationHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ActivationTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.ActivationTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -2734,7 +6077,7 @@ func (m *MsgChangeRatio) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgChangeRatioResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgSetDenomRatioResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2757,12 +6100,31 @@ func (m *MsgChangeRatioResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgChangeRatioResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgSetDenomRatioResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgChangeRatioResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgSetDenomRatioResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChangeId", wireType)
+			}
+			m.ChangeId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ChangeId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -2784,7 +6146,7 @@ func (m *MsgChangeRatioResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgChangeBaseAddress) Unmarshal(dAtA []byte) error {
+func (m *MsgRemoveDenomRatio) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2807,10 +6169,10 @@ func (m *MsgChangeBaseAddress) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgChangeBaseAddress: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgRemoveDenomRatio: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgChangeBaseAddress: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgRemoveDenomRatio: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -2847,7 +6209,7 @@ func (m *MsgChangeBaseAddress) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field NewBaseAddress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2875,7 +6237,59 @@ func (m *MsgChangeBaseAddress) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.NewBaseAddress = string(dAtA[iNdEx:postIndex])
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ActivationHeight", wireType)
+			}
+			m.ActivationHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ActivationHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ActivationTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.ActivationTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -2898,7 +6312,7 @@ func (m *MsgChangeBaseAddress) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgChangeBaseAddressResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgRemoveDenomRatioResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2921,12 +6335,31 @@ func (m *MsgChangeBaseAddressResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgChangeBaseAddressResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgRemoveDenomRatioResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgChangeBaseAddressResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgRemoveDenomRatioResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChangeId", wireType)
+			}
+			m.ChangeId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ChangeId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -2948,7 +6381,7 @@ func (m *MsgChangeBaseAddressResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgChangeModerator) Unmarshal(dAtA []byte) error {
+func (m *MsgWithdrawShareRecordReward) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2971,47 +6404,15 @@ func (m *MsgChangeModerator) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgChangeModerator: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgWithdrawShareRecordReward: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgChangeModerator: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgWithdrawShareRecordReward: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ModeratorAddress", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.ModeratorAddress = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field NewModeratorAddress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field OwnerAddress", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3039,7 +6440,7 @@ func (m *MsgChangeModerator) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.NewModeratorAddress = string(dAtA[iNdEx:postIndex])
+			m.OwnerAddress = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -3062,7 +6463,7 @@ func (m *MsgChangeModerator) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgChangeModeratorResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgWithdrawShareRecordRewardResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3085,12 +6486,46 @@ func (m *MsgChangeModeratorResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgChangeModeratorResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgWithdrawShareRecordRewardResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgChangeModeratorResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgWithdrawShareRecordRewardResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Amount = append(m.Amount, types.Coin{})
+			if err := m.Amount[len(m.Amount)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -0,0 +1,33 @@
+package epoching
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/cosmos/cosmos-sdk/telemetry"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/epoching/keeper"
+	"github.com/cosmos/cosmos-sdk/x/epoching/types"
+)
+
+// EndBlocker executes the queued actions at the end of every epoch. It must
+// run before the staking EndBlocker, so that the resulting validator set
+// changes are applied in the same block.
+func EndBlocker(ctx sdk.Context, k keeper.Keeper) {
+	defer telemetry.ModuleMeasureSince(types.ModuleName, time.Now(), telemetry.MetricKeyEndBlocker)
+
+	if !k.IsEpochEnd(ctx) {
+		return
+	}
+
+	k.ExecuteEpochActions(ctx)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeEndEpoch,
+			sdk.NewAttribute(types.AttributeKeyEpochNumber, fmt.Sprint(k.GetEpochNumber(ctx))),
+		),
+	)
+
+	k.IncreaseEpochNumber(ctx)
+}
@@ -0,0 +1,180 @@
+package epoching_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/tendermint/tendermint/crypto/ed25519"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+	tmtypes "github.com/tendermint/tendermint/types"
+
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
+	"github.com/cosmos/cosmos-sdk/simapp"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	vestingtypes "github.com/cosmos/cosmos-sdk/x/auth/vesting/types"
+	"github.com/cosmos/cosmos-sdk/x/authz"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	"github.com/cosmos/cosmos-sdk/x/epoching/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+)
+
+func TestQueuedStakingMsgs(t *testing.T) {
+	priv := secp256k1.GenPrivKey()
+	addr := sdk.AccAddress(priv.PubKey().Address())
+	genCoin := sdk.NewCoin(sdk.DefaultBondDenom, sdk.TokensFromConsensusPower(42, sdk.DefaultPowerReduction))
+	bondCoin := sdk.NewCoin(sdk.DefaultBondDenom, sdk.TokensFromConsensusPower(10, sdk.DefaultPowerReduction))
+
+	validator := tmtypes.NewValidator(ed25519.GenPrivKey().PubKey(), 1)
+	valSet := tmtypes.NewValidatorSet([]*tmtypes.Validator{validator})
+	valAddr := sdk.ValAddress(validator.Address)
+
+	acc := &authtypes.BaseAccount{Address: addr.String()}
+	balance := banktypes.Balance{Address: addr.String(), Coins: sdk.Coins{genCoin}}
+	app := simapp.SetupWithGenesisValSet(t, valSet, []authtypes.GenesisAccount{acc}, balance)
+	app.Commit()
+
+	// the genesis account delegates to the genesis validator
+	ctx := app.BaseApp.NewContext(true, tmproto.Header{})
+	delegation, found := app.StakingKeeper.GetDelegation(ctx, addr, valAddr)
+	require.True(t, found)
+	genShares := delegation.Shares
+
+	txGen := simapp.MakeTestEncodingConfig().TxConfig
+	delegateMsg := stakingtypes.NewMsgDelegate(addr, valAddr, bondCoin)
+	execMsg := authz.NewMsgExec(addr, []sdk.Msg{delegateMsg})
+	wrappedMsg := types.NewMsgWrappedDelegate(delegateMsg)
+
+	// the unwrapped staking msgs are queued like the wrapped ones, including
+	// when nested in an authz MsgExec, and their tokens are escrowed
+	for i, msg := range []sdk.Msg{delegateMsg, &execMsg, wrappedMsg} {
+		header := tmproto.Header{Height: app.LastBlockHeight() + 1}
+		_, _, err := simapp.SignCheckDeliver(t, txGen, app.BaseApp, header, []sdk.Msg{msg}, "", []uint64{0}, []uint64{uint64(i)}, true, true, priv)
+		require.NoError(t, err)
+	}
+	escrowed := bondCoin.Add(bondCoin).Add(bondCoin)
+	simapp.CheckBalance(t, app, addr, sdk.Coins{genCoin.Sub(escrowed)})
+
+	// the staking msgs executed by a gov or group proposal go through the msg
+	// service router, which queues them too
+	ctx = app.BaseApp.NewContext(true, tmproto.Header{Height: app.LastBlockHeight() + 1})
+	_, err := app.MsgServiceRouter().Handler(delegateMsg)(ctx, delegateMsg)
+	require.NoError(t, err)
+	require.Len(t, app.EpochingKeeper.GetEpochActions(ctx), 4)
+
+	ctx = app.BaseApp.NewContext(true, tmproto.Header{})
+	delegation, _ = app.StakingKeeper.GetDelegation(ctx, addr, valAddr)
+	require.Equal(t, genShares, delegation.Shares)
+
+	// the queued msgs are executed at the end of the epoch
+	simapp.EndEpoch(app)
+	simapp.CheckBalance(t, app, addr, sdk.Coins{genCoin.Sub(escrowed)})
+
+	ctx = app.BaseApp.NewContext(true, tmproto.Header{})
+	delegation, _ = app.StakingKeeper.GetDelegation(ctx, addr, valAddr)
+	require.True(t, delegation.Shares.GT(genShares))
+	require.True(t, app.BankKeeper.GetAllBalances(ctx, authtypes.NewModuleAddress(types.ModuleName)).IsZero())
+}
+
+func TestClawbackQueuedDelegation(t *testing.T) {
+	funderPriv := secp256k1.GenPrivKey()
+	funder := sdk.AccAddress(funderPriv.PubKey().Address())
+	vestingPriv := secp256k1.GenPrivKey()
+	vestingAddr := sdk.AccAddress(vestingPriv.PubKey().Address())
+	genCoin := sdk.NewCoin(sdk.DefaultBondDenom, sdk.TokensFromConsensusPower(42, sdk.DefaultPowerReduction))
+	vestingCoin := sdk.NewCoin(sdk.DefaultBondDenom, sdk.TokensFromConsensusPower(10, sdk.DefaultPowerReduction))
+
+	validator := tmtypes.NewValidator(ed25519.GenPrivKey().PubKey(), 1)
+	valSet := tmtypes.NewValidatorSet([]*tmtypes.Validator{validator})
+	valAddr := sdk.ValAddress(validator.Address)
+
+	acc := &authtypes.BaseAccount{Address: funder.String()}
+	balance := banktypes.Balance{Address: funder.String(), Coins: sdk.Coins{genCoin}}
+	app := simapp.SetupWithGenesisValSet(t, valSet, []authtypes.GenesisAccount{acc}, balance)
+	app.Commit()
+
+	// the vesting schedule starts after the block time, so all the coins are unvested
+	txGen := simapp.MakeTestEncodingConfig().TxConfig
+	periods := []vestingtypes.Period{{Length: 100, Amount: sdk.Coins{vestingCoin}}}
+	createMsg := vestingtypes.NewMsgCreateClawbackVestingAccount(funder, vestingAddr, 1, nil, periods)
+	header := tmproto.Header{Height: app.LastBlockHeight() + 1}
+	_, _, err := simapp.SignCheckDeliver(t, txGen, app.BaseApp, header, []sdk.Msg{createMsg}, "", []uint64{0}, []uint64{0}, true, true, funderPriv)
+	require.NoError(t, err)
+
+	ctx := app.BaseApp.NewContext(true, tmproto.Header{})
+	vestingAccNum := app.AccountKeeper.GetAccount(ctx, vestingAddr).GetAccountNumber()
+
+	// the vesting account delegates its unvested coins, which are escrowed
+	// until the end of the epoch
+	delegateMsg := stakingtypes.NewMsgDelegate(vestingAddr, valAddr, vestingCoin)
+	header = tmproto.Header{Height: app.LastBlockHeight() + 1}
+	_, _, err = simapp.SignCheckDeliver(t, txGen, app.BaseApp, header, []sdk.Msg{delegateMsg}, "", []uint64{vestingAccNum}, []uint64{0}, true, true, vestingPriv)
+	require.NoError(t, err)
+	simapp.CheckBalance(t, app, vestingAddr, sdk.Coins{})
+
+	// the escrowed coins are neither bonded nor unbonding, so they cannot be
+	// clawed back until the delegation is executed
+	clawbackMsg := vestingtypes.NewMsgClawback(funder, vestingAddr, nil)
+	header = tmproto.Header{Height: app.LastBlockHeight() + 1}
+	_, _, err = simapp.SignCheckDeliver(t, txGen, app.BaseApp, header, []sdk.Msg{clawbackMsg}, "", []uint64{0}, []uint64{1}, false, false, funderPriv)
+	require.ErrorIs(t, err, sdkerrors.ErrInvalidRequest)
+
+	// once the delegation is executed, it is transferred to the funder
+	simapp.EndEpoch(app)
+	ctx = app.BaseApp.NewContext(true, tmproto.Header{})
+	funderBonded := app.StakingKeeper.GetDelegatorBonded(ctx, funder)
+	header = tmproto.Header{Height: app.LastBlockHeight() + 1}
+	_, _, err = simapp.SignCheckDeliver(t, txGen, app.BaseApp, header, []sdk.Msg{clawbackMsg}, "", []uint64{0}, []uint64{2}, true, true, funderPriv)
+	require.NoError(t, err)
+
+	ctx = app.BaseApp.NewContext(true, tmproto.Header{})
+	_, found := app.StakingKeeper.GetDelegation(ctx, vestingAddr, valAddr)
+	require.False(t, found)
+	require.Equal(t, funderBonded.Add(vestingCoin.Amount), app.StakingKeeper.GetDelegatorBonded(ctx, funder))
+}
+
+func TestMergeGrantQueuedDelegation(t *testing.T) {
+	funderPriv := secp256k1.GenPrivKey()
+	funder := sdk.AccAddress(funderPriv.PubKey().Address())
+	granteePriv := secp256k1.GenPrivKey()
+	grantee := sdk.AccAddress(granteePriv.PubKey().Address())
+	genCoin := sdk.NewCoin(sdk.DefaultBondDenom, sdk.TokensFromConsensusPower(42, sdk.DefaultPowerReduction))
+	bondCoin := sdk.NewCoin(sdk.DefaultBondDenom, sdk.TokensFromConsensusPower(10, sdk.DefaultPowerReduction))
+
+	validator := tmtypes.NewValidator(ed25519.GenPrivKey().PubKey(), 1)
+	valSet := tmtypes.NewValidatorSet([]*tmtypes.Validator{validator})
+	valAddr := sdk.ValAddress(validator.Address)
+
+	genAccs := []authtypes.GenesisAccount{
+		&authtypes.BaseAccount{Address: funder.String()},
+		&authtypes.BaseAccount{Address: grantee.String(), AccountNumber: 1},
+	}
+	balances := []banktypes.Balance{
+		{Address: funder.String(), Coins: sdk.Coins{genCoin}},
+		{Address: grantee.String(), Coins: sdk.Coins{genCoin}},
+	}
+	app := simapp.SetupWithGenesisValSet(t, valSet, genAccs, balances...)
+	app.Commit()
+
+	// the grantee delegates, and its tokens are escrowed until the end of the epoch
+	txGen := simapp.MakeTestEncodingConfig().TxConfig
+	delegateMsg := stakingtypes.NewMsgDelegate(grantee, valAddr, bondCoin)
+	header := tmproto.Header{Height: app.LastBlockHeight() + 1}
+	_, _, err := simapp.SignCheckDeliver(t, txGen, app.BaseApp, header, []sdk.Msg{delegateMsg}, "", []uint64{1}, []uint64{0}, true, true, granteePriv)
+	require.NoError(t, err)
+
+	// the grant merged into the grantee account is covered by the escrowed
+	// tokens first, as by the bonded ones
+	periods := []vestingtypes.Period{{Length: 100, Amount: sdk.Coins{genCoin}}}
+	createMsg := vestingtypes.NewMsgCreatePeriodicVestingAccount(funder, grantee, 1, periods, true)
+	header = tmproto.Header{Height: app.LastBlockHeight() + 1}
+	_, _, err = simapp.SignCheckDeliver(t, txGen, app.BaseApp, header, []sdk.Msg{createMsg}, "", []uint64{0}, []uint64{0}, true, true, funderPriv)
+	require.NoError(t, err)
+
+	ctx := app.BaseApp.NewContext(true, tmproto.Header{})
+	acc, ok := app.AccountKeeper.GetAccount(ctx, grantee).(*vestingtypes.PeriodicVestingAccount)
+	require.True(t, ok)
+	require.Equal(t, sdk.Coins{bondCoin}, acc.DelegatedVesting)
+	require.True(t, acc.DelegatedFree.IsZero())
+}
@@ -0,0 +1,77 @@
+package keeper
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/epoching/types"
+)
+
+// ExecuteEpochActions executes the queued actions in queue order and dequeues
+// them. Every action is executed in its own cached context: the state changes
+// of a failing action are discarded and the remaining actions still run.
+func (k Keeper) ExecuteEpochActions(ctx sdk.Context) {
+	iterator := k.GetEpochActionsIterator(ctx)
+	defer iterator.Close()
+
+	var keys [][]byte
+	for ; iterator.Valid(); iterator.Next() {
+		key := iterator.Key()
+		keys = append(keys, key)
+
+		epochNumber, actionID := types.ParseActionStoreKey(key)
+		msg := k.GetEpochActionByIterator(iterator)
+		attrs := []sdk.Attribute{
+			sdk.NewAttribute(types.AttributeKeyEpochNumber, fmt.Sprint(epochNumber)),
+			sdk.NewAttribute(types.AttributeKeyActionID, fmt.Sprint(actionID)),
+			sdk.NewAttribute(types.AttributeKeyMsgTypeURL, sdk.MsgTypeURL(msg)),
+		}
+
+		if err := k.executeAction(ctx, msg); err != nil {
+			k.Logger(ctx).Info("queued action failed", "action_id", actionID, "msg", sdk.MsgTypeURL(msg), "err", err)
+			ctx.EventManager().EmitEvent(
+				sdk.NewEvent(types.EventTypeActionFailed, append(attrs, sdk.NewAttribute(types.AttributeKeyError, err.Error()))...),
+			)
+			continue
+		}
+
+		ctx.EventManager().EmitEvent(sdk.NewEvent(types.EventTypeExecuteAction, attrs...))
+	}
+
+	for _, key := range keys {
+		k.DeleteByKey(ctx, key)
+	}
+}
+
+// executeAction executes a msg through the msg service router, writing its
+// state changes only if it succeeds. The tokens escrowed for the msg are
+// returned to their owner first, so that they are refunded if it fails.
+func (k Keeper) executeAction(ctx sdk.Context, msg sdk.Msg) (err error) {
+	if err := k.releaseEscrow(ctx, msg); err != nil {
+		return err
+	}
+
+	handler := k.router.Handler(msg)
+	if handler == nil {
+		return types.ErrInvalidMsg.Wrapf("no handler for %s", sdk.MsgTypeURL(msg))
+	}
+
+	cacheCtx, writeCache := ctx.CacheContext()
+	cacheCtx = cacheCtx.WithValue(executingActionKey{}, true)
+
+	// the msg was checked when queued, so a panic must not halt the chain
+	defer func() {
+		if r := recover(); r != nil {
+			err = fmt.Errorf("panic executing %s: %v", sdk.MsgTypeURL(msg), r)
+		}
+	}()
+
+	res, err := handler(cacheCtx, msg)
+	if err != nil {
+		return err
+	}
+
+	writeCache()
+	ctx.EventManager().EmitEvents(res.GetEvents())
+	return nil
+}
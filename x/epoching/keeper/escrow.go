@@ -0,0 +1,74 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/x/epoching/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+)
+
+// escrowedTokens returns the owner and the amount of the tokens bonded by a
+// queued msg, which are escrowed by the module account until the msg is
+// executed.
+func escrowedTokens(msg sdk.Msg) (owner string, amount sdk.Coin, ok bool) {
+	switch m := msg.(type) {
+	case *stakingtypes.MsgDelegate:
+		return m.DelegatorAddress, m.Amount, true
+	case *stakingtypes.MsgCreateValidator:
+		return m.DelegatorAddress, m.Value, true
+	default:
+		return "", sdk.Coin{}, false
+	}
+}
+
+// escrowTokens moves the tokens bonded by a queued msg from their owner to the
+// module account. They are moved as delegated coins, so that a vesting account
+// can queue the delegation of its vesting tokens.
+func (k Keeper) escrowTokens(ctx sdk.Context, msg sdk.Msg) error {
+	owner, amount, ok := escrowedTokens(msg)
+	if !ok {
+		return nil
+	}
+
+	if bondDenom := k.stakingKeeper.BondDenom(ctx); amount.Denom != bondDenom {
+		return sdkerrors.Wrapf(
+			sdkerrors.ErrInvalidRequest, "invalid coin denomination: got %s, expected %s", amount.Denom, bondDenom,
+		)
+	}
+
+	ownerAddr, err := sdk.AccAddressFromBech32(owner)
+	if err != nil {
+		return err
+	}
+
+	return k.bankKeeper.DelegateCoinsFromAccountToModule(ctx, ownerAddr, types.ModuleName, sdk.NewCoins(amount))
+}
+
+// releaseEscrow returns the escrowed tokens of a queued msg to their owner, for
+// the msg to bond them, or for the owner to keep them if the msg fails.
+func (k Keeper) releaseEscrow(ctx sdk.Context, msg sdk.Msg) error {
+	owner, amount, ok := escrowedTokens(msg)
+	if !ok {
+		return nil
+	}
+
+	ownerAddr, err := sdk.AccAddressFromBech32(owner)
+	if err != nil {
+		return err
+	}
+
+	return k.bankKeeper.UndelegateCoinsFromModuleToAccount(ctx, types.ModuleName, ownerAddr, sdk.NewCoins(amount))
+}
+
+// GetEscrowedTokens returns the tokens of an owner escrowed by the queued
+// actions, which are still tracked as delegated by its account until the
+// actions are executed.
+func (k Keeper) GetEscrowedTokens(ctx sdk.Context, owner sdk.AccAddress) sdk.Coins {
+	escrowed := sdk.NewCoins()
+	for _, msg := range k.GetEpochActions(ctx) {
+		if addr, amount, ok := escrowedTokens(msg); ok && addr == owner.String() {
+			escrowed = escrowed.Add(amount)
+		}
+	}
+	return escrowed
+}
@@ -0,0 +1,28 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/epoching/types"
+)
+
+// InitGenesis sets the epoching params and epoch number, and queues the
+// exported msgs for the current epoch.
+func (k Keeper) InitGenesis(ctx sdk.Context, genState *types.GenesisState) {
+	k.SetParams(ctx, genState.Params)
+	k.SetEpochNumber(ctx, genState.EpochNumber)
+
+	for _, action := range genState.QueuedMsgs {
+		k.RestoreEpochAction(ctx, genState.EpochNumber, action)
+	}
+}
+
+// ExportGenesis returns the epoching module's exported genesis. The queued
+// msgs are exported without their epoch number.
+func (k Keeper) ExportGenesis(ctx sdk.Context) *types.GenesisState {
+	genState, err := types.NewGenesisState(k.GetParams(ctx), k.GetEpochNumber(ctx), k.GetEpochActions(ctx))
+	if err != nil {
+		panic(err)
+	}
+
+	return genState
+}
@@ -0,0 +1,60 @@
+package keeper
+
+import (
+	"context"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"github.com/cosmos/cosmos-sdk/x/epoching/types"
+)
+
+var _ types.QueryServer = Keeper{}
+
+// Params implements the Query/Params gRPC method
+func (k Keeper) Params(c context.Context, _ *types.QueryParamsRequest) (*types.QueryParamsResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+	return &types.QueryParamsResponse{Params: k.GetParams(ctx)}, nil
+}
+
+// CurrentEpoch implements the Query/CurrentEpoch gRPC method
+func (k Keeper) CurrentEpoch(c context.Context, _ *types.QueryCurrentEpochRequest) (*types.QueryCurrentEpochResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+	epochLength := k.GetParams(ctx).EpochLength
+
+	return &types.QueryCurrentEpochResponse{
+		CurrentEpoch:    k.GetEpochNumber(ctx),
+		NextEpochHeight: k.GetNextEpochHeight(ctx, epochLength),
+		NextEpochTime:   k.GetNextEpochTime(ctx, epochLength),
+	}, nil
+}
+
+// QueuedActions implements the Query/QueuedActions gRPC method
+func (k Keeper) QueuedActions(c context.Context, req *types.QueryQueuedActionsRequest) (*types.QueryQueuedActionsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.EpochActionQueuePrefix)
+
+	actions := []types.QueuedAction{}
+	pageRes, err := query.Paginate(store, req.Pagination, func(key []byte, value []byte) error {
+		epochNumber, actionID := types.ParseActionStoreKey(append(types.EpochActionQueuePrefix, key...))
+		var msg codectypes.Any
+		if err := k.cdc.Unmarshal(value, &msg); err != nil {
+			return err
+		}
+		actions = append(actions, types.QueuedAction{EpochNumber: epochNumber, ActionId: actionID, Msg: &msg})
+		return nil
+	})
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryQueuedActionsResponse{Actions: actions, Pagination: pageRes}, nil
+}
@@ -0,0 +1,225 @@
+package keeper_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	"github.com/cosmos/cosmos-sdk/simapp"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	"github.com/cosmos/cosmos-sdk/x/epoching"
+	"github.com/cosmos/cosmos-sdk/x/epoching/keeper"
+	"github.com/cosmos/cosmos-sdk/x/epoching/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+)
+
+type KeeperTestSuite struct {
+	suite.Suite
+
+	app       *simapp.SimApp
+	ctx       sdk.Context
+	msgServer types.MsgServer
+	addrs     []sdk.AccAddress
+	valAddr   sdk.ValAddress
+}
+
+func (s *KeeperTestSuite) SetupTest() {
+	s.app = simapp.Setup(s.T(), false)
+	s.ctx = s.app.BaseApp.NewContext(false, tmproto.Header{Height: 1})
+	s.msgServer = keeper.NewMsgServerImpl(s.app.EpochingKeeper)
+	s.addrs = simapp.AddTestAddrs(s.app, s.ctx, 2, s.app.StakingKeeper.TokensFromConsensusPower(s.ctx, 100))
+	s.valAddr = s.app.StakingKeeper.GetAllValidators(s.ctx)[0].GetOperator()
+}
+
+func (s *KeeperTestSuite) moduleAddr() sdk.AccAddress {
+	return authtypes.NewModuleAddress(types.ModuleName)
+}
+
+func TestKeeperTestSuite(t *testing.T) {
+	suite.Run(t, new(KeeperTestSuite))
+}
+
+func (s *KeeperTestSuite) TestActionIDs() {
+	k := s.app.EpochingKeeper
+	s.Require().Equal(uint64(1), k.GetNewActionID(s.ctx))
+	s.Require().Equal(uint64(2), k.GetNewActionID(s.ctx))
+
+	// the key must not truncate the epoch number and action id
+	msg := stakingtypes.NewMsgDelegate(s.addrs[0], s.valAddr, sdk.NewInt64Coin(sdk.DefaultBondDenom, 1))
+	id := k.QueueMsgForEpoch(s.ctx, 300, msg)
+	s.Require().Equal(uint64(3), id)
+	s.Require().Equal(msg, k.GetEpochMsg(s.ctx, 300, id))
+	s.Require().Nil(k.GetEpochMsg(s.ctx, 300%256, id))
+}
+
+func (s *KeeperTestSuite) TestQueuedDelegationAtEpochEnd() {
+	k := s.app.EpochingKeeper
+	k.SetParams(s.ctx, types.NewParams(5))
+
+	balance := s.app.BankKeeper.GetBalance(s.ctx, s.addrs[0], sdk.DefaultBondDenom)
+	coin := sdk.NewCoin(sdk.DefaultBondDenom, s.app.StakingKeeper.TokensFromConsensusPower(s.ctx, 10))
+	msg := stakingtypes.NewMsgDelegate(s.addrs[0], s.valAddr, coin)
+	res, err := s.msgServer.WrappedDelegate(sdk.WrapSDKContext(s.ctx), types.NewMsgWrappedDelegate(msg))
+	s.Require().NoError(err)
+	s.Require().Equal(uint64(1), res.ActionId)
+
+	// the delegated tokens are escrowed until the end of the epoch
+	s.Require().Equal(balance.Sub(coin), s.app.BankKeeper.GetBalance(s.ctx, s.addrs[0], sdk.DefaultBondDenom))
+	s.Require().Equal(coin, s.app.BankKeeper.GetBalance(s.ctx, s.moduleAddr(), sdk.DefaultBondDenom))
+
+	// nothing happens before the end of the epoch
+	epoching.EndBlocker(s.ctx, k)
+	_, found := s.app.StakingKeeper.GetDelegation(s.ctx, s.addrs[0], s.valAddr)
+	s.Require().False(found)
+	s.Require().Len(k.GetEpochActions(s.ctx), 1)
+	s.Require().Equal(int64(0), k.GetEpochNumber(s.ctx))
+
+	ctx := s.ctx.WithBlockHeight(5)
+	epoching.EndBlocker(ctx, k)
+	delegation, found := s.app.StakingKeeper.GetDelegation(ctx, s.addrs[0], s.valAddr)
+	s.Require().True(found)
+	s.Require().True(delegation.Shares.IsPositive())
+	s.Require().Empty(k.GetEpochActions(ctx))
+	s.Require().Equal(int64(1), k.GetEpochNumber(ctx))
+	s.Require().Equal(balance.Sub(coin), s.app.BankKeeper.GetBalance(ctx, s.addrs[0], sdk.DefaultBondDenom))
+	s.Require().True(s.app.BankKeeper.GetAllBalances(ctx, s.moduleAddr()).IsZero())
+}
+
+func (s *KeeperTestSuite) TestFailedDelegationIsRefunded() {
+	k := s.app.EpochingKeeper
+	k.SetParams(s.ctx, types.NewParams(1))
+
+	balance := s.app.BankKeeper.GetBalance(s.ctx, s.addrs[0], sdk.DefaultBondDenom)
+	msg := stakingtypes.NewMsgDelegate(s.addrs[0], s.valAddr, balance)
+	_, err := s.msgServer.WrappedDelegate(sdk.WrapSDKContext(s.ctx), types.NewMsgWrappedDelegate(msg))
+	s.Require().NoError(err)
+	s.Require().True(s.app.BankKeeper.GetBalance(s.ctx, s.addrs[0], sdk.DefaultBondDenom).IsZero())
+
+	// the escrowed tokens cannot be delegated twice
+	_, err = s.msgServer.WrappedDelegate(sdk.WrapSDKContext(s.ctx), types.NewMsgWrappedDelegate(msg))
+	s.Require().ErrorIs(err, sdkerrors.ErrInsufficientFunds)
+
+	// the delegation fails at the end of the epoch, and the escrowed tokens
+	// are refunded
+	s.app.CircuitKeeper.DisableMsg(s.ctx, sdk.MsgTypeURL(msg))
+	epoching.EndBlocker(s.ctx, k)
+	_, found := s.app.StakingKeeper.GetDelegation(s.ctx, s.addrs[0], s.valAddr)
+	s.Require().False(found)
+	s.Require().Equal(balance, s.app.BankKeeper.GetBalance(s.ctx, s.addrs[0], sdk.DefaultBondDenom))
+	s.Require().True(s.app.BankKeeper.GetAllBalances(s.ctx, s.moduleAddr()).IsZero())
+}
+
+func (s *KeeperTestSuite) TestFailedActionDoesNotBlockQueue() {
+	k := s.app.EpochingKeeper
+	k.SetParams(s.ctx, types.NewParams(1))
+
+	balance := s.app.BankKeeper.GetBalance(s.ctx, s.addrs[0], sdk.DefaultBondDenom)
+	ctx := sdk.WrapSDKContext(s.ctx)
+
+	// the undelegation queued between the delegations fails, as the delegation
+	// it was checked against when queued no longer exists
+	_, err := s.msgServer.WrappedDelegate(ctx, types.NewMsgWrappedDelegate(stakingtypes.NewMsgDelegate(s.addrs[0], s.valAddr, balance)))
+	s.Require().NoError(err)
+	k.QueueMsgForEpoch(s.ctx, k.GetEpochNumber(s.ctx), stakingtypes.NewMsgUndelegate(s.addrs[1], s.valAddr, balance))
+	_, err = s.msgServer.WrappedDelegate(ctx, types.NewMsgWrappedDelegate(stakingtypes.NewMsgDelegate(s.addrs[1], s.valAddr, balance)))
+	s.Require().NoError(err)
+
+	epoching.EndBlocker(s.ctx, k)
+	s.Require().Empty(k.GetEpochActions(s.ctx))
+
+	failed := 0
+	for _, event := range s.ctx.EventManager().Events() {
+		if event.Type == types.EventTypeActionFailed {
+			failed++
+		}
+	}
+	s.Require().Equal(1, failed)
+
+	for _, addr := range s.addrs {
+		_, found := s.app.StakingKeeper.GetDelegation(s.ctx, addr, s.valAddr)
+		s.Require().True(found)
+		s.Require().True(s.app.BankKeeper.GetBalance(s.ctx, addr, sdk.DefaultBondDenom).IsZero())
+	}
+}
+
+func (s *KeeperTestSuite) TestWrappedMsgChecks() {
+	ctx := sdk.WrapSDKContext(s.ctx)
+	coin := sdk.NewInt64Coin(sdk.DefaultBondDenom, 1)
+	unknownVal := sdk.ValAddress(s.addrs[1])
+
+	_, err := s.msgServer.WrappedDelegate(ctx, types.NewMsgWrappedDelegate(stakingtypes.NewMsgDelegate(s.addrs[0], unknownVal, coin)))
+	s.Require().ErrorIs(err, types.ErrValidatorNotFound)
+
+	_, err = s.msgServer.WrappedUndelegate(ctx, types.NewMsgWrappedUndelegate(stakingtypes.NewMsgUndelegate(s.addrs[0], s.valAddr, coin)))
+	s.Require().ErrorIs(err, types.ErrDelegationNotFound)
+
+	_, err = s.msgServer.WrappedBeginRedelegate(ctx, types.NewMsgWrappedBeginRedelegate(stakingtypes.NewMsgBeginRedelegate(s.addrs[0], s.valAddr, unknownVal, coin)))
+	s.Require().ErrorIs(err, types.ErrDelegationNotFound)
+
+	_, err = s.msgServer.WrappedEditValidator(ctx, types.NewMsgWrappedEditValidator(stakingtypes.NewMsgEditValidator(unknownVal, stakingtypes.Description{}, nil, nil)))
+	s.Require().ErrorIs(err, types.ErrValidatorNotFound)
+
+	_, err = s.msgServer.WrappedCancelUnbondingDelegation(ctx, types.NewMsgWrappedCancelUnbondingDelegation(stakingtypes.NewMsgCancelUnbondingDelegation(s.addrs[0], s.valAddr, 1, coin)))
+	s.Require().ErrorIs(err, types.ErrUnbondingDelegationNotFound)
+
+	// the canceled entry must exist and cover the amount
+	s.app.StakingKeeper.SetUnbondingDelegation(s.ctx, stakingtypes.NewUnbondingDelegation(s.addrs[0], s.valAddr, 1, s.ctx.BlockTime(), coin.Amount))
+	_, err = s.msgServer.WrappedCancelUnbondingDelegation(ctx, types.NewMsgWrappedCancelUnbondingDelegation(stakingtypes.NewMsgCancelUnbondingDelegation(s.addrs[0], s.valAddr, 2, coin)))
+	s.Require().ErrorIs(err, sdkerrors.ErrNotFound)
+	_, err = s.msgServer.WrappedCancelUnbondingDelegation(ctx, types.NewMsgWrappedCancelUnbondingDelegation(stakingtypes.NewMsgCancelUnbondingDelegation(s.addrs[0], s.valAddr, 1, coin.Add(coin))))
+	s.Require().ErrorIs(err, sdkerrors.ErrInvalidRequest)
+
+	// only the bond denom can be escrowed for a delegation
+	_, err = s.msgServer.WrappedDelegate(ctx, types.NewMsgWrappedDelegate(stakingtypes.NewMsgDelegate(s.addrs[0], s.valAddr, sdk.NewInt64Coin("foo", 1))))
+	s.Require().ErrorIs(err, sdkerrors.ErrInvalidRequest)
+
+	s.Require().Empty(s.app.EpochingKeeper.GetEpochActions(s.ctx))
+}
+
+func (s *KeeperTestSuite) TestGenesis() {
+	k := s.app.EpochingKeeper
+	k.SetEpochNumber(s.ctx, 3)
+	msg := stakingtypes.NewMsgDelegate(s.addrs[0], s.valAddr, sdk.NewInt64Coin(sdk.DefaultBondDenom, 1))
+	k.QueueMsgForEpoch(s.ctx, 3, msg)
+
+	genState := k.ExportGenesis(s.ctx)
+	s.Require().NoError(genState.Validate())
+	s.Require().Equal(int64(3), genState.EpochNumber)
+	s.Require().Len(genState.QueuedMsgs, 1)
+
+	k.DequeueEpochActions(s.ctx)
+	k.InitGenesis(s.ctx, genState)
+	s.Require().Equal([]sdk.Msg{msg}, k.GetEpochActions(s.ctx))
+	s.Require().Equal(genState, k.ExportGenesis(s.ctx))
+}
+
+func (s *KeeperTestSuite) TestGRPCQueries() {
+	queryHelper := baseapp.NewQueryServerTestHelper(s.ctx, s.app.InterfaceRegistry())
+	types.RegisterQueryServer(queryHelper, s.app.EpochingKeeper)
+	queryClient := types.NewQueryClient(queryHelper)
+	ctx := s.ctx.Context()
+
+	params, err := queryClient.Params(ctx, &types.QueryParamsRequest{})
+	s.Require().NoError(err)
+	s.Require().Equal(types.DefaultParams(), params.Params)
+
+	epoch, err := queryClient.CurrentEpoch(ctx, &types.QueryCurrentEpochRequest{})
+	s.Require().NoError(err)
+	s.Require().Equal(int64(0), epoch.CurrentEpoch)
+	s.Require().Equal(types.DefaultEpochLength, epoch.NextEpochHeight)
+
+	msg := stakingtypes.NewMsgDelegate(s.addrs[0], s.valAddr, sdk.NewInt64Coin(sdk.DefaultBondDenom, 1))
+	s.app.EpochingKeeper.QueueMsgForEpoch(s.ctx, 0, msg)
+
+	actions, err := queryClient.QueuedActions(ctx, &types.QueryQueuedActionsRequest{Pagination: &query.PageRequest{CountTotal: true}})
+	s.Require().NoError(err)
+	s.Require().Len(actions.Actions, 1)
+	s.Require().Equal(uint64(1), actions.Actions[0].ActionId)
+	s.Require().Equal(msg, actions.Actions[0].Msg.GetCachedValue())
+	s.Require().Equal(uint64(1), actions.Pagination.Total)
+}
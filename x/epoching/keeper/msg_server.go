@@ -0,0 +1,190 @@
+package keeper
+
+import (
+	"context"
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/x/epoching/types"
+)
+
+type msgServer struct {
+	Keeper
+}
+
+// NewMsgServerImpl returns an implementation of the epoching MsgServer interface
+// for the provided Keeper.
+func NewMsgServerImpl(keeper Keeper) types.MsgServer {
+	return &msgServer{Keeper: keeper}
+}
+
+var _ types.MsgServer = msgServer{}
+
+// WrappedDelegate implements the Msg/WrappedDelegate Msg service.
+func (k msgServer) WrappedDelegate(goCtx context.Context, msg *types.MsgWrappedDelegate) (*types.MsgWrappedDelegateResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	valAddr, err := sdk.ValAddressFromBech32(msg.Msg.ValidatorAddress)
+	if err != nil {
+		return nil, err
+	}
+	if err := k.validatorExists(ctx, valAddr); err != nil {
+		return nil, err
+	}
+	if err := k.escrowTokens(ctx, msg.Msg); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgWrappedDelegateResponse{ActionId: k.queueAction(ctx, msg.Msg)}, nil
+}
+
+// WrappedUndelegate implements the Msg/WrappedUndelegate Msg service.
+func (k msgServer) WrappedUndelegate(goCtx context.Context, msg *types.MsgWrappedUndelegate) (*types.MsgWrappedUndelegateResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if err := k.delegationExists(ctx, msg.Msg.DelegatorAddress, msg.Msg.ValidatorAddress); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgWrappedUndelegateResponse{ActionId: k.queueAction(ctx, msg.Msg)}, nil
+}
+
+// WrappedBeginRedelegate implements the Msg/WrappedBeginRedelegate Msg service.
+func (k msgServer) WrappedBeginRedelegate(goCtx context.Context, msg *types.MsgWrappedBeginRedelegate) (*types.MsgWrappedBeginRedelegateResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if err := k.delegationExists(ctx, msg.Msg.DelegatorAddress, msg.Msg.ValidatorSrcAddress); err != nil {
+		return nil, err
+	}
+
+	valDstAddr, err := sdk.ValAddressFromBech32(msg.Msg.ValidatorDstAddress)
+	if err != nil {
+		return nil, err
+	}
+	if err := k.validatorExists(ctx, valDstAddr); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgWrappedBeginRedelegateResponse{ActionId: k.queueAction(ctx, msg.Msg)}, nil
+}
+
+// WrappedCreateValidator implements the Msg/WrappedCreateValidator Msg service.
+func (k msgServer) WrappedCreateValidator(goCtx context.Context, msg *types.MsgWrappedCreateValidator) (*types.MsgWrappedCreateValidatorResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	valAddr, err := sdk.ValAddressFromBech32(msg.Msg.ValidatorAddress)
+	if err != nil {
+		return nil, err
+	}
+	if _, found := k.stakingKeeper.GetValidator(ctx, valAddr); found {
+		return nil, types.ErrValidatorExists.Wrap(msg.Msg.ValidatorAddress)
+	}
+	if err := k.escrowTokens(ctx, msg.Msg); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgWrappedCreateValidatorResponse{ActionId: k.queueAction(ctx, msg.Msg)}, nil
+}
+
+// WrappedEditValidator implements the Msg/WrappedEditValidator Msg service.
+func (k msgServer) WrappedEditValidator(goCtx context.Context, msg *types.MsgWrappedEditValidator) (*types.MsgWrappedEditValidatorResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	valAddr, err := sdk.ValAddressFromBech32(msg.Msg.ValidatorAddress)
+	if err != nil {
+		return nil, err
+	}
+	if err := k.validatorExists(ctx, valAddr); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgWrappedEditValidatorResponse{ActionId: k.queueAction(ctx, msg.Msg)}, nil
+}
+
+// WrappedCancelUnbondingDelegation implements the Msg/WrappedCancelUnbondingDelegation Msg service.
+func (k msgServer) WrappedCancelUnbondingDelegation(goCtx context.Context, msg *types.MsgWrappedCancelUnbondingDelegation) (*types.MsgWrappedCancelUnbondingDelegationResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	delAddr, err := sdk.AccAddressFromBech32(msg.Msg.DelegatorAddress)
+	if err != nil {
+		return nil, err
+	}
+
+	valAddr, err := sdk.ValAddressFromBech32(msg.Msg.ValidatorAddress)
+	if err != nil {
+		return nil, err
+	}
+
+	ubd, found := k.stakingKeeper.GetUnbondingDelegation(ctx, delAddr, valAddr)
+	if !found {
+		return nil, types.ErrUnbondingDelegationNotFound.Wrapf(
+			"delegator %s, validator %s", msg.Msg.DelegatorAddress, msg.Msg.ValidatorAddress,
+		)
+	}
+
+	// the entry may still be canceled by another queued msg, which is only
+	// known when the msg is executed
+	entryFound := false
+	for _, entry := range ubd.Entries {
+		if entry.CreationHeight != msg.Msg.CreationHeight {
+			continue
+		}
+		if entry.Balance.LT(msg.Msg.Amount.Amount) {
+			return nil, sdkerrors.ErrInvalidRequest.Wrap("amount is greater than the unbonding delegation entry balance")
+		}
+		entryFound = true
+		break
+	}
+	if !entryFound {
+		return nil, sdkerrors.ErrNotFound.Wrapf("unbonding delegation entry is not found at block height %d", msg.Msg.CreationHeight)
+	}
+
+	return &types.MsgWrappedCancelUnbondingDelegationResponse{ActionId: k.queueAction(ctx, msg.Msg)}, nil
+}
+
+// queueAction queues the msg for the end of the current epoch.
+func (k Keeper) queueAction(ctx sdk.Context, msg sdk.Msg) uint64 {
+	epochNumber := k.GetEpochNumber(ctx)
+	actionID := k.QueueMsgForEpoch(ctx, epochNumber, msg)
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeQueueAction,
+			sdk.NewAttribute(types.AttributeKeyEpochNumber, fmt.Sprint(epochNumber)),
+			sdk.NewAttribute(types.AttributeKeyActionID, fmt.Sprint(actionID)),
+			sdk.NewAttribute(types.AttributeKeyMsgTypeURL, sdk.MsgTypeURL(msg)),
+		),
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(sdk.AttributeKeySender, msg.GetSigners()[0].String()),
+		),
+	})
+
+	return actionID
+}
+
+func (k Keeper) validatorExists(ctx sdk.Context, valAddr sdk.ValAddress) error {
+	if _, found := k.stakingKeeper.GetValidator(ctx, valAddr); !found {
+		return types.ErrValidatorNotFound.Wrap(valAddr.String())
+	}
+	return nil
+}
+
+func (k Keeper) delegationExists(ctx sdk.Context, delegator, validator string) error {
+	delAddr, err := sdk.AccAddressFromBech32(delegator)
+	if err != nil {
+		return err
+	}
+
+	valAddr, err := sdk.ValAddressFromBech32(validator)
+	if err != nil {
+		return err
+	}
+
+	if _, found := k.stakingKeeper.GetDelegation(ctx, delAddr, valAddr); !found {
+		return types.ErrDelegationNotFound.Wrapf("delegator %s, validator %s", delegator, validator)
+	}
+	return nil
+}
@@ -0,0 +1,132 @@
+package keeper
+
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/epoching/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+)
+
+// executingActionKey is the context key set while the queued actions are
+// executed at the end of an epoch.
+type executingActionKey struct{}
+
+type stakingMsgServer struct {
+	epochingMsgServer types.MsgServer
+	stakingMsgServer  stakingtypes.MsgServer
+}
+
+// NewStakingMsgServerImpl wraps a staking MsgServer to queue the msgs changing
+// the validator set until the end of the epoch, as the epoching Msg/Wrapped*
+// services do, and to execute them when the queued actions are executed. The
+// staking msgs not changing the validator set are executed right away.
+//
+// Every staking Msg service is implemented explicitly, so that a new one does
+// not bypass the epochs by default.
+func NewStakingMsgServerImpl(keeper Keeper, msgServer stakingtypes.MsgServer) stakingtypes.MsgServer {
+	return &stakingMsgServer{
+		epochingMsgServer: NewMsgServerImpl(keeper),
+		stakingMsgServer:  msgServer,
+	}
+}
+
+var _ stakingtypes.MsgServer = stakingMsgServer{}
+
+// CreateValidator implements the Msg/CreateValidator Msg service.
+func (s stakingMsgServer) CreateValidator(goCtx context.Context, msg *stakingtypes.MsgCreateValidator) (*stakingtypes.MsgCreateValidatorResponse, error) {
+	if !isExecutingAction(goCtx) {
+		if _, err := s.epochingMsgServer.WrappedCreateValidator(goCtx, types.NewMsgWrappedCreateValidator(msg)); err != nil {
+			return nil, err
+		}
+		return &stakingtypes.MsgCreateValidatorResponse{}, nil
+	}
+	return s.stakingMsgServer.CreateValidator(goCtx, msg)
+}
+
+// EditValidator implements the Msg/EditValidator Msg service.
+func (s stakingMsgServer) EditValidator(goCtx context.Context, msg *stakingtypes.MsgEditValidator) (*stakingtypes.MsgEditValidatorResponse, error) {
+	if !isExecutingAction(goCtx) {
+		if _, err := s.epochingMsgServer.WrappedEditValidator(goCtx, types.NewMsgWrappedEditValidator(msg)); err != nil {
+			return nil, err
+		}
+		return &stakingtypes.MsgEditValidatorResponse{}, nil
+	}
+	return s.stakingMsgServer.EditValidator(goCtx, msg)
+}
+
+// Delegate implements the Msg/Delegate Msg service.
+func (s stakingMsgServer) Delegate(goCtx context.Context, msg *stakingtypes.MsgDelegate) (*stakingtypes.MsgDelegateResponse, error) {
+	if !isExecutingAction(goCtx) {
+		if _, err := s.epochingMsgServer.WrappedDelegate(goCtx, types.NewMsgWrappedDelegate(msg)); err != nil {
+			return nil, err
+		}
+		return &stakingtypes.MsgDelegateResponse{}, nil
+	}
+	return s.stakingMsgServer.Delegate(goCtx, msg)
+}
+
+// BeginRedelegate implements the Msg/BeginRedelegate Msg service. The
+// completion time of a queued redelegation is only known once it is executed,
+// so it is left empty in the response.
+func (s stakingMsgServer) BeginRedelegate(goCtx context.Context, msg *stakingtypes.MsgBeginRedelegate) (*stakingtypes.MsgBeginRedelegateResponse, error) {
+	if !isExecutingAction(goCtx) {
+		if _, err := s.epochingMsgServer.WrappedBeginRedelegate(goCtx, types.NewMsgWrappedBeginRedelegate(msg)); err != nil {
+			return nil, err
+		}
+		return &stakingtypes.MsgBeginRedelegateResponse{}, nil
+	}
+	return s.stakingMsgServer.BeginRedelegate(goCtx, msg)
+}
+
+// Undelegate implements the Msg/Undelegate Msg service. The completion time
+// of a queued unbonding is only known once it is executed, so it is left empty
+// in the response.
+func (s stakingMsgServer) Undelegate(goCtx context.Context, msg *stakingtypes.MsgUndelegate) (*stakingtypes.MsgUndelegateResponse, error) {
+	if !isExecutingAction(goCtx) {
+		if _, err := s.epochingMsgServer.WrappedUndelegate(goCtx, types.NewMsgWrappedUndelegate(msg)); err != nil {
+			return nil, err
+		}
+		return &stakingtypes.MsgUndelegateResponse{}, nil
+	}
+	return s.stakingMsgServer.Undelegate(goCtx, msg)
+}
+
+// CancelUnbondingDelegation implements the Msg/CancelUnbondingDelegation Msg service.
+func (s stakingMsgServer) CancelUnbondingDelegation(goCtx context.Context, msg *stakingtypes.MsgCancelUnbondingDelegation) (*stakingtypes.MsgCancelUnbondingDelegationResponse, error) {
+	if !isExecutingAction(goCtx) {
+		if _, err := s.epochingMsgServer.WrappedCancelUnbondingDelegation(goCtx, types.NewMsgWrappedCancelUnbondingDelegation(msg)); err != nil {
+			return nil, err
+		}
+		return &stakingtypes.MsgCancelUnbondingDelegationResponse{}, nil
+	}
+	return s.stakingMsgServer.CancelUnbondingDelegation(goCtx, msg)
+}
+
+// TokenizeShares implements the Msg/TokenizeShares Msg service. Tokenizing
+// shares moves them to a share record account without changing the tokens of
+// the validator, so it is executed right away.
+func (s stakingMsgServer) TokenizeShares(goCtx context.Context, msg *stakingtypes.MsgTokenizeShares) (*stakingtypes.MsgTokenizeSharesResponse, error) {
+	return s.stakingMsgServer.TokenizeShares(goCtx, msg)
+}
+
+// RedeemTokensForShares implements the Msg/RedeemTokensForShares Msg service.
+// Redeeming moves the shares back from the share record account without
+// changing the tokens of the validator, so it is executed right away.
+func (s stakingMsgServer) RedeemTokensForShares(goCtx context.Context, msg *stakingtypes.MsgRedeemTokensForShares) (*stakingtypes.MsgRedeemTokensForSharesResponse, error) {
+	return s.stakingMsgServer.RedeemTokensForShares(goCtx, msg)
+}
+
+// TransferShareRecord implements the Msg/TransferShareRecord Msg service. It
+// only changes the owner of a share record, so it is executed right away.
+func (s stakingMsgServer) TransferShareRecord(goCtx context.Context, msg *stakingtypes.MsgTransferShareRecord) (*stakingtypes.MsgTransferShareRecordResponse, error) {
+	return s.stakingMsgServer.TransferShareRecord(goCtx, msg)
+}
+
+// isExecutingAction returns true if the msgs are executed as queued actions.
+// The genesis transactions bootstrap the initial validator set, so they are
+// executed without being queued too.
+func isExecutingAction(goCtx context.Context) bool {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	return ctx.BlockHeight() == 0 || ctx.Value(executingActionKey{}) != nil
+}
@@ -0,0 +1,58 @@
+package epoching
+
+import (
+	gogogrpc "github.com/gogo/protobuf/grpc"
+	"google.golang.org/grpc"
+
+	"github.com/cosmos/cosmos-sdk/types/module"
+	"github.com/cosmos/cosmos-sdk/x/epoching/keeper"
+	"github.com/cosmos/cosmos-sdk/x/staking"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+)
+
+var _ module.AppModule = StakingAppModule{}
+
+// StakingAppModule wraps the staking AppModule to register its msg server
+// through keeper.NewStakingMsgServerImpl. The staking msgs changing the
+// validator set are then queued until the end of the epoch on every path to
+// the msg service router, including the authz MsgExec and the gov proposals.
+type StakingAppModule struct {
+	staking.AppModule
+
+	keeper keeper.Keeper
+}
+
+// NewStakingAppModule creates a new StakingAppModule object
+func NewStakingAppModule(am staking.AppModule, k keeper.Keeper) StakingAppModule {
+	return StakingAppModule{AppModule: am, keeper: k}
+}
+
+// RegisterServices registers the staking module services, wrapping its msg
+// server.
+func (am StakingAppModule) RegisterServices(cfg module.Configurator) {
+	am.AppModule.RegisterServices(stakingConfigurator{Configurator: cfg, keeper: am.keeper})
+}
+
+// stakingConfigurator wraps the staking msg server when it is registered.
+type stakingConfigurator struct {
+	module.Configurator
+
+	keeper keeper.Keeper
+}
+
+func (c stakingConfigurator) MsgServer() gogogrpc.Server {
+	return stakingMsgServiceRegistrar{Server: c.Configurator.MsgServer(), keeper: c.keeper}
+}
+
+type stakingMsgServiceRegistrar struct {
+	gogogrpc.Server
+
+	keeper keeper.Keeper
+}
+
+func (r stakingMsgServiceRegistrar) RegisterService(sd *grpc.ServiceDesc, ss interface{}) {
+	if msgServer, ok := ss.(stakingtypes.MsgServer); ok {
+		ss = keeper.NewStakingMsgServerImpl(r.keeper, msgServer)
+	}
+	r.Server.RegisterService(sd, ss)
+}
@@ -0,0 +1,14 @@
+package types
+
+import (
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// x/epoching module sentinel errors
+var (
+	ErrInvalidMsg                  = sdkerrors.Register(ModuleName, 2, "invalid wrapped staking msg")
+	ErrValidatorNotFound           = sdkerrors.Register(ModuleName, 3, "validator does not exist")
+	ErrValidatorExists             = sdkerrors.Register(ModuleName, 4, "validator already exists")
+	ErrDelegationNotFound          = sdkerrors.Register(ModuleName, 5, "delegation does not exist")
+	ErrUnbondingDelegationNotFound = sdkerrors.Register(ModuleName, 6, "unbonding delegation does not exist")
+)
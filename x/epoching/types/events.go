@@ -0,0 +1,16 @@
+package types
+
+// epoching module event types
+const (
+	EventTypeQueueAction   = "queue_action"
+	EventTypeExecuteAction = "execute_action"
+	EventTypeActionFailed  = "action_failed"
+	EventTypeEndEpoch      = "end_epoch"
+
+	AttributeKeyEpochNumber = "epoch_number"
+	AttributeKeyActionID    = "action_id"
+	AttributeKeyMsgTypeURL  = "msg_type_url"
+	AttributeKeyError       = "error"
+
+	AttributeValueCategory = ModuleName
+)
@@ -0,0 +1,87 @@
+package types
+
+import (
+	"fmt"
+
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+var _ codectypes.UnpackInterfacesMessage = GenesisState{}
+
+// NewGenesisState creates a new GenesisState object
+func NewGenesisState(params Params, epochNumber int64, queuedMsgs []sdk.Msg) (*GenesisState, error) {
+	anys := make([]*codectypes.Any, len(queuedMsgs))
+	for i, msg := range queuedMsgs {
+		any, err := codectypes.NewAnyWithValue(msg)
+		if err != nil {
+			return nil, err
+		}
+		anys[i] = any
+	}
+
+	return &GenesisState{
+		Params:      params,
+		EpochNumber: epochNumber,
+		QueuedMsgs:  anys,
+	}, nil
+}
+
+// DefaultGenesisState returns a default epoching module genesis state.
+func DefaultGenesisState() *GenesisState {
+	return &GenesisState{
+		Params:      DefaultParams(),
+		EpochNumber: 0,
+	}
+}
+
+// Validate performs basic genesis state validation returning an error upon any
+// failure.
+func (gs GenesisState) Validate() error {
+	if err := gs.Params.Validate(); err != nil {
+		return err
+	}
+
+	if gs.EpochNumber < 0 {
+		return fmt.Errorf("epoch number cannot be negative: %d", gs.EpochNumber)
+	}
+
+	for i, any := range gs.QueuedMsgs {
+		msg, ok := any.GetCachedValue().(sdk.Msg)
+		if !ok {
+			return fmt.Errorf("queued msg %d: expected sdk.Msg, got %T", i, any.GetCachedValue())
+		}
+		if err := ValidateQueuedMsg(msg); err != nil {
+			return fmt.Errorf("queued msg %d: %w", i, err)
+		}
+	}
+
+	return nil
+}
+
+// UnpackInterfaces implements UnpackInterfacesMessage.UnpackInterfaces
+func (gs GenesisState) UnpackInterfaces(unpacker codectypes.AnyUnpacker) error {
+	for _, any := range gs.QueuedMsgs {
+		var msg sdk.Msg
+		if err := unpacker.UnpackAny(any, &msg); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// UnpackInterfaces implements UnpackInterfacesMessage.UnpackInterfaces
+func (a QueuedAction) UnpackInterfaces(unpacker codectypes.AnyUnpacker) error {
+	var msg sdk.Msg
+	return unpacker.UnpackAny(a.Msg, &msg)
+}
+
+// UnpackInterfaces implements UnpackInterfacesMessage.UnpackInterfaces
+func (r QueryQueuedActionsResponse) UnpackInterfaces(unpacker codectypes.AnyUnpacker) error {
+	for _, action := range r.Actions {
+		if err := action.UnpackInterfaces(unpacker); err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -0,0 +1,40 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+const (
+	// ModuleName is the name of this module
+	ModuleName = "epoching"
+
+	// StoreKey is the prefix under which we store this module's data
+	StoreKey = ModuleName
+
+	// RouterKey is the message route for the epoching module
+	RouterKey = ModuleName
+
+	// QuerierRoute is the querier route for the epoching module
+	QuerierRoute = ModuleName
+)
+
+// KVStore keys
+var (
+	NextEpochActionID      = []byte{0x11} // key for the next action ID
+	EpochNumberID          = []byte{0x12} // key for the current epoch number
+	EpochActionQueuePrefix = []byte{0x13} // prefix for the queued actions
+)
+
+// ActionStoreKey returns the key under which an action queued for an epoch is
+// stored: prefix | epochNumber | actionID.
+func ActionStoreKey(epochNumber int64, actionID uint64) []byte {
+	key := append(EpochActionQueuePrefix, sdk.Uint64ToBigEndian(uint64(epochNumber))...)
+	return append(key, sdk.Uint64ToBigEndian(actionID)...)
+}
+
+// ParseActionStoreKey returns the epoch number and action ID of an action
+// store key.
+func ParseActionStoreKey(key []byte) (epochNumber int64, actionID uint64) {
+	key = key[len(EpochActionQueuePrefix):]
+	return int64(sdk.BigEndianToUint64(key[:8])), sdk.BigEndianToUint64(key[8:])
+}
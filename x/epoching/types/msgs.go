@@ -0,0 +1,233 @@
+package types
+
+import (
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/x/auth/migrations/legacytx"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+)
+
+// epoching message types
+const (
+	TypeMsgWrappedDelegate                  = "wrapped_delegate"
+	TypeMsgWrappedUndelegate                = "wrapped_begin_unbonding"
+	TypeMsgWrappedBeginRedelegate           = "wrapped_begin_redelegate"
+	TypeMsgWrappedCreateValidator           = "wrapped_create_validator"
+	TypeMsgWrappedEditValidator             = "wrapped_edit_validator"
+	TypeMsgWrappedCancelUnbondingDelegation = "wrapped_cancel_unbond"
+)
+
+var (
+	_ sdk.Msg = &MsgWrappedDelegate{}
+	_ sdk.Msg = &MsgWrappedUndelegate{}
+	_ sdk.Msg = &MsgWrappedBeginRedelegate{}
+	_ sdk.Msg = &MsgWrappedCreateValidator{}
+	_ sdk.Msg = &MsgWrappedEditValidator{}
+	_ sdk.Msg = &MsgWrappedCancelUnbondingDelegation{}
+
+	_ legacytx.LegacyMsg = &MsgWrappedDelegate{}
+	_ legacytx.LegacyMsg = &MsgWrappedUndelegate{}
+	_ legacytx.LegacyMsg = &MsgWrappedBeginRedelegate{}
+	_ legacytx.LegacyMsg = &MsgWrappedCreateValidator{}
+	_ legacytx.LegacyMsg = &MsgWrappedEditValidator{}
+	_ legacytx.LegacyMsg = &MsgWrappedCancelUnbondingDelegation{}
+
+	_ codectypes.UnpackInterfacesMessage = (*MsgWrappedCreateValidator)(nil)
+)
+
+// NewMsgWrappedDelegate creates a new MsgWrappedDelegate instance.
+func NewMsgWrappedDelegate(msg *stakingtypes.MsgDelegate) *MsgWrappedDelegate {
+	return &MsgWrappedDelegate{Msg: msg}
+}
+
+// Route implements the LegacyMsg interface.
+func (m MsgWrappedDelegate) Route() string { return RouterKey }
+
+// Type implements the LegacyMsg interface.
+func (m MsgWrappedDelegate) Type() string { return TypeMsgWrappedDelegate }
+
+// GetSignBytes implements the LegacyMsg interface.
+func (m MsgWrappedDelegate) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&m))
+}
+
+// ValidateBasic does a sanity check on the wrapped msg.
+func (m MsgWrappedDelegate) ValidateBasic() error {
+	if m.Msg == nil {
+		return sdkerrors.Wrap(ErrInvalidMsg, "empty wrapped msg")
+	}
+	return m.Msg.ValidateBasic()
+}
+
+// GetSigners returns the signers of the wrapped msg.
+func (m MsgWrappedDelegate) GetSigners() []sdk.AccAddress {
+	return m.Msg.GetSigners()
+}
+
+// NewMsgWrappedUndelegate creates a new MsgWrappedUndelegate instance.
+func NewMsgWrappedUndelegate(msg *stakingtypes.MsgUndelegate) *MsgWrappedUndelegate {
+	return &MsgWrappedUndelegate{Msg: msg}
+}
+
+// Route implements the LegacyMsg interface.
+func (m MsgWrappedUndelegate) Route() string { return RouterKey }
+
+// Type implements the LegacyMsg interface.
+func (m MsgWrappedUndelegate) Type() string { return TypeMsgWrappedUndelegate }
+
+// GetSignBytes implements the LegacyMsg interface.
+func (m MsgWrappedUndelegate) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&m))
+}
+
+// ValidateBasic does a sanity check on the wrapped msg.
+func (m MsgWrappedUndelegate) ValidateBasic() error {
+	if m.Msg == nil {
+		return sdkerrors.Wrap(ErrInvalidMsg, "empty wrapped msg")
+	}
+	return m.Msg.ValidateBasic()
+}
+
+// GetSigners returns the signers of the wrapped msg.
+func (m MsgWrappedUndelegate) GetSigners() []sdk.AccAddress {
+	return m.Msg.GetSigners()
+}
+
+// NewMsgWrappedBeginRedelegate creates a new MsgWrappedBeginRedelegate instance.
+func NewMsgWrappedBeginRedelegate(msg *stakingtypes.MsgBeginRedelegate) *MsgWrappedBeginRedelegate {
+	return &MsgWrappedBeginRedelegate{Msg: msg}
+}
+
+// Route implements the LegacyMsg interface.
+func (m MsgWrappedBeginRedelegate) Route() string { return RouterKey }
+
+// Type implements the LegacyMsg interface.
+func (m MsgWrappedBeginRedelegate) Type() string { return TypeMsgWrappedBeginRedelegate }
+
+// GetSignBytes implements the LegacyMsg interface.
+func (m MsgWrappedBeginRedelegate) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&m))
+}
+
+// ValidateBasic does a sanity check on the wrapped msg.
+func (m MsgWrappedBeginRedelegate) ValidateBasic() error {
+	if m.Msg == nil {
+		return sdkerrors.Wrap(ErrInvalidMsg, "empty wrapped msg")
+	}
+	return m.Msg.ValidateBasic()
+}
+
+// GetSigners returns the signers of the wrapped msg.
+func (m MsgWrappedBeginRedelegate) GetSigners() []sdk.AccAddress {
+	return m.Msg.GetSigners()
+}
+
+// NewMsgWrappedCreateValidator creates a new MsgWrappedCreateValidator instance.
+func NewMsgWrappedCreateValidator(msg *stakingtypes.MsgCreateValidator) *MsgWrappedCreateValidator {
+	return &MsgWrappedCreateValidator{Msg: msg}
+}
+
+// Route implements the LegacyMsg interface.
+func (m MsgWrappedCreateValidator) Route() string { return RouterKey }
+
+// Type implements the LegacyMsg interface.
+func (m MsgWrappedCreateValidator) Type() string { return TypeMsgWrappedCreateValidator }
+
+// GetSignBytes implements the LegacyMsg interface.
+func (m MsgWrappedCreateValidator) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&m))
+}
+
+// ValidateBasic does a sanity check on the wrapped msg.
+func (m MsgWrappedCreateValidator) ValidateBasic() error {
+	if m.Msg == nil {
+		return sdkerrors.Wrap(ErrInvalidMsg, "empty wrapped msg")
+	}
+	return m.Msg.ValidateBasic()
+}
+
+// GetSigners returns the signers of the wrapped msg.
+func (m MsgWrappedCreateValidator) GetSigners() []sdk.AccAddress {
+	return m.Msg.GetSigners()
+}
+
+// UnpackInterfaces implements UnpackInterfacesMessage.UnpackInterfaces
+func (m MsgWrappedCreateValidator) UnpackInterfaces(unpacker codectypes.AnyUnpacker) error {
+	if m.Msg == nil {
+		return nil
+	}
+	return m.Msg.UnpackInterfaces(unpacker)
+}
+
+// NewMsgWrappedEditValidator creates a new MsgWrappedEditValidator instance.
+func NewMsgWrappedEditValidator(msg *stakingtypes.MsgEditValidator) *MsgWrappedEditValidator {
+	return &MsgWrappedEditValidator{Msg: msg}
+}
+
+// Route implements the LegacyMsg interface.
+func (m MsgWrappedEditValidator) Route() string { return RouterKey }
+
+// Type implements the LegacyMsg interface.
+func (m MsgWrappedEditValidator) Type() string { return TypeMsgWrappedEditValidator }
+
+// GetSignBytes implements the LegacyMsg interface.
+func (m MsgWrappedEditValidator) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&m))
+}
+
+// ValidateBasic does a sanity check on the wrapped msg.
+func (m MsgWrappedEditValidator) ValidateBasic() error {
+	if m.Msg == nil {
+		return sdkerrors.Wrap(ErrInvalidMsg, "empty wrapped msg")
+	}
+	return m.Msg.ValidateBasic()
+}
+
+// GetSigners returns the signers of the wrapped msg.
+func (m MsgWrappedEditValidator) GetSigners() []sdk.AccAddress {
+	return m.Msg.GetSigners()
+}
+
+// NewMsgWrappedCancelUnbondingDelegation creates a new MsgWrappedCancelUnbondingDelegation instance.
+func NewMsgWrappedCancelUnbondingDelegation(msg *stakingtypes.MsgCancelUnbondingDelegation) *MsgWrappedCancelUnbondingDelegation {
+	return &MsgWrappedCancelUnbondingDelegation{Msg: msg}
+}
+
+// Route implements the LegacyMsg interface.
+func (m MsgWrappedCancelUnbondingDelegation) Route() string { return RouterKey }
+
+// Type implements the LegacyMsg interface.
+func (m MsgWrappedCancelUnbondingDelegation) Type() string {
+	return TypeMsgWrappedCancelUnbondingDelegation
+}
+
+// GetSignBytes implements the LegacyMsg interface.
+func (m MsgWrappedCancelUnbondingDelegation) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&m))
+}
+
+// ValidateBasic does a sanity check on the wrapped msg.
+func (m MsgWrappedCancelUnbondingDelegation) ValidateBasic() error {
+	if m.Msg == nil {
+		return sdkerrors.Wrap(ErrInvalidMsg, "empty wrapped msg")
+	}
+	return m.Msg.ValidateBasic()
+}
+
+// GetSigners returns the signers of the wrapped msg.
+func (m MsgWrappedCancelUnbondingDelegation) GetSigners() []sdk.AccAddress {
+	return m.Msg.GetSigners()
+}
+
+// ValidateQueuedMsg checks that a msg is one of the staking msgs that can be
+// queued for the end of an epoch, and that it passes its basic validation.
+func ValidateQueuedMsg(msg sdk.Msg) error {
+	switch msg.(type) {
+	case *stakingtypes.MsgDelegate, *stakingtypes.MsgUndelegate, *stakingtypes.MsgBeginRedelegate,
+		*stakingtypes.MsgCreateValidator, *stakingtypes.MsgEditValidator, *stakingtypes.MsgCancelUnbondingDelegation:
+		return msg.ValidateBasic()
+	default:
+		return sdkerrors.Wrapf(ErrInvalidMsg, "cannot queue %s", sdk.MsgTypeURL(msg))
+	}
+}
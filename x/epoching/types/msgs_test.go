@@ -0,0 +1,66 @@
+package types_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	"github.com/cosmos/cosmos-sdk/x/epoching/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+)
+
+var (
+	delAddr = sdk.AccAddress("delegator___________")
+	valAddr = sdk.ValAddress("validator___________")
+	coin    = sdk.NewInt64Coin(sdk.DefaultBondDenom, 10)
+)
+
+func TestActionStoreKey(t *testing.T) {
+	key := types.ActionStoreKey(300, 1<<40)
+	epochNumber, actionID := types.ParseActionStoreKey(key)
+	require.Equal(t, int64(300), epochNumber)
+	require.Equal(t, uint64(1<<40), actionID)
+
+	// keys are ordered by epoch number, then by action id
+	require.Less(t, string(types.ActionStoreKey(1, 2)), string(types.ActionStoreKey(2, 1)))
+	require.Less(t, string(types.ActionStoreKey(2, 1)), string(types.ActionStoreKey(2, 256)))
+}
+
+func TestMsgWrappedDelegate(t *testing.T) {
+	msg := types.NewMsgWrappedDelegate(stakingtypes.NewMsgDelegate(delAddr, valAddr, coin))
+	require.NoError(t, msg.ValidateBasic())
+	require.Equal(t, []sdk.AccAddress{delAddr}, msg.GetSigners())
+	require.Equal(t, types.RouterKey, msg.Route())
+	require.Equal(t, types.TypeMsgWrappedDelegate, msg.Type())
+
+	require.Error(t, types.NewMsgWrappedDelegate(nil).ValidateBasic())
+	require.Error(t, types.NewMsgWrappedDelegate(stakingtypes.NewMsgDelegate(delAddr, valAddr, sdk.Coin{Denom: "stake", Amount: sdk.ZeroInt()})).ValidateBasic())
+}
+
+func TestValidateQueuedMsg(t *testing.T) {
+	require.NoError(t, types.ValidateQueuedMsg(stakingtypes.NewMsgUndelegate(delAddr, valAddr, coin)))
+	require.NoError(t, types.ValidateQueuedMsg(stakingtypes.NewMsgCancelUnbondingDelegation(delAddr, valAddr, 1, coin)))
+	require.ErrorIs(t, types.ValidateQueuedMsg(banktypes.NewMsgSend(delAddr, delAddr, sdk.NewCoins(coin))), types.ErrInvalidMsg)
+}
+
+func TestGenesisStateValidate(t *testing.T) {
+	require.NoError(t, types.DefaultGenesisState().Validate())
+
+	genState, err := types.NewGenesisState(types.DefaultParams(), 2, []sdk.Msg{stakingtypes.NewMsgDelegate(delAddr, valAddr, coin)})
+	require.NoError(t, err)
+	require.NoError(t, genState.Validate())
+
+	genState, err = types.NewGenesisState(types.NewParams(0), 0, nil)
+	require.NoError(t, err)
+	require.Error(t, genState.Validate())
+
+	genState, err = types.NewGenesisState(types.DefaultParams(), -1, nil)
+	require.NoError(t, err)
+	require.Error(t, genState.Validate())
+
+	genState, err = types.NewGenesisState(types.DefaultParams(), 0, []sdk.Msg{banktypes.NewMsgSend(delAddr, delAddr, sdk.NewCoins(coin))})
+	require.NoError(t, err)
+	require.Error(t, genState.Validate())
+}
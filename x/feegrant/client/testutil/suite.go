@@ -84,6 +84,7 @@ func (s *IntegrationTestSuite) createGrant(granter, grantee sdk.Address) {
 		fmt.Sprintf("--%s=%s", flags.FlagBroadcastMode, flags.BroadcastBlock),
 		fmt.Sprintf("--%s=true", flags.FlagSkipConfirmation),
 		fmt.Sprintf("--%s=%s", flags.FlagFees, sdk.NewCoins(sdk.NewCoin(s.cfg.BondDenom, sdk.NewInt(100))).String()),
+		fmt.Sprintf("--%s=200000", flags.FlagGas),
 	}
 
 	fee := sdk.NewCoin("stake", sdk.NewInt(100))
@@ -324,6 +325,7 @@ func (s *IntegrationTestSuite) TestNewCmdFeeGrant() {
 		fmt.Sprintf("--%s=%s", flags.FlagBroadcastMode, flags.BroadcastBlock),
 		fmt.Sprintf("--%s=true", flags.FlagSkipConfirmation),
 		fmt.Sprintf("--%s=%s", flags.FlagFees, sdk.NewCoins(sdk.NewCoin(s.cfg.BondDenom, sdk.NewInt(10))).String()),
+		fmt.Sprintf("--%s=200000", flags.FlagGas),
 	}
 
 	testCases := []struct {
@@ -629,6 +631,7 @@ func (s *IntegrationTestSuite) TestNewCmdRevokeFeegrant() {
 		fmt.Sprintf("--%s=%s", flags.FlagBroadcastMode, flags.BroadcastBlock),
 		fmt.Sprintf("--%s=true", flags.FlagSkipConfirmation),
 		fmt.Sprintf("--%s=%s", flags.FlagFees, sdk.NewCoins(sdk.NewCoin(s.cfg.BondDenom, sdk.NewInt(10))).String()),
+		fmt.Sprintf("--%s=200000", flags.FlagGas),
 	}
 
 	// Create new fee grant specifically to test amino.
@@ -742,6 +745,7 @@ func (s *IntegrationTestSuite) TestTxWithFeeGrant() {
 		fmt.Sprintf("--%s=%s", flags.FlagBroadcastMode, flags.BroadcastBlock),
 		fmt.Sprintf("--%s=true", flags.FlagSkipConfirmation),
 		fmt.Sprintf("--%s=%s", flags.FlagFees, sdk.NewCoins(sdk.NewCoin(s.cfg.BondDenom, sdk.NewInt(10))).String()),
+		fmt.Sprintf("--%s=200000", flags.FlagGas),
 	}
 
 	fee := sdk.NewCoin("stake", sdk.NewInt(100))
@@ -28,3 +28,14 @@ type FeeAllowanceI interface {
 	// ExpiresAt returns the expiry time of the allowance.
 	ExpiresAt() (*time.Time, error)
 }
+
+// RefundableFeeAllowanceI is implemented by the fee allowances which can be
+// restored by the part of an accepted fee that is refunded to the granter
+// after the tx is executed. See Keeper.RefundGrantedFees.
+type RefundableFeeAllowanceI interface {
+	FeeAllowanceI
+
+	// Refund restores the allowance by refund, which must be part of a fee
+	// accepted by it in the same block.
+	Refund(refund sdk.Coins) error
+}
@@ -85,6 +85,26 @@ func (a *AllowedMsgAllowance) Accept(ctx sdk.Context, fee sdk.Coins, msgs []sdk.
 	return remove, err
 }
 
+// Refund restores the inner allowance by the refunded part of a fee accepted
+// in the same block. It is a no-op if the inner allowance is not refundable.
+func (a *AllowedMsgAllowance) Refund(refund sdk.Coins) error {
+	allowance, err := a.GetAllowance()
+	if err != nil {
+		return err
+	}
+
+	refundable, ok := allowance.(RefundableFeeAllowanceI)
+	if !ok {
+		return nil
+	}
+
+	if err := refundable.Refund(refund); err != nil {
+		return err
+	}
+
+	return a.SetAllowance(refundable)
+}
+
 func (a *AllowedMsgAllowance) allowedMsgsToMap(ctx sdk.Context) map[string]bool {
 	msgsMap := make(map[string]bool, len(a.AllowedMessages))
 	for _, msg := range a.AllowedMessages {
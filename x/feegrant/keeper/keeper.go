@@ -1,6 +1,7 @@
 package keeper
 
 import (
+	"errors"
 	"fmt"
 	"time"
 
@@ -11,6 +12,7 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	"github.com/cosmos/cosmos-sdk/x/auth/ante"
+	"github.com/cosmos/cosmos-sdk/x/auth/posthandler"
 	"github.com/cosmos/cosmos-sdk/x/feegrant"
 )
 
@@ -22,7 +24,10 @@ type Keeper struct {
 	authKeeper feegrant.AccountKeeper
 }
 
-var _ ante.FeegrantKeeper = &Keeper{}
+var (
+	_ ante.FeegrantKeeper        = &Keeper{}
+	_ posthandler.FeegrantKeeper = &Keeper{}
+)
 
 // NewKeeper creates a fee grant Keeper
 func NewKeeper(cdc codec.BinaryCodec, storeKey storetypes.StoreKey, ak feegrant.AccountKeeper) Keeper {
@@ -259,6 +264,37 @@ func (k Keeper) UseGrantedFees(ctx sdk.Context, granter, grantee sdk.AccAddress,
 	return k.UpdateAllowance(ctx, granter, grantee, grant)
 }
 
+// RefundGrantedFees restores the allowance of the grantee by the part of a fee
+// paid with UseGrantedFees which is refunded to the granter after the tx is
+// executed, so that the grantee is only charged for the fee kept. It is a no-op
+// if the allowance has been used up and removed by UseGrantedFees, or if it
+// does not implement feegrant.RefundableFeeAllowanceI.
+func (k Keeper) RefundGrantedFees(ctx sdk.Context, granter, grantee sdk.AccAddress, refund sdk.Coins) error {
+	f, err := k.getGrant(ctx, granter, grantee)
+	if errors.Is(err, sdkerrors.ErrNotFound) {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+
+	grant, err := f.GetGrant()
+	if err != nil {
+		return err
+	}
+
+	refundable, ok := grant.(feegrant.RefundableFeeAllowanceI)
+	if !ok {
+		return nil
+	}
+
+	if err := refundable.Refund(refund); err != nil {
+		return err
+	}
+
+	return k.UpdateAllowance(ctx, granter, grantee, refundable)
+}
+
 func emitUseGrantEvent(ctx sdk.Context, granter, grantee string) {
 	ctx.EventManager().EmitEvent(
 		sdk.NewEvent(
@@ -3,12 +3,14 @@ package keeper_test
 import (
 	"context"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/suite"
 	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
 
 	"github.com/cosmos/cosmos-sdk/simapp"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
 	"github.com/cosmos/cosmos-sdk/x/feegrant"
 	"github.com/cosmos/cosmos-sdk/x/feegrant/keeper"
 )
@@ -242,6 +244,90 @@ func (suite *KeeperTestSuite) TestUseGrantedFee() {
 	suite.Contains(err.Error(), "fee-grant not found")
 }
 
+func (suite *KeeperTestSuite) TestRefundGrantedFees() {
+	blockTime := suite.sdkCtx.BlockTime()
+	oneYear := blockTime.AddDate(1, 0, 0)
+	fee := sdk.NewCoins(sdk.NewInt64Coin("atom", 100))
+	refund := sdk.NewCoins(sdk.NewInt64Coin("atom", 40))
+
+	basic := &feegrant.BasicAllowance{
+		SpendLimit: suite.atom,
+		Expiration: &oneYear,
+	}
+	periodic := &feegrant.PeriodicAllowance{
+		Basic:            *basic,
+		Period:           time.Hour,
+		PeriodSpendLimit: sdk.NewCoins(sdk.NewInt64Coin("atom", 200)),
+		PeriodCanSpend:   sdk.NewCoins(sdk.NewInt64Coin("atom", 200)),
+		PeriodReset:      blockTime.Add(time.Hour),
+	}
+	filtered, err := feegrant.NewAllowedMsgAllowance(&feegrant.BasicAllowance{SpendLimit: suite.atom}, []string{sdk.MsgTypeURL(&banktypes.MsgSend{})})
+	suite.Require().NoError(err)
+	expFiltered, err := feegrant.NewAllowedMsgAllowance(&feegrant.BasicAllowance{SpendLimit: sdk.NewCoins(sdk.NewInt64Coin("atom", 495))}, []string{sdk.MsgTypeURL(&banktypes.MsgSend{})})
+	suite.Require().NoError(err)
+
+	cases := map[string]struct {
+		allowance feegrant.FeeAllowanceI
+		fee       sdk.Coins
+		final     feegrant.FeeAllowanceI
+	}{
+		"basic allowance": {
+			allowance: basic,
+			fee:       fee,
+			final: &feegrant.BasicAllowance{
+				SpendLimit: sdk.NewCoins(sdk.NewInt64Coin("atom", 495)),
+				Expiration: &oneYear,
+			},
+		},
+		"no spend limit": {
+			allowance: &feegrant.BasicAllowance{},
+			fee:       fee,
+			final:     &feegrant.BasicAllowance{},
+		},
+		"periodic allowance": {
+			allowance: periodic,
+			fee:       fee,
+			final: &feegrant.PeriodicAllowance{
+				Basic: feegrant.BasicAllowance{
+					SpendLimit: sdk.NewCoins(sdk.NewInt64Coin("atom", 495)),
+					Expiration: &oneYear,
+				},
+				Period:           time.Hour,
+				PeriodSpendLimit: sdk.NewCoins(sdk.NewInt64Coin("atom", 200)),
+				PeriodCanSpend:   sdk.NewCoins(sdk.NewInt64Coin("atom", 140)),
+				PeriodReset:      blockTime.Add(time.Hour),
+			},
+		},
+		"allowed msg allowance": {
+			allowance: filtered,
+			fee:       fee,
+			final:     expFiltered,
+		},
+		// the used up allowance is removed, so the refund is only made to the
+		// balance of the granter
+		"used up allowance": {
+			allowance: basic,
+			fee:       suite.atom,
+			final:     nil,
+		},
+	}
+
+	for name, tc := range cases {
+		tc := tc
+		suite.Run(name, func() {
+			ctx, _ := suite.sdkCtx.CacheContext()
+			msgs := []sdk.Msg{banktypes.NewMsgSend(suite.addrs[1], suite.addrs[2], fee)}
+
+			suite.Require().NoError(suite.keeper.GrantAllowance(ctx, suite.addrs[0], suite.addrs[1], tc.allowance))
+			suite.Require().NoError(suite.keeper.UseGrantedFees(ctx, suite.addrs[0], suite.addrs[1], tc.fee, msgs))
+			suite.Require().NoError(suite.keeper.RefundGrantedFees(ctx, suite.addrs[0], suite.addrs[1], refund))
+
+			loaded, _ := suite.keeper.GetAllowance(ctx, suite.addrs[0], suite.addrs[1])
+			suite.Equal(tc.final, loaded)
+		})
+	}
+}
+
 func (suite *KeeperTestSuite) TestIterateGrants() {
 	eth := sdk.NewCoins(sdk.NewInt64Coin("eth", 123))
 	exp := suite.sdkCtx.BlockTime().AddDate(1, 0, 0)
@@ -7,7 +7,10 @@ import (
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 )
 
-var _ FeeAllowanceI = (*PeriodicAllowance)(nil)
+var (
+	_ FeeAllowanceI           = (*PeriodicAllowance)(nil)
+	_ RefundableFeeAllowanceI = (*PeriodicAllowance)(nil)
+)
 
 // Accept can use fee payment requested as well as timestamp of the current block
 // to determine whether or not to process this. This is checked in
@@ -47,6 +50,13 @@ func (a *PeriodicAllowance) Accept(ctx sdk.Context, fee sdk.Coins, _ []sdk.Msg)
 	return false, nil
 }
 
+// Refund restores both the current period and the max amount by the refunded
+// part of a fee accepted in the same block, which cannot span two periods.
+func (a *PeriodicAllowance) Refund(refund sdk.Coins) error {
+	a.PeriodCanSpend = a.PeriodCanSpend.Add(refund...)
+	return a.Basic.Refund(refund)
+}
+
 // tryResetPeriod will check if the PeriodReset has been hit. If not, it is a no-op.
 // If we hit the reset period, it will top up the PeriodCanSpend amount to
 // min(PeriodSpendLimit, Basic.SpendLimit) so it is never more than the maximum allowed.
@@ -0,0 +1,98 @@
+package feemarket
+
+import (
+	"math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/x/auth/ante"
+	"github.com/cosmos/cosmos-sdk/x/feemarket/keeper"
+	"github.com/cosmos/cosmos-sdk/x/feemarket/types"
+)
+
+// NewTxFeeChecker returns an ante.TxFeeChecker enforcing the base fee of the
+// fee market, to be set in the DeductFeeDecorator.
+//
+// Fees can be paid in the base fee denom or in any whitelisted fee token, at
+// its conversion rate to the base fee denom. A tx must pay fees worth at least
+// ceil(baseFee * gasLimit) of the base fee denom, in both CheckTx and
+// DeliverTx. The validator min gas prices are still enforced in CheckTx. The
+// tx priority is the tip paid per unit of gas on top of the base fee. The
+// whole fee is deducted. The fee market params and base fee are read on the tx
+// gas meter.
+//
+// When the fee market is disabled, or enabled without a positive min base fee,
+// the base fee is not enforced and the whole fee is the tip.
+func NewTxFeeChecker(k keeper.Keeper) ante.TxFeeChecker {
+	return func(ctx sdk.Context, tx sdk.Tx) (sdk.Coins, int64, error) {
+		feeTx, ok := tx.(sdk.FeeTx)
+		if !ok {
+			return nil, 0, sdkerrors.Wrap(sdkerrors.ErrTxDecode, "Tx must be a FeeTx")
+		}
+
+		feeCoins := feeTx.GetFee()
+		gas := feeTx.GetGas()
+
+		params := k.GetParams(ctx)
+		feeValue := params.FeeValue(feeCoins)
+
+		if ctx.IsCheckTx() {
+			if err := checkMinGasPrices(ctx, params, feeCoins, feeValue, gas); err != nil {
+				return nil, 0, err
+			}
+		}
+
+		if gas == 0 {
+			return feeCoins, 0, nil
+		}
+
+		required := sdk.ZeroDec()
+		if params.IsEnabled() {
+			required = k.GetBaseFee(ctx).MulInt64(int64(gas)).Ceil()
+		}
+
+		if feeValue.LT(required) {
+			requiredFee := sdk.NewCoin(params.BaseFeeDenom, required.RoundInt())
+			return nil, 0, sdkerrors.Wrapf(sdkerrors.ErrInsufficientFee, "insufficient fees; got: %s required: %s or its value in fee tokens", feeCoins, requiredFee)
+		}
+
+		return feeCoins, getTipPriority(feeValue.Sub(required), gas), nil
+	}
+}
+
+// checkMinGasPrices ensures that the provided fees meet the minimum gas prices
+// of the validator, where fee = ceil(minGasPrice * gasLimit). The fees paid in
+// whitelisted fee tokens are accepted at the min gas price of the base fee
+// denom, once converted.
+func checkMinGasPrices(ctx sdk.Context, params types.Params, feeCoins sdk.Coins, feeValue sdk.Dec, gas uint64) error {
+	minGasPrices := ctx.MinGasPrices()
+	if minGasPrices.IsZero() {
+		return nil
+	}
+
+	requiredFees := make(sdk.Coins, len(minGasPrices))
+	glDec := sdk.NewDec(int64(gas))
+	for i, gp := range minGasPrices {
+		fee := gp.Amount.Mul(glDec)
+		requiredFees[i] = sdk.NewCoin(gp.Denom, fee.Ceil().RoundInt())
+	}
+
+	if feeCoins.IsAnyGTE(requiredFees) {
+		return nil
+	}
+
+	if requiredValue := requiredFees.AmountOf(params.BaseFeeDenom); requiredValue.IsPositive() && feeValue.GTE(sdk.NewDecFromInt(requiredValue)) {
+		return nil
+	}
+
+	return sdkerrors.Wrapf(sdkerrors.ErrInsufficientFee, "insufficient fees; got: %s required: %s", feeCoins, requiredFees)
+}
+
+// getTipPriority returns the tip gas price, capped to math.MaxInt64.
+func getTipPriority(tip sdk.Dec, gas uint64) int64 {
+	tipPrice := tip.TruncateInt().Quo(sdk.NewIntFromUint64(gas))
+	if !tipPrice.IsInt64() {
+		return math.MaxInt64
+	}
+	return tipPrice.Int64()
+}
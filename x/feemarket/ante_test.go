@@ -0,0 +1,215 @@
+package feemarket_test
+
+import (
+	"math"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+
+	"github.com/cosmos/cosmos-sdk/simapp"
+	"github.com/cosmos/cosmos-sdk/testutil/testdata"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/x/auth/ante"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	banktestutil "github.com/cosmos/cosmos-sdk/x/bank/testutil"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	"github.com/cosmos/cosmos-sdk/x/feemarket"
+	"github.com/cosmos/cosmos-sdk/x/feemarket/types"
+)
+
+func TestTxFeeChecker(t *testing.T) {
+	app := simapp.Setup(t, false)
+	encCfg := simapp.MakeTestEncodingConfig()
+	checker := feemarket.NewTxFeeChecker(app.FeeMarketKeeper)
+
+	_, _, addr1 := testdata.KeyTestPubAddr()
+	_, _, addr2 := testdata.KeyTestPubAddr()
+	msg := banktypes.NewMsgSend(addr1, addr2, sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 1)))
+	denom := sdk.DefaultBondDenom
+	ibcDenom := "ibc/27394FB092D2ECCD56123C74F36E4C1F926001CEADA9CA97EA622B25F41E5EB2"
+
+	tests := []struct {
+		name        string
+		disabled    bool
+		checkTx     bool
+		minGasPrice sdk.DecCoins
+		fee         sdk.Coins
+		expPriority int64
+		expErr      bool
+	}{
+		{"exact base fee", false, false, nil, sdk.NewCoins(sdk.NewInt64Coin(denom, 1500)), 0, false},
+		{"with tip", false, false, nil, sdk.NewCoins(sdk.NewInt64Coin(denom, 3500)), 2, false},
+		{"tip priority is capped", false, false, nil, sdk.NewCoins(sdk.NewCoin(denom, sdk.NewIntFromUint64(math.MaxUint64).MulRaw(1000))), math.MaxInt64, false},
+		{"insufficient base fee", false, false, nil, sdk.NewCoins(sdk.NewInt64Coin(denom, 1499)), 0, true},
+		{"other denom", false, false, nil, sdk.NewCoins(sdk.NewInt64Coin("atom", 1500)), 0, true},
+		{"disabled", true, false, nil, sdk.NewCoins(sdk.NewInt64Coin(denom, 1)), 0, false},
+		{"min gas prices in check tx", false, true, sdk.NewDecCoins(sdk.NewInt64DecCoin(denom, 2)), sdk.NewCoins(sdk.NewInt64Coin(denom, 1500)), 0, true},
+		{"min gas prices in deliver tx", false, false, sdk.NewDecCoins(sdk.NewInt64DecCoin(denom, 2)), sdk.NewCoins(sdk.NewInt64Coin(denom, 1500)), 0, false},
+		{"fee token", false, false, nil, sdk.NewCoins(sdk.NewInt64Coin(ibcDenom, 3000)), 0, false},
+		{"fee token with tip", false, false, nil, sdk.NewCoins(sdk.NewInt64Coin(ibcDenom, 7000)), 2, false},
+		{"insufficient fee token", false, false, nil, sdk.NewCoins(sdk.NewInt64Coin(ibcDenom, 2999)), 0, true},
+		{"fee token and base fee denom", false, false, nil, sdk.NewCoins(sdk.NewInt64Coin(denom, 500), sdk.NewInt64Coin(ibcDenom, 2000)), 0, false},
+		{"fee token meets min gas prices", false, true, sdk.NewDecCoins(sdk.NewInt64DecCoin(denom, 2)), sdk.NewCoins(sdk.NewInt64Coin(ibcDenom, 4000)), 0, false},
+		{"fee token below min gas prices", false, true, sdk.NewDecCoins(sdk.NewInt64DecCoin(denom, 2)), sdk.NewCoins(sdk.NewInt64Coin(ibcDenom, 3999)), 0, true},
+		{"fee token without base denom min gas price", false, true, sdk.NewDecCoins(sdk.NewInt64DecCoin("atom", 2)), sdk.NewCoins(sdk.NewInt64Coin(ibcDenom, 4000)), 0, true},
+		{"disabled fee token priority", true, false, nil, sdk.NewCoins(sdk.NewInt64Coin(ibcDenom, 4000)), 2, false},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			ctx := app.BaseApp.NewContext(tc.checkTx, tmproto.Header{Height: 1}).WithMinGasPrices(tc.minGasPrice)
+
+			params := types.DefaultParams()
+			params.Enabled = !tc.disabled
+			params.MinBaseFee = sdk.NewDecWithPrec(1, 1)
+			params.FeeTokens = []types.FeeToken{types.NewFeeToken(ibcDenom, sdk.NewDecWithPrec(5, 1))}
+			app.FeeMarketKeeper.SetParams(ctx, params)
+			app.FeeMarketKeeper.SetBaseFee(ctx, sdk.NewDecWithPrec(15, 1))
+
+			txBuilder := encCfg.TxConfig.NewTxBuilder()
+			require.NoError(t, txBuilder.SetMsgs(msg))
+			txBuilder.SetGasLimit(1000)
+			txBuilder.SetFeeAmount(tc.fee)
+
+			// the fee market state is read on the tx gas meter
+			ctx = ctx.WithGasMeter(sdk.NewInfiniteGasMeter())
+			fee, priority, err := checker(ctx, txBuilder.GetTx())
+			require.Positive(t, ctx.GasMeter().GasConsumed())
+			if tc.expErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.fee, fee)
+			require.Equal(t, tc.expPriority, priority)
+		})
+	}
+}
+
+func TestDeductFeeTokens(t *testing.T) {
+	app := simapp.Setup(t, false)
+	encCfg := simapp.MakeTestEncodingConfig()
+	ctx := app.BaseApp.NewContext(false, tmproto.Header{Height: 1})
+	ibcDenom := "ibc/27394FB092D2ECCD56123C74F36E4C1F926001CEADA9CA97EA622B25F41E5EB2"
+
+	params := types.DefaultParams()
+	params.FeeTokens = []types.FeeToken{types.NewFeeToken(ibcDenom, sdk.NewDecWithPrec(5, 1))}
+	app.FeeMarketKeeper.SetParams(ctx, params)
+	app.FeeMarketKeeper.SetBaseFee(ctx, sdk.NewDec(1))
+
+	// the payer only holds the fee token
+	_, _, addr1 := testdata.KeyTestPubAddr()
+	_, _, addr2 := testdata.KeyTestPubAddr()
+	app.AccountKeeper.SetAccount(ctx, app.AccountKeeper.NewAccountWithAddress(ctx, addr1))
+	fee := sdk.NewCoins(sdk.NewInt64Coin(ibcDenom, 2000))
+	require.NoError(t, banktestutil.FundAccount(app.BankKeeper, ctx, addr1, fee))
+
+	anteHandler := sdk.ChainAnteDecorators(ante.NewDeductFeeDecorator(
+		app.AccountKeeper, app.BankKeeper, app.FeeGrantKeeper, feemarket.NewTxFeeChecker(app.FeeMarketKeeper),
+	))
+
+	txBuilder := encCfg.TxConfig.NewTxBuilder()
+	require.NoError(t, txBuilder.SetMsgs(banktypes.NewMsgSend(addr1, addr2, fee)))
+	txBuilder.SetGasLimit(1000)
+	txBuilder.SetFeeAmount(fee)
+
+	_, err := anteHandler(ctx, txBuilder.GetTx(), false)
+	require.NoError(t, err)
+
+	// the fee is collected in the fee token, to be allocated by x/distribution
+	require.True(t, app.BankKeeper.GetAllBalances(ctx, addr1).IsZero())
+	feeCollector := app.AccountKeeper.GetModuleAddress(authtypes.FeeCollectorName)
+	require.Equal(t, fee, app.BankKeeper.GetAllBalances(ctx, feeCollector))
+}
+
+func TestEndBlocker(t *testing.T) {
+	app := simapp.Setup(t, false)
+	ctx := app.BaseApp.NewContext(false, tmproto.Header{Height: 1})
+
+	params := types.DefaultParams()
+	params.Enabled = true
+	params.MinBaseFee = sdk.NewDec(1)
+	params.TargetBlockGas = 1000
+	app.FeeMarketKeeper.SetParams(ctx, params)
+	app.FeeMarketKeeper.SetBaseFee(ctx, sdk.NewDec(80))
+
+	ctx = ctx.WithBlockGasMeter(sdk.NewGasMeter(2000))
+	ctx.BlockGasMeter().ConsumeGas(2000, "test")
+	feemarket.EndBlocker(ctx, app.FeeMarketKeeper)
+	require.Equal(t, sdk.NewDec(90), app.FeeMarketKeeper.GetBaseFee(ctx))
+
+	// the base fee is not adjusted when the fee market is disabled
+	params.Enabled = false
+	app.FeeMarketKeeper.SetParams(ctx, params)
+	feemarket.EndBlocker(ctx, app.FeeMarketKeeper)
+	require.Equal(t, sdk.NewDec(90), app.FeeMarketKeeper.GetBaseFee(ctx))
+}
+
+func TestEndBlockerFromDefaultGenesis(t *testing.T) {
+	app := simapp.Setup(t, false)
+	ctx := app.BaseApp.NewContext(false, tmproto.Header{Height: 1})
+
+	// the fee market cannot be enabled without a min base fee, and the initial
+	// base fee is not lower than it
+	genState := types.DefaultGenesisState()
+	genState.Params.Enabled = true
+	require.Error(t, genState.Validate())
+
+	genState.Params.MinBaseFee = sdk.NewDecWithPrec(1, 2)
+	genState.Params.TargetBlockGas = 1000
+	require.Error(t, genState.Validate())
+
+	genState.BaseFee = genState.Params.MinBaseFee
+	require.NoError(t, genState.Validate())
+	app.FeeMarketKeeper.InitGenesis(ctx, *genState)
+
+	// congested blocks raise the base fee
+	for i := 0; i < 3; i++ {
+		ctx = ctx.WithBlockGasMeter(sdk.NewGasMeter(2000))
+		ctx.BlockGasMeter().ConsumeGas(2000, "test")
+		feemarket.EndBlocker(ctx, app.FeeMarketKeeper)
+	}
+	require.Equal(t, sdk.MustNewDecFromStr("0.014238281250000000"), app.FeeMarketKeeper.GetBaseFee(ctx))
+}
+
+func TestParamChangeProposalWithoutMinBaseFee(t *testing.T) {
+	app := simapp.Setup(t, false)
+	ctx := app.BaseApp.NewContext(false, tmproto.Header{Height: 1})
+	encCfg := simapp.MakeTestEncodingConfig()
+	checker := feemarket.NewTxFeeChecker(app.FeeMarketKeeper)
+
+	params := types.DefaultParams()
+	params.TargetBlockGas = 1000
+	app.FeeMarketKeeper.SetParams(ctx, params)
+
+	// a parameter change proposal only validates the changed param, so it
+	// enables the fee market without a min base fee
+	subspace := app.GetSubspace(types.ModuleName)
+	require.NoError(t, subspace.Update(ctx, types.KeyEnabled, []byte("true")))
+	require.True(t, app.FeeMarketKeeper.GetParams(ctx).Enabled)
+	require.False(t, app.FeeMarketKeeper.GetParams(ctx).IsEnabled())
+
+	// the fee market is then treated as disabled
+	_, _, addr1 := testdata.KeyTestPubAddr()
+	txBuilder := encCfg.TxConfig.NewTxBuilder()
+	require.NoError(t, txBuilder.SetMsgs(banktypes.NewMsgSend(addr1, addr1, sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 1)))))
+	txBuilder.SetGasLimit(1000)
+	_, _, err := checker(ctx.WithGasMeter(sdk.NewInfiniteGasMeter()), txBuilder.GetTx())
+	require.NoError(t, err)
+
+	ctx = ctx.WithBlockGasMeter(sdk.NewGasMeter(2000))
+	ctx.BlockGasMeter().ConsumeGas(2000, "test")
+	feemarket.EndBlocker(ctx, app.FeeMarketKeeper)
+	require.Empty(t, ctx.EventManager().Events())
+
+	// until a min base fee is set
+	require.NoError(t, subspace.Update(ctx, types.KeyMinBaseFee, []byte(`"1.000000000000000000"`)))
+	require.True(t, app.FeeMarketKeeper.GetParams(ctx).IsEnabled())
+	_, _, err = checker(ctx.WithGasMeter(sdk.NewInfiniteGasMeter()), txBuilder.GetTx())
+	require.ErrorIs(t, err, sdkerrors.ErrInsufficientFee)
+
+	feemarket.EndBlocker(ctx, app.FeeMarketKeeper)
+	require.Equal(t, sdk.MustNewDecFromStr("1.125"), app.FeeMarketKeeper.GetBaseFee(ctx))
+}
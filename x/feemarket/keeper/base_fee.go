@@ -0,0 +1,57 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/feemarket/types"
+)
+
+// TargetBlockGas returns the block gas usage the base fee converges to, zero
+// if there is no target because the block gas is not limited.
+func (k Keeper) TargetBlockGas(ctx sdk.Context, params types.Params) uint64 {
+	if params.TargetBlockGas > 0 {
+		return params.TargetBlockGas
+	}
+
+	cp := ctx.ConsensusParams()
+	if cp == nil || cp.Block == nil || cp.Block.MaxGas <= 0 {
+		return 0
+	}
+
+	return uint64(cp.Block.MaxGas) / uint64(params.ElasticityMultiplier)
+}
+
+// NextBaseFee computes the base fee of the next block from the current base
+// fee and the gas used by the current block. The base fee moves towards the
+// target block gas by at most 1/BaseFeeChangeDenominator of its value:
+//
+//	next = base + base * (gasUsed - target) / target / BaseFeeChangeDenominator
+//
+// and never goes below the min base fee.
+func (k Keeper) NextBaseFee(ctx sdk.Context, baseFee sdk.Dec, gasUsed uint64) sdk.Dec {
+	params := k.GetParams(ctx)
+	target := k.TargetBlockGas(ctx, params)
+	if target == 0 || gasUsed == target {
+		return sdk.MaxDec(baseFee, params.MinBaseFee)
+	}
+
+	// the block gas usage is bounded by the max block gas, and at most one
+	// transaction exceeds it
+	if gasUsed > target*uint64(params.ElasticityMultiplier) {
+		gasUsed = target * uint64(params.ElasticityMultiplier)
+	}
+
+	delta := sdk.NewDecFromInt(sdk.NewIntFromUint64(gasUsed)).
+		Sub(sdk.NewDecFromInt(sdk.NewIntFromUint64(target))).
+		QuoInt64(int64(target)).
+		QuoInt64(int64(params.BaseFeeChangeDenominator))
+
+	return sdk.MaxDec(baseFee.Add(baseFee.Mul(delta)), params.MinBaseFee)
+}
+
+// UpdateBaseFee sets the base fee of the next block from the gas used by the
+// current block and returns it.
+func (k Keeper) UpdateBaseFee(ctx sdk.Context, gasUsed uint64) sdk.Dec {
+	baseFee := k.NextBaseFee(ctx, k.GetBaseFee(ctx), gasUsed)
+	k.SetBaseFee(ctx, baseFee)
+	return baseFee
+}
@@ -0,0 +1,118 @@
+package keeper_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	"github.com/cosmos/cosmos-sdk/simapp"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/feemarket/types"
+)
+
+type KeeperTestSuite struct {
+	suite.Suite
+
+	app         *simapp.SimApp
+	ctx         sdk.Context
+	queryClient types.QueryClient
+}
+
+func (s *KeeperTestSuite) SetupTest() {
+	s.app = simapp.Setup(s.T(), false)
+	s.ctx = s.app.BaseApp.NewContext(false, tmproto.Header{Height: 1})
+
+	queryHelper := baseapp.NewQueryServerTestHelper(s.ctx, s.app.InterfaceRegistry())
+	types.RegisterQueryServer(queryHelper, s.app.FeeMarketKeeper)
+	s.queryClient = types.NewQueryClient(queryHelper)
+}
+
+func TestKeeperTestSuite(t *testing.T) {
+	suite.Run(t, new(KeeperTestSuite))
+}
+
+func (s *KeeperTestSuite) setParams(minBaseFee sdk.Dec, targetBlockGas uint64) {
+	params := types.DefaultParams()
+	params.MinBaseFee = minBaseFee
+	params.TargetBlockGas = targetBlockGas
+	s.app.FeeMarketKeeper.SetParams(s.ctx, params)
+}
+
+func (s *KeeperTestSuite) TestBaseFee() {
+	k := s.app.FeeMarketKeeper
+	s.setParams(sdk.NewDec(1), 1000)
+
+	// the base fee is never lower than the min base fee
+	k.SetBaseFee(s.ctx, sdk.ZeroDec())
+	s.Require().Equal(sdk.NewDec(1), k.GetBaseFee(s.ctx))
+
+	k.SetBaseFee(s.ctx, sdk.NewDec(8))
+	s.Require().Equal(sdk.NewDec(8), k.GetBaseFee(s.ctx))
+
+	res, err := s.queryClient.BaseFee(s.ctx.Context(), &types.QueryBaseFeeRequest{})
+	s.Require().NoError(err)
+	s.Require().Equal(sdk.NewDec(8), res.BaseFee)
+	s.Require().Equal(sdk.DefaultBondDenom, res.Denom)
+}
+
+func (s *KeeperTestSuite) TestNextBaseFee() {
+	k := s.app.FeeMarketKeeper
+	baseFee := sdk.NewDec(80)
+
+	tests := []struct {
+		name       string
+		minBaseFee sdk.Dec
+		target     uint64
+		gasUsed    uint64
+		exp        sdk.Dec
+	}{
+		{"at target", sdk.ZeroDec(), 1000, 1000, sdk.NewDec(80)},
+		{"full block", sdk.ZeroDec(), 1000, 2000, sdk.NewDec(90)},
+		{"above max block gas", sdk.ZeroDec(), 1000, 5000, sdk.NewDec(90)},
+		{"half full block", sdk.ZeroDec(), 1000, 1500, sdk.NewDec(85)},
+		{"empty block", sdk.ZeroDec(), 1000, 0, sdk.NewDec(70)},
+		{"min base fee", sdk.NewDec(75), 1000, 0, sdk.NewDec(75)},
+	}
+
+	for _, tc := range tests {
+		s.Run(tc.name, func() {
+			s.setParams(tc.minBaseFee, tc.target)
+			s.Require().Equal(tc.exp, k.NextBaseFee(s.ctx, baseFee, tc.gasUsed))
+		})
+	}
+}
+
+func (s *KeeperTestSuite) TestTargetBlockGas() {
+	k := s.app.FeeMarketKeeper
+	params := types.DefaultParams()
+
+	// the default target is half of the max block gas
+	cp := s.app.GetConsensusParams(s.ctx)
+	cp.Block.MaxGas = 1000
+	ctx := s.ctx.WithConsensusParams(cp)
+	s.Require().Equal(uint64(500), k.TargetBlockGas(ctx, params))
+
+	// no target when the block gas is not limited
+	cp.Block.MaxGas = -1
+	s.Require().Zero(k.TargetBlockGas(s.ctx.WithConsensusParams(cp), params))
+
+	params.TargetBlockGas = 300
+	s.Require().Equal(uint64(300), k.TargetBlockGas(ctx, params))
+}
+
+func (s *KeeperTestSuite) TestGenesis() {
+	k := s.app.FeeMarketKeeper
+	s.setParams(sdk.NewDec(1), 1000)
+	k.SetBaseFee(s.ctx, sdk.NewDec(5))
+
+	genState := k.ExportGenesis(s.ctx)
+	s.Require().NoError(genState.Validate())
+
+	s.SetupTest()
+	k = s.app.FeeMarketKeeper
+	k.InitGenesis(s.ctx, *genState)
+	s.Require().Equal(genState.Params, k.GetParams(s.ctx))
+	s.Require().Equal(sdk.NewDec(5), k.GetBaseFee(s.ctx))
+}
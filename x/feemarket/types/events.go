@@ -0,0 +1,9 @@
+package types
+
+// fee market module event types
+const (
+	EventTypeFeeMarket = "fee_market"
+
+	AttributeKeyBaseFee      = "base_fee"
+	AttributeKeyBlockGasUsed = "block_gas_used"
+)
@@ -0,0 +1,18 @@
+package types
+
+const (
+	// ModuleName is the name of the fee market module
+	ModuleName = "feemarket"
+
+	// StoreKey is the string store representation
+	StoreKey = ModuleName
+
+	// RouterKey is the msg router key for the fee market module
+	RouterKey = ModuleName
+
+	// QuerierRoute defines the module's query routing key
+	QuerierRoute = ModuleName
+)
+
+// BaseFeeKey is the key of the base gas price of the next block.
+var BaseFeeKey = []byte{0x01}
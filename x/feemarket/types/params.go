@@ -0,0 +1,224 @@
+package types
+
+import (
+	"fmt"
+
+	"sigs.k8s.io/yaml"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
+)
+
+// Default parameter values
+const (
+	DefaultElasticityMultiplier     uint32 = 2
+	DefaultBaseFeeChangeDenominator uint32 = 8
+)
+
+// Parameter store keys
+var (
+	KeyEnabled                  = []byte("Enabled")
+	KeyBaseFeeDenom             = []byte("BaseFeeDenom")
+	KeyMinBaseFee               = []byte("MinBaseFee")
+	KeyTargetBlockGas           = []byte("TargetBlockGas")
+	KeyElasticityMultiplier     = []byte("ElasticityMultiplier")
+	KeyBaseFeeChangeDenominator = []byte("BaseFeeChangeDenominator")
+	KeyFeeTokens                = []byte("FeeTokens")
+)
+
+var _ paramtypes.ParamSet = (*Params)(nil)
+
+// ParamKeyTable for the fee market module.
+func ParamKeyTable() paramtypes.KeyTable {
+	return paramtypes.NewKeyTable().RegisterParamSet(&Params{})
+}
+
+// DefaultParams returns the default fee market module parameters. The fee
+// market is disabled until a chain sets a min base fee: the base fee changes
+// by a fraction of its value, so it never rises from zero.
+func DefaultParams() Params {
+	return Params{
+		Enabled:                  false,
+		BaseFeeDenom:             sdk.DefaultBondDenom,
+		MinBaseFee:               sdk.ZeroDec(),
+		TargetBlockGas:           0,
+		ElasticityMultiplier:     DefaultElasticityMultiplier,
+		BaseFeeChangeDenominator: DefaultBaseFeeChangeDenominator,
+		FeeTokens:                []FeeToken{},
+	}
+}
+
+// NewFeeToken creates a new FeeToken instance
+func NewFeeToken(denom string, conversionRate sdk.Dec) FeeToken {
+	return FeeToken{Denom: denom, ConversionRate: conversionRate}
+}
+
+// Validate validates the params. A parameter change proposal only runs the
+// validation of the params it changes, so the checks across params are not
+// enforced on it: IsEnabled and ConversionRate handle the params breaking them.
+func (p Params) Validate() error {
+	if err := validateBool(p.Enabled); err != nil {
+		return err
+	}
+	if err := validateBaseFeeDenom(p.BaseFeeDenom); err != nil {
+		return err
+	}
+	if err := validateMinBaseFee(p.MinBaseFee); err != nil {
+		return err
+	}
+	if err := validateTargetBlockGas(p.TargetBlockGas); err != nil {
+		return err
+	}
+	if err := validatePositiveUint32(p.ElasticityMultiplier); err != nil {
+		return err
+	}
+	if err := validatePositiveUint32(p.BaseFeeChangeDenominator); err != nil {
+		return err
+	}
+	if err := validateFeeTokens(p.FeeTokens); err != nil {
+		return err
+	}
+
+	if p.Enabled && !p.MinBaseFee.IsPositive() {
+		return fmt.Errorf("min base fee must be positive when the fee market is enabled: %s", p.MinBaseFee)
+	}
+
+	for _, feeToken := range p.FeeTokens {
+		if feeToken.Denom == p.BaseFeeDenom {
+			return fmt.Errorf("fee token %s is the base fee denom", feeToken.Denom)
+		}
+	}
+
+	return nil
+}
+
+// IsEnabled returns whether the base fee is enforced and adjusted. A fee market
+// enabled with a non-positive min base fee, which a parameter change proposal
+// can set, is treated as disabled, as its base fee would never rise from zero.
+func (p Params) IsEnabled() bool {
+	return p.Enabled && !p.MinBaseFee.IsNil() && p.MinBaseFee.IsPositive()
+}
+
+// ConversionRate returns the amount of base fee denom one unit of denom is
+// worth, and false if denom is not accepted to pay fees. A fee token with the
+// base fee denom, which a parameter change proposal can set, is ignored.
+func (p Params) ConversionRate(denom string) (sdk.Dec, bool) {
+	if denom == p.BaseFeeDenom {
+		return sdk.OneDec(), true
+	}
+
+	for _, feeToken := range p.FeeTokens {
+		if feeToken.Denom == denom {
+			return feeToken.ConversionRate, true
+		}
+	}
+
+	return sdk.Dec{}, false
+}
+
+// FeeValue returns the value of fee in base fee denom. The coins which are not
+// accepted to pay fees are ignored.
+func (p Params) FeeValue(fee sdk.Coins) sdk.Dec {
+	value := sdk.ZeroDec()
+	for _, coin := range fee {
+		if rate, ok := p.ConversionRate(coin.Denom); ok {
+			value = value.Add(rate.MulInt(coin.Amount))
+		}
+	}
+
+	return value
+}
+
+// String implements the Stringer interface.
+func (p Params) String() string {
+	out, _ := yaml.Marshal(p)
+	return string(out)
+}
+
+// ParamSetPairs implements params.ParamSet
+func (p *Params) ParamSetPairs() paramtypes.ParamSetPairs {
+	return paramtypes.ParamSetPairs{
+		paramtypes.NewParamSetPair(KeyEnabled, &p.Enabled, validateBool),
+		paramtypes.NewParamSetPair(KeyBaseFeeDenom, &p.BaseFeeDenom, validateBaseFeeDenom),
+		paramtypes.NewParamSetPair(KeyMinBaseFee, &p.MinBaseFee, validateMinBaseFee),
+		paramtypes.NewParamSetPair(KeyTargetBlockGas, &p.TargetBlockGas, validateTargetBlockGas),
+		paramtypes.NewParamSetPair(KeyElasticityMultiplier, &p.ElasticityMultiplier, validatePositiveUint32),
+		paramtypes.NewParamSetPair(KeyBaseFeeChangeDenominator, &p.BaseFeeChangeDenominator, validatePositiveUint32),
+		paramtypes.NewParamSetPair(KeyFeeTokens, &p.FeeTokens, validateFeeTokens),
+	}
+}
+
+func validateBool(i interface{}) error {
+	if _, ok := i.(bool); !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	return nil
+}
+
+func validateBaseFeeDenom(i interface{}) error {
+	v, ok := i.(string)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	return sdk.ValidateDenom(v)
+}
+
+func validateMinBaseFee(i interface{}) error {
+	v, ok := i.(sdk.Dec)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if v.IsNil() || v.IsNegative() {
+		return fmt.Errorf("min base fee must not be negative: %s", v)
+	}
+
+	return nil
+}
+
+func validateTargetBlockGas(i interface{}) error {
+	if _, ok := i.(uint64); !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	return nil
+}
+
+func validatePositiveUint32(i interface{}) error {
+	v, ok := i.(uint32)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if v == 0 {
+		return fmt.Errorf("parameter must be positive")
+	}
+
+	return nil
+}
+
+func validateFeeTokens(i interface{}) error {
+	v, ok := i.([]FeeToken)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	seen := make(map[string]bool, len(v))
+	for _, feeToken := range v {
+		if err := sdk.ValidateDenom(feeToken.Denom); err != nil {
+			return err
+		}
+		if seen[feeToken.Denom] {
+			return fmt.Errorf("duplicate fee token %s", feeToken.Denom)
+		}
+		seen[feeToken.Denom] = true
+
+		if feeToken.ConversionRate.IsNil() || !feeToken.ConversionRate.IsPositive() {
+			return fmt.Errorf("conversion rate of fee token %s must be positive: %s", feeToken.Denom, feeToken.ConversionRate)
+		}
+	}
+
+	return nil
+}
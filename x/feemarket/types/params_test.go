@@ -0,0 +1,68 @@
+package types_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/feemarket/types"
+)
+
+func TestParamsValidate(t *testing.T) {
+	feeToken := types.NewFeeToken("atom", sdk.NewDecWithPrec(5, 1))
+
+	tests := []struct {
+		name      string
+		feeTokens []types.FeeToken
+		expErr    bool
+	}{
+		{"no fee tokens", nil, false},
+		{"fee token", []types.FeeToken{feeToken}, false},
+		{"duplicate fee token", []types.FeeToken{feeToken, feeToken}, true},
+		{"base fee denom", []types.FeeToken{types.NewFeeToken(sdk.DefaultBondDenom, sdk.OneDec())}, true},
+		{"invalid denom", []types.FeeToken{types.NewFeeToken("1atom", sdk.OneDec())}, true},
+		{"zero conversion rate", []types.FeeToken{types.NewFeeToken("atom", sdk.ZeroDec())}, true},
+		{"nil conversion rate", []types.FeeToken{{Denom: "atom"}}, true},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			params := types.DefaultParams()
+			params.FeeTokens = tc.feeTokens
+			err := params.Validate()
+			if tc.expErr {
+				require.Error(t, err)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestParamsValidateMinBaseFee(t *testing.T) {
+	params := types.DefaultParams()
+	require.NoError(t, params.Validate())
+
+	// the base fee never rises from zero
+	params.Enabled = true
+	require.Error(t, params.Validate())
+
+	params.MinBaseFee = sdk.NewDecWithPrec(1, 2)
+	require.NoError(t, params.Validate())
+}
+
+func TestFeeValue(t *testing.T) {
+	params := types.DefaultParams()
+	params.FeeTokens = []types.FeeToken{types.NewFeeToken("atom", sdk.NewDecWithPrec(5, 1))}
+
+	fee := sdk.NewCoins(
+		sdk.NewInt64Coin(sdk.DefaultBondDenom, 10),
+		sdk.NewInt64Coin("atom", 10),
+		sdk.NewInt64Coin("other", 10),
+	)
+	require.Equal(t, sdk.NewDec(15), params.FeeValue(fee))
+
+	_, ok := params.ConversionRate("other")
+	require.False(t, ok)
+}
@@ -0,0 +1,128 @@
+package cli
+
+import (
+	"fmt"
+
+	"github.com/spf13/cobra"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/version"
+	"github.com/cosmos/cosmos-sdk/x/freeze/types"
+)
+
+// GetQueryCmd returns the cli query commands for this module
+func GetQueryCmd() *cobra.Command {
+	freezeQueryCmd := &cobra.Command{
+		Use:                        types.ModuleName,
+		Short:                      "Querying commands for the freeze module",
+		DisableFlagParsing:         true,
+		SuggestionsMinimumDistance: 2,
+		RunE:                       client.ValidateCmd,
+	}
+
+	freezeQueryCmd.AddCommand(
+		GetCmdQueryFrozenStatus(),
+		GetCmdQueryFrozenAccounts(),
+		GetCmdQueryFrozenDenoms(),
+	)
+	return freezeQueryCmd
+}
+
+// GetCmdQueryFrozenStatus implements the query frozen status command.
+func GetCmdQueryFrozenStatus() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "frozen-status [denom] [address]",
+		Args:    cobra.ExactArgs(2),
+		Short:   "query whether an account is frozen for a denom, and whether the denom is globally frozen",
+		Example: fmt.Sprintf(`$ %s query %s frozen-status factory/<issuer>/usd <address>`, version.AppName, types.ModuleName),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			if _, err := sdk.AccAddressFromBech32(args[1]); err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+			res, err := queryClient.FrozenStatus(cmd.Context(), &types.QueryFrozenStatusRequest{
+				Denom:   args[0],
+				Address: args[1],
+			})
+			if err != nil {
+				return err
+			}
+			return clientCtx.PrintProto(res)
+		},
+	}
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+// GetCmdQueryFrozenAccounts implements the query frozen accounts command.
+func GetCmdQueryFrozenAccounts() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "frozen-accounts [denom]",
+		Args:    cobra.ExactArgs(1),
+		Short:   "query the accounts frozen for a denom",
+		Example: fmt.Sprintf(`$ %s query %s frozen-accounts factory/<issuer>/usd`, version.AppName, types.ModuleName),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+			res, err := queryClient.FrozenAccounts(cmd.Context(), &types.QueryFrozenAccountsRequest{
+				Denom:      args[0],
+				Pagination: pageReq,
+			})
+			if err != nil {
+				return err
+			}
+			return clientCtx.PrintProto(res)
+		},
+	}
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "frozen-accounts")
+	return cmd
+}
+
+// GetCmdQueryFrozenDenoms implements the query frozen denoms command.
+func GetCmdQueryFrozenDenoms() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "frozen-denoms",
+		Args:    cobra.NoArgs,
+		Short:   "query the globally frozen denoms",
+		Example: fmt.Sprintf(`$ %s query %s frozen-denoms`, version.AppName, types.ModuleName),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+			res, err := queryClient.FrozenDenoms(cmd.Context(), &types.QueryFrozenDenomsRequest{Pagination: pageReq})
+			if err != nil {
+				return err
+			}
+			return clientCtx.PrintProto(res)
+		},
+	}
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "frozen-denoms")
+	return cmd
+}
@@ -0,0 +1,129 @@
+package cli
+
+import (
+	"fmt"
+
+	"github.com/spf13/cobra"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	"github.com/cosmos/cosmos-sdk/version"
+	"github.com/cosmos/cosmos-sdk/x/freeze/types"
+)
+
+// GetTxCmd returns the transaction commands for this module
+func GetTxCmd() *cobra.Command {
+	freezeTxCmd := &cobra.Command{
+		Use:                        types.ModuleName,
+		Short:                      "freeze transactions subcommands",
+		DisableFlagParsing:         true,
+		SuggestionsMinimumDistance: 2,
+		RunE:                       client.ValidateCmd,
+	}
+
+	freezeTxCmd.AddCommand(
+		NewCmdFreezeAccount(),
+		NewCmdUnfreezeAccount(),
+		NewCmdFreezeDenom(),
+		NewCmdUnfreezeDenom(),
+	)
+
+	return freezeTxCmd
+}
+
+// NewCmdFreezeAccount returns a CLI command handler for creating a
+// MsgFreezeAccount transaction.
+func NewCmdFreezeAccount() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "freeze-account [denom] [address]",
+		Args:  cobra.ExactArgs(2),
+		Short: "freeze an account for a denom",
+		Example: fmt.Sprintf(`$ %s tx %s freeze-account factory/<issuer>/usd <address> --from <issuer>`,
+			version.AppName, types.ModuleName),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgFreezeAccount(clientCtx.GetFromAddress().String(), args[0], args[1])
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+// NewCmdUnfreezeAccount returns a CLI command handler for creating a
+// MsgUnfreezeAccount transaction.
+func NewCmdUnfreezeAccount() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "unfreeze-account [denom] [address]",
+		Args:  cobra.ExactArgs(2),
+		Short: "unfreeze an account for a denom",
+		Example: fmt.Sprintf(`$ %s tx %s unfreeze-account factory/<issuer>/usd <address> --from <issuer>`,
+			version.AppName, types.ModuleName),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgUnfreezeAccount(clientCtx.GetFromAddress().String(), args[0], args[1])
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+// NewCmdFreezeDenom returns a CLI command handler for creating a
+// MsgFreezeDenom transaction.
+func NewCmdFreezeDenom() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "freeze-denom [denom]",
+		Args:  cobra.ExactArgs(1),
+		Short: "freeze a denom globally",
+		Example: fmt.Sprintf(`$ %s tx %s freeze-denom factory/<issuer>/usd --from <issuer>`,
+			version.AppName, types.ModuleName),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgFreezeDenom(clientCtx.GetFromAddress().String(), args[0])
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+// NewCmdUnfreezeDenom returns a CLI command handler for creating a
+// MsgUnfreezeDenom transaction.
+func NewCmdUnfreezeDenom() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "unfreeze-denom [denom]",
+		Args:  cobra.ExactArgs(1),
+		Short: "unfreeze a globally frozen denom",
+		Example: fmt.Sprintf(`$ %s tx %s unfreeze-denom factory/<issuer>/usd --from <issuer>`,
+			version.AppName, types.ModuleName),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgUnfreezeDenom(clientCtx.GetFromAddress().String(), args[0])
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
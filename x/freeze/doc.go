@@ -0,0 +1,10 @@
+/*
+Package freeze implements compliance controls on the denoms of a chain.
+
+The issuer of a denom, which is the admin of the denoms created by the token
+factory, and the module authority can freeze accounts for a denom, and freeze a
+denom globally. The module registers a bank send restriction which rejects the
+transfers of frozen coins, and a bank frozen coins hook which excludes them
+from the spendable coins of the accounts.
+*/
+package freeze
@@ -0,0 +1,41 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/freeze/types"
+)
+
+// InitGenesis sets the frozen accounts and denoms from the genesis state.
+func (k Keeper) InitGenesis(ctx sdk.Context, genState *types.GenesisState) {
+	for _, fa := range genState.FrozenAccounts {
+		addr, err := sdk.AccAddressFromBech32(fa.Address)
+		if err != nil {
+			panic(err)
+		}
+		k.FreezeAccount(ctx, fa.Denom, addr)
+	}
+
+	for _, denom := range genState.FrozenDenoms {
+		k.FreezeDenom(ctx, denom)
+	}
+}
+
+// ExportGenesis returns the freeze module's exported genesis.
+func (k Keeper) ExportGenesis(ctx sdk.Context) *types.GenesisState {
+	frozenAccounts := []types.FrozenAccount{}
+	k.IterateFrozenAccounts(ctx, func(denom string, addr sdk.AccAddress) bool {
+		frozenAccounts = append(frozenAccounts, types.FrozenAccount{
+			Denom:   denom,
+			Address: addr.String(),
+		})
+		return false
+	})
+
+	frozenDenoms := []string{}
+	k.IterateFrozenDenoms(ctx, func(denom string) bool {
+		frozenDenoms = append(frozenDenoms, denom)
+		return false
+	})
+
+	return types.NewGenesisState(frozenAccounts, frozenDenoms)
+}
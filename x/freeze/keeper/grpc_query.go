@@ -0,0 +1,83 @@
+package keeper
+
+import (
+	"context"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"github.com/cosmos/cosmos-sdk/x/freeze/types"
+)
+
+var _ types.QueryServer = Keeper{}
+
+// FrozenStatus implements the Query/FrozenStatus gRPC method
+func (k Keeper) FrozenStatus(c context.Context, req *types.QueryFrozenStatusRequest) (*types.QueryFrozenStatusResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	if err := sdk.ValidateDenom(req.Denom); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	addr, err := sdk.AccAddressFromBech32(req.Address)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid address: %s", err)
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	return &types.QueryFrozenStatusResponse{
+		AccountFrozen: k.IsAccountFrozen(ctx, req.Denom, addr),
+		DenomFrozen:   k.IsDenomFrozen(ctx, req.Denom),
+	}, nil
+}
+
+// FrozenAccounts implements the Query/FrozenAccounts gRPC method
+func (k Keeper) FrozenAccounts(c context.Context, req *types.QueryFrozenAccountsRequest) (*types.QueryFrozenAccountsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	if err := sdk.ValidateDenom(req.Denom); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.GetFrozenAccountsPrefix(req.Denom))
+
+	addresses := []string{}
+	pageRes, err := query.Paginate(store, req.Pagination, func(key []byte, _ []byte) error {
+		addresses = append(addresses, sdk.AccAddress(key).String())
+		return nil
+	})
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryFrozenAccountsResponse{Addresses: addresses, Pagination: pageRes}, nil
+}
+
+// FrozenDenoms implements the Query/FrozenDenoms gRPC method
+func (k Keeper) FrozenDenoms(c context.Context, req *types.QueryFrozenDenomsRequest) (*types.QueryFrozenDenomsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.FrozenDenomPrefix)
+
+	denoms := []string{}
+	pageRes, err := query.Paginate(store, req.Pagination, func(key []byte, _ []byte) error {
+		denoms = append(denoms, string(key))
+		return nil
+	})
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryFrozenDenomsResponse{Denoms: denoms, Pagination: pageRes}, nil
+}
@@ -0,0 +1,46 @@
+package keeper_test
+
+import (
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"github.com/cosmos/cosmos-sdk/x/freeze/types"
+)
+
+func (s *KeeperTestSuite) TestGRPCQueries() {
+	queryHelper := baseapp.NewQueryServerTestHelper(s.ctx, s.app.InterfaceRegistry())
+	types.RegisterQueryServer(queryHelper, s.app.FreezeKeeper)
+	queryClient := types.NewQueryClient(queryHelper)
+	ctx := s.ctx.Context()
+
+	s.app.FreezeKeeper.FreezeAccount(s.ctx, s.denom, s.addrs[0])
+	s.app.FreezeKeeper.FreezeAccount(s.ctx, s.denom, s.addrs[1])
+	s.app.FreezeKeeper.FreezeAccount(s.ctx, sdk.DefaultBondDenom, s.addrs[2])
+	s.app.FreezeKeeper.FreezeDenom(s.ctx, sdk.DefaultBondDenom)
+
+	status, err := queryClient.FrozenStatus(ctx, &types.QueryFrozenStatusRequest{Denom: s.denom, Address: s.addrs[0].String()})
+	s.Require().NoError(err)
+	s.Require().Equal(&types.QueryFrozenStatusResponse{AccountFrozen: true, DenomFrozen: false}, status)
+
+	status, err = queryClient.FrozenStatus(ctx, &types.QueryFrozenStatusRequest{Denom: sdk.DefaultBondDenom, Address: s.addrs[0].String()})
+	s.Require().NoError(err)
+	s.Require().Equal(&types.QueryFrozenStatusResponse{AccountFrozen: false, DenomFrozen: true}, status)
+
+	_, err = queryClient.FrozenStatus(ctx, &types.QueryFrozenStatusRequest{Denom: s.denom, Address: "invalid"})
+	s.Require().Error(err)
+
+	accounts, err := queryClient.FrozenAccounts(ctx, &types.QueryFrozenAccountsRequest{
+		Denom:      s.denom,
+		Pagination: &query.PageRequest{CountTotal: true},
+	})
+	s.Require().NoError(err)
+	s.Require().ElementsMatch([]string{s.addrs[0].String(), s.addrs[1].String()}, accounts.Addresses)
+	s.Require().Equal(uint64(2), accounts.Pagination.Total)
+
+	_, err = queryClient.FrozenAccounts(ctx, &types.QueryFrozenAccountsRequest{Denom: "1invalid"})
+	s.Require().Error(err)
+
+	denoms, err := queryClient.FrozenDenoms(ctx, &types.QueryFrozenDenomsRequest{})
+	s.Require().NoError(err)
+	s.Require().Equal([]string{sdk.DefaultBondDenom}, denoms.Denoms)
+}
@@ -0,0 +1,121 @@
+package keeper
+
+import (
+	"github.com/tendermint/tendermint/libs/log"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/freeze/types"
+)
+
+// Keeper of the freeze store
+type Keeper struct {
+	cdc      codec.BinaryCodec
+	storeKey storetypes.StoreKey
+
+	tokenFactoryKeeper types.TokenFactoryKeeper
+
+	// the address capable of freezing any denom, on top of the issuers of the
+	// denoms. Typically, this should be the x/gov module account.
+	authority string
+}
+
+// NewKeeper creates a new freeze Keeper instance
+func NewKeeper(cdc codec.BinaryCodec, storeKey storetypes.StoreKey, tfk types.TokenFactoryKeeper, authority string) Keeper {
+	if _, err := sdk.AccAddressFromBech32(authority); err != nil {
+		panic(err)
+	}
+
+	return Keeper{
+		cdc:                cdc,
+		storeKey:           storeKey,
+		tokenFactoryKeeper: tfk,
+		authority:          authority,
+	}
+}
+
+// GetAuthority returns the x/freeze module's authority.
+func (k Keeper) GetAuthority() string {
+	return k.authority
+}
+
+// Logger returns a module-specific logger.
+func (k Keeper) Logger(ctx sdk.Context) log.Logger {
+	return ctx.Logger().With("module", "x/"+types.ModuleName)
+}
+
+// GetIssuer returns the issuer of a denom, which is the admin of the denoms
+// created by the token factory, and an empty string for the other denoms.
+func (k Keeper) GetIssuer(ctx sdk.Context, denom string) string {
+	metadata, err := k.tokenFactoryKeeper.GetAuthorityMetadata(ctx, denom)
+	if err != nil {
+		return ""
+	}
+	return metadata.Admin
+}
+
+// IsAccountFrozen returns true if the account is frozen for the denom.
+func (k Keeper) IsAccountFrozen(ctx sdk.Context, denom string, addr sdk.AccAddress) bool {
+	store := ctx.KVStore(k.storeKey)
+	return store.Has(types.GetFrozenAccountKey(denom, addr))
+}
+
+// FreezeAccount freezes an account for a denom.
+func (k Keeper) FreezeAccount(ctx sdk.Context, denom string, addr sdk.AccAddress) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.GetFrozenAccountKey(denom, addr), []byte{})
+}
+
+// UnfreezeAccount unfreezes an account for a denom.
+func (k Keeper) UnfreezeAccount(ctx sdk.Context, denom string, addr sdk.AccAddress) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.GetFrozenAccountKey(denom, addr))
+}
+
+// IterateFrozenAccounts iterates over the accounts frozen for any denom.
+func (k Keeper) IterateFrozenAccounts(ctx sdk.Context, handler func(denom string, addr sdk.AccAddress) (stop bool)) {
+	store := ctx.KVStore(k.storeKey)
+	iter := sdk.KVStorePrefixIterator(store, types.FrozenAccountPrefix)
+	defer iter.Close()
+	for ; iter.Valid(); iter.Next() {
+		// the key is prefix | len(denom) | denom | addr
+		key := iter.Key()[len(types.FrozenAccountPrefix):]
+		denomLen := int(key[0])
+		denom := string(key[1 : 1+denomLen])
+		addr := sdk.AccAddress(key[1+denomLen:])
+		if handler(denom, addr) {
+			break
+		}
+	}
+}
+
+// IsDenomFrozen returns true if the denom is globally frozen.
+func (k Keeper) IsDenomFrozen(ctx sdk.Context, denom string) bool {
+	store := ctx.KVStore(k.storeKey)
+	return store.Has(types.GetFrozenDenomKey(denom))
+}
+
+// FreezeDenom freezes a denom globally.
+func (k Keeper) FreezeDenom(ctx sdk.Context, denom string) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.GetFrozenDenomKey(denom), []byte{})
+}
+
+// UnfreezeDenom unfreezes a globally frozen denom.
+func (k Keeper) UnfreezeDenom(ctx sdk.Context, denom string) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.GetFrozenDenomKey(denom))
+}
+
+// IterateFrozenDenoms iterates over the globally frozen denoms.
+func (k Keeper) IterateFrozenDenoms(ctx sdk.Context, handler func(denom string) (stop bool)) {
+	store := ctx.KVStore(k.storeKey)
+	iter := sdk.KVStorePrefixIterator(store, types.FrozenDenomPrefix)
+	defer iter.Close()
+	for ; iter.Valid(); iter.Next() {
+		if handler(string(iter.Key()[len(types.FrozenDenomPrefix):])) {
+			break
+		}
+	}
+}
@@ -0,0 +1,199 @@
+package keeper_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+
+	"github.com/cosmos/cosmos-sdk/simapp"
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	"github.com/cosmos/cosmos-sdk/x/freeze/keeper"
+	"github.com/cosmos/cosmos-sdk/x/freeze/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+	tokenfactorykeeper "github.com/cosmos/cosmos-sdk/x/tokenfactory/keeper"
+	tokenfactorytypes "github.com/cosmos/cosmos-sdk/x/tokenfactory/types"
+)
+
+type KeeperTestSuite struct {
+	suite.Suite
+
+	app       *simapp.SimApp
+	ctx       sdk.Context
+	msgServer types.MsgServer
+	authority sdk.AccAddress
+	issuer    sdk.AccAddress
+	addrs     []sdk.AccAddress
+	denom     string
+}
+
+func (s *KeeperTestSuite) SetupTest() {
+	s.app = simapp.Setup(s.T(), false)
+	s.ctx = s.app.BaseApp.NewContext(false, tmproto.Header{})
+	s.msgServer = keeper.NewMsgServerImpl(s.app.FreezeKeeper)
+	s.authority = authtypes.NewModuleAddress(govtypes.ModuleName)
+
+	addrs := simapp.AddTestAddrs(s.app, s.ctx, 4, sdk.NewInt(100_000_000))
+	s.issuer, s.addrs = addrs[0], addrs[1:]
+
+	// the issuer creates a denom with the token factory, and mints it to the
+	// test accounts
+	tfMsgServer := tokenfactorykeeper.NewMsgServerImpl(s.app.TokenFactoryKeeper)
+	ctx := sdk.WrapSDKContext(s.ctx)
+	res, err := tfMsgServer.CreateDenom(ctx, tokenfactorytypes.NewMsgCreateDenom(s.issuer.String(), "usd"))
+	s.Require().NoError(err)
+	s.denom = res.NewTokenDenom
+
+	_, err = tfMsgServer.Mint(ctx, tokenfactorytypes.NewMsgMint(s.issuer.String(), sdk.NewInt64Coin(s.denom, 3000)))
+	s.Require().NoError(err)
+	for _, addr := range s.addrs {
+		s.Require().NoError(s.app.BankKeeper.SendCoins(s.ctx, s.issuer, addr, s.coins(1000)))
+	}
+}
+
+func TestKeeperTestSuite(t *testing.T) {
+	suite.Run(t, new(KeeperTestSuite))
+}
+
+func (s *KeeperTestSuite) coins(amount int64) sdk.Coins {
+	return sdk.NewCoins(sdk.NewInt64Coin(s.denom, amount))
+}
+
+func (s *KeeperTestSuite) TestFreezeAccount() {
+	frozen, other := s.addrs[0], s.addrs[1]
+	ctx := sdk.WrapSDKContext(s.ctx)
+
+	// only the issuer and the authority can freeze accounts
+	_, err := s.msgServer.FreezeAccount(ctx, types.NewMsgFreezeAccount(other.String(), s.denom, frozen.String()))
+	s.Require().ErrorIs(err, types.ErrUnauthorized)
+	_, err = s.msgServer.FreezeAccount(ctx, types.NewMsgFreezeAccount(s.issuer.String(), s.denom, s.issuer.String()))
+	s.Require().ErrorIs(err, types.ErrFreezeIssuer)
+
+	_, err = s.msgServer.FreezeAccount(ctx, types.NewMsgFreezeAccount(s.issuer.String(), s.denom, frozen.String()))
+	s.Require().NoError(err)
+	s.Require().True(s.app.FreezeKeeper.IsAccountFrozen(s.ctx, s.denom, frozen))
+	_, err = s.msgServer.FreezeAccount(ctx, types.NewMsgFreezeAccount(s.authority.String(), s.denom, frozen.String()))
+	s.Require().ErrorIs(err, types.ErrAlreadyFrozen)
+
+	// the frozen account can neither send nor receive the denom
+	s.Require().ErrorIs(s.app.BankKeeper.SendCoins(s.ctx, frozen, other, s.coins(1)), types.ErrFrozen)
+	s.Require().ErrorIs(s.app.BankKeeper.SendCoins(s.ctx, other, frozen, s.coins(1)), types.ErrFrozen)
+	s.Require().ErrorIs(s.app.BankKeeper.SendCoinsFromAccountToModule(s.ctx, frozen, authtypes.FeeCollectorName, s.coins(1)), types.ErrFrozen)
+
+	// its other coins are not frozen
+	stake := sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 1))
+	s.Require().NoError(s.app.BankKeeper.SendCoins(s.ctx, frozen, other, stake))
+
+	// its frozen coins are not spendable
+	s.Require().Equal(sdk.ZeroInt(), s.app.BankKeeper.SpendableCoins(s.ctx, frozen).AmountOf(s.denom))
+	s.Require().Equal(s.coins(1000), sdk.NewCoins(s.app.BankKeeper.GetBalance(s.ctx, frozen, s.denom)))
+
+	// the authority can unfreeze the account
+	_, err = s.msgServer.UnfreezeAccount(ctx, types.NewMsgUnfreezeAccount(other.String(), s.denom, frozen.String()))
+	s.Require().ErrorIs(err, types.ErrUnauthorized)
+	_, err = s.msgServer.UnfreezeAccount(ctx, types.NewMsgUnfreezeAccount(s.authority.String(), s.denom, frozen.String()))
+	s.Require().NoError(err)
+	_, err = s.msgServer.UnfreezeAccount(ctx, types.NewMsgUnfreezeAccount(s.authority.String(), s.denom, frozen.String()))
+	s.Require().ErrorIs(err, types.ErrNotFrozen)
+
+	s.Require().NoError(s.app.BankKeeper.SendCoins(s.ctx, frozen, other, s.coins(1)))
+	s.Require().Equal(sdk.NewInt(999), s.app.BankKeeper.SpendableCoins(s.ctx, frozen).AmountOf(s.denom))
+}
+
+func (s *KeeperTestSuite) TestFreezeDenom() {
+	holder, other := s.addrs[0], s.addrs[1]
+	ctx := sdk.WrapSDKContext(s.ctx)
+
+	_, err := s.msgServer.FreezeDenom(ctx, types.NewMsgFreezeDenom(holder.String(), s.denom))
+	s.Require().ErrorIs(err, types.ErrUnauthorized)
+
+	_, err = s.msgServer.FreezeDenom(ctx, types.NewMsgFreezeDenom(s.issuer.String(), s.denom))
+	s.Require().NoError(err)
+	s.Require().True(s.app.FreezeKeeper.IsDenomFrozen(s.ctx, s.denom))
+	_, err = s.msgServer.FreezeDenom(ctx, types.NewMsgFreezeDenom(s.issuer.String(), s.denom))
+	s.Require().ErrorIs(err, types.ErrAlreadyFrozen)
+
+	// the holders cannot transfer the denom anymore
+	s.Require().ErrorIs(s.app.BankKeeper.SendCoins(s.ctx, holder, other, s.coins(1)), types.ErrFrozen)
+	s.Require().Equal(sdk.ZeroInt(), s.app.BankKeeper.SpendableCoins(s.ctx, holder).AmountOf(s.denom))
+
+	// but the issuer can still mint, burn and transfer it
+	tfMsgServer := tokenfactorykeeper.NewMsgServerImpl(s.app.TokenFactoryKeeper)
+	_, err = tfMsgServer.Mint(ctx, tokenfactorytypes.NewMsgMint(s.issuer.String(), sdk.NewInt64Coin(s.denom, 100)))
+	s.Require().NoError(err)
+	_, err = tfMsgServer.Burn(ctx, tokenfactorytypes.NewMsgBurn(s.issuer.String(), sdk.NewInt64Coin(s.denom, 50)))
+	s.Require().NoError(err)
+	s.Require().NoError(s.app.BankKeeper.SendCoins(s.ctx, holder, s.issuer, s.coins(10)))
+	s.Require().NoError(s.app.BankKeeper.SendCoins(s.ctx, s.issuer, holder, s.coins(10)))
+	s.Require().Equal(sdk.NewInt(50), s.app.BankKeeper.SpendableCoins(s.ctx, s.issuer).AmountOf(s.denom))
+
+	_, err = s.msgServer.UnfreezeDenom(ctx, types.NewMsgUnfreezeDenom(s.authority.String(), s.denom))
+	s.Require().NoError(err)
+	_, err = s.msgServer.UnfreezeDenom(ctx, types.NewMsgUnfreezeDenom(s.authority.String(), s.denom))
+	s.Require().ErrorIs(err, types.ErrNotFrozen)
+	s.Require().NoError(s.app.BankKeeper.SendCoins(s.ctx, holder, other, s.coins(1)))
+}
+
+func (s *KeeperTestSuite) TestAuthorityFreezesAnyDenom() {
+	holder, other := s.addrs[0], s.addrs[1]
+	ctx := sdk.WrapSDKContext(s.ctx)
+	stake := sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 1))
+
+	// the denoms which were not created by the token factory have no issuer
+	_, err := s.msgServer.FreezeAccount(ctx, types.NewMsgFreezeAccount(s.issuer.String(), sdk.DefaultBondDenom, holder.String()))
+	s.Require().ErrorIs(err, types.ErrUnauthorized)
+
+	_, err = s.msgServer.FreezeDenom(ctx, types.NewMsgFreezeDenom(s.authority.String(), sdk.DefaultBondDenom))
+	s.Require().NoError(err)
+	s.Require().ErrorIs(s.app.BankKeeper.SendCoins(s.ctx, holder, other, stake), types.ErrFrozen)
+	s.Require().ErrorIs(s.app.BankKeeper.SendCoins(s.ctx, s.issuer, other, stake), types.ErrFrozen)
+
+	// the transfers between module accounts are not restricted
+	s.Require().NoError(s.app.BankKeeper.MintCoins(s.ctx, "mint", stake))
+	s.Require().NoError(s.app.BankKeeper.SendCoinsFromModuleToModule(s.ctx, "mint", authtypes.FeeCollectorName, stake))
+}
+
+func (s *KeeperTestSuite) TestSendGas() {
+	sender, recipient := s.addrs[0], s.addrs[1]
+
+	sendGas := func(ctx sdk.Context) sdk.Gas {
+		ctx = ctx.WithGasMeter(sdk.NewInfiniteGasMeter())
+		s.Require().NoError(s.app.BankKeeper.SendCoins(ctx, sender, recipient, s.coins(1)))
+		return ctx.GasMeter().GasConsumed()
+	}
+
+	// the first send shortens the encoded balances, which changes the gas used
+	sendGas(s.ctx)
+	gas := sendGas(s.ctx)
+
+	// the freeze checks of the transferred coin read the frozen state of the
+	// sender, of the recipient and of the denom
+	unrestrictedGas := sendGas(banktypes.WithSendRestrictionsBypass(s.ctx))
+	s.Require().Equal(3*storetypes.KVGasConfig().HasCost, gas-unrestrictedGas)
+
+	// the gas does not depend on the frozen accounts
+	s.app.FreezeKeeper.FreezeAccount(s.ctx, s.denom, s.addrs[2])
+	s.Require().Equal(gas, sendGas(s.ctx))
+}
+
+func (s *KeeperTestSuite) TestGenesis() {
+	s.app.FreezeKeeper.FreezeAccount(s.ctx, s.denom, s.addrs[0])
+	s.app.FreezeKeeper.FreezeAccount(s.ctx, sdk.DefaultBondDenom, s.addrs[1])
+	s.app.FreezeKeeper.FreezeDenom(s.ctx, s.denom)
+
+	genState := s.app.FreezeKeeper.ExportGenesis(s.ctx)
+	s.Require().ElementsMatch([]types.FrozenAccount{
+		{Denom: s.denom, Address: s.addrs[0].String()},
+		{Denom: sdk.DefaultBondDenom, Address: s.addrs[1].String()},
+	}, genState.FrozenAccounts)
+	s.Require().Equal([]string{s.denom}, genState.FrozenDenoms)
+
+	app := simapp.Setup(s.T(), false)
+	ctx := app.BaseApp.NewContext(false, tmproto.Header{})
+	app.FreezeKeeper.InitGenesis(ctx, genState)
+	s.Require().Equal(genState, app.FreezeKeeper.ExportGenesis(ctx))
+	s.Require().True(app.FreezeKeeper.IsAccountFrozen(ctx, s.denom, s.addrs[0]))
+}
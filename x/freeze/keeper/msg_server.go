@@ -0,0 +1,163 @@
+package keeper
+
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/freeze/types"
+)
+
+type msgServer struct {
+	Keeper
+}
+
+// NewMsgServerImpl returns an implementation of the freeze MsgServer interface
+// for the provided Keeper.
+func NewMsgServerImpl(k Keeper) types.MsgServer {
+	return &msgServer{
+		Keeper: k,
+	}
+}
+
+var _ types.MsgServer = msgServer{}
+
+// FreezeAccount implements the Msg/FreezeAccount Msg service.
+func (k msgServer) FreezeAccount(goCtx context.Context, msg *types.MsgFreezeAccount) (*types.MsgFreezeAccountResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if err := k.validateSender(ctx, msg.Sender, msg.Denom); err != nil {
+		return nil, err
+	}
+
+	addr, err := sdk.AccAddressFromBech32(msg.Address)
+	if err != nil {
+		return nil, err
+	}
+
+	// the issuer must be able to mint and burn while the denom is frozen
+	if msg.Address == k.GetIssuer(ctx, msg.Denom) {
+		return nil, types.ErrFreezeIssuer.Wrapf("%s is the issuer of %s", msg.Address, msg.Denom)
+	}
+	if k.IsAccountFrozen(ctx, msg.Denom, addr) {
+		return nil, types.ErrAlreadyFrozen.Wrapf("%s for %s", msg.Address, msg.Denom)
+	}
+	k.Keeper.FreezeAccount(ctx, msg.Denom, addr)
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeFreezeAccount,
+			sdk.NewAttribute(types.AttributeKeyDenom, msg.Denom),
+			sdk.NewAttribute(types.AttributeKeyAddress, msg.Address),
+		),
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(sdk.AttributeKeySender, msg.Sender),
+		),
+	})
+
+	return &types.MsgFreezeAccountResponse{}, nil
+}
+
+// UnfreezeAccount implements the Msg/UnfreezeAccount Msg service.
+func (k msgServer) UnfreezeAccount(goCtx context.Context, msg *types.MsgUnfreezeAccount) (*types.MsgUnfreezeAccountResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if err := k.validateSender(ctx, msg.Sender, msg.Denom); err != nil {
+		return nil, err
+	}
+
+	addr, err := sdk.AccAddressFromBech32(msg.Address)
+	if err != nil {
+		return nil, err
+	}
+
+	if !k.IsAccountFrozen(ctx, msg.Denom, addr) {
+		return nil, types.ErrNotFrozen.Wrapf("%s for %s", msg.Address, msg.Denom)
+	}
+	k.Keeper.UnfreezeAccount(ctx, msg.Denom, addr)
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeUnfreezeAccount,
+			sdk.NewAttribute(types.AttributeKeyDenom, msg.Denom),
+			sdk.NewAttribute(types.AttributeKeyAddress, msg.Address),
+		),
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(sdk.AttributeKeySender, msg.Sender),
+		),
+	})
+
+	return &types.MsgUnfreezeAccountResponse{}, nil
+}
+
+// FreezeDenom implements the Msg/FreezeDenom Msg service.
+func (k msgServer) FreezeDenom(goCtx context.Context, msg *types.MsgFreezeDenom) (*types.MsgFreezeDenomResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if err := k.validateSender(ctx, msg.Sender, msg.Denom); err != nil {
+		return nil, err
+	}
+
+	if k.IsDenomFrozen(ctx, msg.Denom) {
+		return nil, types.ErrAlreadyFrozen.Wrap(msg.Denom)
+	}
+	k.Keeper.FreezeDenom(ctx, msg.Denom)
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeFreezeDenom,
+			sdk.NewAttribute(types.AttributeKeyDenom, msg.Denom),
+		),
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(sdk.AttributeKeySender, msg.Sender),
+		),
+	})
+
+	return &types.MsgFreezeDenomResponse{}, nil
+}
+
+// UnfreezeDenom implements the Msg/UnfreezeDenom Msg service.
+func (k msgServer) UnfreezeDenom(goCtx context.Context, msg *types.MsgUnfreezeDenom) (*types.MsgUnfreezeDenomResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if err := k.validateSender(ctx, msg.Sender, msg.Denom); err != nil {
+		return nil, err
+	}
+
+	if !k.IsDenomFrozen(ctx, msg.Denom) {
+		return nil, types.ErrNotFrozen.Wrap(msg.Denom)
+	}
+	k.Keeper.UnfreezeDenom(ctx, msg.Denom)
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeUnfreezeDenom,
+			sdk.NewAttribute(types.AttributeKeyDenom, msg.Denom),
+		),
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(sdk.AttributeKeySender, msg.Sender),
+		),
+	})
+
+	return &types.MsgUnfreezeDenomResponse{}, nil
+}
+
+// validateSender checks that the sender is either the module authority or the
+// issuer of the denom.
+func (k Keeper) validateSender(ctx sdk.Context, sender, denom string) error {
+	if sender == k.authority {
+		return nil
+	}
+
+	if issuer := k.GetIssuer(ctx, denom); issuer == "" || issuer != sender {
+		return types.ErrUnauthorized.Wrapf("%s cannot freeze %s", sender, denom)
+	}
+	return nil
+}
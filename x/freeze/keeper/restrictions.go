@@ -0,0 +1,65 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	"github.com/cosmos/cosmos-sdk/x/freeze/types"
+)
+
+var (
+	_ banktypes.SendRestrictionFn = Keeper{}.SendRestrictionFn
+	_ banktypes.FrozenCoinsFn     = Keeper{}.FrozenCoins
+)
+
+// SendRestrictionFn is the bank send restriction of the freeze module. It
+// rejects the transfers of a denom from or to an account frozen for the denom,
+// and the transfers of a globally frozen denom which do not involve its
+// issuer. The freeze checks are metered, every transferred coin costs the gas
+// of three store reads, plus the issuer read of a globally frozen denom.
+func (k Keeper) SendRestrictionFn(ctx sdk.Context, fromAddr, toAddr sdk.AccAddress, amt sdk.Coins) (sdk.AccAddress, error) {
+	for _, coin := range amt {
+		if k.IsAccountFrozen(ctx, coin.Denom, fromAddr) {
+			return toAddr, types.ErrFrozen.Wrapf("%s is frozen for %s", fromAddr, coin.Denom)
+		}
+		if k.IsAccountFrozen(ctx, coin.Denom, toAddr) {
+			return toAddr, types.ErrFrozen.Wrapf("%s is frozen for %s", toAddr, coin.Denom)
+		}
+		if k.IsDenomFrozen(ctx, coin.Denom) && !k.isIssuer(ctx, coin.Denom, fromAddr, toAddr) {
+			return toAddr, types.ErrFrozen.Wrapf("%s is globally frozen", coin.Denom)
+		}
+	}
+
+	return toAddr, nil
+}
+
+// FrozenCoins is the bank frozen coins hook of the freeze module. It returns
+// the balances of an account in the denoms it is frozen for, and in the
+// globally frozen denoms unless it is their issuer. The freeze checks are
+// metered, every balance costs the gas of two store reads, plus the issuer
+// read of a globally frozen denom.
+func (k Keeper) FrozenCoins(ctx sdk.Context, addr sdk.AccAddress, balances sdk.Coins) sdk.Coins {
+	frozen := sdk.NewCoins()
+	for _, coin := range balances {
+		if k.IsAccountFrozen(ctx, coin.Denom, addr) ||
+			(k.IsDenomFrozen(ctx, coin.Denom) && !k.isIssuer(ctx, coin.Denom, addr)) {
+			frozen = frozen.Add(coin)
+		}
+	}
+
+	return frozen
+}
+
+// isIssuer returns true if one of the accounts is the issuer of the denom.
+func (k Keeper) isIssuer(ctx sdk.Context, denom string, addrs ...sdk.AccAddress) bool {
+	issuer := k.GetIssuer(ctx, denom)
+	if issuer == "" {
+		return false
+	}
+
+	for _, addr := range addrs {
+		if addr.String() == issuer {
+			return true
+		}
+	}
+	return false
+}
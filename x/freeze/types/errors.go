@@ -0,0 +1,14 @@
+package types
+
+import (
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// x/freeze module sentinel errors
+var (
+	ErrUnauthorized  = sdkerrors.Register(ModuleName, 2, "account is neither the issuer of the denom nor the authority")
+	ErrAlreadyFrozen = sdkerrors.Register(ModuleName, 3, "already frozen")
+	ErrNotFrozen     = sdkerrors.Register(ModuleName, 4, "not frozen")
+	ErrFrozen        = sdkerrors.Register(ModuleName, 5, "coins are frozen")
+	ErrFreezeIssuer  = sdkerrors.Register(ModuleName, 6, "the issuer of a denom cannot be frozen")
+)
@@ -0,0 +1,14 @@
+package types
+
+// freeze module event types
+const (
+	EventTypeFreezeAccount   = "freeze_account"
+	EventTypeUnfreezeAccount = "unfreeze_account"
+	EventTypeFreezeDenom     = "freeze_denom"
+	EventTypeUnfreezeDenom   = "unfreeze_denom"
+
+	AttributeKeyDenom   = "denom"
+	AttributeKeyAddress = "address"
+
+	AttributeValueCategory = ModuleName
+)
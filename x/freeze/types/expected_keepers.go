@@ -0,0 +1,12 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	tokenfactorytypes "github.com/cosmos/cosmos-sdk/x/tokenfactory/types"
+)
+
+// TokenFactoryKeeper defines the expected token factory keeper, used to find
+// the issuer of a denom.
+type TokenFactoryKeeper interface {
+	GetAuthorityMetadata(ctx sdk.Context, denom string) (tokenfactorytypes.DenomAuthorityMetadata, error)
+}
@@ -0,0 +1,53 @@
+package types
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// NewGenesisState creates a new GenesisState object
+func NewGenesisState(frozenAccounts []FrozenAccount, frozenDenoms []string) *GenesisState {
+	return &GenesisState{
+		FrozenAccounts: frozenAccounts,
+		FrozenDenoms:   frozenDenoms,
+	}
+}
+
+// DefaultGenesisState returns a default freeze module genesis state.
+func DefaultGenesisState() *GenesisState {
+	return NewGenesisState([]FrozenAccount{}, []string{})
+}
+
+// Validate performs basic genesis state validation returning an error upon any
+// failure.
+func (gs GenesisState) Validate() error {
+	seenAccounts := make(map[string]bool, len(gs.FrozenAccounts))
+	for _, fa := range gs.FrozenAccounts {
+		if err := sdk.ValidateDenom(fa.Denom); err != nil {
+			return err
+		}
+		if _, err := sdk.AccAddressFromBech32(fa.Address); err != nil {
+			return fmt.Errorf("invalid frozen account address %s: %w", fa.Address, err)
+		}
+
+		key := fa.Denom + "/" + fa.Address
+		if seenAccounts[key] {
+			return fmt.Errorf("duplicate frozen account %s for denom %s", fa.Address, fa.Denom)
+		}
+		seenAccounts[key] = true
+	}
+
+	seenDenoms := make(map[string]bool, len(gs.FrozenDenoms))
+	for _, denom := range gs.FrozenDenoms {
+		if err := sdk.ValidateDenom(denom); err != nil {
+			return err
+		}
+		if seenDenoms[denom] {
+			return fmt.Errorf("duplicate frozen denom %s", denom)
+		}
+		seenDenoms[denom] = true
+	}
+
+	return nil
+}
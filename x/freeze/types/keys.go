@@ -0,0 +1,38 @@
+package types
+
+import (
+	"github.com/cosmos/cosmos-sdk/types/address"
+)
+
+const (
+	// ModuleName is the name of this module
+	ModuleName = "freeze"
+
+	// StoreKey is the prefix under which we store this module's data
+	StoreKey = ModuleName
+
+	// RouterKey is the message route for the freeze module
+	RouterKey = ModuleName
+)
+
+// KVStore keys
+var (
+	FrozenAccountPrefix = []byte{0x01} // prefix for the accounts frozen for a denom
+	FrozenDenomPrefix   = []byte{0x02} // prefix for the globally frozen denoms
+)
+
+// GetFrozenAccountsPrefix creates the prefix of the accounts frozen for a
+// denom.
+func GetFrozenAccountsPrefix(denom string) []byte {
+	return append(FrozenAccountPrefix, address.MustLengthPrefix([]byte(denom))...)
+}
+
+// GetFrozenAccountKey creates the key of an account frozen for a denom.
+func GetFrozenAccountKey(denom string, addr []byte) []byte {
+	return append(GetFrozenAccountsPrefix(denom), addr...)
+}
+
+// GetFrozenDenomKey creates the key of a globally frozen denom.
+func GetFrozenDenomKey(denom string) []byte {
+	return append(FrozenDenomPrefix, []byte(denom)...)
+}
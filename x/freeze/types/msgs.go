@@ -0,0 +1,161 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/x/auth/migrations/legacytx"
+)
+
+var (
+	_, _, _, _ sdk.Msg            = &MsgFreezeAccount{}, &MsgUnfreezeAccount{}, &MsgFreezeDenom{}, &MsgUnfreezeDenom{}
+	_, _, _, _ legacytx.LegacyMsg = &MsgFreezeAccount{}, &MsgUnfreezeAccount{}, &MsgFreezeDenom{}, &MsgUnfreezeDenom{}
+)
+
+// NewMsgFreezeAccount creates a new MsgFreezeAccount instance.
+func NewMsgFreezeAccount(sender, denom, address string) *MsgFreezeAccount {
+	return &MsgFreezeAccount{
+		Sender:  sender,
+		Denom:   denom,
+		Address: address,
+	}
+}
+
+// Route implements the LegacyMsg interface.
+func (m MsgFreezeAccount) Route() string { return sdk.MsgTypeURL(&m) }
+
+// Type implements the LegacyMsg interface.
+func (m MsgFreezeAccount) Type() string { return sdk.MsgTypeURL(&m) }
+
+// GetSignBytes implements the LegacyMsg interface.
+func (m MsgFreezeAccount) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&m))
+}
+
+// ValidateBasic does a sanity check on the provided data.
+func (m *MsgFreezeAccount) ValidateBasic() error {
+	return validateAccountMsg(m.Sender, m.Denom, m.Address)
+}
+
+// GetSigners returns the expected signers for MsgFreezeAccount.
+func (m *MsgFreezeAccount) GetSigners() []sdk.AccAddress {
+	addr, _ := sdk.AccAddressFromBech32(m.Sender)
+	return []sdk.AccAddress{addr}
+}
+
+// NewMsgUnfreezeAccount creates a new MsgUnfreezeAccount instance.
+func NewMsgUnfreezeAccount(sender, denom, address string) *MsgUnfreezeAccount {
+	return &MsgUnfreezeAccount{
+		Sender:  sender,
+		Denom:   denom,
+		Address: address,
+	}
+}
+
+// Route implements the LegacyMsg interface.
+func (m MsgUnfreezeAccount) Route() string { return sdk.MsgTypeURL(&m) }
+
+// Type implements the LegacyMsg interface.
+func (m MsgUnfreezeAccount) Type() string { return sdk.MsgTypeURL(&m) }
+
+// GetSignBytes implements the LegacyMsg interface.
+func (m MsgUnfreezeAccount) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&m))
+}
+
+// ValidateBasic does a sanity check on the provided data.
+func (m *MsgUnfreezeAccount) ValidateBasic() error {
+	return validateAccountMsg(m.Sender, m.Denom, m.Address)
+}
+
+// GetSigners returns the expected signers for MsgUnfreezeAccount.
+func (m *MsgUnfreezeAccount) GetSigners() []sdk.AccAddress {
+	addr, _ := sdk.AccAddressFromBech32(m.Sender)
+	return []sdk.AccAddress{addr}
+}
+
+// NewMsgFreezeDenom creates a new MsgFreezeDenom instance.
+func NewMsgFreezeDenom(sender, denom string) *MsgFreezeDenom {
+	return &MsgFreezeDenom{
+		Sender: sender,
+		Denom:  denom,
+	}
+}
+
+// Route implements the LegacyMsg interface.
+func (m MsgFreezeDenom) Route() string { return sdk.MsgTypeURL(&m) }
+
+// Type implements the LegacyMsg interface.
+func (m MsgFreezeDenom) Type() string { return sdk.MsgTypeURL(&m) }
+
+// GetSignBytes implements the LegacyMsg interface.
+func (m MsgFreezeDenom) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&m))
+}
+
+// ValidateBasic does a sanity check on the provided data.
+func (m *MsgFreezeDenom) ValidateBasic() error {
+	return validateDenomMsg(m.Sender, m.Denom)
+}
+
+// GetSigners returns the expected signers for MsgFreezeDenom.
+func (m *MsgFreezeDenom) GetSigners() []sdk.AccAddress {
+	addr, _ := sdk.AccAddressFromBech32(m.Sender)
+	return []sdk.AccAddress{addr}
+}
+
+// NewMsgUnfreezeDenom creates a new MsgUnfreezeDenom instance.
+func NewMsgUnfreezeDenom(sender, denom string) *MsgUnfreezeDenom {
+	return &MsgUnfreezeDenom{
+		Sender: sender,
+		Denom:  denom,
+	}
+}
+
+// Route implements the LegacyMsg interface.
+func (m MsgUnfreezeDenom) Route() string { return sdk.MsgTypeURL(&m) }
+
+// Type implements the LegacyMsg interface.
+func (m MsgUnfreezeDenom) Type() string { return sdk.MsgTypeURL(&m) }
+
+// GetSignBytes implements the LegacyMsg interface.
+func (m MsgUnfreezeDenom) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&m))
+}
+
+// ValidateBasic does a sanity check on the provided data.
+func (m *MsgUnfreezeDenom) ValidateBasic() error {
+	return validateDenomMsg(m.Sender, m.Denom)
+}
+
+// GetSigners returns the expected signers for MsgUnfreezeDenom.
+func (m *MsgUnfreezeDenom) GetSigners() []sdk.AccAddress {
+	addr, _ := sdk.AccAddressFromBech32(m.Sender)
+	return []sdk.AccAddress{addr}
+}
+
+// validateDenomMsg validates the sender and denom of a freeze msg.
+func validateDenomMsg(sender, denom string) error {
+	if _, err := sdk.AccAddressFromBech32(sender); err != nil {
+		return sdkerrors.Wrap(err, "sender")
+	}
+
+	if err := sdk.ValidateDenom(denom); err != nil {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, err.Error())
+	}
+
+	return nil
+}
+
+// validateAccountMsg validates the sender, denom and frozen address of a
+// freeze msg.
+func validateAccountMsg(sender, denom, address string) error {
+	if err := validateDenomMsg(sender, denom); err != nil {
+		return err
+	}
+
+	if _, err := sdk.AccAddressFromBech32(address); err != nil {
+		return sdkerrors.Wrap(err, "address")
+	}
+
+	return nil
+}
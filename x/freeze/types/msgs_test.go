@@ -0,0 +1,91 @@
+package types_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/freeze/types"
+)
+
+var (
+	addr1 = sdk.AccAddress("addr1_______________").String()
+	addr2 = sdk.AccAddress("addr2_______________").String()
+	denom = "factory/" + addr1 + "/usd"
+)
+
+func TestMsgsValidateBasic(t *testing.T) {
+	tests := []struct {
+		name   string
+		msg    sdk.Msg
+		expErr bool
+	}{
+		{"valid freeze account", types.NewMsgFreezeAccount(addr1, denom, addr2), false},
+		{"valid unfreeze account", types.NewMsgUnfreezeAccount(addr1, denom, addr2), false},
+		{"valid freeze denom", types.NewMsgFreezeDenom(addr1, denom), false},
+		{"valid unfreeze denom", types.NewMsgUnfreezeDenom(addr1, "stake"), false},
+		{"invalid sender", types.NewMsgFreezeAccount("invalid", denom, addr2), true},
+		{"invalid denom", types.NewMsgFreezeAccount(addr1, "1usd", addr2), true},
+		{"invalid address", types.NewMsgUnfreezeAccount(addr1, denom, "invalid"), true},
+		{"empty denom", types.NewMsgFreezeDenom(addr1, ""), true},
+		{"empty sender", types.NewMsgUnfreezeDenom("", denom), true},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.msg.ValidateBasic()
+			if tc.expErr {
+				require.Error(t, err)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestGenesisStateValidate(t *testing.T) {
+	tests := []struct {
+		name     string
+		genState *types.GenesisState
+		expErr   bool
+	}{
+		{"default", types.DefaultGenesisState(), false},
+		{
+			"valid",
+			types.NewGenesisState(
+				[]types.FrozenAccount{{Denom: denom, Address: addr1}, {Denom: "stake", Address: addr1}},
+				[]string{denom, "stake"},
+			),
+			false,
+		},
+		{
+			"invalid frozen account denom",
+			types.NewGenesisState([]types.FrozenAccount{{Denom: "1usd", Address: addr1}}, nil),
+			true,
+		},
+		{
+			"invalid frozen account address",
+			types.NewGenesisState([]types.FrozenAccount{{Denom: denom, Address: "invalid"}}, nil),
+			true,
+		},
+		{
+			"duplicate frozen account",
+			types.NewGenesisState([]types.FrozenAccount{{Denom: denom, Address: addr1}, {Denom: denom, Address: addr1}}, nil),
+			true,
+		},
+		{"invalid frozen denom", types.NewGenesisState(nil, []string{"1usd"}), true},
+		{"duplicate frozen denom", types.NewGenesisState(nil, []string{denom, denom}), true},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.genState.Validate()
+			if tc.expErr {
+				require.Error(t, err)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
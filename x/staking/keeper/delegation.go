@@ -1011,3 +1011,211 @@ func (k Keeper) ValidateUnbondAmount(
 
 	return shares, nil
 }
+
+// getRedelegationsToValidator returns the redelegations of a delegator whose
+// destination is the given validator.
+func (k Keeper) getRedelegationsToValidator(ctx sdk.Context, delAddr sdk.AccAddress, valDstAddr sdk.ValAddress) (reds []types.Redelegation) {
+	store := ctx.KVStore(k.storeKey)
+
+	iterator := sdk.KVStorePrefixIterator(store, types.GetREDsByDelToValDstIndexKey(delAddr, valDstAddr))
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		key := types.GetREDKeyFromValDstIndexKey(iterator.Key())
+		red := types.MustUnmarshalRED(k.cdc, store.Get(key))
+		reds = append(reds, red)
+	}
+
+	return reds
+}
+
+// TransferDelegation changes the ownership of at most wantShares shares of the
+// delegation from fromAddr to valAddr, to toAddr. It returns the number of
+// shares actually transferred. The redelegation entries into the validator
+// are transferred as well, so that the remaining shares of fromAddr always
+// cover its redelegations, which can be slashed.
+//
+// NOTE: no tokens are transferred to or from any pool or account, as the
+// tokens and shares of the validator are unchanged.
+func (k Keeper) TransferDelegation(
+	ctx sdk.Context, fromAddr, toAddr sdk.AccAddress, valAddr sdk.ValAddress, wantShares sdk.Dec,
+) (transferred sdk.Dec, err error) {
+	transferred = sdk.ZeroDec()
+	if !wantShares.IsPositive() || fromAddr.Equals(toAddr) {
+		return transferred, nil
+	}
+
+	if _, found := k.GetValidator(ctx, valAddr); !found {
+		return transferred, types.ErrNoValidatorFound
+	}
+
+	delFrom, found := k.GetDelegation(ctx, fromAddr, valAddr)
+	if !found {
+		return transferred, types.ErrNoDelegation
+	}
+
+	// Check the redelegation entry limits while nothing has been written, in
+	// the worst case where all the redelegation entries are transferred.
+	redelegations := k.getRedelegationsToValidator(ctx, fromAddr, valAddr)
+	valSrcAddrs := make([]sdk.ValAddress, len(redelegations))
+	for i, red := range redelegations {
+		valSrcAddrs[i], err = sdk.ValAddressFromBech32(red.ValidatorSrcAddress)
+		if err != nil {
+			return transferred, err
+		}
+		redTo, found := k.GetRedelegation(ctx, toAddr, valSrcAddrs[i], valAddr)
+		if found && len(redTo.Entries)+len(red.Entries) > int(k.MaxEntries(ctx)) {
+			return transferred, types.ErrMaxRedelegationEntries
+		}
+	}
+
+	transferred = sdk.MinDec(delFrom.Shares, wantShares)
+	remaining := delFrom.Shares.Sub(transferred)
+
+	// update or create the destination delegation, calling the appropriate hooks
+	delTo, found := k.GetDelegation(ctx, toAddr, valAddr)
+	if found {
+		err = k.BeforeDelegationSharesModified(ctx, toAddr, valAddr)
+	} else {
+		delTo = types.NewDelegation(toAddr, valAddr, sdk.ZeroDec())
+		err = k.BeforeDelegationCreated(ctx, toAddr, valAddr)
+	}
+	if err != nil {
+		return sdk.ZeroDec(), err
+	}
+
+	delTo.Shares = delTo.Shares.Add(transferred)
+	k.SetDelegation(ctx, delTo)
+	if err := k.AfterDelegationModified(ctx, toAddr, valAddr); err != nil {
+		return sdk.ZeroDec(), err
+	}
+
+	// update the source delegation
+	if err := k.BeforeDelegationSharesModified(ctx, fromAddr, valAddr); err != nil {
+		return sdk.ZeroDec(), err
+	}
+	delFrom.Shares = remaining
+	if remaining.IsZero() {
+		err = k.RemoveDelegation(ctx, delFrom)
+	} else {
+		k.SetDelegation(ctx, delFrom)
+		err = k.AfterDelegationModified(ctx, fromAddr, valAddr)
+	}
+	if err != nil {
+		return sdk.ZeroDec(), err
+	}
+
+	// If there are not enough remaining shares to cover the redelegations,
+	// transfer some redelegation entries. For instance, if a delegation of 300
+	// shares had redelegation entries of 100 shares each from validators B, C
+	// and D, and 175 shares are transferred, then the entry from B is kept, the
+	// one from D is transferred, and the one from C is split.
+	for j, red := range redelegations {
+		modified := false
+		for i := 0; i < len(red.Entries); i++ {
+			entry := red.Entries[i]
+
+			// partition the entry shares between keeping and sending
+			sharesToKeep := sdk.MinDec(entry.SharesDst, remaining)
+			sharesToSend := entry.SharesDst.Sub(sharesToKeep)
+			remaining = remaining.Sub(sharesToKeep)
+			if sharesToSend.IsZero() {
+				continue
+			}
+
+			balanceToSend := entry.InitialBalance
+			if sharesToKeep.IsPositive() {
+				balanceToSend = sharesToSend.Quo(entry.SharesDst).MulInt(entry.InitialBalance).TruncateInt()
+			}
+
+			redTo := k.SetRedelegationEntry(
+				ctx, toAddr, valSrcAddrs[j], valAddr,
+				entry.CreationHeight, entry.CompletionTime, balanceToSend, sdk.ZeroDec(), sharesToSend,
+			)
+			k.InsertRedelegationQueue(ctx, redTo, entry.CompletionTime)
+			modified = true
+
+			if sharesToKeep.IsZero() {
+				// the obsolete entry can stay in the queue, as the queue
+				// entries are only used to look the redelegations up
+				red.RemoveEntry(int64(i))
+				i--
+				continue
+			}
+
+			entry.InitialBalance = entry.InitialBalance.Sub(balanceToSend)
+			entry.SharesDst = sharesToKeep
+			red.Entries[i] = entry
+		}
+
+		switch {
+		case !modified:
+		case len(red.Entries) == 0:
+			k.RemoveRedelegation(ctx, red)
+		default:
+			k.SetRedelegation(ctx, red)
+		}
+	}
+
+	return transferred, nil
+}
+
+// TransferUnbonding changes the ownership of the unbonding delegation entries
+// of fromAddr from valAddr, to toAddr, until wantAmt tokens have changed hands.
+// It returns the number of tokens actually transferred, which is lower than
+// wantAmt if the unbonding entries are insufficient or if toAddr reaches the
+// maximum number of unbonding entries.
+func (k Keeper) TransferUnbonding(
+	ctx sdk.Context, fromAddr, toAddr sdk.AccAddress, valAddr sdk.ValAddress, wantAmt math.Int,
+) math.Int {
+	transferred := sdk.ZeroInt()
+	if fromAddr.Equals(toAddr) {
+		return transferred
+	}
+
+	ubdFrom, found := k.GetUnbondingDelegation(ctx, fromAddr, valAddr)
+	if !found {
+		return transferred
+	}
+
+	modified := false
+	for i := 0; i < len(ubdFrom.Entries) && wantAmt.IsPositive(); i++ {
+		if k.HasMaxUnbondingDelegationEntries(ctx, toAddr, valAddr) {
+			break
+		}
+
+		entry := ubdFrom.Entries[i]
+		toXfer := sdk.MinInt(entry.Balance, wantAmt)
+		if !toXfer.IsPositive() {
+			continue
+		}
+
+		// the transferred part of the entry keeps its creation height, so that it
+		// is still slashed for the infractions committed while it was bonded
+		ubdTo := k.SetUnbondingDelegationEntry(ctx, toAddr, valAddr, entry.CreationHeight, entry.CompletionTime, toXfer)
+		k.InsertUBDQueue(ctx, ubdTo, entry.CompletionTime)
+		transferred = transferred.Add(toXfer)
+		wantAmt = wantAmt.Sub(toXfer)
+		modified = true
+
+		if toXfer.Equal(entry.Balance) {
+			ubdFrom.RemoveEntry(int64(i))
+			i--
+			continue
+		}
+
+		entry.Balance = entry.Balance.Sub(toXfer)
+		entry.InitialBalance = entry.InitialBalance.Sub(sdk.MinInt(toXfer, entry.InitialBalance))
+		ubdFrom.Entries[i] = entry
+	}
+
+	switch {
+	case !modified:
+	case len(ubdFrom.Entries) == 0:
+		k.RemoveUnbondingDelegation(ctx, ubdFrom)
+	default:
+		k.SetUnbondingDelegation(ctx, ubdFrom)
+	}
+
+	return transferred
+}
@@ -1000,3 +1000,129 @@ func TestRedelegateFromUnbondedValidator(t *testing.T) {
 	red, found := app.StakingKeeper.GetRedelegation(ctx, addrDels[0], addrVals[0], addrVals[1])
 	require.False(t, found, "%v", red)
 }
+
+func TestTransferDelegation(t *testing.T) {
+	_, app, ctx := createTestInput(t)
+
+	addrDels := simapp.AddTestAddrsIncremental(app, ctx, 3, app.StakingKeeper.TokensFromConsensusPower(ctx, 100))
+	addrVals := simapp.ConvertAddrsToValAddrs(addrDels)
+	bondDenom := app.StakingKeeper.BondDenom(ctx)
+
+	// add bonded tokens to pool for the validators
+	notBondedPool := app.StakingKeeper.GetNotBondedPool(ctx)
+	require.NoError(t, testutil.FundModuleAccount(app.BankKeeper, ctx, notBondedPool.GetName(), sdk.NewCoins(sdk.NewCoin(bondDenom, app.StakingKeeper.TokensFromConsensusPower(ctx, 20)))))
+	app.AccountKeeper.SetModuleAccount(ctx, notBondedPool)
+
+	// create two bonded validators
+	for i := 0; i < 2; i++ {
+		validator := teststaking.NewValidator(t, addrVals[i], PKs[i])
+		validator, _ = validator.AddTokensFromDel(app.StakingKeeper.TokensFromConsensusPower(ctx, 10))
+		validator = keeper.TestingUpdateValidator(app.StakingKeeper, ctx, validator, true)
+		require.Equal(t, types.Bonded, validator.Status)
+	}
+
+	fromAddr, toAddr := addrDels[0], addrDels[1]
+	delTokens := app.StakingKeeper.TokensFromConsensusPower(ctx, 30)
+	validator0, found := app.StakingKeeper.GetValidator(ctx, addrVals[0])
+	require.True(t, found)
+	_, err := app.StakingKeeper.Delegate(ctx, fromAddr, delTokens, types.Unbonded, validator0, true)
+	require.NoError(t, err)
+
+	// redelegate a third of the delegation to the second validator
+	redTokens := app.StakingKeeper.TokensFromConsensusPower(ctx, 10)
+	_, err = app.StakingKeeper.BeginRedelegation(ctx, fromAddr, addrVals[0], addrVals[1], sdk.NewDecFromInt(redTokens))
+	require.NoError(t, err)
+	validator1, found := app.StakingKeeper.GetValidator(ctx, addrVals[1])
+	require.True(t, found)
+	_, err = app.StakingKeeper.Delegate(ctx, fromAddr, app.StakingKeeper.TokensFromConsensusPower(ctx, 20), types.Unbonded, validator1, true)
+	require.NoError(t, err)
+
+	// no transfer for unknown validators and delegations
+	_, err = app.StakingKeeper.TransferDelegation(ctx, fromAddr, toAddr, sdk.ValAddress(addrDels[2]), sdk.OneDec())
+	require.ErrorIs(t, err, types.ErrNoValidatorFound)
+	_, err = app.StakingKeeper.TransferDelegation(ctx, toAddr, fromAddr, addrVals[1], sdk.OneDec())
+	require.ErrorIs(t, err, types.ErrNoDelegation)
+
+	// transfer 25 of the 30 shares: the redelegation entry of 10 shares is split
+	transferred, err := app.StakingKeeper.TransferDelegation(ctx, fromAddr, toAddr, addrVals[1], sdk.NewDecFromInt(app.StakingKeeper.TokensFromConsensusPower(ctx, 25)))
+	require.NoError(t, err)
+	require.Equal(t, sdk.NewDecFromInt(app.StakingKeeper.TokensFromConsensusPower(ctx, 25)), transferred)
+
+	delFrom, found := app.StakingKeeper.GetDelegation(ctx, fromAddr, addrVals[1])
+	require.True(t, found)
+	require.Equal(t, sdk.NewDecFromInt(app.StakingKeeper.TokensFromConsensusPower(ctx, 5)), delFrom.Shares)
+	delTo, found := app.StakingKeeper.GetDelegation(ctx, toAddr, addrVals[1])
+	require.True(t, found)
+	require.Equal(t, transferred, delTo.Shares)
+
+	redFrom, found := app.StakingKeeper.GetRedelegation(ctx, fromAddr, addrVals[0], addrVals[1])
+	require.True(t, found)
+	require.Len(t, redFrom.Entries, 1)
+	require.Equal(t, sdk.NewDecFromInt(app.StakingKeeper.TokensFromConsensusPower(ctx, 5)), redFrom.Entries[0].SharesDst)
+	redTo, found := app.StakingKeeper.GetRedelegation(ctx, toAddr, addrVals[0], addrVals[1])
+	require.True(t, found)
+	require.Len(t, redTo.Entries, 1)
+	require.Equal(t, sdk.NewDecFromInt(app.StakingKeeper.TokensFromConsensusPower(ctx, 5)), redTo.Entries[0].SharesDst)
+	require.Equal(t, redFrom.Entries[0].CreationHeight, redTo.Entries[0].CreationHeight)
+
+	// transferring more than the delegation transfers it all
+	transferred, err = app.StakingKeeper.TransferDelegation(ctx, fromAddr, toAddr, addrVals[1], sdk.NewDecFromInt(delTokens))
+	require.NoError(t, err)
+	require.Equal(t, sdk.NewDecFromInt(app.StakingKeeper.TokensFromConsensusPower(ctx, 5)), transferred)
+	_, found = app.StakingKeeper.GetDelegation(ctx, fromAddr, addrVals[1])
+	require.False(t, found)
+	_, found = app.StakingKeeper.GetRedelegation(ctx, fromAddr, addrVals[0], addrVals[1])
+	require.False(t, found)
+	redTo, found = app.StakingKeeper.GetRedelegation(ctx, toAddr, addrVals[0], addrVals[1])
+	require.True(t, found)
+	require.Equal(t, sdk.NewDecFromInt(redTokens), redTo.Entries[0].SharesDst.Add(redTo.Entries[1].SharesDst))
+}
+
+func TestTransferUnbonding(t *testing.T) {
+	_, app, ctx := createTestInput(t)
+
+	addrDels := simapp.AddTestAddrsIncremental(app, ctx, 2, app.StakingKeeper.TokensFromConsensusPower(ctx, 100))
+	addrVals := simapp.ConvertAddrsToValAddrs(addrDels)
+	fromAddr, toAddr := addrDels[0], addrDels[1]
+
+	validator := teststaking.NewValidator(t, addrVals[0], PKs[0])
+	validator = keeper.TestingUpdateValidator(app.StakingKeeper, ctx, validator, true)
+	_, err := app.StakingKeeper.Delegate(ctx, fromAddr, app.StakingKeeper.TokensFromConsensusPower(ctx, 30), types.Unbonded, validator, true)
+	require.NoError(t, err)
+
+	// create two unbonding entries of 10 tokens each
+	unbondTokens := app.StakingKeeper.TokensFromConsensusPower(ctx, 10)
+	for i := 0; i < 2; i++ {
+		ctx = ctx.WithBlockHeight(int64(10 + i))
+		_, err = app.StakingKeeper.Undelegate(ctx, fromAddr, addrVals[0], sdk.NewDecFromInt(unbondTokens))
+		require.NoError(t, err)
+	}
+
+	// no transfer without unbonding delegations
+	require.True(t, app.StakingKeeper.TransferUnbonding(ctx, toAddr, fromAddr, addrVals[0], unbondTokens).IsZero())
+
+	// transfer 15 tokens: the first entry is moved and the second one is split
+	wantAmt := app.StakingKeeper.TokensFromConsensusPower(ctx, 15)
+	transferred := app.StakingKeeper.TransferUnbonding(ctx, fromAddr, toAddr, addrVals[0], wantAmt)
+	require.Equal(t, wantAmt, transferred)
+
+	ubdFrom, found := app.StakingKeeper.GetUnbondingDelegation(ctx, fromAddr, addrVals[0])
+	require.True(t, found)
+	require.Len(t, ubdFrom.Entries, 1)
+	require.Equal(t, int64(11), ubdFrom.Entries[0].CreationHeight)
+	require.Equal(t, app.StakingKeeper.TokensFromConsensusPower(ctx, 5), ubdFrom.Entries[0].Balance)
+
+	ubdTo, found := app.StakingKeeper.GetUnbondingDelegation(ctx, toAddr, addrVals[0])
+	require.True(t, found)
+	require.Len(t, ubdTo.Entries, 2)
+	require.Equal(t, int64(10), ubdTo.Entries[0].CreationHeight)
+	require.Equal(t, unbondTokens, ubdTo.Entries[0].Balance)
+	require.Equal(t, int64(11), ubdTo.Entries[1].CreationHeight)
+	require.Equal(t, app.StakingKeeper.TokensFromConsensusPower(ctx, 5), ubdTo.Entries[1].Balance)
+
+	// transferring more than the unbonding delegation transfers it all
+	transferred = app.StakingKeeper.TransferUnbonding(ctx, fromAddr, toAddr, addrVals[0], wantAmt)
+	require.Equal(t, app.StakingKeeper.TokensFromConsensusPower(ctx, 5), transferred)
+	_, found = app.StakingKeeper.GetUnbondingDelegation(ctx, fromAddr, addrVals[0])
+	require.False(t, found)
+}
@@ -785,6 +785,46 @@ func (suite *KeeperTestSuite) TestGRPCQueryValidatorUnbondingDelegations() {
 	}
 }
 
+func (suite *KeeperTestSuite) TestGRPCQueryTokenizeShareRecords() {
+	app, ctx, queryClient, addrs, vals := suite.app, suite.ctx, suite.queryClient, suite.addrs, suite.vals
+	delAddr, owner := addrs[0], addrs[2]
+	valAddr := vals[1].GetOperator()
+
+	_, record1, err := app.StakingKeeper.TokenizeShares(ctx, delAddr, valAddr, app.StakingKeeper.TokensFromConsensusPower(ctx, 3), owner)
+	suite.Require().NoError(err)
+	_, record2, err := app.StakingKeeper.TokenizeShares(ctx, delAddr, valAddr, app.StakingKeeper.TokensFromConsensusPower(ctx, 2), owner)
+	suite.Require().NoError(err)
+
+	// query a record by id
+	_, err = queryClient.TokenizeShareRecord(gocontext.Background(), &types.QueryTokenizeShareRecordRequest{Id: record2.Id + 1})
+	suite.Require().Error(err)
+	res, err := queryClient.TokenizeShareRecord(gocontext.Background(), &types.QueryTokenizeShareRecordRequest{Id: record1.Id})
+	suite.Require().NoError(err)
+	suite.Require().Equal(record1, res.Record)
+
+	// query the records of an owner
+	_, err = queryClient.TokenizeShareRecordsOwned(gocontext.Background(), &types.QueryTokenizeShareRecordsOwnedRequest{})
+	suite.Require().Error(err)
+	resOwned, err := queryClient.TokenizeShareRecordsOwned(gocontext.Background(), &types.QueryTokenizeShareRecordsOwnedRequest{
+		Owner:      owner.String(),
+		Pagination: &query.PageRequest{Limit: 1, CountTotal: true},
+	})
+	suite.Require().NoError(err)
+	suite.Require().Equal([]types.TokenizeShareRecord{record1}, resOwned.Records)
+	suite.Require().Equal(uint64(2), resOwned.Pagination.Total)
+
+	// query the total tokenized delegations, and those of a validator
+	resTotal, err := queryClient.TotalLiquidStaked(gocontext.Background(), &types.QueryTotalLiquidStakedRequest{})
+	suite.Require().NoError(err)
+	suite.Require().Equal(app.StakingKeeper.TokensFromConsensusPower(ctx, 5), resTotal.Tokens)
+	suite.Require().True(resTotal.ValidatorShares.IsZero())
+
+	resTotal, err = queryClient.TotalLiquidStaked(gocontext.Background(), &types.QueryTotalLiquidStakedRequest{ValidatorAddr: valAddr.String()})
+	suite.Require().NoError(err)
+	suite.Require().Equal(app.StakingKeeper.TokensFromConsensusPower(ctx, 5), resTotal.Tokens)
+	suite.Require().Equal(sdk.NewDecFromInt(app.StakingKeeper.TokensFromConsensusPower(ctx, 5)), resTotal.ValidatorShares)
+}
+
 func createValidators(t *testing.T, ctx sdk.Context, app *simapp.SimApp, powers []int64) ([]sdk.AccAddress, []sdk.ValAddress, []types.Validator) {
 	addrs := simapp.AddTestAddrsIncremental(app, ctx, 5, app.StakingKeeper.TokensFromConsensusPower(ctx, 300))
 	valAddrs := simapp.ConvertAddrsToValAddrs(addrs)
@@ -39,7 +39,7 @@ func NewKeeper(
 		ps = ps.WithKeyTable(types.ParamKeyTable())
 	}
 
-	// ensure bonded and not bonded module accounts are set
+	// ensure bonded, not bonded and tokenize share module accounts are set
 	if addr := ak.GetModuleAddress(types.BondedPoolName); addr == nil {
 		panic(fmt.Sprintf("%s module account has not been set", types.BondedPoolName))
 	}
@@ -48,6 +48,10 @@ func NewKeeper(
 		panic(fmt.Sprintf("%s module account has not been set", types.NotBondedPoolName))
 	}
 
+	if addr := ak.GetModuleAddress(types.TokenizeSharePoolName); addr == nil {
+		panic(fmt.Sprintf("%s module account has not been set", types.TokenizeSharePoolName))
+	}
+
 	return Keeper{
 		storeKey:   key,
 		cdc:        cdc,
@@ -0,0 +1,193 @@
+package keeper
+
+import (
+	"cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	"github.com/cosmos/cosmos-sdk/x/staking/types"
+)
+
+// TokenizeShares converts the shares of the delegation from delAddr to valAddr
+// worth amount tokens into share tokens, minted to delAddr. The shares are
+// transferred to the module account of a new tokenize share record, owned by
+// owner, who is entitled to their rewards.
+//
+// NOTE: the tokens and shares of the validator are unchanged, so are the
+// staking pools.
+func (k Keeper) TokenizeShares(
+	ctx sdk.Context, delAddr sdk.AccAddress, valAddr sdk.ValAddress, amount math.Int, owner sdk.AccAddress,
+) (shareToken sdk.Coin, record types.TokenizeShareRecord, err error) {
+	validator, found := k.GetValidator(ctx, valAddr)
+	if !found {
+		return shareToken, record, types.ErrNoValidatorFound
+	}
+
+	// the self-delegation backs the minimum self delegation of the validator
+	if delAddr.Equals(validator.GetOperator()) {
+		return shareToken, record, types.ErrTokenizeSelfDelegation
+	}
+
+	shares, err := k.ValidateUnbondAmount(ctx, delAddr, valAddr, amount)
+	if err != nil {
+		return shareToken, record, err
+	}
+	if shares.TruncateInt().IsZero() {
+		return shareToken, record, types.ErrTinyTokenizeShares
+	}
+
+	if err := k.checkLiquidStakingCaps(ctx, validator, shares); err != nil {
+		return shareToken, record, err
+	}
+
+	// The tokenized delegation leaves the vesting account, which must not
+	// unlock its vesting coins.
+	acc := k.authKeeper.GetAccount(ctx, delAddr)
+	if vacc, ok := acc.(banktypes.VestingAccount); ok {
+		coins := sdk.NewCoins(sdk.NewCoin(k.BondDenom(ctx), amount))
+		if coins.IsAnyGT(vacc.GetDelegatedFree()) {
+			return shareToken, record, types.ErrExceedingFreeVestingDelegations
+		}
+		vacc.TrackUndelegation(coins)
+		k.authKeeper.SetAccount(ctx, acc)
+	}
+
+	id := k.GetLastTokenizeShareRecordID(ctx) + 1
+	record = types.NewTokenizeShareRecord(id, owner, valAddr)
+	k.SetTokenizeShareRecord(ctx, record)
+	k.SetLastTokenizeShareRecordID(ctx, id)
+
+	transferred, err := k.TransferDelegation(ctx, delAddr, record.GetModuleAddress(), valAddr, shares)
+	if err != nil {
+		return shareToken, record, err
+	}
+	k.SetValidatorLiquidShares(ctx, valAddr, k.GetValidatorLiquidShares(ctx, valAddr).Add(transferred))
+
+	shareToken = sdk.NewCoin(record.GetShareTokenDenom(), transferred.TruncateInt())
+	if err := k.bankKeeper.MintCoins(ctx, types.TokenizeSharePoolName, sdk.NewCoins(shareToken)); err != nil {
+		return shareToken, record, err
+	}
+	if err := k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.TokenizeSharePoolName, delAddr, sdk.NewCoins(shareToken)); err != nil {
+		return shareToken, record, err
+	}
+
+	return shareToken, record, nil
+}
+
+// RedeemTokensForShares burns the share tokens of delAddr and transfers the
+// corresponding shares of the tokenized delegation to delAddr. The rewards
+// withdrawn by the transfer are paid to the owner of the record, and the
+// record is removed once all its share tokens are redeemed.
+func (k Keeper) RedeemTokensForShares(
+	ctx sdk.Context, delAddr sdk.AccAddress, shareToken sdk.Coin,
+) (tokens sdk.Coin, record types.TokenizeShareRecord, err error) {
+	valAddr, id, err := types.ParseShareTokenDenom(shareToken.Denom)
+	if err != nil {
+		return tokens, record, err
+	}
+
+	record, found := k.GetTokenizeShareRecord(ctx, id)
+	if !found || record.Validator != valAddr.String() {
+		return tokens, record, types.ErrNoTokenizeShareRecord
+	}
+
+	validator, found := k.GetValidator(ctx, valAddr)
+	if !found {
+		return tokens, record, types.ErrNoValidatorFound
+	}
+
+	delegation, found := k.GetDelegation(ctx, record.GetModuleAddress(), valAddr)
+	if !found {
+		return tokens, record, types.ErrNoDelegation
+	}
+
+	// The share tokens are the truncated shares, so the redemption of the last
+	// share tokens transfers the remaining fraction of share as well.
+	shares := sdk.MinDec(sdk.NewDecFromInt(shareToken.Amount), delegation.Shares)
+	isLast := shareToken.Amount.Equal(k.bankKeeper.GetSupply(ctx, shareToken.Denom).Amount)
+	if isLast {
+		shares = delegation.Shares
+	}
+
+	coins := sdk.NewCoins(shareToken)
+	if err := k.bankKeeper.SendCoinsFromAccountToModule(ctx, delAddr, types.TokenizeSharePoolName, coins); err != nil {
+		return tokens, record, err
+	}
+	if err := k.bankKeeper.BurnCoins(ctx, types.TokenizeSharePoolName, coins); err != nil {
+		return tokens, record, err
+	}
+
+	transferred, err := k.TransferDelegation(ctx, record.GetModuleAddress(), delAddr, valAddr, shares)
+	if err != nil {
+		return tokens, record, err
+	}
+	k.SetValidatorLiquidShares(ctx, valAddr, k.GetValidatorLiquidShares(ctx, valAddr).Sub(transferred))
+
+	if err := k.payTokenizeShareRecordRewards(ctx, record); err != nil {
+		return tokens, record, err
+	}
+
+	if isLast {
+		k.RemoveTokenizeShareRecord(ctx, record)
+	}
+
+	tokens = sdk.NewCoin(k.BondDenom(ctx), validator.TokensFromShares(transferred).TruncateInt())
+	return tokens, record, nil
+}
+
+// TransferShareRecord transfers the ownership of a tokenize share record, and
+// thus the rights to the unclaimed rewards of its delegation, from sender to
+// newOwner.
+func (k Keeper) TransferShareRecord(ctx sdk.Context, id uint64, sender, newOwner sdk.AccAddress) error {
+	record, found := k.GetTokenizeShareRecord(ctx, id)
+	if !found {
+		return types.ErrNoTokenizeShareRecord
+	}
+
+	if record.Owner != sender.String() {
+		return types.ErrNotTokenizeShareRecordOwner
+	}
+
+	k.RemoveTokenizeShareRecord(ctx, record)
+	record.Owner = newOwner.String()
+	k.SetTokenizeShareRecord(ctx, record)
+
+	return nil
+}
+
+// payTokenizeShareRecordRewards sends the balance of the module account of a
+// tokenize share record to its owner. The module account only receives the
+// rewards of its delegation, withdrawn whenever the delegation is modified.
+func (k Keeper) payTokenizeShareRecordRewards(ctx sdk.Context, record types.TokenizeShareRecord) error {
+	rewards := k.bankKeeper.GetAllBalances(ctx, record.GetModuleAddress())
+	if rewards.IsZero() {
+		return nil
+	}
+
+	return k.bankKeeper.SendCoins(ctx, record.GetModuleAddress(), record.GetOwnerAddress(), rewards)
+}
+
+// checkLiquidStakingCaps returns an error if tokenizing shares of validator
+// would exceed the liquid staking caps. A cap of 100% is disabled.
+func (k Keeper) checkLiquidStakingCaps(ctx sdk.Context, validator types.Validator, shares sdk.Dec) error {
+	if valCap := k.ValidatorLiquidStakingCap(ctx); valCap.LT(sdk.OneDec()) {
+		liquidShares := k.GetValidatorLiquidShares(ctx, validator.GetOperator()).Add(shares)
+		if liquidShares.GT(validator.DelegatorShares.Mul(valCap)) {
+			return types.ErrValidatorLiquidStakingCapExceeded.Wrapf(
+				"%s tokenized shares of %s delegator shares", liquidShares, validator.DelegatorShares,
+			)
+		}
+	}
+
+	if globalCap := k.GlobalLiquidStakingCap(ctx); globalCap.LT(sdk.OneDec()) {
+		liquidTokens := k.GetTotalLiquidStakedTokens(ctx).Add(validator.TokensFromShares(shares).TruncateInt())
+		bondedTokens := k.TotalBondedTokens(ctx)
+		if sdk.NewDecFromInt(liquidTokens).GT(sdk.NewDecFromInt(bondedTokens).Mul(globalCap)) {
+			return types.ErrGlobalLiquidStakingCapExceeded.Wrapf(
+				"%s tokenized tokens of %s bonded tokens", liquidTokens, bondedTokens,
+			)
+		}
+	}
+
+	return nil
+}
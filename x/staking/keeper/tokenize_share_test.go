@@ -0,0 +1,244 @@
+package keeper_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/cosmos-sdk/simapp"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	vestingtypes "github.com/cosmos/cosmos-sdk/x/auth/vesting/types"
+	"github.com/cosmos/cosmos-sdk/x/bank/testutil"
+	"github.com/cosmos/cosmos-sdk/x/staking/keeper"
+	"github.com/cosmos/cosmos-sdk/x/staking/teststaking"
+	"github.com/cosmos/cosmos-sdk/x/staking/types"
+)
+
+// setupTokenizeShares creates a bonded validator operated by the first address,
+// and a delegation of 30 tokens to it from the second address.
+func setupTokenizeShares(t *testing.T) (*simapp.SimApp, sdk.Context, []sdk.AccAddress, types.Validator) {
+	_, app, ctx := createTestInput(t)
+
+	addrDels := simapp.AddTestAddrsIncremental(app, ctx, 3, app.StakingKeeper.TokensFromConsensusPower(ctx, 100))
+	valAddr := sdk.ValAddress(addrDels[0])
+
+	validator := teststaking.NewValidator(t, valAddr, PKs[0])
+	validator = keeper.TestingUpdateValidator(app.StakingKeeper, ctx, validator, true)
+	_, err := app.StakingKeeper.Delegate(ctx, addrDels[0], app.StakingKeeper.TokensFromConsensusPower(ctx, 10), types.Unbonded, validator, true)
+	require.NoError(t, err)
+
+	validator, found := app.StakingKeeper.GetValidator(ctx, valAddr)
+	require.True(t, found)
+	_, err = app.StakingKeeper.Delegate(ctx, addrDels[1], app.StakingKeeper.TokensFromConsensusPower(ctx, 30), types.Unbonded, validator, true)
+	require.NoError(t, err)
+
+	// bond the validator
+	_, err = app.StakingKeeper.ApplyAndReturnValidatorSetUpdates(ctx)
+	require.NoError(t, err)
+	validator, found = app.StakingKeeper.GetValidator(ctx, valAddr)
+	require.True(t, found)
+	require.Equal(t, types.Bonded, validator.Status)
+
+	return app, ctx, addrDels, validator
+}
+
+func TestTokenizeAndRedeemShares(t *testing.T) {
+	app, ctx, addrDels, validator := setupTokenizeShares(t)
+	delAddr, owner := addrDels[1], addrDels[2]
+	valAddr := validator.GetOperator()
+	bondDenom := app.StakingKeeper.BondDenom(ctx)
+	bondedPool := app.StakingKeeper.GetBondedPool(ctx)
+	bondedBalance := app.BankKeeper.GetBalance(ctx, bondedPool.GetAddress(), bondDenom)
+
+	// the self-delegation of the operator cannot be tokenized
+	_, _, err := app.StakingKeeper.TokenizeShares(ctx, addrDels[0], valAddr, app.StakingKeeper.TokensFromConsensusPower(ctx, 1), owner)
+	require.ErrorIs(t, err, types.ErrTokenizeSelfDelegation)
+
+	// nor more than the delegation
+	_, _, err = app.StakingKeeper.TokenizeShares(ctx, delAddr, valAddr, app.StakingKeeper.TokensFromConsensusPower(ctx, 31), owner)
+	require.ErrorIs(t, err, sdkerrors.ErrInvalidRequest)
+
+	// tokenize 10 of the 30 tokens delegated
+	tokenized := app.StakingKeeper.TokensFromConsensusPower(ctx, 10)
+	shareToken, record, err := app.StakingKeeper.TokenizeShares(ctx, delAddr, valAddr, tokenized, owner)
+	require.NoError(t, err)
+	require.Equal(t, uint64(1), record.Id)
+	require.Equal(t, owner.String(), record.Owner)
+	require.Equal(t, valAddr.String()+"/1", shareToken.Denom)
+	require.Equal(t, tokenized, shareToken.Amount)
+	require.Equal(t, shareToken, app.BankKeeper.GetBalance(ctx, delAddr, shareToken.Denom))
+	tokenizeSharePool := app.AccountKeeper.GetModuleAddress(types.TokenizeSharePoolName)
+	require.True(t, app.BankKeeper.GetAllBalances(ctx, tokenizeSharePool).IsZero())
+
+	delegation, found := app.StakingKeeper.GetDelegation(ctx, delAddr, valAddr)
+	require.True(t, found)
+	require.Equal(t, sdk.NewDecFromInt(app.StakingKeeper.TokensFromConsensusPower(ctx, 20)), delegation.Shares)
+	delegation, found = app.StakingKeeper.GetDelegation(ctx, record.GetModuleAddress(), valAddr)
+	require.True(t, found)
+	require.Equal(t, sdk.NewDecFromInt(tokenized), delegation.Shares)
+
+	// the validator and the pools are unchanged
+	resValidator, found := app.StakingKeeper.GetValidator(ctx, valAddr)
+	require.True(t, found)
+	require.Equal(t, validator.Tokens, resValidator.Tokens)
+	require.Equal(t, validator.DelegatorShares, resValidator.DelegatorShares)
+	require.Equal(t, bondedBalance, app.BankKeeper.GetBalance(ctx, bondedPool.GetAddress(), bondDenom))
+
+	require.Equal(t, sdk.NewDecFromInt(tokenized), app.StakingKeeper.GetValidatorLiquidShares(ctx, valAddr))
+	require.Equal(t, tokenized, app.StakingKeeper.GetTotalLiquidStakedTokens(ctx))
+	require.Equal(t, []types.TokenizeShareRecord{record}, app.StakingKeeper.GetTokenizeShareRecordsByOwner(ctx, owner))
+
+	// share tokens of unknown records cannot be redeemed
+	_, _, err = app.StakingKeeper.RedeemTokensForShares(ctx, delAddr, sdk.NewInt64Coin(valAddr.String()+"/2", 1))
+	require.ErrorIs(t, err, types.ErrNoTokenizeShareRecord)
+
+	// redeem some of the share tokens, from another account
+	redeemer := addrDels[2]
+	redeemed := app.StakingKeeper.TokensFromConsensusPower(ctx, 4)
+	require.NoError(t, app.BankKeeper.SendCoins(ctx, delAddr, redeemer, sdk.NewCoins(sdk.NewCoin(shareToken.Denom, redeemed))))
+	tokens, _, err := app.StakingKeeper.RedeemTokensForShares(ctx, redeemer, sdk.NewCoin(shareToken.Denom, redeemed))
+	require.NoError(t, err)
+	require.Equal(t, sdk.NewCoin(bondDenom, redeemed), tokens)
+
+	delegation, found = app.StakingKeeper.GetDelegation(ctx, redeemer, valAddr)
+	require.True(t, found)
+	require.Equal(t, sdk.NewDecFromInt(redeemed), delegation.Shares)
+	require.Equal(t, sdk.NewDecFromInt(tokenized.Sub(redeemed)), app.StakingKeeper.GetValidatorLiquidShares(ctx, valAddr))
+	require.Equal(t, tokenized.Sub(redeemed), app.BankKeeper.GetSupply(ctx, shareToken.Denom).Amount)
+	_, found = app.StakingKeeper.GetTokenizeShareRecord(ctx, record.Id)
+	require.True(t, found)
+
+	// redeeming the last share tokens removes the record
+	_, _, err = app.StakingKeeper.RedeemTokensForShares(ctx, delAddr, sdk.NewCoin(shareToken.Denom, tokenized.Sub(redeemed)))
+	require.NoError(t, err)
+
+	delegation, found = app.StakingKeeper.GetDelegation(ctx, delAddr, valAddr)
+	require.True(t, found)
+	require.Equal(t, sdk.NewDecFromInt(app.StakingKeeper.TokensFromConsensusPower(ctx, 26)), delegation.Shares)
+	_, found = app.StakingKeeper.GetDelegation(ctx, record.GetModuleAddress(), valAddr)
+	require.False(t, found)
+	_, found = app.StakingKeeper.GetTokenizeShareRecord(ctx, record.Id)
+	require.False(t, found)
+	require.Empty(t, app.StakingKeeper.GetTokenizeShareRecordsByOwner(ctx, owner))
+	require.True(t, app.StakingKeeper.GetValidatorLiquidShares(ctx, valAddr).IsZero())
+	require.True(t, app.BankKeeper.GetSupply(ctx, shareToken.Denom).IsZero())
+	require.True(t, app.BankKeeper.GetAllBalances(ctx, tokenizeSharePool).IsZero())
+
+	resValidator, found = app.StakingKeeper.GetValidator(ctx, valAddr)
+	require.True(t, found)
+	require.Equal(t, validator.Tokens, resValidator.Tokens)
+	require.Equal(t, validator.DelegatorShares, resValidator.DelegatorShares)
+	require.Equal(t, bondedBalance, app.BankKeeper.GetBalance(ctx, bondedPool.GetAddress(), bondDenom))
+
+	// the next record gets a new id
+	_, record, err = app.StakingKeeper.TokenizeShares(ctx, delAddr, valAddr, tokenized, owner)
+	require.NoError(t, err)
+	require.Equal(t, uint64(2), record.Id)
+}
+
+func TestTokenizeSharesLiquidStakingCaps(t *testing.T) {
+	app, ctx, addrDels, validator := setupTokenizeShares(t)
+	delAddr, owner := addrDels[1], addrDels[2]
+	valAddr := validator.GetOperator()
+
+	// at most half of the 40 delegator shares of the validator
+	params := app.StakingKeeper.GetParams(ctx)
+	params.ValidatorLiquidStakingCap = sdk.NewDecWithPrec(5, 1)
+	app.StakingKeeper.SetParams(ctx, params)
+
+	_, _, err := app.StakingKeeper.TokenizeShares(ctx, delAddr, valAddr, app.StakingKeeper.TokensFromConsensusPower(ctx, 15), owner)
+	require.NoError(t, err)
+	_, _, err = app.StakingKeeper.TokenizeShares(ctx, delAddr, valAddr, app.StakingKeeper.TokensFromConsensusPower(ctx, 6), owner)
+	require.ErrorIs(t, err, types.ErrValidatorLiquidStakingCapExceeded)
+	_, _, err = app.StakingKeeper.TokenizeShares(ctx, delAddr, valAddr, app.StakingKeeper.TokensFromConsensusPower(ctx, 5), owner)
+	require.NoError(t, err)
+
+	// at most half of the 41 bonded tokens, including the genesis validator
+	require.Equal(t, app.StakingKeeper.TokensFromConsensusPower(ctx, 41), app.StakingKeeper.TotalBondedTokens(ctx))
+	params.ValidatorLiquidStakingCap = sdk.OneDec()
+	params.GlobalLiquidStakingCap = sdk.NewDecWithPrec(5, 1)
+	app.StakingKeeper.SetParams(ctx, params)
+
+	_, _, err = app.StakingKeeper.TokenizeShares(ctx, delAddr, valAddr, app.StakingKeeper.TokensFromConsensusPower(ctx, 1), owner)
+	require.ErrorIs(t, err, types.ErrGlobalLiquidStakingCapExceeded)
+	_, _, err = app.StakingKeeper.TokenizeShares(ctx, delAddr, valAddr, app.StakingKeeper.TokensFromConsensusPower(ctx, 1).QuoRaw(2), owner)
+	require.NoError(t, err)
+	require.Equal(t, app.StakingKeeper.TokensFromConsensusPower(ctx, 41).QuoRaw(2), app.StakingKeeper.GetTotalLiquidStakedTokens(ctx))
+}
+
+func TestTokenizeSharesVestingAccount(t *testing.T) {
+	app, ctx, addrDels, validator := setupTokenizeShares(t)
+	valAddr := validator.GetOperator()
+	bondDenom := app.StakingKeeper.BondDenom(ctx)
+
+	// 100 vesting tokens and 20 free tokens, all delegated
+	vestingAddr := sdk.AccAddress([]byte("vesting_delegator___"))
+	vestingCoins := sdk.NewCoins(sdk.NewCoin(bondDenom, app.StakingKeeper.TokensFromConsensusPower(ctx, 100)))
+	freeCoins := sdk.NewCoins(sdk.NewCoin(bondDenom, app.StakingKeeper.TokensFromConsensusPower(ctx, 20)))
+	baseAcc := authtypes.NewBaseAccountWithAddress(vestingAddr)
+	vacc := vestingtypes.NewContinuousVestingAccount(baseAcc, vestingCoins, ctx.BlockTime().Unix(), ctx.BlockTime().Add(time.Hour).Unix())
+	app.AccountKeeper.SetAccount(ctx, vacc)
+	require.NoError(t, testutil.FundAccount(app.BankKeeper, ctx, vestingAddr, vestingCoins.Add(freeCoins...)))
+
+	_, err := app.StakingKeeper.Delegate(ctx, vestingAddr, vestingCoins.Add(freeCoins...).AmountOf(bondDenom), types.Unbonded, validator, true)
+	require.NoError(t, err)
+
+	// the delegated vesting tokens cannot be tokenized
+	_, _, err = app.StakingKeeper.TokenizeShares(ctx, vestingAddr, valAddr, app.StakingKeeper.TokensFromConsensusPower(ctx, 21), addrDels[2])
+	require.ErrorIs(t, err, types.ErrExceedingFreeVestingDelegations)
+
+	_, _, err = app.StakingKeeper.TokenizeShares(ctx, vestingAddr, valAddr, app.StakingKeeper.TokensFromConsensusPower(ctx, 20), addrDels[2])
+	require.NoError(t, err)
+
+	acc := app.AccountKeeper.GetAccount(ctx, vestingAddr).(*vestingtypes.ContinuousVestingAccount)
+	require.True(t, acc.GetDelegatedFree().IsZero())
+	require.Equal(t, vestingCoins, acc.GetDelegatedVesting())
+}
+
+func TestTransferShareRecord(t *testing.T) {
+	app, ctx, addrDels, validator := setupTokenizeShares(t)
+	delAddr, owner := addrDels[1], addrDels[2]
+
+	_, record, err := app.StakingKeeper.TokenizeShares(ctx, delAddr, validator.GetOperator(), app.StakingKeeper.TokensFromConsensusPower(ctx, 10), owner)
+	require.NoError(t, err)
+
+	require.ErrorIs(t, app.StakingKeeper.TransferShareRecord(ctx, record.Id+1, owner, delAddr), types.ErrNoTokenizeShareRecord)
+	require.ErrorIs(t, app.StakingKeeper.TransferShareRecord(ctx, record.Id, delAddr, delAddr), types.ErrNotTokenizeShareRecordOwner)
+
+	require.NoError(t, app.StakingKeeper.TransferShareRecord(ctx, record.Id, owner, delAddr))
+
+	record, found := app.StakingKeeper.GetTokenizeShareRecord(ctx, record.Id)
+	require.True(t, found)
+	require.Equal(t, delAddr.String(), record.Owner)
+	require.Empty(t, app.StakingKeeper.GetTokenizeShareRecordsByOwner(ctx, owner))
+	require.Equal(t, []types.TokenizeShareRecord{record}, app.StakingKeeper.GetTokenizeShareRecordsByOwner(ctx, delAddr))
+}
+
+func TestTokenizeShareRecordsGenesis(t *testing.T) {
+	app, ctx, addrDels, validator := setupTokenizeShares(t)
+	valAddr := validator.GetOperator()
+
+	_, record, err := app.StakingKeeper.TokenizeShares(ctx, addrDels[1], valAddr, app.StakingKeeper.TokensFromConsensusPower(ctx, 10), addrDels[2])
+	require.NoError(t, err)
+	liquidShares := app.StakingKeeper.GetValidatorLiquidShares(ctx, valAddr)
+
+	genesis := app.StakingKeeper.ExportGenesis(ctx)
+	require.Equal(t, []types.TokenizeShareRecord{record}, genesis.TokenizeShareRecords)
+	require.Equal(t, record.Id, genesis.LastTokenizeShareRecordId)
+
+	// the tokenized shares of the validators are restored from the records
+	app.StakingKeeper.RemoveTokenizeShareRecord(ctx, record)
+	app.StakingKeeper.SetLastTokenizeShareRecordID(ctx, 0)
+	app.StakingKeeper.SetValidatorLiquidShares(ctx, valAddr, sdk.ZeroDec())
+
+	app.StakingKeeper.InitGenesis(ctx, genesis)
+
+	resRecord, found := app.StakingKeeper.GetTokenizeShareRecord(ctx, record.Id)
+	require.True(t, found)
+	require.Equal(t, record, resRecord)
+	require.Equal(t, record.Id, app.StakingKeeper.GetLastTokenizeShareRecordID(ctx))
+	require.Equal(t, liquidShares, app.StakingKeeper.GetValidatorLiquidShares(ctx, valAddr))
+}
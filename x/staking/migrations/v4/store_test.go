@@ -0,0 +1,36 @@
+package v4_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/cosmos-sdk/simapp"
+	"github.com/cosmos/cosmos-sdk/testutil"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
+	v4staking "github.com/cosmos/cosmos-sdk/x/staking/migrations/v4"
+	"github.com/cosmos/cosmos-sdk/x/staking/types"
+)
+
+func TestStoreMigration(t *testing.T) {
+	encCfg := simapp.MakeTestEncodingConfig()
+	stakingKey := sdk.NewKVStoreKey("staking")
+	tStakingKey := sdk.NewTransientStoreKey("transient_test")
+	ctx := testutil.DefaultContext(stakingKey, tStakingKey)
+	paramstore := paramtypes.NewSubspace(encCfg.Codec, encCfg.Amino, stakingKey, tStakingKey, "staking")
+
+	// Check no params
+	require.False(t, paramstore.Has(ctx, types.KeyGlobalLiquidStakingCap))
+	require.False(t, paramstore.Has(ctx, types.KeyValidatorLiquidStakingCap))
+
+	// Run migrations.
+	require.NoError(t, v4staking.MigrateStore(ctx, paramstore))
+
+	// Make sure the new params are set to their defaults.
+	var globalCap, validatorCap sdk.Dec
+	paramstore.Get(ctx, types.KeyGlobalLiquidStakingCap, &globalCap)
+	paramstore.Get(ctx, types.KeyValidatorLiquidStakingCap, &validatorCap)
+	require.Equal(t, types.DefaultGlobalLiquidStakingCap, globalCap)
+	require.Equal(t, types.DefaultValidatorLiquidStakingCap, validatorCap)
+}
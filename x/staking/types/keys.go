@@ -49,6 +49,11 @@ var (
 	ValidatorQueueKey    = []byte{0x43} // prefix for the timestamps in validator queue
 
 	HistoricalInfoKey = []byte{0x50} // prefix for the historical info
+
+	TokenizeShareRecordKey        = []byte{0x81} // prefix for each key to a tokenize share record
+	TokenizeShareRecordByOwnerKey = []byte{0x82} // prefix for each key to a tokenize share record index, by owner
+	LastTokenizeShareRecordIDKey  = []byte{0x83} // key for the id of the last tokenize share record
+	ValidatorLiquidSharesKey      = []byte{0x84} // prefix for the tokenized delegator shares of each validator
 )
 
 // GetValidatorKey creates the key for the validator with address
@@ -348,3 +353,36 @@ func GetREDsByDelToValDstIndexKey(delAddr sdk.AccAddress, valDstAddr sdk.ValAddr
 func GetHistoricalInfoKey(height int64) []byte {
 	return append(HistoricalInfoKey, []byte(strconv.FormatInt(height, 10))...)
 }
+
+// GetTokenizeShareRecordKey creates the key for the tokenize share record with id
+// VALUE: staking/TokenizeShareRecord
+func GetTokenizeShareRecordKey(id uint64) []byte {
+	return append(TokenizeShareRecordKey, sdk.Uint64ToBigEndian(id)...)
+}
+
+// GetTokenizeShareRecordsByOwnerKey creates the prefix for the tokenize share
+// records of an owner.
+func GetTokenizeShareRecordsByOwnerKey(owner sdk.AccAddress) []byte {
+	return append(TokenizeShareRecordByOwnerKey, address.MustLengthPrefix(owner)...)
+}
+
+// GetTokenizeShareRecordByOwnerKey creates the key for the tokenize share record
+// with id, in the index by owner.
+// VALUE: none (key rearrangement used)
+func GetTokenizeShareRecordByOwnerKey(owner sdk.AccAddress, id uint64) []byte {
+	return append(GetTokenizeShareRecordsByOwnerKey(owner), sdk.Uint64ToBigEndian(id)...)
+}
+
+// GetValidatorLiquidSharesKey creates the key for the tokenized delegator
+// shares of the validator with address
+// VALUE: sdk.DecProto
+func GetValidatorLiquidSharesKey(valAddr sdk.ValAddress) []byte {
+	return append(ValidatorLiquidSharesKey, address.MustLengthPrefix(valAddr)...)
+}
+
+// AddressFromValidatorLiquidSharesKey creates the validator operator address
+// from ValidatorLiquidSharesKey
+func AddressFromValidatorLiquidSharesKey(key []byte) sdk.ValAddress {
+	kv.AssertKeyAtLeastLength(key, 3)
+	return key[2:] // remove prefix bytes and address length
+}
@@ -35,4 +35,19 @@ func Test_validateParams(t *testing.T) {
 
 	params.MinCommissionRate = sdk.NewDec(2)
 	require.Error(t, params.Validate())
+
+	// validate liquid staking caps
+	params = types.DefaultParams()
+	params.GlobalLiquidStakingCap = sdk.NewDecWithPrec(-1, 1)
+	require.Error(t, params.Validate())
+
+	params.GlobalLiquidStakingCap = sdk.NewDecWithPrec(11, 1)
+	require.Error(t, params.Validate())
+
+	params = types.DefaultParams()
+	params.ValidatorLiquidStakingCap = sdk.Dec{}
+	require.Error(t, params.Validate())
+
+	params.ValidatorLiquidStakingCap = sdk.ZeroDec()
+	require.NoError(t, params.Validate())
 }
@@ -7,9 +7,13 @@ import "cosmossdk.io/math"
 // - NotBondedPool -> "not_bonded_tokens_pool"
 //
 // - BondedPool -> "bonded_tokens_pool"
+//
+// - TokenizeSharePool -> "tokenize_share_pool", minting and burning the share
+// tokens of the tokenized delegations
 const (
-	NotBondedPoolName = "not_bonded_tokens_pool"
-	BondedPoolName    = "bonded_tokens_pool"
+	NotBondedPoolName     = "not_bonded_tokens_pool"
+	BondedPoolName        = "bonded_tokens_pool"
+	TokenizeSharePoolName = "tokenize_share_pool"
 )
 
 // NewPool creates a new Pool instance used for queries
@@ -0,0 +1,94 @@
+package types
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+)
+
+// TokenizeShareModuleAccountPrefix is the prefix of the names of the module
+// accounts holding the tokenized delegations.
+const TokenizeShareModuleAccountPrefix = "tokenizeshare_"
+
+// NewTokenizeShareRecord creates a new TokenizeShareRecord instance.
+//
+//nolint:interfacer
+func NewTokenizeShareRecord(id uint64, owner sdk.AccAddress, valAddr sdk.ValAddress) TokenizeShareRecord {
+	return TokenizeShareRecord{
+		Id:            id,
+		Owner:         owner.String(),
+		ModuleAccount: fmt.Sprintf("%s%d", TokenizeShareModuleAccountPrefix, id),
+		Validator:     valAddr.String(),
+	}
+}
+
+// GetModuleAddress returns the address of the module account holding the
+// tokenized delegation.
+func (r TokenizeShareRecord) GetModuleAddress() sdk.AccAddress {
+	return authtypes.NewModuleAddress(r.ModuleAccount)
+}
+
+// GetShareTokenDenom returns the denom of the share tokens of the record, of
+// the form {validator}/{id}.
+func (r TokenizeShareRecord) GetShareTokenDenom() string {
+	return fmt.Sprintf("%s/%d", r.Validator, r.Id)
+}
+
+// GetOwnerAddress returns the owner address of the record.
+func (r TokenizeShareRecord) GetOwnerAddress() sdk.AccAddress {
+	owner, err := sdk.AccAddressFromBech32(r.Owner)
+	if err != nil {
+		panic(err)
+	}
+	return owner
+}
+
+// GetValidatorAddress returns the validator address of the record.
+func (r TokenizeShareRecord) GetValidatorAddress() sdk.ValAddress {
+	valAddr, err := sdk.ValAddressFromBech32(r.Validator)
+	if err != nil {
+		panic(err)
+	}
+	return valAddr
+}
+
+// Validate performs a stateless validation of the record.
+func (r TokenizeShareRecord) Validate() error {
+	if r.Id == 0 {
+		return fmt.Errorf("tokenize share record id cannot be zero")
+	}
+	if _, err := sdk.AccAddressFromBech32(r.Owner); err != nil {
+		return fmt.Errorf("invalid tokenize share record owner %s: %w", r.Owner, err)
+	}
+	if _, err := sdk.ValAddressFromBech32(r.Validator); err != nil {
+		return fmt.Errorf("invalid tokenize share record validator %s: %w", r.Validator, err)
+	}
+	if expected := fmt.Sprintf("%s%d", TokenizeShareModuleAccountPrefix, r.Id); r.ModuleAccount != expected {
+		return fmt.Errorf("invalid tokenize share record module account %s, expected %s", r.ModuleAccount, expected)
+	}
+	return nil
+}
+
+// ParseShareTokenDenom returns the validator address and the record id of a
+// share token denom.
+func ParseShareTokenDenom(denom string) (sdk.ValAddress, uint64, error) {
+	parts := strings.Split(denom, "/")
+	if len(parts) != 2 {
+		return nil, 0, ErrInvalidShareTokenDenom.Wrap(denom)
+	}
+
+	valAddr, err := sdk.ValAddressFromBech32(parts[0])
+	if err != nil {
+		return nil, 0, ErrInvalidShareTokenDenom.Wrapf("%s: %s", denom, err)
+	}
+
+	id, err := strconv.ParseUint(parts[1], 10, 64)
+	if err != nil || id == 0 {
+		return nil, 0, ErrInvalidShareTokenDenom.Wrap(denom)
+	}
+
+	return valAddr, id, nil
+}
@@ -0,0 +1,202 @@
+package cli
+
+import (
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/spf13/cobra"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/version"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	"github.com/cosmos/cosmos-sdk/x/tokenfactory/types"
+)
+
+// GetTxCmd returns the transaction commands for this module
+func GetTxCmd() *cobra.Command {
+	tokenfactoryTxCmd := &cobra.Command{
+		Use:                        types.ModuleName,
+		Short:                      "token factory transactions subcommands",
+		DisableFlagParsing:         true,
+		SuggestionsMinimumDistance: 2,
+		RunE:                       client.ValidateCmd,
+	}
+
+	tokenfactoryTxCmd.AddCommand(
+		NewCmdCreateDenom(),
+		NewCmdMint(),
+		NewCmdBurn(),
+		NewCmdChangeAdmin(),
+		NewCmdSetDenomMetadata(),
+	)
+
+	return tokenfactoryTxCmd
+}
+
+// NewCmdCreateDenom returns a CLI command handler for creating a
+// MsgCreateDenom transaction.
+func NewCmdCreateDenom() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "create-denom [subdenom]",
+		Args:  cobra.ExactArgs(1),
+		Short: "create the denom factory/{sender}/{subdenom}, paying the denom creation fee",
+		Example: fmt.Sprintf(`$ %s tx %s create-denom mytoken --from <account>`,
+			version.AppName, types.ModuleName),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgCreateDenom(clientCtx.GetFromAddress().String(), args[0])
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+// NewCmdMint returns a CLI command handler for creating a MsgMint
+// transaction.
+func NewCmdMint() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "mint [amount]",
+		Args:  cobra.ExactArgs(1),
+		Short: "mint coins of a denom to its admin",
+		Example: fmt.Sprintf(`$ %s tx %s mint 1000factory/<admin>/mytoken --from <admin>`,
+			version.AppName, types.ModuleName),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			amount, err := sdk.ParseCoinNormalized(args[0])
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgMint(clientCtx.GetFromAddress().String(), amount)
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+// NewCmdBurn returns a CLI command handler for creating a MsgBurn
+// transaction.
+func NewCmdBurn() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "burn [amount]",
+		Args:  cobra.ExactArgs(1),
+		Short: "burn coins of a denom from its admin",
+		Example: fmt.Sprintf(`$ %s tx %s burn 1000factory/<admin>/mytoken --from <admin>`,
+			version.AppName, types.ModuleName),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			amount, err := sdk.ParseCoinNormalized(args[0])
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgBurn(clientCtx.GetFromAddress().String(), amount)
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+// NewCmdChangeAdmin returns a CLI command handler for creating a
+// MsgChangeAdmin transaction.
+func NewCmdChangeAdmin() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "change-admin [denom] [new_admin]",
+		Args:  cobra.ExactArgs(2),
+		Short: "change the admin of a denom",
+		Long: strings.TrimSpace(fmt.Sprintf(`Change the admin of a denom.
+An empty new admin ("") leaves the denom without admin for good.
+
+Example:
+$ %s tx %s change-admin factory/<admin>/mytoken <new_admin> --from <admin>
+`, version.AppName, types.ModuleName),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgChangeAdmin(clientCtx.GetFromAddress().String(), args[0], args[1])
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+// NewCmdSetDenomMetadata returns a CLI command handler for creating a
+// MsgSetDenomMetadata transaction.
+func NewCmdSetDenomMetadata() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "set-denom-metadata [metadata_file]",
+		Args:  cobra.ExactArgs(1),
+		Short: "set the bank metadata of a denom from a JSON file",
+		Long: strings.TrimSpace(fmt.Sprintf(`Set the bank metadata of a denom from a JSON file.
+The base of the metadata must be the denom.
+
+Example:
+$ %s tx %s set-denom-metadata metadata.json --from <admin>
+
+Where metadata.json contains:
+
+{
+  "description": "My token",
+  "denom_units": [
+    {"denom": "factory/<admin>/mytoken", "exponent": 0},
+    {"denom": "mytoken", "exponent": 6}
+  ],
+  "base": "factory/<admin>/mytoken",
+  "display": "mytoken",
+  "name": "My token",
+  "symbol": "MTK"
+}
+`, version.AppName, types.ModuleName),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			bz, err := os.ReadFile(args[0])
+			if err != nil {
+				return err
+			}
+
+			var metadata banktypes.Metadata
+			if err := clientCtx.Codec.UnmarshalJSON(bz, &metadata); err != nil {
+				return err
+			}
+
+			msg := types.NewMsgSetDenomMetadata(clientCtx.GetFromAddress().String(), metadata)
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
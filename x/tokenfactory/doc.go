@@ -0,0 +1,9 @@
+/*
+Package tokenfactory implements a token factory, which lets any account create
+denoms of the form factory/{creator}/{subdenom} for a creation fee.
+
+The creator of a denom becomes its admin. The admin can mint and burn the
+denom, set its x/bank metadata, and hand over the admin role, or renounce it,
+to another account.
+*/
+package tokenfactory
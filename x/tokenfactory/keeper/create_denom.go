@@ -0,0 +1,80 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	"github.com/cosmos/cosmos-sdk/x/tokenfactory/types"
+)
+
+// CreateDenom creates the denom factory/{creator}/{subdenom}, with the creator
+// as admin, and charges the creator the denom creation fee.
+func (k Keeper) CreateDenom(ctx sdk.Context, creator sdk.AccAddress, subdenom string) (string, error) {
+	denom, err := k.validateCreateDenom(ctx, creator, subdenom)
+	if err != nil {
+		return "", err
+	}
+
+	if err := k.chargeForCreateDenom(ctx, creator); err != nil {
+		return "", err
+	}
+
+	if err := k.createDenomAfterValidation(ctx, creator, denom); err != nil {
+		return "", err
+	}
+
+	return denom, nil
+}
+
+// validateCreateDenom returns the denom of the creator and subdenom, and an
+// error if it is invalid or already exists.
+func (k Keeper) validateCreateDenom(ctx sdk.Context, creator sdk.AccAddress, subdenom string) (string, error) {
+	denom, err := types.GetTokenDenom(creator.String(), subdenom)
+	if err != nil {
+		return "", err
+	}
+
+	if _, err := k.GetAuthorityMetadata(ctx, denom); err == nil {
+		return "", types.ErrDenomExists.Wrap(denom)
+	}
+	if _, found := k.bankKeeper.GetDenomMetaData(ctx, denom); found {
+		return "", types.ErrDenomExists.Wrapf("%s already has bank metadata", denom)
+	}
+	if k.bankKeeper.HasSupply(ctx, denom) {
+		return "", types.ErrDenomExists.Wrapf("%s already has a supply", denom)
+	}
+
+	return denom, nil
+}
+
+// chargeForCreateDenom sends the denom creation fee from the creator to the
+// community pool.
+func (k Keeper) chargeForCreateDenom(ctx sdk.Context, creator sdk.AccAddress) error {
+	fee := k.GetParams(ctx).DenomCreationFee
+	if fee.IsZero() {
+		return nil
+	}
+
+	return k.distrKeeper.FundCommunityPool(ctx, fee, creator)
+}
+
+// createDenomAfterValidation sets the bank metadata of a new denom, if it is
+// not set yet, and makes the creator its admin.
+func (k Keeper) createDenomAfterValidation(ctx sdk.Context, creator sdk.AccAddress, denom string) error {
+	if _, found := k.bankKeeper.GetDenomMetaData(ctx, denom); !found {
+		k.bankKeeper.SetDenomMetaData(ctx, banktypes.Metadata{
+			DenomUnits: []*banktypes.DenomUnit{{
+				Denom:    denom,
+				Exponent: 0,
+			}},
+			Base:    denom,
+			Display: denom,
+		})
+	}
+
+	if err := k.SetAuthorityMetadata(ctx, denom, types.DenomAuthorityMetadata{Admin: creator.String()}); err != nil {
+		return err
+	}
+
+	k.addDenomFromCreator(ctx, creator, denom)
+	return nil
+}
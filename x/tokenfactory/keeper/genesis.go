@@ -0,0 +1,47 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/tokenfactory/types"
+)
+
+// InitGenesis sets the token factory params and denoms from the genesis
+// state.
+func (k Keeper) InitGenesis(ctx sdk.Context, genState *types.GenesisState) {
+	// ensure the module account is created
+	k.accountKeeper.GetModuleAccount(ctx, types.ModuleName)
+
+	k.SetParams(ctx, genState.Params)
+
+	for _, genDenom := range genState.FactoryDenoms {
+		creator, _, err := types.DeconstructDenom(genDenom.Denom)
+		if err != nil {
+			panic(err)
+		}
+		creatorAddr, err := sdk.AccAddressFromBech32(creator)
+		if err != nil {
+			panic(err)
+		}
+
+		if err := k.createDenomAfterValidation(ctx, creatorAddr, genDenom.Denom); err != nil {
+			panic(err)
+		}
+		if err := k.SetAuthorityMetadata(ctx, genDenom.Denom, genDenom.AuthorityMetadata); err != nil {
+			panic(err)
+		}
+	}
+}
+
+// ExportGenesis returns the token factory module's exported genesis.
+func (k Keeper) ExportGenesis(ctx sdk.Context) *types.GenesisState {
+	genDenoms := []types.GenesisDenom{}
+	k.IterateAuthorityMetadata(ctx, func(denom string, metadata types.DenomAuthorityMetadata) bool {
+		genDenoms = append(genDenoms, types.GenesisDenom{
+			Denom:             denom,
+			AuthorityMetadata: metadata,
+		})
+		return false
+	})
+
+	return types.NewGenesisState(k.GetParams(ctx), genDenoms)
+}
@@ -0,0 +1,43 @@
+package keeper_test
+
+import (
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"github.com/cosmos/cosmos-sdk/x/tokenfactory/types"
+)
+
+func (s *KeeperTestSuite) TestGRPCQueries() {
+	queryHelper := baseapp.NewQueryServerTestHelper(s.ctx, s.app.InterfaceRegistry())
+	types.RegisterQueryServer(queryHelper, s.app.TokenFactoryKeeper)
+	queryClient := types.NewQueryClient(queryHelper)
+	ctx := s.ctx.Context()
+
+	bitcoin := s.createDenom(s.addrs[0], "bitcoin")
+	litecoin := s.createDenom(s.addrs[0], "litecoin")
+
+	params, err := queryClient.Params(ctx, &types.QueryParamsRequest{})
+	s.Require().NoError(err)
+	s.Require().Equal(types.DefaultParams(), params.Params)
+
+	metadata, err := queryClient.DenomAuthorityMetadata(ctx, &types.QueryDenomAuthorityMetadataRequest{Denom: bitcoin})
+	s.Require().NoError(err)
+	s.Require().Equal(s.addrs[0].String(), metadata.AuthorityMetadata.Admin)
+
+	_, err = queryClient.DenomAuthorityMetadata(ctx, &types.QueryDenomAuthorityMetadataRequest{Denom: "stake"})
+	s.Require().Error(err)
+
+	denoms, err := queryClient.DenomsFromCreator(ctx, &types.QueryDenomsFromCreatorRequest{
+		Creator:    s.addrs[0].String(),
+		Pagination: &query.PageRequest{CountTotal: true},
+	})
+	s.Require().NoError(err)
+	s.Require().Equal([]string{bitcoin, litecoin}, denoms.Denoms)
+	s.Require().Equal(uint64(2), denoms.Pagination.Total)
+
+	denoms, err = queryClient.DenomsFromCreator(ctx, &types.QueryDenomsFromCreatorRequest{Creator: s.addrs[1].String()})
+	s.Require().NoError(err)
+	s.Require().Empty(denoms.Denoms)
+
+	_, err = queryClient.DenomsFromCreator(ctx, &types.QueryDenomsFromCreatorRequest{Creator: "invalid"})
+	s.Require().Error(err)
+}
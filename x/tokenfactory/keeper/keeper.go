@@ -0,0 +1,124 @@
+package keeper
+
+import (
+	"github.com/tendermint/tendermint/libs/log"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
+	"github.com/cosmos/cosmos-sdk/x/tokenfactory/types"
+)
+
+// Keeper of the token factory store
+type Keeper struct {
+	cdc        codec.BinaryCodec
+	storeKey   storetypes.StoreKey
+	paramSpace paramtypes.Subspace
+
+	accountKeeper types.AccountKeeper
+	bankKeeper    types.BankKeeper
+	distrKeeper   types.DistrKeeper
+}
+
+// NewKeeper creates a new token factory Keeper instance
+func NewKeeper(
+	cdc codec.BinaryCodec, storeKey storetypes.StoreKey, paramSpace paramtypes.Subspace,
+	ak types.AccountKeeper, bk types.BankKeeper, dk types.DistrKeeper,
+) Keeper {
+	// ensure the module account is set
+	if addr := ak.GetModuleAddress(types.ModuleName); addr == nil {
+		panic("the token factory module account has not been set")
+	}
+
+	// set KeyTable if it has not already been set
+	if !paramSpace.HasKeyTable() {
+		paramSpace = paramSpace.WithKeyTable(types.ParamKeyTable())
+	}
+
+	return Keeper{
+		cdc:           cdc,
+		storeKey:      storeKey,
+		paramSpace:    paramSpace,
+		accountKeeper: ak,
+		bankKeeper:    bk,
+		distrKeeper:   dk,
+	}
+}
+
+// Logger returns a module-specific logger.
+func (k Keeper) Logger(ctx sdk.Context) log.Logger {
+	return ctx.Logger().With("module", "x/"+types.ModuleName)
+}
+
+// GetParams returns the total set of token factory parameters.
+func (k Keeper) GetParams(ctx sdk.Context) (params types.Params) {
+	k.paramSpace.GetParamSet(ctx, &params)
+	return params
+}
+
+// SetParams sets the total set of token factory parameters.
+func (k Keeper) SetParams(ctx sdk.Context, params types.Params) {
+	k.paramSpace.SetParamSet(ctx, &params)
+}
+
+// GetAuthorityMetadata returns the authority metadata of a denom created by
+// the token factory.
+func (k Keeper) GetAuthorityMetadata(ctx sdk.Context, denom string) (types.DenomAuthorityMetadata, error) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.GetDenomAuthorityMetadataKey(denom))
+	if bz == nil {
+		return types.DenomAuthorityMetadata{}, types.ErrDenomDoesNotExist.Wrap(denom)
+	}
+
+	var metadata types.DenomAuthorityMetadata
+	k.cdc.MustUnmarshal(bz, &metadata)
+	return metadata, nil
+}
+
+// SetAuthorityMetadata sets the authority metadata of a denom.
+func (k Keeper) SetAuthorityMetadata(ctx sdk.Context, denom string, metadata types.DenomAuthorityMetadata) error {
+	if err := metadata.Validate(); err != nil {
+		return err
+	}
+
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.GetDenomAuthorityMetadataKey(denom), k.cdc.MustMarshal(&metadata))
+	return nil
+}
+
+// IterateAuthorityMetadata iterates over the denoms created by the token
+// factory and their authority metadata.
+func (k Keeper) IterateAuthorityMetadata(ctx sdk.Context, handler func(denom string, metadata types.DenomAuthorityMetadata) (stop bool)) {
+	store := ctx.KVStore(k.storeKey)
+	iter := sdk.KVStorePrefixIterator(store, types.DenomAuthorityMetadataPrefix)
+	defer iter.Close()
+	for ; iter.Valid(); iter.Next() {
+		denom := string(iter.Key()[len(types.DenomAuthorityMetadataPrefix):])
+		var metadata types.DenomAuthorityMetadata
+		k.cdc.MustUnmarshal(iter.Value(), &metadata)
+		if handler(denom, metadata) {
+			break
+		}
+	}
+}
+
+// GetDenomsFromCreator returns the denoms created by an account.
+func (k Keeper) GetDenomsFromCreator(ctx sdk.Context, creator sdk.AccAddress) []string {
+	store := ctx.KVStore(k.storeKey)
+	prefix := types.GetCreatorPrefix(creator)
+	iter := sdk.KVStorePrefixIterator(store, prefix)
+	defer iter.Close()
+
+	denoms := []string{}
+	for ; iter.Valid(); iter.Next() {
+		denoms = append(denoms, string(iter.Key()[len(prefix):]))
+	}
+	return denoms
+}
+
+// addDenomFromCreator adds a denom to the index of the denoms of its creator.
+func (k Keeper) addDenomFromCreator(ctx sdk.Context, creator sdk.AccAddress, denom string) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.GetCreatorDenomKey(creator, denom), []byte{})
+}
@@ -0,0 +1,191 @@
+package keeper_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+
+	"github.com/cosmos/cosmos-sdk/simapp"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	"github.com/cosmos/cosmos-sdk/x/tokenfactory/keeper"
+	"github.com/cosmos/cosmos-sdk/x/tokenfactory/types"
+)
+
+type KeeperTestSuite struct {
+	suite.Suite
+
+	app       *simapp.SimApp
+	ctx       sdk.Context
+	msgServer types.MsgServer
+	addrs     []sdk.AccAddress
+}
+
+func (s *KeeperTestSuite) SetupTest() {
+	s.app = simapp.Setup(s.T(), false)
+	s.ctx = s.app.BaseApp.NewContext(false, tmproto.Header{})
+	s.msgServer = keeper.NewMsgServerImpl(s.app.TokenFactoryKeeper)
+	s.addrs = simapp.AddTestAddrs(s.app, s.ctx, 3, sdk.NewInt(100_000_000))
+}
+
+func TestKeeperTestSuite(t *testing.T) {
+	suite.Run(t, new(KeeperTestSuite))
+}
+
+func (s *KeeperTestSuite) createDenom(creator sdk.AccAddress, subdenom string) string {
+	res, err := s.msgServer.CreateDenom(sdk.WrapSDKContext(s.ctx), types.NewMsgCreateDenom(creator.String(), subdenom))
+	s.Require().NoError(err)
+	return res.NewTokenDenom
+}
+
+func (s *KeeperTestSuite) TestCreateDenom() {
+	creator := s.addrs[0]
+	ctx := sdk.WrapSDKContext(s.ctx)
+	fee := types.DefaultParams().DenomCreationFee
+	balance := s.app.BankKeeper.GetAllBalances(s.ctx, creator)
+	communityPool := s.app.DistrKeeper.GetFeePoolCommunityCoins(s.ctx)
+
+	denom := s.createDenom(creator, "bitcoin")
+	s.Require().Equal("factory/"+creator.String()+"/bitcoin", denom)
+
+	// the creation fee goes to the community pool
+	s.Require().Equal(balance.Sub(fee...), s.app.BankKeeper.GetAllBalances(s.ctx, creator))
+	s.Require().Equal(communityPool.Add(sdk.NewDecCoinsFromCoins(fee...)...), s.app.DistrKeeper.GetFeePoolCommunityCoins(s.ctx))
+
+	metadata, err := s.app.TokenFactoryKeeper.GetAuthorityMetadata(s.ctx, denom)
+	s.Require().NoError(err)
+	s.Require().Equal(creator.String(), metadata.Admin)
+	s.Require().Equal([]string{denom}, s.app.TokenFactoryKeeper.GetDenomsFromCreator(s.ctx, creator))
+
+	bankMetadata, found := s.app.BankKeeper.GetDenomMetaData(s.ctx, denom)
+	s.Require().True(found)
+	s.Require().Equal(denom, bankMetadata.Base)
+
+	// a denom cannot be created twice
+	_, err = s.msgServer.CreateDenom(ctx, types.NewMsgCreateDenom(creator.String(), "bitcoin"))
+	s.Require().ErrorIs(err, types.ErrDenomExists)
+
+	// the same subdenom can be used by another creator
+	s.createDenom(s.addrs[1], "bitcoin")
+
+	// the creation fee can be disabled
+	s.app.TokenFactoryKeeper.SetParams(s.ctx, types.NewParams(sdk.NewCoins()))
+	balance = s.app.BankKeeper.GetAllBalances(s.ctx, creator)
+	s.createDenom(creator, "litecoin")
+	s.Require().Equal(balance, s.app.BankKeeper.GetAllBalances(s.ctx, creator))
+	s.Require().Len(s.app.TokenFactoryKeeper.GetDenomsFromCreator(s.ctx, creator), 2)
+
+	// the creator must be able to pay the creation fee
+	s.app.TokenFactoryKeeper.SetParams(s.ctx, types.NewParams(sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 1_000_000_000))))
+	_, err = s.msgServer.CreateDenom(ctx, types.NewMsgCreateDenom(creator.String(), "dogecoin"))
+	s.Require().Error(err)
+}
+
+func (s *KeeperTestSuite) TestMintAndBurn() {
+	admin, other := s.addrs[0], s.addrs[1]
+	ctx := sdk.WrapSDKContext(s.ctx)
+	denom := s.createDenom(admin, "bitcoin")
+
+	_, err := s.msgServer.Mint(ctx, types.NewMsgMint(admin.String(), sdk.NewInt64Coin(denom, 100)))
+	s.Require().NoError(err)
+	s.Require().Equal(sdk.NewInt64Coin(denom, 100), s.app.BankKeeper.GetBalance(s.ctx, admin, denom))
+	s.Require().Equal(sdk.NewInt64Coin(denom, 100), s.app.BankKeeper.GetSupply(s.ctx, denom))
+
+	// only the admin can mint and burn
+	_, err = s.msgServer.Mint(ctx, types.NewMsgMint(other.String(), sdk.NewInt64Coin(denom, 100)))
+	s.Require().ErrorIs(err, types.ErrUnauthorized)
+	_, err = s.msgServer.Burn(ctx, types.NewMsgBurn(other.String(), sdk.NewInt64Coin(denom, 100)))
+	s.Require().ErrorIs(err, types.ErrUnauthorized)
+
+	// denoms which were not created by the token factory cannot be minted
+	_, err = s.msgServer.Mint(ctx, types.NewMsgMint(admin.String(), sdk.NewInt64Coin("factory/"+admin.String()+"/unknown", 100)))
+	s.Require().ErrorIs(err, types.ErrDenomDoesNotExist)
+
+	_, err = s.msgServer.Burn(ctx, types.NewMsgBurn(admin.String(), sdk.NewInt64Coin(denom, 40)))
+	s.Require().NoError(err)
+	s.Require().Equal(sdk.NewInt64Coin(denom, 60), s.app.BankKeeper.GetBalance(s.ctx, admin, denom))
+	s.Require().Equal(sdk.NewInt64Coin(denom, 60), s.app.BankKeeper.GetSupply(s.ctx, denom))
+
+	// the admin cannot burn more than its balance
+	_, err = s.msgServer.Burn(ctx, types.NewMsgBurn(admin.String(), sdk.NewInt64Coin(denom, 61)))
+	s.Require().Error(err)
+}
+
+func (s *KeeperTestSuite) TestChangeAdmin() {
+	admin, newAdmin := s.addrs[0], s.addrs[1]
+	ctx := sdk.WrapSDKContext(s.ctx)
+	denom := s.createDenom(admin, "bitcoin")
+
+	_, err := s.msgServer.ChangeAdmin(ctx, types.NewMsgChangeAdmin(newAdmin.String(), denom, newAdmin.String()))
+	s.Require().ErrorIs(err, types.ErrUnauthorized)
+
+	_, err = s.msgServer.ChangeAdmin(ctx, types.NewMsgChangeAdmin(admin.String(), denom, newAdmin.String()))
+	s.Require().NoError(err)
+
+	// the former admin lost its rights
+	_, err = s.msgServer.Mint(ctx, types.NewMsgMint(admin.String(), sdk.NewInt64Coin(denom, 100)))
+	s.Require().ErrorIs(err, types.ErrUnauthorized)
+	_, err = s.msgServer.Mint(ctx, types.NewMsgMint(newAdmin.String(), sdk.NewInt64Coin(denom, 100)))
+	s.Require().NoError(err)
+
+	// the denom stays in the index of its creator
+	s.Require().Equal([]string{denom}, s.app.TokenFactoryKeeper.GetDenomsFromCreator(s.ctx, admin))
+
+	// renouncing the admin leaves the denom without admin for good
+	_, err = s.msgServer.ChangeAdmin(ctx, types.NewMsgChangeAdmin(newAdmin.String(), denom, ""))
+	s.Require().NoError(err)
+	metadata, err := s.app.TokenFactoryKeeper.GetAuthorityMetadata(s.ctx, denom)
+	s.Require().NoError(err)
+	s.Require().Empty(metadata.Admin)
+	_, err = s.msgServer.Mint(ctx, types.NewMsgMint(newAdmin.String(), sdk.NewInt64Coin(denom, 100)))
+	s.Require().ErrorIs(err, types.ErrUnauthorized)
+}
+
+func (s *KeeperTestSuite) TestSetDenomMetadata() {
+	admin, other := s.addrs[0], s.addrs[1]
+	ctx := sdk.WrapSDKContext(s.ctx)
+	denom := s.createDenom(admin, "bitcoin")
+
+	metadata := banktypes.Metadata{
+		Description: "Bitcoin on the token factory",
+		DenomUnits: []*banktypes.DenomUnit{
+			{Denom: denom, Exponent: 0},
+			{Denom: "btc", Exponent: 8},
+		},
+		Base:    denom,
+		Display: "btc",
+		Name:    "Bitcoin",
+		Symbol:  "BTC",
+	}
+
+	_, err := s.msgServer.SetDenomMetadata(ctx, types.NewMsgSetDenomMetadata(other.String(), metadata))
+	s.Require().ErrorIs(err, types.ErrUnauthorized)
+
+	_, err = s.msgServer.SetDenomMetadata(ctx, types.NewMsgSetDenomMetadata(admin.String(), metadata))
+	s.Require().NoError(err)
+	bankMetadata, found := s.app.BankKeeper.GetDenomMetaData(s.ctx, denom)
+	s.Require().True(found)
+	s.Require().Equal(metadata, bankMetadata)
+}
+
+func (s *KeeperTestSuite) TestGenesis() {
+	denom := s.createDenom(s.addrs[0], "bitcoin")
+	_, err := s.msgServer.ChangeAdmin(sdk.WrapSDKContext(s.ctx), types.NewMsgChangeAdmin(s.addrs[0].String(), denom, s.addrs[1].String()))
+	s.Require().NoError(err)
+
+	genState := s.app.TokenFactoryKeeper.ExportGenesis(s.ctx)
+	s.Require().Equal(types.DefaultParams(), genState.Params)
+	s.Require().Equal([]types.GenesisDenom{{
+		Denom:             denom,
+		AuthorityMetadata: types.DenomAuthorityMetadata{Admin: s.addrs[1].String()},
+	}}, genState.FactoryDenoms)
+
+	app := simapp.Setup(s.T(), false)
+	ctx := app.BaseApp.NewContext(false, tmproto.Header{})
+	app.TokenFactoryKeeper.InitGenesis(ctx, genState)
+	s.Require().Equal(genState, app.TokenFactoryKeeper.ExportGenesis(ctx))
+	s.Require().Equal([]string{denom}, app.TokenFactoryKeeper.GetDenomsFromCreator(ctx, s.addrs[0]))
+	_, found := app.BankKeeper.GetDenomMetaData(ctx, denom)
+	s.Require().True(found)
+}
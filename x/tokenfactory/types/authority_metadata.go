@@ -0,0 +1,16 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// Validate performs a basic validation of the authority metadata, an empty
+// admin is valid.
+func (m DenomAuthorityMetadata) Validate() error {
+	if m.Admin == "" {
+		return nil
+	}
+
+	_, err := sdk.AccAddressFromBech32(m.Admin)
+	return err
+}
@@ -0,0 +1,69 @@
+package types
+
+import (
+	"strings"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+const (
+	// ModuleDenomPrefix is the prefix of all the denoms created by the token
+	// factory.
+	ModuleDenomPrefix = "factory"
+
+	// MaxSubdenomLength is the maximum length of a subdenom.
+	MaxSubdenomLength = 44
+
+	// MaxHrpLength is the maximum length of a bech32 human readable part.
+	MaxHrpLength = 16
+
+	// MaxCreatorLength is the maximum length of the bech32 address of a
+	// creator.
+	MaxCreatorLength = 59 + MaxHrpLength
+)
+
+// GetTokenDenom returns the denom factory/{creator}/{subdenom}, and an error
+// if it is not a valid denom.
+func GetTokenDenom(creator, subdenom string) (string, error) {
+	if len(subdenom) > MaxSubdenomLength {
+		return "", ErrInvalidDenom.Wrapf("subdenom too long, max length is %d", MaxSubdenomLength)
+	}
+	if len(creator) > MaxCreatorLength {
+		return "", ErrInvalidDenom.Wrapf("creator too long, max length is %d", MaxCreatorLength)
+	}
+	if strings.Contains(creator, "/") {
+		return "", ErrInvalidDenom.Wrapf("creator cannot contain '/': %s", creator)
+	}
+
+	denom := strings.Join([]string{ModuleDenomPrefix, creator, subdenom}, "/")
+	if err := sdk.ValidateDenom(denom); err != nil {
+		return "", ErrInvalidDenom.Wrapf("%s", err)
+	}
+
+	return denom, nil
+}
+
+// DeconstructDenom splits a token factory denom into its creator and
+// subdenom.
+func DeconstructDenom(denom string) (creator, subdenom string, err error) {
+	if err := sdk.ValidateDenom(denom); err != nil {
+		return "", "", ErrInvalidDenom.Wrapf("%s", err)
+	}
+
+	parts := strings.Split(denom, "/")
+	if len(parts) < 3 {
+		return "", "", ErrInvalidDenom.Wrapf("not enough parts in denom %s", denom)
+	}
+	if parts[0] != ModuleDenomPrefix {
+		return "", "", ErrInvalidDenom.Wrapf("denom %s does not start with %s", denom, ModuleDenomPrefix)
+	}
+
+	creator = parts[1]
+	if _, err := sdk.AccAddressFromBech32(creator); err != nil {
+		return "", "", ErrInvalidDenom.Wrapf("invalid creator address %s: %s", creator, err)
+	}
+
+	// the subdenom may contain '/'
+	subdenom = strings.Join(parts[2:], "/")
+	return creator, subdenom, nil
+}
@@ -0,0 +1,14 @@
+package types
+
+import (
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// x/tokenfactory module sentinel errors
+var (
+	ErrDenomExists       = sdkerrors.Register(ModuleName, 2, "denom already exists")
+	ErrUnauthorized      = sdkerrors.Register(ModuleName, 3, "account is not the admin of the denom")
+	ErrInvalidDenom      = sdkerrors.Register(ModuleName, 4, "invalid denom")
+	ErrDenomDoesNotExist = sdkerrors.Register(ModuleName, 5, "denom does not exist")
+	ErrInvalidMetadata   = sdkerrors.Register(ModuleName, 6, "invalid denom metadata")
+)
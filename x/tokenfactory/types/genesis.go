@@ -0,0 +1,43 @@
+package types
+
+import (
+	"fmt"
+)
+
+// NewGenesisState creates a new GenesisState object
+func NewGenesisState(params Params, factoryDenoms []GenesisDenom) *GenesisState {
+	return &GenesisState{
+		Params:        params,
+		FactoryDenoms: factoryDenoms,
+	}
+}
+
+// DefaultGenesisState returns a default token factory module genesis state.
+func DefaultGenesisState() *GenesisState {
+	return NewGenesisState(DefaultParams(), []GenesisDenom{})
+}
+
+// Validate performs basic genesis state validation returning an error upon any
+// failure.
+func (gs GenesisState) Validate() error {
+	if err := gs.Params.Validate(); err != nil {
+		return err
+	}
+
+	seen := make(map[string]bool, len(gs.FactoryDenoms))
+	for _, denom := range gs.FactoryDenoms {
+		if _, _, err := DeconstructDenom(denom.Denom); err != nil {
+			return err
+		}
+		if seen[denom.Denom] {
+			return fmt.Errorf("duplicate denom %s", denom.Denom)
+		}
+		seen[denom.Denom] = true
+
+		if err := denom.AuthorityMetadata.Validate(); err != nil {
+			return fmt.Errorf("invalid admin of denom %s: %w", denom.Denom, err)
+		}
+	}
+
+	return nil
+}
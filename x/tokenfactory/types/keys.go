@@ -0,0 +1,39 @@
+package types
+
+import (
+	"github.com/cosmos/cosmos-sdk/types/address"
+)
+
+const (
+	// ModuleName is the name of this module
+	ModuleName = "tokenfactory"
+
+	// StoreKey is the prefix under which we store this module's data
+	StoreKey = ModuleName
+
+	// RouterKey is the message route for the token factory module
+	RouterKey = ModuleName
+)
+
+// KVStore keys
+var (
+	DenomAuthorityMetadataPrefix = []byte{0x01} // prefix for the authority metadata of a denom
+	CreatorDenomPrefix           = []byte{0x02} // prefix for the index of the denoms of a creator
+)
+
+// GetDenomAuthorityMetadataKey creates the key for the authority metadata of a
+// denom.
+func GetDenomAuthorityMetadataKey(denom string) []byte {
+	return append(DenomAuthorityMetadataPrefix, []byte(denom)...)
+}
+
+// GetCreatorPrefix creates the prefix of the denoms created by an account.
+func GetCreatorPrefix(creator []byte) []byte {
+	return append(CreatorDenomPrefix, address.MustLengthPrefix(creator)...)
+}
+
+// GetCreatorDenomKey creates the key of a denom in the index of the denoms of
+// its creator.
+func GetCreatorDenomKey(creator []byte, denom string) []byte {
+	return append(GetCreatorPrefix(creator), []byte(denom)...)
+}
@@ -0,0 +1,177 @@
+package types_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	"github.com/cosmos/cosmos-sdk/x/tokenfactory/types"
+)
+
+var (
+	addr1 = sdk.AccAddress("addr1_______________").String()
+	addr2 = sdk.AccAddress("addr2_______________").String()
+	denom = "factory/" + addr1 + "/bitcoin"
+)
+
+func TestGetTokenDenom(t *testing.T) {
+	tests := []struct {
+		name     string
+		creator  string
+		subdenom string
+		expErr   bool
+	}{
+		{"valid", addr1, "bitcoin", false},
+		{"subdenom with slash", addr1, "bit/coin", false},
+		{"empty subdenom", addr1, "", false},
+		{"subdenom too long", addr1, "abcdefghijklmnopqrstuvwxyzabcdefghijklmnopqrstuvwxyz", true},
+		{"creator with slash", "cosmos/1", "bitcoin", true},
+		{"invalid characters", addr1, "bit$coin", true},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			denom, err := types.GetTokenDenom(tc.creator, tc.subdenom)
+			if tc.expErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+
+			creator, subdenom, err := types.DeconstructDenom(denom)
+			require.NoError(t, err)
+			require.Equal(t, tc.creator, creator)
+			require.Equal(t, tc.subdenom, subdenom)
+		})
+	}
+}
+
+func TestDeconstructDenom(t *testing.T) {
+	tests := []struct {
+		name   string
+		denom  string
+		expErr bool
+	}{
+		{"valid", denom, false},
+		{"not a factory denom", "stake", true},
+		{"wrong prefix", "ibc/" + addr1 + "/bitcoin", true},
+		{"invalid creator", "factory/cosmos1invalid/bitcoin", true},
+		{"missing subdenom", "factory/" + addr1, true},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			_, _, err := types.DeconstructDenom(tc.denom)
+			if tc.expErr {
+				require.ErrorIs(t, err, types.ErrInvalidDenom)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestMsgsValidateBasic(t *testing.T) {
+	metadata := banktypes.Metadata{
+		DenomUnits: []*banktypes.DenomUnit{{Denom: denom, Exponent: 0}},
+		Base:       denom,
+		Display:    denom,
+		Name:       "Bitcoin",
+		Symbol:     "BTC",
+	}
+	stakeMetadata := banktypes.Metadata{
+		DenomUnits: []*banktypes.DenomUnit{{Denom: "stake", Exponent: 0}},
+		Base:       "stake",
+		Display:    "stake",
+		Name:       "Stake",
+		Symbol:     "STAKE",
+	}
+
+	tests := []struct {
+		name   string
+		msg    sdk.Msg
+		expErr bool
+	}{
+		{"create denom", types.NewMsgCreateDenom(addr1, "bitcoin"), false},
+		{"create denom: invalid sender", types.NewMsgCreateDenom("", "bitcoin"), true},
+		{"create denom: invalid subdenom", types.NewMsgCreateDenom(addr1, "bit$coin"), true},
+		{"mint", types.NewMsgMint(addr1, sdk.NewInt64Coin(denom, 100)), false},
+		{"mint: invalid sender", types.NewMsgMint("", sdk.NewInt64Coin(denom, 100)), true},
+		{"mint: zero amount", types.NewMsgMint(addr1, sdk.NewInt64Coin(denom, 0)), true},
+		{"mint: not a factory denom", types.NewMsgMint(addr1, sdk.NewInt64Coin("stake", 100)), true},
+		{"burn", types.NewMsgBurn(addr1, sdk.NewInt64Coin(denom, 100)), false},
+		{"burn: zero amount", types.NewMsgBurn(addr1, sdk.NewInt64Coin(denom, 0)), true},
+		{"burn: not a factory denom", types.NewMsgBurn(addr1, sdk.NewInt64Coin("stake", 100)), true},
+		{"change admin", types.NewMsgChangeAdmin(addr1, denom, addr2), false},
+		{"change admin: renounce", types.NewMsgChangeAdmin(addr1, denom, ""), false},
+		{"change admin: invalid new admin", types.NewMsgChangeAdmin(addr1, denom, "invalid"), true},
+		{"change admin: not a factory denom", types.NewMsgChangeAdmin(addr1, "stake", addr2), true},
+		{"set denom metadata", types.NewMsgSetDenomMetadata(addr1, metadata), false},
+		{"set denom metadata: invalid metadata", types.NewMsgSetDenomMetadata(addr1, banktypes.Metadata{Base: denom}), true},
+		{"set denom metadata: not a factory denom", types.NewMsgSetDenomMetadata(addr1, stakeMetadata), true},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.msg.ValidateBasic()
+			if tc.expErr {
+				require.Error(t, err)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestGenesisStateValidate(t *testing.T) {
+	tests := []struct {
+		name     string
+		genState *types.GenesisState
+		expErr   bool
+	}{
+		{"default", types.DefaultGenesisState(), false},
+		{
+			"valid",
+			types.NewGenesisState(types.DefaultParams(), []types.GenesisDenom{
+				{Denom: denom, AuthorityMetadata: types.DenomAuthorityMetadata{Admin: addr2}},
+				{Denom: denom + "2", AuthorityMetadata: types.DenomAuthorityMetadata{}},
+			}),
+			false,
+		},
+		{
+			"invalid params",
+			types.NewGenesisState(types.NewParams(sdk.Coins{sdk.Coin{Denom: "stake", Amount: sdk.NewInt(-1)}}), nil),
+			true,
+		},
+		{
+			"duplicate denom",
+			types.NewGenesisState(types.DefaultParams(), []types.GenesisDenom{{Denom: denom}, {Denom: denom}}),
+			true,
+		},
+		{
+			"not a factory denom",
+			types.NewGenesisState(types.DefaultParams(), []types.GenesisDenom{{Denom: "stake"}}),
+			true,
+		},
+		{
+			"invalid admin",
+			types.NewGenesisState(types.DefaultParams(), []types.GenesisDenom{
+				{Denom: denom, AuthorityMetadata: types.DenomAuthorityMetadata{Admin: "invalid"}},
+			}),
+			true,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.genState.Validate()
+			if tc.expErr {
+				require.Error(t, err)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}